@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -20,13 +21,21 @@ func init() {
 		"queue", "", "queue to cancel jobs from (requires job set to be specified)")
 	cancelCmd.Flags().String(
 		"jobSet", "", "jobSet to cancel (requires queue to be specified)")
+	cancelCmd.Flags().Duration(
+		"olderThan", 0, "cancel only jobs submitted longer ago than this duration (requires queue to be specified, job set is optional)")
+	cancelCmd.Flags().Bool(
+		"all", false, "cancel all queued and leased jobs of the queue (requires queue to be specified)")
+	cancelCmd.Flags().String(
+		"reason", "", "reason of the cancellation, included in the cancelled events")
 }
 
 var cancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancels jobs in armada",
-	Long:  `Cancels jobs either by jobId or by combination of queue & job set.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Cancels jobs either by jobId or by combination of queue & job set.
+Jobs submitted before given age can be cancelled by specifying queue (and optionally job set) together with olderThan flag.
+All jobs of a queue can be cancelled by specifying queue together with all flag.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
@@ -36,13 +45,41 @@ var cancelCmd = &cobra.Command{
 			jobId, _ := cmd.Flags().GetString("jobId")
 			queue, _ := cmd.Flags().GetString("queue")
 			jobSet, _ := cmd.Flags().GetString("jobSet")
+			olderThan, _ := cmd.Flags().GetDuration("olderThan")
+			all, _ := cmd.Flags().GetBool("all")
+			reason, _ := cmd.Flags().GetString("reason")
 
 			ctx, cancel := common.ContextWithDefaultTimeout()
 			defer cancel()
+
+			if all {
+				result, e := client.CancelJobsInQueue(ctx, &api.JobCancelQueueRequest{Queue: queue, Reason: reason})
+				if e != nil {
+					exitWithError(e)
+				}
+				log.Infof("Cancelled %d jobs of queue %s, %d jobs were already finished", result.CancelledCount, queue, result.AlreadyFinishedCount)
+				return
+			}
+
+			if olderThan > 0 {
+				result, e := client.CancelJobsSubmittedBefore(ctx, &api.JobCancelSubmittedBeforeRequest{
+					Queue:           queue,
+					JobSetId:        jobSet,
+					SubmittedBefore: time.Now().Add(-olderThan),
+					Reason:          reason,
+				})
+				if e != nil {
+					exitWithError(e)
+				}
+				log.Infof("Cancellation request submitted for %d jobs", result.CancelledCount)
+				return
+			}
+
 			result, e := client.CancelJobs(ctx, &api.JobCancelRequest{
 				JobId:    jobId,
 				JobSetId: jobSet,
 				Queue:    queue,
+				Reason:   reason,
 			})
 			if e != nil {
 				exitWithError(e)
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(cancelReasonCmd)
+}
+
+var cancelReasonCmd = &cobra.Command{
+	Use:   "cancel-reason jobId",
+	Short: "Prints out why the job was cancelled.",
+	Long:  `Prints out the reason the job was cancelled with, reasons are kept for a week after the job was cancelled.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		jobId := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			cancelReason, e := submitClient.GetJobCancelReason(ctx, &api.JobCancelReasonRequest{JobId: jobId})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if cancelReason.Reason == "" {
+				log.Infof("Job %s was not cancelled with a reason", cancelReason.JobId)
+				return
+			}
+			log.Infof("Job %s was cancelled: %s", cancelReason.JobId, cancelReason.Reason)
+		})
+	},
+}
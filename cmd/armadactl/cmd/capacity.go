@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(capacityCmd)
+	capacityCmd.Flags().String("pool", "", "Only print capacity of this pool")
+}
+
+var capacityCmd = &cobra.Command{
+	Use:   "capacity",
+	Short: "Prints out largest node and total allocatable resources of each pool.",
+	Long: `Prints out largest node and total allocatable resources of each pool.
+Jobs with a pod requesting more than the largest node of every pool can never be scheduled.`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		pool, _ := cmd.Flags().GetString("pool")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			capacity, e := submitClient.GetPoolCapacity(ctx, &api.PoolCapacityRequest{Pool: pool})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if len(capacity.Pools) == 0 {
+				log.Info("No active cluster.")
+			}
+			for _, poolCapacity := range capacity.Pools {
+				log.Infof("Pool %s (%d clusters): largest node %v, total %v",
+					poolCapacity.Pool, poolCapacity.Clusters, poolCapacity.LargestNodeAllocatable, poolCapacity.TotalAllocatable)
+			}
+		})
+	},
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(clusterCmd)
+}
+
+var clusterCmd = &cobra.Command{
+	Use:   "cluster jobId",
+	Short: "Prints out cluster and pool the job is running on.",
+	Long:  `Prints out cluster and pool the job is leased to, or the last cluster it ran on for a recently finished job.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		jobId := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			info, e := submitClient.GetJobCluster(ctx, &api.JobClusterRequest{JobId: jobId})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if info.Leased {
+				log.Infof("Job %s is leased to cluster %s (pool %s)", info.JobId, info.ClusterId, info.Pool)
+			} else {
+				log.Infof("Job %s is no longer running, it last ran on cluster %s (pool %s)", info.JobId, info.ClusterId, info.Pool)
+			}
+		})
+	},
+}
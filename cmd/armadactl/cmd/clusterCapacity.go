@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(clusterCapacityCmd)
+	clusterCapacityCmd.Flags().String("pool", "", "Only print capacity of this pool")
+	clusterCapacityCmd.Flags().Bool("node-types", false, "Also print allocatable resources of node types of each cluster")
+}
+
+var clusterCapacityCmd = &cobra.Command{
+	Use:   "cluster-capacity",
+	Short: "Prints out allocatable, used and available resources of each pool and cluster.",
+	Long: `Prints out allocatable, used and available resources of each pool and cluster,
+together with the age of the reports they are computed from.`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		pool, _ := cmd.Flags().GetString("pool")
+		nodeTypes, _ := cmd.Flags().GetBool("node-types")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			report, e := submitClient.GetClusterCapacityReport(ctx, &api.ClusterCapacityReportRequest{Pool: pool})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if len(report.Pools) == 0 {
+				log.Info("No active cluster.")
+			}
+			for _, poolCapacity := range report.Pools {
+				log.Infof("Pool %s (report age %s): allocatable %v, used %v, available %v",
+					poolCapacity.Pool, poolCapacity.ReportAge.Round(time.Second),
+					common.ComputeResources(poolCapacity.Allocatable), common.ComputeResources(poolCapacity.Used), common.ComputeResources(poolCapacity.Available))
+				for _, clusterCapacity := range poolCapacity.Clusters {
+					log.Infof("  Cluster %s (report age %s): allocatable %v, used %v, available %v",
+						clusterCapacity.ClusterId, clusterCapacity.ReportAge.Round(time.Second),
+						common.ComputeResources(clusterCapacity.Allocatable), common.ComputeResources(clusterCapacity.Used), common.ComputeResources(clusterCapacity.Available))
+					if nodeTypes {
+						for _, nodeType := range clusterCapacity.NodeTypes {
+							log.Infof("    Node type %v, labels %v, taints %d", common.ComputeResources(nodeType.AllocatableResources), nodeType.Labels, len(nodeType.Taints))
+						}
+					}
+				}
+			}
+		})
+	},
+}
@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client"
@@ -13,18 +17,58 @@ import (
 
 func init() {
 	rootCmd.AddCommand(createQueueCmd)
-	createQueueCmd.Flags().Float64(
-		"priorityFactor", 1,
-		"Set queue priority factor - lower number makes queue more important, must be > 0.")
-	createQueueCmd.Flags().StringSlice(
+	addQueueFlags(createQueueCmd, "defaults to current user", 1, " Queues created from a template default to the priority factor of the template.")
+	createQueueCmd.Flags().String(
+		"template", "",
+		"Name of server configured queue template providing priority factor, resource limits, event retention and default pod labels not set by other flags.")
+}
+
+func addQueueFlags(cmd *cobra.Command, ownersDefault string, priorityFactorDefault float64, priorityFactorDescription string) {
+	cmd.Flags().Float64(
+		"priorityFactor", priorityFactorDefault,
+		"Set queue priority factor - lower number makes queue more important, must be > 0."+priorityFactorDescription)
+	cmd.Flags().StringSlice(
 		"owners", []string{},
-		"Comma separated list of queue owners, defaults to current user.")
-	createQueueCmd.Flags().StringSlice(
+		"Comma separated list of queue owners, "+ownersDefault+".")
+	cmd.Flags().StringSlice(
 		"groupOwners", []string{},
 		"Comma separated list of queue group owners, defaults to empty list.")
-	createQueueCmd.Flags().StringToString(
+	cmd.Flags().StringToString(
 		"resourceLimits", map[string]string{},
-		"Command separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2")
+		"Comma separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2")
+	cmd.Flags().StringToString(
+		"resourceFloor", map[string]string{},
+		"Comma separated list of resource fractions always reserved for the queue while it has queued jobs, defaults to empty list. Example: --resourceFloor cpu=0.1,memory=0.1")
+	cmd.Flags().StringToString(
+		"resourceQuota", map[string]string{},
+		"Comma separated list of maximum resources leased and running jobs of the queue use on all clusters together, defaults to no quota. Example: --resourceQuota cpu=100,memory=400Gi,nvidia.com/gpu=8")
+	cmd.Flags().Duration(
+		"eventRetention", 0,
+		"Set how long events of queue job sets are kept, defaults to server wide retention policy.")
+	cmd.Flags().Int64(
+		"eventMaxLength", 0,
+		"Set maximum number of events kept per job set, older events are removed, defaults to no limit.")
+	cmd.Flags().Uint32(
+		"maxPodSpecSizeBytes", 0,
+		"Set maximum serialized size of a single job pod spec, defaults to server wide limit.")
+	cmd.Flags().Bool(
+		"requireContainerCommand", false,
+		"Reject jobs with containers which do not specify command or args and rely on the image entrypoint.")
+	cmd.Flags().StringSlice(
+		"allowedVolumeTypes", []string{},
+		"Comma separated list of volume types jobs of the queue can use, defaults to all types. Example: --allowedVolumeTypes persistentVolumeClaim,configMap,emptyDir")
+	cmd.Flags().Bool(
+		"requireEmptyDirSizeLimit", false,
+		"Reject jobs with emptyDir volumes which do not set sizeLimit.")
+	cmd.Flags().StringArray(
+		"schedulingWindow", []string{},
+		"Restrict when jobs of the queue are leased, can be repeated, defaults to always. Example: --schedulingWindow \"Mon,Tue 22:00-06:00\"")
+	cmd.Flags().StringToString(
+		"defaultPodLabels", map[string]string{},
+		"Comma separated list of labels added to pods of all queue jobs which do not set them, defaults to empty list. Example: --defaultPodLabels team=ml,cost-center=42")
+	cmd.Flags().StringToString(
+		"volumeClaimTemplate", map[string]string{},
+		"Comma separated settings of a volume claim created for every pod of the queue jobs and mounted into its containers, keys are name, mountPath, storage, storageClassName (defaults to the cluster default) and accessMode (defaults to ReadWriteOnce). Example: --volumeClaimTemplate name=scratch,mountPath=/scratch,storage=100Gi")
 }
 
 // createQueueCmd represents the createQueue command
@@ -36,35 +80,139 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		queue := args[0]
-		priority, _ := cmd.Flags().GetFloat64("priorityFactor")
-		owners, _ := cmd.Flags().GetStringSlice("owners")
-		groups, _ := cmd.Flags().GetStringSlice("groupOwners")
-		resourceLimits, _ := cmd.Flags().GetStringToString("resourceLimits")
-		resourceLimitsFloat, err := convertResourceLimitsToFloat64(resourceLimits)
+		queue, err := queueFromFlags(cmd, args[0])
 		if err != nil {
 			exitWithError(err)
 		}
+		queue.Template, _ = cmd.Flags().GetString("template")
+		if queue.Template != "" && !cmd.Flags().Changed("priorityFactor") {
+			// the server fills it from the template
+			queue.PriorityFactor = 0
+		}
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
-			e := client.CreateQueue(submissionClient, &api.Queue{
-				Name:           queue,
-				PriorityFactor: priority,
-				UserOwners:     owners,
-				GroupOwners:    groups,
-				ResourceLimits: resourceLimitsFloat})
+			e := client.CreateQueue(submissionClient, queue)
 
 			if e != nil {
 				exitWithError(e)
 			}
-			log.Infof("Queue %s created.", queue)
+			log.Infof("Queue %s created.", queue.Name)
 		})
 	},
 }
 
+func queueFromFlags(cmd *cobra.Command, name string) (*api.Queue, error) {
+	priority, _ := cmd.Flags().GetFloat64("priorityFactor")
+	owners, _ := cmd.Flags().GetStringSlice("owners")
+	groups, _ := cmd.Flags().GetStringSlice("groupOwners")
+	resourceLimits, _ := cmd.Flags().GetStringToString("resourceLimits")
+	resourceFloor, _ := cmd.Flags().GetStringToString("resourceFloor")
+	resourceQuota, _ := cmd.Flags().GetStringToString("resourceQuota")
+	eventRetention, _ := cmd.Flags().GetDuration("eventRetention")
+	eventMaxLength, _ := cmd.Flags().GetInt64("eventMaxLength")
+	maxPodSpecSize, _ := cmd.Flags().GetUint32("maxPodSpecSizeBytes")
+	requireContainerCommand, _ := cmd.Flags().GetBool("requireContainerCommand")
+	allowedVolumeTypes, _ := cmd.Flags().GetStringSlice("allowedVolumeTypes")
+	requireEmptyDirSizeLimit, _ := cmd.Flags().GetBool("requireEmptyDirSizeLimit")
+	schedulingWindowValues, _ := cmd.Flags().GetStringArray("schedulingWindow")
+	defaultPodLabels, _ := cmd.Flags().GetStringToString("defaultPodLabels")
+	volumeClaimTemplateSettings, _ := cmd.Flags().GetStringToString("volumeClaimTemplate")
+	resourceLimitsFloat, err := convertResourceLimitsToFloat64(resourceLimits)
+	if err != nil {
+		return nil, err
+	}
+	resourceFloorFloat, err := convertResourceLimitsToFloat64(resourceFloor)
+	if err != nil {
+		return nil, err
+	}
+	resourceQuotaQuantities, err := convertResourceQuota(resourceQuota)
+	if err != nil {
+		return nil, err
+	}
+	schedulingWindows, err := parseSchedulingWindows(schedulingWindowValues)
+	if err != nil {
+		return nil, err
+	}
+	volumeClaimTemplate, err := parseVolumeClaimTemplate(volumeClaimTemplateSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Queue{
+		Name:                     name,
+		PriorityFactor:           priority,
+		UserOwners:               owners,
+		GroupOwners:              groups,
+		ResourceLimits:           resourceLimitsFloat,
+		EventRetention:           createEventRetention(eventRetention, eventMaxLength),
+		SchedulingWindows:        schedulingWindows,
+		MaxPodSpecSizeBytes:      maxPodSpecSize,
+		RequireContainerCommand:  requireContainerCommand,
+		AllowedVolumeTypes:       allowedVolumeTypes,
+		RequireEmptyDirSizeLimit: requireEmptyDirSizeLimit,
+		ResourceFloor:            resourceFloorFloat,
+		ResourceQuota:            resourceQuotaQuantities,
+		DefaultPodLabels:         defaultPodLabels,
+		VolumeClaimTemplate:      volumeClaimTemplate}, nil
+}
+
+func createEventRetention(retentionDuration time.Duration, maxLength int64) *api.QueueEventRetention {
+	if retentionDuration == 0 && maxLength == 0 {
+		return nil
+	}
+	return &api.QueueEventRetention{RetentionDuration: retentionDuration, MaxLength: maxLength}
+}
+
+func parseSchedulingWindows(values []string) ([]*api.QueueSchedulingWindow, error) {
+	windows := make([]*api.QueueSchedulingWindow, 0, len(values))
+	for _, value := range values {
+		window := &api.QueueSchedulingWindow{}
+		timeRange := value
+		if parts := strings.Fields(value); len(parts) == 2 {
+			window.Days = strings.Split(parts[0], ",")
+			timeRange = parts[1]
+		}
+		times := strings.Split(timeRange, "-")
+		if len(times) != 2 {
+			return nil, fmt.Errorf("invalid scheduling window %q, expected format [Mon,Tue] HH:MM-HH:MM", value)
+		}
+		window.Start, window.End = times[0], times[1]
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func parseVolumeClaimTemplate(settings map[string]string) (*api.VolumeClaimTemplate, error) {
+	if len(settings) == 0 {
+		return nil, nil
+	}
+	template := &api.VolumeClaimTemplate{}
+	for key, value := range settings {
+		switch key {
+		case "name":
+			template.Name = value
+		case "mountPath":
+			template.MountPath = value
+		case "storage":
+			storage, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid volume claim storage: %s", err)
+			}
+			template.Storage = storage
+		case "storageClassName":
+			template.StorageClassName = value
+		case "accessMode":
+			template.AccessModes = []string{value}
+		default:
+			return nil, fmt.Errorf("unknown volume claim template setting %s", key)
+		}
+	}
+	return template, nil
+}
+
 func convertResourceLimitsToFloat64(resourceLimits map[string]string) (map[string]float64, error) {
 	resourceLimitsFloat := make(map[string]float64, len(resourceLimits))
 	for resourceName, limit := range resourceLimits {
@@ -77,3 +225,15 @@ func convertResourceLimitsToFloat64(resourceLimits map[string]string) (map[strin
 
 	return resourceLimitsFloat, nil
 }
+
+func convertResourceQuota(resourceQuota map[string]string) (map[string]resource.Quantity, error) {
+	quantities := make(map[string]resource.Quantity, len(resourceQuota))
+	for resourceName, quota := range resourceQuota {
+		quantity, err := resource.ParseQuantity(quota)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota of %s: %s", resourceName, err)
+		}
+		quantities[resourceName] = quantity
+	}
+	return quantities, nil
+}
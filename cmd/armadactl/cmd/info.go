@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client"
 )
@@ -43,6 +45,12 @@ var infoCmd = &cobra.Command{
 			})
 
 			log.Infof("Queue %s:", queueInfo.Name)
+			if retention := queueInfo.EventRetention; retention != nil {
+				log.Infof("event retention: %s", formatEventRetention(retention))
+			}
+			if len(queueInfo.ResourceQuota) > 0 {
+				log.Infof("resource quota: %v, leased: %v", common.ComputeResources(queueInfo.ResourceQuota), common.ComputeResources(queueInfo.ResourcesLeased))
+			}
 			if len(jobSets) == 0 {
 				log.Info("No job queued or running.")
 			}
@@ -53,3 +61,14 @@ var infoCmd = &cobra.Command{
 		})
 	},
 }
+
+func formatEventRetention(retention *api.QueueEventRetention) string {
+	description := "no expiry"
+	if retention.RetentionDuration > 0 {
+		description = retention.RetentionDuration.String()
+	}
+	if retention.MaxLength > 0 {
+		description += fmt.Sprintf(", at most %d events per job set", retention.MaxLength)
+	}
+	return description
+}
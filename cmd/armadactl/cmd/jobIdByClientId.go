@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(jobIdByClientIdCmd)
+}
+
+var jobIdByClientIdCmd = &cobra.Command{
+	Use:   "job-id queue clientId",
+	Short: "Prints out id of the job submitted to the queue with the client id.",
+	Long: `Prints out id of the job submitted to the queue with the client id, also when later submissions
+with the same client id were detected as duplicates. Client ids are kept for 4 hours.`,
+
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		clientId := args[1]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			response, e := submitClient.GetJobIdByClientId(ctx, &api.JobIdByClientIdRequest{Queue: queue, ClientId: clientId})
+			if e != nil {
+				exitWithError(e)
+			}
+			fmt.Println(response.JobId)
+		})
+	},
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(jobSetStatusCmd)
+}
+
+var jobSetStatusCmd = &cobra.Command{
+	Use:   "job-set-status queue jobSetId",
+	Short: "Prints out number of jobs of the job set in each state.",
+	Long:  `Prints out number of queued, leased, running, succeeded, failed and cancelled jobs of the job set.`,
+
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		jobSetId := args[1]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			eventClient := api.NewEventClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			jobSetStatus, e := eventClient.GetJobSetStatus(ctx, &api.JobSetStatusRequest{Queue: queue, JobSetId: jobSetId})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			log.Infof("Job set %s in queue %s: queued: %d, leased: %d, running: %d, succeeded: %d, failed: %d, cancelled: %d",
+				jobSetStatus.JobSetId, jobSetStatus.Queue, jobSetStatus.Queued, jobSetStatus.Leased, jobSetStatus.Running,
+				jobSetStatus.Succeeded, jobSetStatus.Failed, jobSetStatus.Cancelled)
+		})
+	},
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(pauseQueueCmd)
+	rootCmd.AddCommand(resumeQueueCmd)
+}
+
+var pauseQueueCmd = &cobra.Command{
+	Use:   "pause-queue name",
+	Short: "Stop leasing jobs of the queue",
+	Long: `This command stops jobs of the queue from being leased to clusters. Already leased jobs keep running,
+queued jobs stay queued and new jobs can still be submitted until the queue is resumed.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submissionClient := api.NewSubmitClient(conn)
+			e := client.PauseQueue(submissionClient, queue)
+			if e != nil {
+				exitWithError(e)
+			}
+			log.Infof("Queue %s paused.", queue)
+		})
+	},
+}
+
+var resumeQueueCmd = &cobra.Command{
+	Use:   "resume-queue name",
+	Short: "Resume leasing jobs of a paused queue",
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submissionClient := api.NewSubmitClient(conn)
+			e := client.ResumeQueue(submissionClient, queue)
+			if e != nil {
+				exitWithError(e)
+			}
+			log.Infof("Queue %s resumed.", queue)
+		})
+	},
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(reprioritizeCmd)
+	reprioritizeCmd.Flags().StringSlice(
+		"jobIds", []string{}, "jobs to reprioritize")
+	reprioritizeCmd.Flags().String(
+		"queue", "", "queue of the job set to reprioritize (requires job set to be specified)")
+	reprioritizeCmd.Flags().String(
+		"jobSet", "", "job set to reprioritize (requires queue to be specified)")
+}
+
+var reprioritizeCmd = &cobra.Command{
+	Use:   "reprioritize priority",
+	Short: "Changes priority of queued jobs",
+	Long: `Changes priority of queued jobs either by job ids or by combination of queue & job set.
+Jobs with lower priority value are leased first. Jobs which are already leased keep their original priority.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		priority, e := strconv.ParseFloat(args[0], 64)
+		if e != nil {
+			exitWithError(e)
+		}
+
+		jobIds, _ := cmd.Flags().GetStringSlice("jobIds")
+		queue, _ := cmd.Flags().GetString("queue")
+		jobSet, _ := cmd.Flags().GetString("jobSet")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+
+			result, e := submitClient.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{
+				JobIds:      jobIds,
+				JobSetId:    jobSet,
+				Queue:       queue,
+				NewPriority: priority,
+			})
+			if e != nil {
+				exitWithError(e)
+			}
+			log.Infof("Reprioritized jobs: %s", strings.Join(result.ReprioritizedIds, ", "))
+			for jobId, reason := range result.NotReprioritized {
+				log.Infof("Job %s was not reprioritized: %s", jobId, reason)
+			}
+		})
+	},
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(requeuesCmd)
+}
+
+var requeuesCmd = &cobra.Command{
+	Use:   "requeues jobId",
+	Short: "Prints out why the job was requeued.",
+	Long:  `Prints out the requeue history of the job, with the reason and cluster of every lease it lost.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		jobId := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			history, e := submitClient.GetJobRequeueHistory(ctx, &api.JobRequeueHistoryRequest{JobId: jobId})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if len(history.Requeues) == 0 {
+				log.Infof("Job %s was not requeued", history.JobId)
+			}
+			for _, requeue := range history.Requeues {
+				log.Infof("%s: requeued from cluster %s, reason %s", requeue.Created.Format(time.RFC3339), requeue.ClusterId, requeue.Reason)
+			}
+		})
+	},
+}
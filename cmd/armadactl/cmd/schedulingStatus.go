@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(schedulingStatusCmd)
+}
+
+var schedulingStatusCmd = &cobra.Command{
+	Use:   "scheduling-status queue",
+	Short: "Prints out reasons jobs of the queue are not being leased.",
+	Long: `Prints out reasons jobs of the queue are not being leased, like closed scheduling windows,
+reached resource limits or queued jobs not fitting on any cluster.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submitClient := api.NewSubmitClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			schedulingStatus, e := submitClient.GetQueueSchedulingStatus(ctx, &api.QueueSchedulingStatusRequest{Queue: queue})
+			if e != nil {
+				exitWithError(e)
+			}
+
+			if schedulingStatus.Schedulable {
+				log.Infof("Queue %s is being scheduled.", schedulingStatus.Queue)
+			} else {
+				log.Infof("Queue %s is not being scheduled:", schedulingStatus.Queue)
+			}
+			for _, reason := range schedulingStatus.Reasons {
+				log.Infof("%s: %s", reason.Reason, reason.Message)
+			}
+		})
+	},
+}
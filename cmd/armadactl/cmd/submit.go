@@ -17,6 +17,11 @@ import (
 func init() {
 	rootCmd.AddCommand(submitCmd)
 	submitCmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file. Does no actual submission of jobs to the server.")
+	submitCmd.Flags().Bool("server-dry-run", false, "Validates the jobs on the server, including whether they can be scheduled, without submitting them.")
+	submitCmd.Flags().Bool("reject-if-queue-paused", false, "Fails the submission when the queue is paused instead of queueing the jobs until it is resumed.")
+	submitCmd.Flags().Int("maxMessageSize", client.DefaultMaxSubmitMessageSize, "Maximum size in bytes of a single submit request, bigger submissions are split into multiple requests")
+	submitCmd.Flags().Int("concurrency", 1, "Number of submit requests sent at the same time")
+	submitCmd.Flags().Bool("stream", false, "Sends all submit requests over one stream, jobs before a failed one stay submitted. Concurrency is ignored.")
 }
 
 var submitCmd = &cobra.Command{
@@ -36,6 +41,11 @@ var submitCmd = &cobra.Command{
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		serverDryRun, _ := cmd.Flags().GetBool("server-dry-run")
+		rejectIfQueuePaused, _ := cmd.Flags().GetBool("reject-if-queue-paused")
+		maxMessageSize, _ := cmd.Flags().GetInt("maxMessageSize")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		stream, _ := cmd.Flags().GetBool("stream")
 		filePath := args[0]
 
 		ok, err := validation.ValidateSubmitFile(filePath)
@@ -58,19 +68,28 @@ var submitCmd = &cobra.Command{
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
-		requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
+		request := &api.JobSubmitRequest{
+			Queue:               submitFile.Queue,
+			JobSetId:            submitFile.JobSetId,
+			JobRequestItems:     submitFile.Jobs,
+			DryRun:              serverDryRun,
+			RejectIfQueuePaused: rejectIfQueuePaused,
+		}
+		options := client.BatchSubmitOptions{MaxMessageSize: maxMessageSize, Concurrency: concurrency}
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
-			for _, request := range requests {
-				response, e := client.SubmitJobs(submissionClient, request)
-
-				if e != nil {
-					exitWithError(e)
-				}
-
+			submit := client.SubmitJobsInBatches
+			if stream {
+				submit = client.SubmitJobsStream
+			}
+			response, e := submit(submissionClient, request, options)
+			if response != nil {
 				summariseResponse(response, request.JobSetId)
 			}
+			if e != nil {
+				exitWithError(e)
+			}
 		})
 	},
 }
@@ -79,6 +98,8 @@ func summariseResponse(response *api.JobSubmitResponse, jobSetId string) {
 	for _, jobResponseItem := range response.JobResponseItems {
 		if jobResponseItem.Error != "" {
 			log.Errorf("Failed to submit job because: %s", jobResponseItem.Error)
+		} else if response.DryRun {
+			log.Infof("Validated job (set: %s), it was not submitted", jobSetId)
 		} else {
 			log.Infof("Submitted job id: %s (set: %s)", jobResponseItem.JobId, jobSetId)
 		}
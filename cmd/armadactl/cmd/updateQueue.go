@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(updateQueueCmd)
+	addQueueFlags(updateQueueCmd, "defaults to empty list", 1, "")
+}
+
+// Queue fields set by each flag, only fields of changed flags are updated
+var queueFlagFields = map[string]string{
+	"priorityFactor":           "priority_factor",
+	"owners":                   "user_owners",
+	"groupOwners":              "group_owners",
+	"resourceLimits":           "resource_limits",
+	"resourceFloor":            "resource_floor",
+	"resourceQuota":            "resource_quota",
+	"eventRetention":           "event_retention.retention_duration",
+	"eventMaxLength":           "event_retention.max_length",
+	"maxPodSpecSizeBytes":      "max_pod_spec_size_bytes",
+	"requireContainerCommand":  "require_container_command",
+	"allowedVolumeTypes":       "allowed_volume_types",
+	"requireEmptyDirSizeLimit": "require_empty_dir_size_limit",
+	"schedulingWindow":         "scheduling_windows",
+	"defaultPodLabels":         "default_pod_labels",
+	"volumeClaimTemplate":      "volume_claim_template",
+}
+
+func changedQueueFields(cmd *cobra.Command) []string {
+	paths := []string{}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if path, ok := queueFlagFields[flag.Name]; ok {
+			paths = append(paths, path)
+		}
+	})
+	return paths
+}
+
+var updateQueueCmd = &cobra.Command{
+	Use:   "update-queue name",
+	Short: "Update existing queue",
+	Long: `This command changes the settings of existing queue given as flags (owners, priority factor, limits and other settings), 
+other settings, queue history and jobs are kept. Only queue owners or users with update_any_queue permission can update the queue.`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue, err := queueFromFlags(cmd, args[0])
+		if err != nil {
+			exitWithError(err)
+		}
+		queue.UpdateMask = &types.FieldMask{Paths: changedQueueFields(cmd)}
+		if len(queue.UpdateMask.Paths) == 0 {
+			exitWithError(fmt.Errorf("no queue settings to update given"))
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			submissionClient := api.NewSubmitClient(conn)
+			e := client.UpdateQueue(submissionClient, queue)
+			if e != nil {
+				exitWithError(e)
+			}
+			log.Infof("Queue %s updated.", queue.Name)
+		})
+	},
+}
@@ -63,6 +63,26 @@ var watchCmd = &cobra.Command{
 							log.Errorf("You might be able to get the pod logs by running (logs are available for limited time):\n%s --tail=50\n",
 								client.GetKubectlCommand(jobInfo.ClusterId, jobInfo.Job.Namespace, event.JobId, int(event.PodNumber), "logs"))
 						}
+					case *api.JobServiceCreatedEvent:
+						printSummary(state, e)
+						log.Infof("Service %s has cluster IP %s, ports: %v\n", event.ServiceName, event.ClusterIp, event.Ports)
+					case *api.JobGangRejectedEvent:
+						printSummary(state, e)
+						log.Infof("Gang %s rejected by cluster %s: %s\n", event.GangId, event.ClusterId, event.Reason)
+					case *api.JobMemoryIncreasedEvent:
+						printSummary(state, e)
+						log.Infof("Job %s retried with more memory for attempt %d: %s\n", event.JobId, event.Attempt, event.Reason)
+					case *api.JobDeadlineExceededEvent:
+						printSummary(state, e)
+						log.Infof("Job %s not finished by its deadline %s, cancelling\n", event.JobId, event.Deadline)
+					case *api.JobProtectedEvent:
+						printSummary(state, e)
+						log.Infof("Job %s is protected, not requeued: %s\n", event.JobId, event.Reason)
+					case *api.JobCancelledEvent:
+						printSummary(state, e)
+						if event.Reason != "" {
+							log.Infof("Cancellation reason: %s\n", event.Reason)
+						}
 					default:
 						printSummary(state, e)
 					}
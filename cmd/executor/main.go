@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/health"
 	"github.com/G-Research/armada/internal/executor"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/metrics"
@@ -33,11 +34,16 @@ func main() {
 	shutdownChannel := make(chan os.Signal, 1)
 	signal.Notify(shutdownChannel, syscall.SIGINT, syscall.SIGTERM)
 
-	shutdownMetricServer := common.ServeMetricsFor(config.Metric.Port,
-		prometheus.Gatherers{metrics.GetMetricsGatherer()})
+	healthChecks := health.NewChecks()
+	startupCompleteCheck := health.NewStartupCompleteChecker()
+	healthChecks.Readiness.Add("startup", startupCompleteCheck)
+
+	shutdownMetricServer := common.ServeMetricsAndHealthFor(config.Metric.Port, config.Health.Port,
+		prometheus.Gatherers{metrics.GetMetricsGatherer()}, healthChecks)
 	defer shutdownMetricServer()
 
-	shutdown, wg := executor.StartUp(config)
+	shutdown, wg := executor.StartUp(config, healthChecks)
+	startupCompleteCheck.MarkComplete()
 	go func() {
 		<-shutdownChannel
 		shutdown()
@@ -5,10 +5,12 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/health"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/fake"
 	"github.com/G-Research/armada/internal/executor/fake/context"
@@ -38,10 +40,15 @@ func main() {
 	shutdownChannel := make(chan os.Signal, 1)
 	signal.Notify(shutdownChannel, syscall.SIGINT, syscall.SIGTERM)
 
-	shutdownMetricServer := common.ServeMetrics(config.Metric.Port)
+	healthChecks := health.NewChecks()
+	startupCompleteCheck := health.NewStartupCompleteChecker()
+	healthChecks.Readiness.Add("startup", startupCompleteCheck)
+
+	shutdownMetricServer := common.ServeMetricsAndHealthFor(config.Metric.Port, config.Health.Port, prometheus.DefaultGatherer, healthChecks)
 	defer shutdownMetricServer()
 
-	shutdown, wg := fake.StartUp(config, nodes)
+	shutdown, wg := fake.StartUp(config, nodes, healthChecks)
+	startupCompleteCheck.MarkComplete()
 	go func() {
 		<-shutdownChannel
 		shutdown()
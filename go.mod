@@ -43,6 +43,8 @@ require (
 	github.com/pelletier/go-toml v1.6.0 // indirect
 	github.com/pquerna/cachecontrol v0.0.0-20180517163645-1555304b9b35 // indirect
 	github.com/prometheus/client_golang v1.7.1
+	github.com/prometheus/client_model v0.2.0
+	github.com/prometheus/common v0.10.0
 	github.com/rakyll/statik v0.1.7
 	github.com/segmentio/kafka-go v0.3.5
 	github.com/sirupsen/logrus v1.6.0
@@ -3,13 +3,17 @@ package permissions
 type Permission string
 
 const (
-	SubmitJobs     Permission = "submit_jobs"
-	SubmitAnyJobs             = "submit_any_jobs"
-	CreateQueue               = "create_queue"
-	DeleteQueue               = "delete_queue"
-	CancelJobs                = "cancel_jobs"
-	CancelAnyJobs             = "cancel_any_jobs"
-	WatchAllEvents            = "watch_all_events"
+	SubmitJobs             Permission = "submit_jobs"
+	SubmitAnyJobs                     = "submit_any_jobs"
+	CreateQueue                       = "create_queue"
+	UpdateAnyQueue                    = "update_any_queue"
+	DeleteQueue                       = "delete_queue"
+	CancelJobs                        = "cancel_jobs"
+	CancelAnyJobs                     = "cancel_any_jobs"
+	ReprioritizeJobs                  = "reprioritize_jobs"
+	ReprioritizeAnyJobs               = "reprioritize_any_jobs"
+	WatchAllEvents                    = "watch_all_events"
+	ManagePodSpecTemplates            = "manage_pod_spec_templates"
 
 	ExecuteJobs = "execute_jobs"
 )
@@ -25,6 +25,8 @@ type QueueCache struct {
 	queueDurations         map[string]map[string]*metrics.FloatMetrics
 	queuedResources        map[string]map[string]metrics.ResourceMetrics
 	queueNonMatchingJobIds map[string]map[string]stringSet
+	queueBacklogs          map[string]metrics.QueueBacklog
+	queuePoolBacklogs      map[string]map[string]metrics.QueueBacklog
 }
 
 func NewQueueCache(
@@ -38,7 +40,9 @@ func NewQueueCache(
 		schedulingInfoRepository: schedulingInfoRepository,
 		queueDurations:           map[string]map[string]*metrics.FloatMetrics{},
 		queuedResources:          map[string]map[string]metrics.ResourceMetrics{},
-		queueNonMatchingJobIds:   map[string]map[string]stringSet{}}
+		queueNonMatchingJobIds:   map[string]map[string]stringSet{},
+		queueBacklogs:            map[string]metrics.QueueBacklog{},
+		queuePoolBacklogs:        map[string]map[string]metrics.QueueBacklog{}}
 
 	return collector
 }
@@ -63,11 +67,14 @@ func (c *QueueCache) Refresh() {
 		resourceUsageByPool := map[string]*metrics.ResourceMetricsRecorder{}
 		nonMatchingJobs := map[string]stringSet{}
 		queueDurationByPool := map[string]*metrics.FloatMetricsRecorder{}
+		backlog := metrics.QueueBacklog{}
+		backlogByPool := map[string]metrics.QueueBacklog{}
 		currentTime := time.Now()
 		err := c.jobRepository.IterateQueueJobs(queue.Name, func(job *api.Job) {
 			jobResources := common.TotalJobResourceRequest(job)
 			nonMatchingClusters := stringSet{}
 			queuedTime := currentTime.Sub(job.Created)
+			backlog.Record(job.Created)
 
 			for pool, infos := range clusterInfoByPool {
 				matches := false
@@ -93,6 +100,10 @@ func (c *QueueCache) Refresh() {
 						queueDurationByPool[pool] = qd
 					}
 					qd.Record(queuedTime.Seconds())
+
+					poolBacklog := backlogByPool[pool]
+					poolBacklog.Record(job.Created)
+					backlogByPool[pool] = poolBacklog
 				}
 			}
 			nonMatchingJobs[job.Id] = nonMatchingClusters
@@ -104,9 +115,17 @@ func (c *QueueCache) Refresh() {
 
 		c.updateQueuedNonMatchingJobs(queue.Name, nonMatchingJobs)
 		c.updateQueueMetrics(queue.Name, resourceUsageByPool, queueDurationByPool)
+		c.updateQueueBacklogs(queue.Name, backlog, backlogByPool)
 	}
 }
 
+func (c *QueueCache) updateQueueBacklogs(queueName string, backlog metrics.QueueBacklog, backlogByPool map[string]metrics.QueueBacklog) {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	c.queueBacklogs[queueName] = backlog
+	c.queuePoolBacklogs[queueName] = backlogByPool
+}
+
 func (c *QueueCache) updateQueueMetrics(queueName string, resourcesByPool map[string]*metrics.ResourceMetricsRecorder,
 	queueDurationsByPool map[string]*metrics.FloatMetricsRecorder) {
 	c.refreshMutex.Lock()
@@ -135,8 +154,10 @@ func (c *QueueCache) GetQueueMetrics(queueName string) *metrics.QueueMetrics {
 	c.refreshMutex.Lock()
 	defer c.refreshMutex.Unlock()
 	return &metrics.QueueMetrics{
-		Resources: c.queuedResources[queueName],
-		Durations: c.queueDurations[queueName],
+		Resources:    c.queuedResources[queueName],
+		Durations:    c.queueDurations[queueName],
+		Backlog:      c.queueBacklogs[queueName],
+		PoolBacklogs: c.queuePoolBacklogs[queueName],
 	}
 }
 
@@ -154,9 +175,23 @@ func (c *QueueCache) PeekClusterQueue(clusterId, queue string, limit int64) ([]*
 	nonMatchingJobs := c.getNonSchedulableJobIds(queue)
 
 	filtered := []string{}
-	for _, id := range ids {
+	candidates := []string{}
+	for i, id := range ids {
 		if matches(nonMatchingJobs, clusterId, id) {
-			filtered = append(filtered, id)
+			candidates = append(candidates, id)
+		}
+		if len(filtered)+len(candidates) == int(limit) || i == len(ids)-1 {
+			// jobs with recently returned lease are skipped, so look further in the queue to fill the limit
+			cooldowns, e := c.jobRepository.GetLeaseCooldowns(candidates)
+			if e != nil {
+				return nil, e
+			}
+			for _, candidate := range candidates {
+				if _, coolingDown := cooldowns[candidate]; !coolingDown {
+					filtered = append(filtered, candidate)
+				}
+			}
+			candidates = []string{}
 		}
 		if len(filtered) == int(limit) {
 			break
@@ -177,3 +212,7 @@ func matches(nonMatchingJobs map[string]stringSet, clusterId, jobId string) bool
 func (c *QueueCache) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
 	return c.jobRepository.TryLeaseJobs(clusterId, queue, jobs)
 }
+
+func (c *QueueCache) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	return c.jobRepository.ReturnLease(clusterId, jobId)
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestQueueCache_Refresh_ComputesBacklogOfQueueAndPools(t *testing.T) {
+	db, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer db.Close()
+	client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	jobRepository := repository.NewRedisJobRepository(client, nil, configuration.JobRepositoryConfig{})
+	queueRepository := repository.NewRedisQueueRepository(client)
+	assert.NoError(t, queueRepository.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+
+	now := time.Now()
+	addQueuedJob(t, jobRepository, "queue1", "1", now.Add(-time.Hour))
+	addQueuedJob(t, jobRepository, "queue1", "1", now.Add(-time.Minute))
+	addQueuedJob(t, jobRepository, "queue1", "8", now.Add(-2*time.Hour))
+
+	schedulingInfo := &countingSchedulingInfoRepository{reports: map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "cpu", ReportTime: now, NodeTypes: []*api.NodeType{
+			{AllocatableResources: map[string]resource.Quantity{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")}},
+		}},
+	}}
+	c := NewQueueCache(queueRepository, jobRepository, schedulingInfo)
+
+	c.Refresh()
+
+	queueMetrics := c.GetQueueMetrics("queue1")
+	assert.Equal(t, 3, queueMetrics.Backlog.QueuedJobs)
+	assert.InDelta(t, (2 * time.Hour).Seconds(), queueMetrics.Backlog.OldestAge(now).Seconds(), 1)
+	// the 8 cpu job does not fit on any node of the pool
+	assert.Equal(t, 2, queueMetrics.PoolBacklogs["cpu"].QueuedJobs)
+	assert.InDelta(t, time.Hour.Seconds(), queueMetrics.PoolBacklogs["cpu"].OldestAge(now).Seconds(), 1)
+	assert.Equal(t, time.Duration(0), c.GetQueueMetrics("queue2").Backlog.OldestAge(now))
+}
+
+func addQueuedJob(t *testing.T, r *repository.RedisJobRepository, queue string, cpu string, created time.Time) {
+	resources := v1.ResourceList{"cpu": resource.MustParse(cpu), "memory": resource.MustParse("1Gi")}
+	jobs, err := r.CreateJobs(&api.JobSubmitRequest{
+		Queue:    queue,
+		JobSetId: "set1",
+		JobRequestItems: []*api.JobSubmitRequestItem{{
+			PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+				Name:      "container1",
+				Resources: v1.ResourceRequirements{Limits: resources, Requests: resources},
+			}}},
+		}},
+	}, authorization.NewStaticPrincipal("user", []string{}))
+	assert.NoError(t, err)
+	jobs[0].Created = created
+	_, err = r.AddJobs(jobs, false)
+	assert.NoError(t, err)
+}
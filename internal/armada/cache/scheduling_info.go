@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/metrics"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var schedulingInfoCacheRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: metrics.MetricPrefix + "scheduling_info_cache_requests_total",
+		Help: "Number of cluster scheduling info reads by cache result (hit or miss)",
+	},
+	[]string{"result"})
+
+// SchedulingInfoCache keeps cluster scheduling info in memory, so it is not loaded from redis for every read.
+// Data older than maxAge is never returned, updates are written through to the underlying repository.
+type SchedulingInfoCache struct {
+	schedulingInfoRepository repository.SchedulingInfoRepository
+	maxAge                   time.Duration
+
+	mutex     sync.RWMutex
+	reports   map[string]*api.ClusterSchedulingInfoReport
+	loadedAt  time.Time
+	clockFunc func() time.Time
+}
+
+func NewSchedulingInfoCache(schedulingInfoRepository repository.SchedulingInfoRepository, maxAge time.Duration) *SchedulingInfoCache {
+	return &SchedulingInfoCache{
+		schedulingInfoRepository: schedulingInfoRepository,
+		maxAge:                   maxAge,
+		clockFunc:                time.Now,
+	}
+}
+
+func (c *SchedulingInfoCache) Refresh() {
+	_, e := c.load()
+	if e != nil {
+		log.Errorf("Error while refreshing cluster scheduling info cache %s", e)
+	}
+}
+
+func (c *SchedulingInfoCache) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	c.mutex.RLock()
+	if c.reports != nil && c.clockFunc().Sub(c.loadedAt) <= c.maxAge {
+		result := copyReports(c.reports)
+		c.mutex.RUnlock()
+		schedulingInfoCacheRequests.WithLabelValues("hit").Inc()
+		return result, nil
+	}
+	c.mutex.RUnlock()
+
+	schedulingInfoCacheRequests.WithLabelValues("miss").Inc()
+	return c.load()
+}
+
+func (c *SchedulingInfoCache) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
+	e := c.schedulingInfoRepository.UpdateClusterSchedulingInfo(report)
+	if e != nil {
+		return e
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.reports != nil {
+		c.reports[report.ClusterId] = report
+	}
+	return nil
+}
+
+func (c *SchedulingInfoCache) load() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	loadedAt := c.clockFunc()
+	reports, e := c.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, e
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reports = copyReports(reports)
+	c.loadedAt = loadedAt
+	return reports, nil
+}
+
+func copyReports(reports map[string]*api.ClusterSchedulingInfoReport) map[string]*api.ClusterSchedulingInfoReport {
+	result := make(map[string]*api.ClusterSchedulingInfoReport, len(reports))
+	for k, v := range reports {
+		result[k] = v
+	}
+	return result
+}
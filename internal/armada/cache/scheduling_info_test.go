@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestSchedulingInfoCache_ServesCachedDataUntilMaxAge(t *testing.T) {
+	repo := &countingSchedulingInfoRepository{reports: map[string]*api.ClusterSchedulingInfoReport{"cluster1": {ClusterId: "cluster1"}}}
+	now := time.Now()
+	c := NewSchedulingInfoCache(repo, time.Minute)
+	c.clockFunc = func() time.Time { return now }
+
+	c.Refresh()
+	reports, err := c.GetClusterSchedulingInfo()
+	assert.NoError(t, err)
+	assert.Contains(t, reports, "cluster1")
+	assert.Equal(t, 1, repo.reads)
+
+	now = now.Add(2 * time.Minute)
+	_, err = c.GetClusterSchedulingInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, repo.reads)
+}
+
+func TestSchedulingInfoCache_UpdateIsVisibleImmediately(t *testing.T) {
+	repo := &countingSchedulingInfoRepository{reports: map[string]*api.ClusterSchedulingInfoReport{}}
+	c := NewSchedulingInfoCache(repo, time.Minute)
+	c.Refresh()
+
+	err := c.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "cluster2"})
+	assert.NoError(t, err)
+
+	reports, err := c.GetClusterSchedulingInfo()
+	assert.NoError(t, err)
+	assert.Contains(t, reports, "cluster2")
+	assert.Contains(t, repo.reports, "cluster2")
+	assert.Equal(t, 1, repo.reads)
+}
+
+type countingSchedulingInfoRepository struct {
+	reports map[string]*api.ClusterSchedulingInfoReport
+	reads   int
+}
+
+func (r *countingSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	r.reads++
+	return copyReports(r.reports), nil
+}
+
+func (r *countingSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
+	r.reports[report.ClusterId] = report
+	return nil
+}
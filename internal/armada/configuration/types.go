@@ -33,8 +33,10 @@ type ArmadaConfig struct {
 	PermissionScopeMapping map[permissions.Permission][]string
 
 	Scheduling      SchedulingConfig
+	JobRepository   JobRepositoryConfig
 	QueueManagement QueueManagementConfig
 	EventRetention  EventRetentionPolicy
+	EventApi        EventApiConfig
 	Metrics         MetricsConfig
 }
 
@@ -65,6 +67,31 @@ type SchedulingConfig struct {
 	MaxRetries                                uint // Maximum number of retries before a Job is failed
 	ResourceScarcity                          map[string]float64
 	PoolResourceScarcity                      map[string]map[string]float64
+	ResourceOversubscription                  map[string]float64 // Factor allocatable resource is multiplied by for scheduling, 1 if not set
+	PoolResourceOversubscription              map[string]map[string]float64
+	QueueScheduleTimezone                     string        // Timezone used to evaluate queue scheduling windows, UTC if empty
+	SchedulingInfoCacheMaxAge                 time.Duration // Cluster scheduling info is cached in memory for up to this long, 0 disables the cache
+	DeadlineCheckInterval                     time.Duration // Interval of the loop cancelling jobs past their deadline
+	DecisionTrace                             DecisionTraceConfig
+}
+
+// Logs how resources were distributed between queues in sampled allocation cycles, verbose so disabled by default
+type DecisionTraceConfig struct {
+	Enabled    bool
+	SampleRate float64 // Fraction of allocation cycles traced, 0 traces every cycle
+}
+
+type JobRepositoryConfig struct {
+	ReadBatchSize   int // Number of jobs read from redis in one pipeline when reading jobs by ids, 0 reads all jobs in one pipeline
+	ReadConcurrency int // Maximum number of pipelines sent at the same time when reading jobs by ids
+	// Store resource quantities of submitted jobs in canonical form, with binary suffixes (like 1Gi) for BinaryResources
+	// and decimal suffixes (like 500m) for other resources. Values are never rounded.
+	NormalizeResources bool
+	BinaryResources    []string
+}
+
+type EventApiConfig struct {
+	ReadBatchSize int64 // Number of events read from redis at once when streaming job set events
 }
 
 type EventRetentionPolicy struct {
@@ -75,6 +102,11 @@ type EventRetentionPolicy struct {
 type LeaseSettings struct {
 	ExpireAfter        time.Duration
 	ExpiryLoopInterval time.Duration
+	ReturnCooldown     time.Duration // Jobs are not leased again for this long after their lease is returned, 0 disables the cooldown
+	MaxReturnCooldown  time.Duration // The cooldown doubles with every consecutive return without the job running, up to this long
+	// Leases of protected jobs on clusters which still report usage expire after this long instead of ExpireAfter,
+	// 0 expires them like the leases of other jobs
+	ProtectedJobExpireAfter time.Duration
 }
 
 type KafkaConfig struct {
@@ -93,8 +125,47 @@ type NatsConfig struct {
 }
 
 type QueueManagementConfig struct {
-	AutoCreateQueues      bool
-	DefaultPriorityFactor float64
+	AutoCreateQueues           bool
+	DefaultPriorityFactor      float64
+	DefaultMaxPodSpecSizeBytes uint32 // Maximum serialized size of a single pod spec, can be overridden per queue, 0 means no limit
+	AllowEmptySubmissions      bool   // Accept submit requests without any jobs as a no-op instead of rejecting them
+	// Resources (like GPUs) for which every container setting them has to set both request and limit to the same value
+	ResourcesRequiringEqualRequestAndLimit []string
+	// Annotations jobs can use to require node labels without writing node selectors
+	ConstraintAnnotations []ConstraintAnnotation
+	// Named bundles of settings used for queues created with the template, for settings the queue does not set
+	QueueTemplates []QueueTemplate
+	// Queues allowed to submit jobs with the protected job annotation
+	ProtectedJobQueues []string
+	// Submit requests accepted per queue per second by each server instance, 0 disables the limit
+	SubmitRatePerSecond float64
+	// Submit requests of a queue accepted at once before the rate applies, 0 uses the rate rounded up
+	SubmitBurst int
+	// Containers requesting one of these resources without a limit get a limit of the request times the multiplier
+	DefaultLimitMultipliers map[string]float64
+	// Queues whose jobs identical to a queued or leased job of the same owner get the id of that job instead of being added
+	ContentDeduplicationQueues []string
+	// Requests of these resources are rounded up to a multiple of the quantity, e.g. 100m of cpu or 128Mi of memory
+	ResourceRequestGranularity common.ComputeResources
+}
+
+type QueueTemplate struct {
+	Name           string
+	PriorityFactor float64 // 0 uses DefaultPriorityFactor
+	ResourceLimits map[string]float64
+	EventRetention QueueTemplateEventRetention
+	// Used when the queue is created without default pod labels
+	DefaultPodLabels map[string]string
+}
+
+type QueueTemplateEventRetention struct {
+	RetentionDuration time.Duration
+	MaxLength         int64
+}
+
+type ConstraintAnnotation struct {
+	Name      string // annotation name after the armada.io/ prefix, e.g. requires-gpu-type
+	NodeLabel string // node label the annotation value is required for
 }
 
 type MetricsConfig struct {
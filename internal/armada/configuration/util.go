@@ -1,5 +1,7 @@
 package configuration
 
+import "time"
+
 func (c *SchedulingConfig) GetResourceScarcity(pool string) map[string]float64 {
 	if c.PoolResourceScarcity != nil {
 		s, ok := c.PoolResourceScarcity[pool]
@@ -9,3 +11,20 @@ func (c *SchedulingConfig) GetResourceScarcity(pool string) map[string]float64 {
 	}
 	return c.ResourceScarcity
 }
+
+func (c *SchedulingConfig) GetResourceOversubscription(pool string) map[string]float64 {
+	if c.PoolResourceOversubscription != nil {
+		s, ok := c.PoolResourceOversubscription[pool]
+		if ok {
+			return s
+		}
+	}
+	return c.ResourceOversubscription
+}
+
+func (c *SchedulingConfig) GetQueueScheduleLocation() (*time.Location, error) {
+	if c.QueueScheduleTimezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(c.QueueScheduleTimezone)
+}
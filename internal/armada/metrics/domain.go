@@ -1,6 +1,31 @@
 package metrics
 
+import "time"
+
 type QueueMetrics struct {
 	Resources map[string]ResourceMetrics
 	Durations map[string]*FloatMetrics
+	Backlog   QueueBacklog
+	// backlog of jobs which can be scheduled in each pool, jobs matching several pools are counted in each
+	PoolBacklogs map[string]QueueBacklog
+}
+
+type QueueBacklog struct {
+	QueuedJobs    int
+	OldestCreated time.Time
+}
+
+func (b *QueueBacklog) Record(created time.Time) {
+	if b.QueuedJobs == 0 || created.Before(b.OldestCreated) {
+		b.OldestCreated = created
+	}
+	b.QueuedJobs++
+}
+
+// Age of the oldest queued job, 0 when nothing is queued
+func (b QueueBacklog) OldestAge(now time.Time) time.Duration {
+	if b.QueuedJobs == 0 {
+		return 0
+	}
+	return now.Sub(b.OldestCreated)
 }
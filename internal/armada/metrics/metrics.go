@@ -50,6 +50,27 @@ var queueSizeDesc = prometheus.NewDesc(
 	nil,
 )
 
+var queueOldestQueuedJobAgeDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_oldest_queued_job_age_seconds",
+	"Time since the oldest queued job of a queue was submitted, 0 when nothing is queued",
+	[]string{"queueName"},
+	nil,
+)
+
+var queuePoolQueuedJobsDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_pool_queued_jobs",
+	"Number of queued jobs of a queue which can be scheduled in a pool, as of the last queue cache refresh",
+	[]string{"pool", "queueName"},
+	nil,
+)
+
+var queuePoolOldestQueuedJobAgeDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_pool_oldest_queued_job_age_seconds",
+	"Time since the oldest queued job of a queue which can be scheduled in a pool was submitted",
+	[]string{"pool", "queueName"},
+	nil,
+)
+
 var queuePriorityDesc = prometheus.NewDesc(
 	MetricPrefix+"queue_priority",
 	"Priority of a queue",
@@ -199,6 +220,9 @@ var clusterAvailableCapacity = prometheus.NewDesc(
 
 func (c *QueueInfoCollector) Describe(desc chan<- *prometheus.Desc) {
 	desc <- queueSizeDesc
+	desc <- queueOldestQueuedJobAgeDesc
+	desc <- queuePoolQueuedJobsDesc
+	desc <- queuePoolOldestQueuedJobAgeDesc
 	desc <- queuePriorityDesc
 	desc <- queueDurationDesc
 	desc <- minQueueDurationDesc
@@ -269,9 +293,15 @@ func (c *QueueInfoCollector) Collect(metrics chan<- prometheus.Metric) {
 		}
 	}
 
+	now := time.Now()
 	for i, q := range queues {
 		metrics <- prometheus.MustNewConstMetric(queueSizeDesc, prometheus.GaugeValue, float64(queueSizes[i]), q.Name)
 		queueMetrics := c.queueMetrics.GetQueueMetrics(q.Name)
+		metrics <- prometheus.MustNewConstMetric(queueOldestQueuedJobAgeDesc, prometheus.GaugeValue, queueMetrics.Backlog.OldestAge(now).Seconds(), q.Name)
+		for pool, backlog := range queueMetrics.PoolBacklogs {
+			metrics <- prometheus.MustNewConstMetric(queuePoolQueuedJobsDesc, prometheus.GaugeValue, float64(backlog.QueuedJobs), pool, q.Name)
+			metrics <- prometheus.MustNewConstMetric(queuePoolOldestQueuedJobAgeDesc, prometheus.GaugeValue, backlog.OldestAge(now).Seconds(), pool, q.Name)
+		}
 		for pool, queueDurations := range queueMetrics.Durations {
 			if queueDurations.GetCount() > 0 {
 				metrics <- prometheus.MustNewConstHistogram(queueDurationDesc, queueDurations.GetCount(),
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/pkg/api"
 )
@@ -123,6 +124,19 @@ func TestCalculateRunningJobStats_SkipsWhenJobOnInactivecluster(t *testing.T) {
 	})
 }
 
+func TestQueueBacklog_TracksOldestJob(t *testing.T) {
+	now := time.Now()
+	backlog := QueueBacklog{}
+	assert.Equal(t, time.Duration(0), backlog.OldestAge(now))
+
+	backlog.Record(now.Add(-time.Minute))
+	backlog.Record(now.Add(-time.Hour))
+	backlog.Record(now.Add(-time.Second))
+
+	assert.Equal(t, 3, backlog.QueuedJobs)
+	assert.Equal(t, time.Hour, backlog.OldestAge(now))
+}
+
 func createClusterInfo(clusterId string, pool string) *api.ClusterSchedulingInfoReport {
 	return &api.ClusterSchedulingInfoReport{
 		ClusterId: clusterId,
@@ -172,7 +186,7 @@ func addTestJobWithRequirements(t *testing.T, r *repository.RedisJobRepository,
 	}, authorization.NewStaticPrincipal("user", []string{}))
 	assert.NoError(t, e)
 
-	results, e := r.AddJobs(jobs)
+	results, e := r.AddJobs(jobs, false)
 	assert.Nil(t, e)
 	for _, result := range results {
 		assert.Empty(t, result.Error)
@@ -188,6 +202,6 @@ func withRepository(action func(r *repository.RedisJobRepository)) {
 	defer db.Close()
 
 	redisClient := redis.NewClient(&redis.Options{Addr: db.Addr()})
-	repo := repository.NewRedisJobRepository(redisClient, nil)
+	repo := repository.NewRedisJobRepository(redisClient, nil, configuration.JobRepositoryConfig{})
 	action(repo)
 }
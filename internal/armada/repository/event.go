@@ -1,10 +1,15 @@
 package repository
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/pkg/api"
@@ -13,22 +18,42 @@ import (
 const eventStreamPrefix = "Events:"
 const dataKey = "message"
 
+// Changes of the event retention of queues apply to reported events after this long
+const queueEventRetentionCacheExpiry = 10 * time.Second
+
 type EventStore interface {
 	ReportEvents(message []*api.EventMessage) error
 }
 
 type EventRepository interface {
-	ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error)
+	ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration, eventTypes map[string]bool) ([]*api.EventStreamMessage, string, error)
+	IterateEvents(queue, jobSetId string, lastId string, batchSize int64, eventTypes map[string]bool, action func(*api.EventStreamMessage) error) error
 	GetLastMessageId(queue, jobSetId string) (string, error)
+	GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatus, error)
 }
 
 type RedisEventRepository struct {
-	db             redis.UniversalClient
-	eventRetention configuration.EventRetentionPolicy
+	db              redis.UniversalClient
+	eventRetention  configuration.EventRetentionPolicy
+	queueRepository QueueRepository
+
+	queueRetentionCacheExpiry time.Duration
+	queueRetentionCache       map[string]cachedEventRetention
+	queueRetentionCacheMutex  sync.Mutex
+}
+
+type cachedEventRetention struct {
+	retention api.QueueEventRetention
+	loaded    time.Time
 }
 
-func NewRedisEventRepository(db redis.UniversalClient, eventRetention configuration.EventRetentionPolicy) *RedisEventRepository {
-	return &RedisEventRepository{db: db, eventRetention: eventRetention}
+func NewRedisEventRepository(db redis.UniversalClient, eventRetention configuration.EventRetentionPolicy, queueRepository QueueRepository) *RedisEventRepository {
+	return &RedisEventRepository{
+		db:                        db,
+		eventRetention:            eventRetention,
+		queueRepository:           queueRepository,
+		queueRetentionCacheExpiry: queueEventRetentionCacheExpiry,
+		queueRetentionCache:       map[string]cachedEventRetention{}}
 }
 
 func (repo *RedisEventRepository) ReportEvent(message *api.EventMessage) error {
@@ -41,11 +66,20 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 	}
 
 	type eventData struct {
-		key  string
-		data []byte
+		key   string
+		queue string
+		data  []byte
+	}
+	type jobStateUpdate struct {
+		queue    string
+		jobSetId string
+		jobId    string
+		state    string
 	}
 	data := []eventData{}
-	uniqueJobSets := make(map[string]bool)
+	stateUpdates := []jobStateUpdate{}
+	uniqueJobSets := make(map[string]string)
+	jobSetIds := make(map[string]string)
 
 	for _, m := range messages {
 		event, e := api.UnwrapEvent(m)
@@ -57,23 +91,43 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 			return e
 		}
 		key := getJobSetEventsKey(event.GetQueue(), event.GetJobSetId())
-		data = append(data, eventData{key: key, data: messageData})
-		uniqueJobSets[key] = true
+		data = append(data, eventData{key: key, queue: event.GetQueue(), data: messageData})
+		uniqueJobSets[key] = event.GetQueue()
+		jobSetIds[key] = event.GetJobSetId()
+		if state, ok := jobSetState(event); ok {
+			stateUpdates = append(stateUpdates, jobStateUpdate{queue: event.GetQueue(), jobSetId: event.GetJobSetId(), jobId: event.GetJobId(), state: state})
+		}
 	}
 
+	queueRetention := repo.getQueueEventRetention(uniqueJobSets)
+
 	pipe := repo.db.Pipeline()
 	for _, e := range data {
 		pipe.XAdd(&redis.XAddArgs{
 			Stream: e.key,
+			MaxLen: queueRetention[e.queue].MaxLength,
 			Values: map[string]interface{}{
 				dataKey: e.data,
 			},
 		})
 	}
 
-	if repo.eventRetention.ExpiryEnabled {
-		for key, _ := range uniqueJobSets {
-			pipe.Expire(key, repo.eventRetention.RetentionDuration)
+	if len(stateUpdates) > 0 {
+		updateJobSetStateScript.Load(pipe)
+	}
+	for _, u := range stateUpdates {
+		updateJobSetState(pipe, u.queue, u.jobSetId, u.jobId, u.state)
+	}
+
+	for key, queue := range uniqueJobSets {
+		keys := []string{key, getJobSetStatesKey(queue, jobSetIds[key]), getJobSetCountsKey(queue, jobSetIds[key])}
+		for _, k := range keys {
+			if retention := queueRetention[queue]; retention.RetentionDuration > 0 {
+				pipe.Expire(k, retention.RetentionDuration)
+			} else {
+				// streams might have expiry set by an earlier policy of the queue
+				pipe.Persist(k)
+			}
 		}
 	}
 
@@ -81,39 +135,168 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 	return e
 }
 
-func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error) {
+func (repo *RedisEventRepository) getQueueEventRetention(jobSetQueues map[string]string) map[string]api.QueueEventRetention {
+	repo.queueRetentionCacheMutex.Lock()
+	defer repo.queueRetentionCacheMutex.Unlock()
 
-	if lastId == "" {
-		lastId = "0"
+	now := time.Now()
+	retention := map[string]api.QueueEventRetention{}
+	for _, queueName := range jobSetQueues {
+		if _, exists := retention[queueName]; exists {
+			continue
+		}
+		if cached, ok := repo.queueRetentionCache[queueName]; ok && cached.loaded.Add(repo.queueRetentionCacheExpiry).After(now) {
+			retention[queueName] = cached.retention
+			continue
+		}
+		queue, e := repo.queueRepository.GetQueue(queueName)
+		if e != nil {
+			if e != redis.Nil {
+				// not cached, so the queue is loaded again with the next events
+				log.Warnf("Failed to load event retention of queue %s, using global retention policy: %v", queueName, e)
+				retention[queueName] = EffectiveEventRetention(nil, repo.eventRetention)
+				continue
+			}
+			queue = nil
+		}
+		retention[queueName] = EffectiveEventRetention(queue, repo.eventRetention)
+		repo.queueRetentionCache[queueName] = cachedEventRetention{retention: retention[queueName], loaded: now}
+	}
+	return retention
+}
+
+// EffectiveEventRetention returns the retention applied to events of the queue, queues without an override
+// (including queues created before overrides existed) use the global policy.
+func EffectiveEventRetention(queue *api.Queue, eventRetention configuration.EventRetentionPolicy) api.QueueEventRetention {
+	effective := api.QueueEventRetention{}
+	if eventRetention.ExpiryEnabled {
+		effective.RetentionDuration = eventRetention.RetentionDuration
+	}
+	if queue == nil || queue.EventRetention == nil {
+		return effective
+	}
+	if queue.EventRetention.RetentionDuration > 0 {
+		effective.RetentionDuration = queue.EventRetention.RetentionDuration
+	}
+	effective.MaxLength = queue.EventRetention.MaxLength
+	return effective
+}
+
+// ReadEvents reads up to limit events after lastId and returns the ones of eventTypes, all of them when eventTypes is empty.
+// The id of the last event read is returned as well, so reading can continue after events which were filtered out.
+func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration, eventTypes map[string]bool) ([]*api.EventStreamMessage, string, error) {
+
+	readFrom := lastId
+	if readFrom == "" {
+		readFrom = "0"
 	}
 
 	cmd, e := repo.db.XRead(&redis.XReadArgs{
-		Streams: []string{getJobSetEventsKey(queue, jobSetId), lastId},
+		Streams: []string{getJobSetEventsKey(queue, jobSetId), readFrom},
 		Count:   limit,
 		Block:   block,
 	}).Result()
 
 	// redis signals empty list by Nil
 	if e == redis.Nil {
-		return make([]*api.EventStreamMessage, 0), nil
+		return make([]*api.EventStreamMessage, 0), lastId, nil
 	}
 
 	if e != nil {
-		return nil, e
+		return nil, lastId, e
 	}
 
+	return decodeEvents(cmd[0].Messages, lastId, eventTypes)
+}
+
+func decodeEvents(streamMessages []redis.XMessage, lastId string, eventTypes map[string]bool) ([]*api.EventStreamMessage, string, error) {
 	messages := make([]*api.EventStreamMessage, 0)
-	for _, m := range cmd[0].Messages {
+	for _, m := range streamMessages {
+		lastId = m.ID
 		data := m.Values[dataKey]
 		msg := &api.EventMessage{}
 		bytes := []byte(data.(string))
-		e = proto.Unmarshal(bytes, msg)
+		e := proto.Unmarshal(bytes, msg)
 		if e != nil {
-			return nil, e
+			return nil, lastId, e
+		}
+		if len(eventTypes) > 0 && !eventTypes[api.EventTypeName(msg)] {
+			continue
 		}
 		messages = append(messages, &api.EventStreamMessage{Id: m.ID, Message: msg})
 	}
-	return messages, nil
+	return messages, lastId, nil
+}
+
+// IterateEvents calls action for all events of the job set after lastId which exist at the time of the call,
+// reading them from redis in batches of batchSize so the whole history is never loaded at once.
+func (repo *RedisEventRepository) IterateEvents(queue, jobSetId string, lastId string, batchSize int64, eventTypes map[string]bool, action func(*api.EventStreamMessage) error) error {
+	stopAfter, e := repo.GetLastMessageId(queue, jobSetId)
+	if e != nil {
+		return e
+	}
+	if stopAfter == "" || stopAfter == "0" {
+		return nil
+	}
+
+	for {
+		messages, readId, e := repo.readEventsUntil(queue, jobSetId, lastId, stopAfter, batchSize, eventTypes)
+		if e != nil {
+			return e
+		}
+		if readId == lastId {
+			return nil
+		}
+
+		for _, msg := range messages {
+			e = action(msg)
+			if e != nil {
+				return e
+			}
+		}
+		if compareStreamIds(readId, stopAfter) >= 0 {
+			return nil
+		}
+		lastId = readId
+	}
+}
+
+// readEventsUntil reads up to limit events after lastId, never beyond the stopAfter id.
+func (repo *RedisEventRepository) readEventsUntil(queue, jobSetId string, lastId string, stopAfter string, limit int64, eventTypes map[string]bool) ([]*api.EventStreamMessage, string, error) {
+	readFrom := "-"
+	if lastId != "" && lastId != "0" {
+		milliseconds, sequence := parseStreamId(lastId)
+		readFrom = fmt.Sprintf("%d-%d", milliseconds, sequence+1)
+	}
+
+	streamMessages, e := repo.db.XRangeN(getJobSetEventsKey(queue, jobSetId), readFrom, stopAfter, limit).Result()
+	if e != nil {
+		return nil, lastId, e
+	}
+	return decodeEvents(streamMessages, lastId, eventTypes)
+}
+
+// stream ids are <milliseconds>-<sequence number>
+func compareStreamIds(a, b string) int {
+	aTime, aSequence := parseStreamId(a)
+	bTime, bSequence := parseStreamId(b)
+	switch {
+	case aTime < bTime || aTime == bTime && aSequence < bSequence:
+		return -1
+	case aTime > bTime || aSequence > bSequence:
+		return 1
+	}
+	return 0
+}
+
+func parseStreamId(id string) (uint64, uint64) {
+	parts := strings.SplitN(id, "-", 2)
+	milliseconds, _ := strconv.ParseUint(parts[0], 10, 64)
+	sequence := uint64(0)
+	if len(parts) > 1 {
+		sequence, _ = strconv.ParseUint(parts[1], 10, 64)
+	}
+	return milliseconds, sequence
 }
 
 func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (string, error) {
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestReportEvents_UsesGlobalRetentionWithoutQueueOverride(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour}, func(r *RedisEventRepository, db *redis.Client) {
+		assert.NoError(t, r.queueRepository.CreateQueue(&api.Queue{Name: "queue1"}))
+
+		reportSubmittedEvent(t, r, "queue1", "set1")
+
+		ttl := db.TTL(getJobSetEventsKey("queue1", "set1")).Val()
+		assert.True(t, ttl > 59*time.Minute && ttl <= time.Hour)
+	})
+}
+
+func TestReportEvents_UsesQueueRetentionOverride(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		assert.NoError(t, r.queueRepository.CreateQueue(&api.Queue{
+			Name:           "queue1",
+			EventRetention: &api.QueueEventRetention{RetentionDuration: time.Minute, MaxLength: 1},
+		}))
+
+		for i := 0; i < 5; i++ {
+			reportSubmittedEvent(t, r, "queue1", "set1")
+		}
+		reportSubmittedEvent(t, r, "queue2", "set1")
+
+		for _, key := range []string{getJobSetEventsKey("queue1", "set1"), getJobSetStatesKey("queue1", "set1"), getJobSetCountsKey("queue1", "set1")} {
+			ttl := db.TTL(key).Val()
+			assert.True(t, ttl > 0 && ttl <= time.Minute, "%s should expire within the queue retention", key)
+		}
+		lastId, e := r.GetLastMessageId("queue1", "set1")
+		assert.NoError(t, e)
+		events := db.XRange(getJobSetEventsKey("queue1", "set1"), "-", "+").Val()
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, lastId, events[0].ID)
+
+		assert.True(t, db.TTL(getJobSetEventsKey("queue2", "set1")).Val() < 0, "stream without retention should not expire")
+	})
+}
+
+func TestIterateEvents_ReadsAllEventsInBatches(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		for i := 0; i < 5; i++ {
+			reportSubmittedEvent(t, r, "queue1", "set1")
+		}
+
+		ids := []string{}
+		e := r.IterateEvents("queue1", "set1", "", 2, nil, func(msg *api.EventStreamMessage) error {
+			ids = append(ids, msg.Id)
+			return nil
+		})
+		assert.NoError(t, e)
+		assert.Equal(t, 5, len(ids))
+
+		fromSecond := []string{}
+		e = r.IterateEvents("queue1", "set1", ids[1], 2, nil, func(msg *api.EventStreamMessage) error {
+			fromSecond = append(fromSecond, msg.Id)
+			return nil
+		})
+		assert.NoError(t, e)
+		assert.Equal(t, ids[2:], fromSecond)
+	})
+}
+
+func TestIterateEvents_ReadsOnlyEventsExistingAtTheStart(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		for i := 0; i < 3; i++ {
+			reportSubmittedEvent(t, r, "queue1", "set1")
+		}
+
+		calls := 0
+		e := r.IterateEvents("queue1", "set1", "", 2, nil, func(msg *api.EventStreamMessage) error {
+			calls++
+			reportSubmittedEvent(t, r, "queue1", "set1")
+			return nil
+		})
+		assert.NoError(t, e)
+		assert.Equal(t, 3, calls)
+	})
+}
+
+func TestReadEvents_ReturnsOnlyEventsOfFilteredTypes(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		reportSubmittedEvent(t, r, "queue1", "set1")
+		reportEvent(t, r, &api.JobFailedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: time.Now()})
+		reportSubmittedEvent(t, r, "queue1", "set1")
+
+		all, lastId, e := r.ReadEvents("queue1", "set1", "", 10, -1, nil)
+		assert.NoError(t, e)
+		assert.Equal(t, 3, len(all))
+		assert.Equal(t, all[2].Id, lastId)
+
+		failed, filteredLastId, e := r.ReadEvents("queue1", "set1", "", 10, -1, map[string]bool{"failed": true})
+		assert.NoError(t, e)
+		assert.Equal(t, 1, len(failed))
+		assert.Equal(t, "job1", failed[0].Message.GetFailed().JobId)
+		assert.Equal(t, lastId, filteredLastId, "last read id should include events filtered out")
+
+		none, noneLastId, e := r.ReadEvents("queue1", "set1", lastId, 10, -1, map[string]bool{"failed": true})
+		assert.NoError(t, e)
+		assert.Equal(t, 0, len(none))
+		assert.Equal(t, lastId, noneLastId)
+	})
+}
+
+func TestIterateEvents_SkipsBatchesWithoutEventsOfFilteredTypes(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		for i := 0; i < 5; i++ {
+			reportSubmittedEvent(t, r, "queue1", "set1")
+		}
+		reportEvent(t, r, &api.JobSucceededEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: time.Now()})
+
+		jobIds := []string{}
+		e := r.IterateEvents("queue1", "set1", "", 2, map[string]bool{"succeeded": true, "cancelled": true}, func(msg *api.EventStreamMessage) error {
+			jobIds = append(jobIds, msg.Message.GetSucceeded().JobId)
+			return nil
+		})
+		assert.NoError(t, e)
+		assert.Equal(t, []string{"job1"}, jobIds)
+	})
+}
+
+func TestCompareStreamIds(t *testing.T) {
+	assert.Equal(t, 0, compareStreamIds("1526919030474-55", "1526919030474-55"))
+	assert.Equal(t, -1, compareStreamIds("1526919030474-9", "1526919030474-55"))
+	assert.Equal(t, 1, compareStreamIds("1526919030475-0", "1526919030474-55"))
+	assert.Equal(t, -1, compareStreamIds("0", "1526919030474-0"))
+}
+
+func TestIterateEvents_StopsOnActionError(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		for i := 0; i < 5; i++ {
+			reportSubmittedEvent(t, r, "queue1", "set1")
+		}
+
+		calls := 0
+		e := r.IterateEvents("queue1", "set1", "", 2, nil, func(msg *api.EventStreamMessage) error {
+			calls++
+			return fmt.Errorf("stream closed")
+		})
+		assert.Error(t, e)
+		assert.Equal(t, 1, calls)
+
+		e = r.IterateEvents("queue1", "empty-set", "", 2, nil, func(msg *api.EventStreamMessage) error {
+			calls++
+			return nil
+		})
+		assert.NoError(t, e)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestReportEvents_RemovesExpiryWhenQueueRetentionNoLongerExpires(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		queue := &api.Queue{Name: "queue1", EventRetention: &api.QueueEventRetention{RetentionDuration: time.Minute}}
+		assert.NoError(t, r.queueRepository.CreateQueue(queue))
+		r.queueRetentionCacheExpiry = 0
+		reportSubmittedEvent(t, r, "queue1", "set1")
+		assert.True(t, db.TTL(getJobSetEventsKey("queue1", "set1")).Val() > 0)
+
+		_, e := r.queueRepository.UpdateQueue("queue1", func(queue *api.Queue, allQueues []*api.Queue) error {
+			queue.EventRetention = nil
+			return nil
+		})
+		assert.NoError(t, e)
+		reportSubmittedEvent(t, r, "queue1", "set1")
+		assert.True(t, db.TTL(getJobSetEventsKey("queue1", "set1")).Val() < 0)
+	})
+}
+
+func TestReportEvents_CachesQueueRetention(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		queue := &api.Queue{Name: "queue1", EventRetention: &api.QueueEventRetention{RetentionDuration: time.Minute}}
+		assert.NoError(t, r.queueRepository.CreateQueue(queue))
+		reportSubmittedEvent(t, r, "queue1", "set1")
+
+		_, e := r.queueRepository.UpdateQueue("queue1", func(queue *api.Queue, allQueues []*api.Queue) error {
+			queue.EventRetention = nil
+			return nil
+		})
+		assert.NoError(t, e)
+		reportSubmittedEvent(t, r, "queue1", "set2")
+		assert.True(t, db.TTL(getJobSetEventsKey("queue1", "set2")).Val() > 0, "cached retention should be used")
+
+		r.queueRetentionCache["queue1"] = cachedEventRetention{retention: r.queueRetentionCache["queue1"].retention, loaded: time.Now().Add(-time.Minute)}
+		reportSubmittedEvent(t, r, "queue1", "set3")
+		assert.True(t, db.TTL(getJobSetEventsKey("queue1", "set3")).Val() < 0, "expired cache entry should be reloaded")
+	})
+}
+
+func TestEffectiveEventRetention(t *testing.T) {
+	global := configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour}
+
+	assert.Equal(t, api.QueueEventRetention{RetentionDuration: time.Hour}, EffectiveEventRetention(nil, global))
+	assert.Equal(t, api.QueueEventRetention{RetentionDuration: time.Hour}, EffectiveEventRetention(&api.Queue{Name: "queue1"}, global))
+	assert.Equal(t, api.QueueEventRetention{RetentionDuration: time.Hour, MaxLength: 10},
+		EffectiveEventRetention(&api.Queue{Name: "queue1", EventRetention: &api.QueueEventRetention{MaxLength: 10}}, global))
+	assert.Equal(t, api.QueueEventRetention{RetentionDuration: time.Minute},
+		EffectiveEventRetention(&api.Queue{Name: "queue1", EventRetention: &api.QueueEventRetention{RetentionDuration: time.Minute}}, global))
+	assert.Equal(t, api.QueueEventRetention{},
+		EffectiveEventRetention(&api.Queue{Name: "queue1"}, configuration.EventRetentionPolicy{ExpiryEnabled: false, RetentionDuration: time.Hour}))
+}
+
+func reportSubmittedEvent(t *testing.T, r *RedisEventRepository, queue string, jobSetId string) {
+	message, e := api.Wrap(&api.JobSubmittedEvent{Queue: queue, JobSetId: jobSetId, Created: time.Now()})
+	assert.NoError(t, e)
+	assert.NoError(t, r.ReportEvent(message))
+}
+
+func reportEvent(t *testing.T, r *RedisEventRepository, event api.Event) {
+	message, e := api.Wrap(event)
+	assert.NoError(t, e)
+	assert.NoError(t, r.ReportEvent(message))
+}
+
+func withEventRepository(eventRetention configuration.EventRetentionPolicy, action func(r *RedisEventRepository, db *redis.Client)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisEventRepository(client, eventRetention, NewRedisQueueRepository(client))
+	action(repo, client)
+}
@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -10,8 +14,10 @@ import (
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/version"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/common/validation"
@@ -24,47 +30,84 @@ const jobQueuePrefix = "Job:Queue:"
 const jobSetPrefix = "Job:Set:"
 const jobLeasedPrefix = "Job:Leased:"
 const jobClusterMapKey = "Job:ClusterId"
+const jobLastClusterPrefix = "Job:LastClusterId:"
 const jobRetriesPrefix = "Job:Retries:"
+const jobRequeuesPrefix = "Job:Requeues:"
+const jobLeaseCooldownPrefix = "Job:LeaseCooldown:"
+const jobCancelReasonPrefix = "Job:CancelReason:"
 const jobClientIdPrefix = "job:ClientId:"
+const jobContentHashPrefix = "job:ContentHash:"
+const jobDeadlinesKey = "Job:Deadlines"
+const jobProtectedLeaseKeptPrefix = "Job:ProtectedLeaseKept:"
+
+// How long deleted jobs, and their requeue history and cancel reason, are kept
+const deletedJobRetention = time.Hour * 24 * 7
 const keySeparator = ":"
 
 const queueResourcesBatchSize = 20000
 
+const maxJobPriorityUpdateAttempts = 10
+
 const JobNotFound = "no job found with provided Id"
 
 type JobRepository interface {
 	PeekQueue(queue string, limit int64) ([]*api.Job, error)
 	TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error)
 	CreateJobs(request *api.JobSubmitRequest, principal authorization.Principal) ([]*api.Job, error)
-	AddJobs(job []*api.Job) ([]*SubmitJobResult, error)
+	AddJobs(job []*api.Job, deduplicateContent bool) ([]*SubmitJobResult, error)
 	GetExistingJobsByIds(ids []string) ([]*api.Job, error)
 	FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error)
 	GetQueueSizes(queues []*api.Queue) (sizes []int64, e error)
 	IterateQueueJobs(queueName string, action func(*api.Job)) error
 	GetQueueJobIds(queueName string) ([]string, error)
 	RenewLease(clusterId string, jobIds []string) (renewed []string, e error)
-	ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error)
+	ExpireLeases(queue string, deadline time.Time, protectedDeadline time.Time, protectedClusters map[string]bool) (expired []*api.Job, protected []*api.Job, clusters map[string]string, e error)
 	ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error)
 	DeleteJobs(jobs []*api.Job) map[*api.Job]error
 	GetActiveJobIds(queue string, jobSetId string) ([]string, error)
+	GetActiveJobsSubmittedBefore(queue string, jobSetId string, submittedBefore time.Time) ([]*api.Job, error)
 	GetLeasedJobIds(queue string) ([]string, error)
+	GetQueueActiveJobIds(queue string) ([]string, error)
 	UpdateStartTime(jobId string, clusterId string, startTime time.Time) error
 	GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error)
+	GetJobCluster(jobId string) (clusterId string, leased bool, e error)
 	GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error)
 	AddRetryAttempt(jobId string) error
 	GetNumberOfRetryAttempts(jobId string) (int, error)
+	AddRequeue(jobId string, requeue *api.JobRequeue) error
+	GetRequeueHistory(jobId string) ([]*api.JobRequeue, error)
+	AddLeaseCooldown(jobId string, cooldown time.Duration, maxCooldown time.Duration) (time.Duration, error)
+	GetLeaseCooldowns(jobIds []string) (map[string]time.Time, error)
+	SetCancelReason(jobIds []string, reason string) error
+	GetCancelReason(jobId string) (string, error)
+	GetJobIdByClientId(queue string, clientId string) (string, error)
+	UpdateQueuedJobsPriority(jobs []*api.Job, priority float64) (reprioritized []*api.Job, e error)
+	UpdateLeasedJobMemory(clusterId string, jobId string, podNumber int, newMemory map[string]resource.Quantity) error
+	GetJobsPastDeadline(now time.Time) ([]*api.Job, error)
+	RemoveDeadlines(jobs []*api.Job) ([]*api.Job, error)
+	RestoreDeadlines(jobs []*api.Job) error
 }
 
 type RedisJobRepository struct {
 	db               redis.UniversalClient
 	defaultJobLimits common.ComputeResources
+	readConfig       configuration.JobRepositoryConfig
+	// nil disables normalization of resource quantities
+	binaryResources map[v1.ResourceName]bool
 }
 
-func NewRedisJobRepository(db redis.UniversalClient, defaultJobLimits common.ComputeResources) *RedisJobRepository {
+func NewRedisJobRepository(db redis.UniversalClient, defaultJobLimits common.ComputeResources, readConfig configuration.JobRepositoryConfig) *RedisJobRepository {
 	if defaultJobLimits == nil {
 		defaultJobLimits = common.ComputeResources{}
 	}
-	return &RedisJobRepository{db: db, defaultJobLimits: defaultJobLimits}
+	var binaryResources map[v1.ResourceName]bool
+	if readConfig.NormalizeResources {
+		binaryResources = make(map[v1.ResourceName]bool, len(readConfig.BinaryResources))
+		for _, name := range readConfig.BinaryResources {
+			binaryResources[v1.ResourceName(name)] = true
+		}
+	}
+	return &RedisJobRepository{db: db, defaultJobLimits: defaultJobLimits, readConfig: readConfig, binaryResources: binaryResources}
 }
 
 func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, principal authorization.Principal) ([]*api.Job, error) {
@@ -83,7 +126,7 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, princi
 			return nil, fmt.Errorf("job with index %v has both pod spec and pod spec list specified", i)
 		}
 
-		if len(item.GetAllPodSpecs()) == 0 {
+		if item.PodSpec == nil && len(item.PodSpecs) == 0 {
 			return nil, fmt.Errorf("job with index %v has no pod spec", i)
 		}
 
@@ -92,8 +135,36 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, princi
 			namespace = "default"
 		}
 
+		if item.MinKubernetesVersion != "" {
+			if _, e := version.ParseGeneric(item.MinKubernetesVersion); e != nil {
+				return nil, fmt.Errorf("job with index %v has invalid minimum kubernetes version: %v", i, e)
+			}
+		}
+
+		for _, cluster := range item.RequiredClusters {
+			if cluster == "" {
+				return nil, fmt.Errorf("job with index %v has empty required cluster", i)
+			}
+		}
+
+		if (item.GangId == "") != (item.GangSize == 0) {
+			return nil, fmt.Errorf("job with index %v has to specify both gang id and gang size, or neither", i)
+		}
+
+		if item.Deadline != nil && !item.Deadline.After(time.Now()) {
+			return nil, fmt.Errorf("job with index %v has deadline %s in the past", i, item.Deadline.Format(time.RFC3339))
+		}
+
+		if e := validation.ValidateServicePorts(item.ServicePorts); e != nil {
+			return nil, fmt.Errorf("job with index %v has invalid service ports: %v", i, e)
+		}
+		if item.MaxRetries > 0 && len(item.ServicePorts) > 0 {
+			return nil, fmt.Errorf("job with index %v has service ports, retries of jobs with service ports are not supported", i)
+		}
+
 		for j, podSpec := range item.GetAllPodSpecs() {
 			repo.applyDefaults(podSpec)
+			repo.normalizeResources(podSpec)
 			e := validation.ValidatePodSpec(podSpec)
 			if e != nil {
 				return nil, fmt.Errorf("error validating pod spec of job with index %v, pod: %v: %v", i, j, e)
@@ -120,6 +191,16 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, princi
 
 			RequiredNodeLabels: item.RequiredNodeLabels,
 
+			MinKubernetesVersion: item.MinKubernetesVersion,
+			ServicePorts:         item.ServicePorts,
+			MaxRetries:           item.MaxRetries,
+			RequiredClusters:     item.RequiredClusters,
+			GangId:               item.GangId,
+			GangSize:             item.GangSize,
+			Deadline:             item.Deadline,
+
+			ToleratesPreemptibleNodes: item.ToleratesPreemptibleNodes,
+
 			Priority: item.Priority,
 
 			PodSpec:  item.PodSpec,
@@ -130,6 +211,10 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, princi
 		jobs = append(jobs, j)
 	}
 
+	if e := validateGangs(request.JobRequestItems); e != nil {
+		return nil, e
+	}
+
 	return jobs, nil
 }
 
@@ -140,7 +225,9 @@ type SubmitJobResult struct {
 	Error             error
 }
 
-func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+// With deduplicateContent, jobs identical to a queued or leased job of the same owner get the id of that job instead of
+// being added, like jobs with an already used client id. Members of gangs are never deduplicated by content.
+func (repo *RedisJobRepository) AddJobs(jobs []*api.Job, deduplicateContent bool) ([]*SubmitJobResult, error) {
 	pipe := repo.db.Pipeline()
 
 	addJobScript.Load(pipe)
@@ -153,7 +240,15 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 			return nil, e
 		}
 
-		result := addJob(pipe, job, &jobData)
+		contentHash := ""
+		if deduplicateContent && job.GangId == "" {
+			contentHash, e = jobContentHash(job)
+			if e != nil {
+				return nil, e
+			}
+		}
+
+		result := addJob(pipe, job, &jobData, contentHash)
 		saveResults = append(saveResults, result)
 	}
 
@@ -210,11 +305,16 @@ type deleteJobRedisResponse struct {
 	removeStartTimeResult          *redis.IntCmd
 	setJobExpiryResult             *redis.BoolCmd
 	deleteJobSetIndexResult        *redis.IntCmd
+	deleteLegacyJobSetIndexResult  *redis.IntCmd
 	deleteJobRetriesResult         *redis.IntCmd
+	removeDeadlineResult           *redis.IntCmd
+	setRequeuesExpiryResult        *redis.BoolCmd
+	setLastClusterResult           *redis.StatusCmd
 }
 
 func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 	expiryStatus := repo.getExpiryStatus(jobs)
+	associatedClusters := repo.getAssociatedClusterOfJobs(jobs)
 	pipe := repo.db.Pipeline()
 	deletionResults := make([]*deleteJobRedisResponse, 0, len(jobs))
 	for _, job := range jobs {
@@ -223,11 +323,18 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 		deletionResult.removeFromLeasedResult = pipe.ZRem(jobLeasedPrefix+job.Queue, job.Id)
 		deletionResult.removeClusterAssociationResult = pipe.HDel(jobClusterMapKey, job.Id)
 		deletionResult.removeStartTimeResult = pipe.Del(jobStartTimePrefix + job.Id)
-		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetPrefix+job.JobSetId, job.Id)
+		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetKey(job.Queue, job.JobSetId), job.Id)
+		deletionResult.deleteLegacyJobSetIndexResult = pipe.SRem(legacyJobSetKey(job.JobSetId), job.Id)
 		deletionResult.deleteJobRetriesResult = pipe.Del(jobRetriesPrefix + job.Id)
+		deletionResult.removeDeadlineResult = pipe.ZRem(jobDeadlinesKey, job.Id)
 
 		if !deletionResult.expiryAlreadySet {
-			deletionResult.setJobExpiryResult = pipe.Expire(jobObjectPrefix+job.Id, time.Hour*24*7)
+			deletionResult.setJobExpiryResult = pipe.Expire(jobObjectPrefix+job.Id, deletedJobRetention)
+			// requeue history is kept as long as the job itself
+			deletionResult.setRequeuesExpiryResult = pipe.Expire(jobRequeuesPrefix+job.Id, deletedJobRetention)
+		}
+		if clusterId, ok := associatedClusters[job.Id]; ok {
+			deletionResult.setLastClusterResult = pipe.Set(jobLastClusterPrefix+job.Id, clusterId, deletedJobRetention)
 		}
 		deletionResults = append(deletionResults, deletionResult)
 	}
@@ -249,6 +356,19 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 	return cancelledJobs
 }
 
+// Failing to load clusters only means last known cluster of deleted jobs is not recorded
+func (repo *RedisJobRepository) getAssociatedClusterOfJobs(jobs []*api.Job) map[string]string {
+	jobIds := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		jobIds = append(jobIds, job.Id)
+	}
+	associatedClusters, e := repo.getAssociatedCluster(jobIds)
+	if e != nil {
+		log.Warnf("Failed to load clusters of deleted jobs: %v", e)
+	}
+	return associatedClusters
+}
+
 // Returns details on if the expiry for each job is already set or not
 func (repo *RedisJobRepository) getExpiryStatus(jobs []*api.Job) map[*api.Job]bool {
 	pipe := repo.db.Pipeline()
@@ -295,6 +415,12 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 		errorMessage = e
 	}
 
+	modified, e = deletionResponse.deleteLegacyJobSetIndexResult.Result()
+	totalUpdates += modified
+	if e != nil {
+		errorMessage = e
+	}
+
 	modified, e = deletionResponse.removeClusterAssociationResult.Result()
 	totalUpdates += modified
 	if e != nil {
@@ -313,6 +439,12 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 		errorMessage = e
 	}
 
+	// not counted as an update, removing the deadline of a job which already finished does not cancel it
+	_, e = deletionResponse.removeDeadlineResult.Result()
+	if e != nil {
+		errorMessage = e
+	}
+
 	if !deletionResponse.expiryAlreadySet {
 		expirySet, e := deletionResponse.setJobExpiryResult.Result()
 		if expirySet {
@@ -321,6 +453,18 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 		if e != nil {
 			errorMessage = e
 		}
+
+		_, e = deletionResponse.setRequeuesExpiryResult.Result()
+		if e != nil {
+			errorMessage = e
+		}
+	}
+
+	if deletionResponse.setLastClusterResult != nil {
+		_, e = deletionResponse.setLastClusterResult.Result()
+		if e != nil {
+			errorMessage = e
+		}
 	}
 
 	return totalUpdates, errorMessage
@@ -356,7 +500,53 @@ func (repo *RedisJobRepository) TryLeaseJobs(clusterId string, queue string, job
 // Returns existing jobs by Id
 // If an Id is supplied that no longer exists, that job will simply be omitted from the result.
 // No error will be thrown for missing jobs
+// Ids are read in pipelined batches of readConfig.ReadBatchSize, up to readConfig.ReadConcurrency batches at the same time.
+// Jobs are returned in the order of ids, missing jobs are skipped.
 func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	batchSize := repo.readConfig.ReadBatchSize
+	if batchSize <= 0 || len(ids) <= batchSize {
+		return repo.getExistingJobsBatch(ids)
+	}
+
+	concurrency := repo.readConfig.ReadConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	batches := make([][]string, 0, len(ids)/batchSize+1)
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batches = append(batches, ids[start:end])
+	}
+
+	results := make([][]*api.Job, len(batches))
+	errs := make([]error, len(batches))
+	limit := make(chan bool, concurrency)
+	wg := sync.WaitGroup{}
+	for i, batch := range batches {
+		wg.Add(1)
+		limit <- true
+		go func(i int, batch []string) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			results[i], errs[i] = repo.getExistingJobsBatch(batch)
+		}(i, batch)
+	}
+	wg.Wait()
+
+	jobs := make([]*api.Job, 0, len(ids))
+	for i, batchJobs := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		jobs = append(jobs, batchJobs...)
+	}
+	return jobs, nil
+}
+
+func (repo *RedisJobRepository) getExistingJobsBatch(ids []string) ([]*api.Job, error) {
 	pipe := repo.db.Pipeline()
 	var cmds []*redis.StringCmd
 	for _, id := range ids {
@@ -463,6 +653,44 @@ func (repo *RedisJobRepository) GetLeasedJobIds(queue string) ([]string, error)
 	return repo.db.ZRange(jobLeasedPrefix+queue, 0, -1).Result()
 }
 
+// Returns ids of queued and leased jobs of all job sets of the queue, both are read in one transaction
+// so jobs leased or returned meanwhile are neither missing nor counted twice
+func (repo *RedisJobRepository) GetQueueActiveJobIds(queue string) ([]string, error) {
+	pipe := repo.db.TxPipeline()
+	queuedCmd := pipe.ZRange(jobQueuePrefix+queue, 0, -1)
+	leasedCmd := pipe.ZRange(jobLeasedPrefix+queue, 0, -1)
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	ids := queuedCmd.Val()
+	queued := util.StringListToSet(ids)
+	for _, id := range leasedCmd.Val() {
+		if !queued[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Returns cluster the job is leased to, or the last cluster it was leased to if the job is already finished
+func (repo *RedisJobRepository) GetJobCluster(jobId string) (string, bool, error) {
+	clusterId, e := repo.db.HGet(jobClusterMapKey, jobId).Result()
+	if e == nil {
+		return clusterId, true, nil
+	}
+	if e != redis.Nil {
+		return "", false, e
+	}
+
+	clusterId, e = repo.db.Get(jobLastClusterPrefix + jobId).Result()
+	if e == redis.Nil {
+		return "", false, nil
+	}
+	return clusterId, false, e
+}
+
 func (repo *RedisJobRepository) getAssociatedCluster(jobIds []string) (map[string]string, error) {
 	associatedCluster := make(map[string]string, len(jobIds))
 	pipe := repo.db.Pipeline()
@@ -508,8 +736,12 @@ func (repo *RedisJobRepository) UpdateStartTime(jobId string, clusterId string,
 	}
 
 	output := updateStartTimeScript.Run(repo.db, []string{jobStartTimePrefix + jobId, jobClusterMapKey}, clusterId, startTime.UnixNano())
+	if output.Err() != nil {
+		return output.Err()
+	}
 
-	return output.Err()
+	// job managed to run, following lease returns start the backoff from the beginning
+	return repo.db.Del(jobLeaseCooldownPrefix + jobId).Err()
 }
 
 var updateStartTimeScript = redis.NewScript(`
@@ -594,7 +826,7 @@ func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) (
 	if e != nil {
 		return nil, e
 	}
-	jobSetIds, e := repo.db.SMembers(jobSetPrefix + jobSetId).Result()
+	jobSetIds, e := repo.db.SUnion(jobSetKey(queue, jobSetId), legacyJobSetKey(jobSetId)).Result()
 	if e != nil {
 		return nil, e
 	}
@@ -609,6 +841,38 @@ func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) (
 	return activeSetIds, nil
 }
 
+// Returns queued and leased jobs of the queue created before provided time,
+// if jobSetId is empty jobs from all job sets of the queue are considered
+func (repo *RedisJobRepository) GetActiveJobsSubmittedBefore(queue string, jobSetId string, submittedBefore time.Time) ([]*api.Job, error) {
+	var ids []string
+	if jobSetId != "" {
+		activeIds, e := repo.GetActiveJobIds(queue, jobSetId)
+		if e != nil {
+			return nil, e
+		}
+		ids = activeIds
+	} else {
+		activeIds, e := repo.GetQueueActiveJobIds(queue)
+		if e != nil {
+			return nil, e
+		}
+		ids = activeIds
+	}
+
+	jobs, e := repo.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, e
+	}
+
+	result := []*api.Job{}
+	for _, job := range jobs {
+		if job.Created.Before(submittedBefore) {
+			result = append(result, job)
+		}
+	}
+	return result, nil
+}
+
 func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 
 	queuedIds, e := repo.db.ZRange(jobQueuePrefix+queue, 0, -1).Result()
@@ -655,48 +919,164 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 	return result, nil
 }
 
-func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+// Leases of protected jobs leased to one of protectedClusters only expire when they were renewed before protectedDeadline.
+// Returns the expired jobs, the protected jobs whose lease would have expired otherwise the first time their lease is kept,
+// and the clusters both were leased to by job id.
+func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time, protectedDeadline time.Time, protectedClusters map[string]bool) ([]*api.Job, []*api.Job, map[string]string, error) {
 	maxScore := strconv.FormatInt(deadline.UnixNano(), 10)
 
 	// TODO: expire just limited number here ???
 	ids, e := repo.db.ZRangeByScore(jobLeasedPrefix+queue, redis.ZRangeBy{Max: maxScore, Min: "-Inf"}).Result()
 	if e != nil {
-		return nil, e
+		return nil, nil, nil, e
 	}
 	expiringJobs, e := repo.GetExistingJobsByIds(ids)
 	if e != nil {
-		return nil, e
+		return nil, nil, nil, e
 	}
 
 	expired := make([]*api.Job, 0)
+	clusters := map[string]string{}
 	if len(expiringJobs) == 0 {
-		return expired, nil
+		return expired, []*api.Job{}, clusters, nil
+	}
+
+	jobClusters, e := repo.getAssociatedCluster(jobIds(expiringJobs))
+	if e != nil {
+		return nil, nil, nil, e
 	}
 
 	cmds := make(map[*api.Job]*redis.Cmd)
+	protectedJobs := map[*api.Job]bool{}
 
 	pipe := repo.db.Pipeline()
 	expireScript.Load(pipe)
 	for _, job := range expiringJobs {
-		cmds[job] = expire(pipe, job.Queue, job.Id, job.Created, deadline)
+		jobDeadline := deadline
+		if _, protected := job.Annotations[common.ProtectedJobAnnotation]; protected && protectedClusters[jobClusters[job.Id]] {
+			jobDeadline = protectedDeadline
+			protectedJobs[job] = true
+		}
+		cmds[job] = expire(pipe, job.Queue, job.Id, job.Created, jobDeadline)
 	}
 	_, e = pipe.Exec()
 
 	if e != nil {
-		return nil, e
+		return nil, nil, nil, e
 	}
 
+	kept := []*api.Job{}
 	for job, cmd := range cmds {
 		value, e := cmd.Int()
 		if e != nil {
 			log.Error(e)
 		} else if value > 0 {
 			expired = append(expired, job)
+			clusters[job.Id] = jobClusters[job.Id]
+		} else if protectedJobs[job] {
+			kept = append(kept, job)
+		}
+	}
+
+	protected, e := repo.firstKeptProtectedLeases(kept)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+	for _, job := range protected {
+		clusters[job.Id] = jobClusters[job.Id]
+	}
+	return expired, protected, clusters, nil
+}
+
+func (repo *RedisJobRepository) firstKeptProtectedLeases(jobs []*api.Job) ([]*api.Job, error) {
+	if len(jobs) == 0 {
+		return []*api.Job{}, nil
+	}
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.BoolCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.SetNX(jobProtectedLeaseKeptPrefix+job.Id, 1, deletedJobRetention))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+	first := []*api.Job{}
+	for i, cmd := range cmds {
+		if cmd.Val() {
+			first = append(first, jobs[i])
+		}
+	}
+	return first, nil
+}
+
+func jobIds(jobs []*api.Job) []string {
+	ids := []string{}
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+// Returns queued and leased jobs with a deadline before now
+func (repo *RedisJobRepository) GetJobsPastDeadline(now time.Time) ([]*api.Job, error) {
+	maxScore := strconv.FormatInt(now.UnixNano(), 10)
+	ids, e := repo.db.ZRangeByScore(jobDeadlinesKey, redis.ZRangeBy{Max: maxScore, Min: "-Inf"}).Result()
+	if e != nil {
+		return nil, e
+	}
+	return repo.GetExistingJobsByIds(ids)
+}
+
+// Returns the jobs whose deadline was removed by this call, jobs handled by another call are left out
+func (repo *RedisJobRepository) RemoveDeadlines(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.ZRem(jobDeadlinesKey, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	removed := []*api.Job{}
+	for i, cmd := range cmds {
+		if cmd.Val() > 0 {
+			removed = append(removed, jobs[i])
 		}
 	}
-	return expired, nil
+	return removed, nil
 }
 
+// Adds the deadlines removed by RemoveDeadlines back for jobs which are still queued or leased, so they are handled again
+func (repo *RedisJobRepository) RestoreDeadlines(jobs []*api.Job) error {
+	pipe := repo.db.Pipeline()
+	restoreDeadlineScript.Load(pipe)
+	for _, job := range jobs {
+		if job.Deadline != nil {
+			restoreDeadlineScript.Run(pipe, []string{jobDeadlinesKey, jobQueuePrefix + job.Queue, jobLeasedPrefix + job.Queue},
+				job.Id, job.Deadline.UnixNano())
+		}
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+var restoreDeadlineScript = redis.NewScript(`
+local deadlinesKey = KEYS[1]
+local queueKey = KEYS[2]
+local leasedJobsKey = KEYS[3]
+
+local jobId = ARGV[1]
+local deadline = ARGV[2]
+
+if redis.call('ZSCORE', queueKey, jobId) or redis.call('ZSCORE', leasedJobsKey, jobId) then
+	return redis.call('ZADD', deadlinesKey, deadline, jobId)
+end
+return 0
+`)
+
 func (repo *RedisJobRepository) AddRetryAttempt(jobId string) error {
 	_, err := repo.db.Incr(jobRetriesPrefix + jobId).Result()
 	return err
@@ -719,6 +1099,208 @@ func (repo *RedisJobRepository) GetNumberOfRetryAttempts(jobId string) (int, err
 	return retries, nil
 }
 
+func (repo *RedisJobRepository) AddRequeue(jobId string, requeue *api.JobRequeue) error {
+	data, e := proto.Marshal(requeue)
+	if e != nil {
+		return e
+	}
+	return repo.db.RPush(jobRequeuesPrefix+jobId, data).Err()
+}
+
+func (repo *RedisJobRepository) GetRequeueHistory(jobId string) ([]*api.JobRequeue, error) {
+	values, e := repo.db.LRange(jobRequeuesPrefix+jobId, 0, -1).Result()
+	if e != nil {
+		return nil, e
+	}
+	requeues := make([]*api.JobRequeue, 0, len(values))
+	for _, value := range values {
+		requeue := &api.JobRequeue{}
+		e = proto.Unmarshal([]byte(value), requeue)
+		if e != nil {
+			return nil, e
+		}
+		requeues = append(requeues, requeue)
+	}
+	return requeues, nil
+}
+
+// Sets a cooldown during which the job should not be leased, the cooldown doubles with every consecutive return up to maxCooldown.
+// Returns stop being consecutive once the job starts running or maxCooldown passes after the previous cooldown ended.
+func (repo *RedisJobRepository) AddLeaseCooldown(jobId string, cooldown time.Duration, maxCooldown time.Duration) (time.Duration, error) {
+	key := jobLeaseCooldownPrefix + jobId
+	returns, e := repo.db.HIncrBy(key, "returns", 1).Result()
+	if e != nil {
+		return 0, e
+	}
+
+	if maxCooldown < cooldown {
+		maxCooldown = cooldown
+	}
+	for i := int64(1); i < returns && cooldown < maxCooldown; i++ {
+		cooldown *= 2
+	}
+	if cooldown > maxCooldown {
+		cooldown = maxCooldown
+	}
+
+	pipe := repo.db.TxPipeline()
+	pipe.HSet(key, "until", time.Now().Add(cooldown).UnixNano())
+	pipe.Expire(key, cooldown+maxCooldown)
+	_, e = pipe.Exec()
+	if e != nil {
+		return 0, e
+	}
+	return cooldown, nil
+}
+
+// Returns end of the cooldown of jobs which should not be leased now
+func (repo *RedisJobRepository) GetLeaseCooldowns(jobIds []string) (map[string]time.Time, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make(map[string]*redis.StringCmd, len(jobIds))
+	for _, jobId := range jobIds {
+		cmds[jobId] = pipe.HGet(jobLeaseCooldownPrefix+jobId, "until")
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	now := time.Now()
+	cooldowns := map[string]time.Time{}
+	for jobId, cmd := range cmds {
+		if cmd.Val() == "" {
+			continue
+		}
+		until, e := strconv.ParseInt(cmd.Val(), 10, 64)
+		if e != nil {
+			log.Errorf("Failed to parse lease cooldown of job %s because %s", jobId, e)
+			continue
+		}
+		if time.Unix(0, until).After(now) {
+			cooldowns[jobId] = time.Unix(0, until)
+		}
+	}
+	return cooldowns, nil
+}
+
+// Reasons are set once the jobs are cancelled and deleted, so they expire about when the deleted jobs do
+func (repo *RedisJobRepository) SetCancelReason(jobIds []string, reason string) error {
+	pipe := repo.db.Pipeline()
+	for _, jobId := range jobIds {
+		pipe.Set(jobCancelReasonPrefix+jobId, reason, deletedJobRetention)
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+// Returns empty reason for jobs which were not cancelled or were cancelled without reason
+func (repo *RedisJobRepository) GetCancelReason(jobId string) (string, error) {
+	reason, e := repo.db.Get(jobCancelReasonPrefix + jobId).Result()
+	if e == redis.Nil {
+		return "", nil
+	}
+	return reason, e
+}
+
+// Client id index is kept for the deduplication period, returns empty job id when the client id is not known
+func (repo *RedisJobRepository) GetJobIdByClientId(queue string, clientId string) (string, error) {
+	jobId, e := repo.db.Get(jobClientIdKey(queue, clientId)).Result()
+	if e == redis.Nil {
+		return "", nil
+	}
+	return jobId, e
+}
+
+// Only jobs still waiting in the queue are updated, leased jobs keep their priority
+func (repo *RedisJobRepository) UpdateQueuedJobsPriority(jobs []*api.Job, priority float64) ([]*api.Job, error) {
+	reprioritized := []*api.Job{}
+	for _, job := range jobs {
+		updated, e := repo.updateQueuedJobPriority(job, priority)
+		if e != nil {
+			return nil, e
+		}
+		if updated != nil {
+			reprioritized = append(reprioritized, updated)
+		}
+	}
+	return reprioritized, nil
+}
+
+// Only the priority of the stored job is changed, in a transaction which fails when the job is changed, leased or
+// deleted after it was read. The transaction is retried, jobs no longer queued are left unchanged and nil is returned.
+func (repo *RedisJobRepository) updateQueuedJobPriority(job *api.Job, priority float64) (*api.Job, error) {
+	queueKey := jobQueuePrefix + job.Queue
+	jobKey := jobObjectPrefix + job.Id
+	var updated *api.Job
+	update := func(tx *redis.Tx) error {
+		updated = nil
+		if e := tx.ZScore(queueKey, job.Id).Err(); e == redis.Nil {
+			return nil
+		} else if e != nil {
+			return e
+		}
+		jobData, e := tx.Get(jobKey).Bytes()
+		if e == redis.Nil {
+			return nil
+		} else if e != nil {
+			return e
+		}
+		storedJob := &api.Job{}
+		if e := proto.Unmarshal(jobData, storedJob); e != nil {
+			return e
+		}
+		storedJob.Priority = priority
+		jobData, e = proto.Marshal(storedJob)
+		if e != nil {
+			return e
+		}
+		_, e = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			pipe.Set(jobKey, jobData, 0)
+			pipe.ZAdd(queueKey, redis.Z{Score: priority, Member: job.Id})
+			return nil
+		})
+		if e == nil {
+			updated = storedJob
+		}
+		return e
+	}
+
+	var e error
+	for attempt := 0; attempt < maxJobPriorityUpdateAttempts; attempt++ {
+		e = repo.db.Watch(update, queueKey, jobKey)
+		if e != redis.TxFailedErr {
+			break
+		}
+	}
+	if e != nil {
+		return nil, e
+	}
+	return updated, nil
+}
+
+// Stores the memory a pod of a job got when it was recreated by the cluster leasing the job, so the job is accounted with the
+// memory it is running with. Jobs not leased by the cluster are left unchanged.
+func (repo *RedisJobRepository) UpdateLeasedJobMemory(clusterId string, jobId string, podNumber int, newMemory map[string]resource.Quantity) error {
+	jobs, e := repo.GetExistingJobsByIds([]string{jobId})
+	if e != nil {
+		return e
+	}
+	if len(jobs) <= 0 {
+		return fmt.Errorf(JobNotFound)
+	}
+	job := jobs[0]
+	podSpecs := job.GetAllPodSpecs()
+	if podNumber < 0 || podNumber >= len(podSpecs) {
+		return fmt.Errorf("job %s has no pod %d", jobId, podNumber)
+	}
+	common.IncreaseContainerMemory(podSpecs[podNumber], newMemory)
+	jobData, e := proto.Marshal(job)
+	if e != nil {
+		return e
+	}
+	return updateLeasedJobScript.Run(repo.db, []string{jobClusterMapKey, jobObjectPrefix + jobId}, clusterId, jobId, jobData).Err()
+}
+
 func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) ([]string, error) {
 
 	now := time.Now()
@@ -751,6 +1333,31 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) ([]
 	return leasedJobs, nil
 }
 
+func validateGangs(items []*api.JobSubmitRequestItem) error {
+	members := map[string]uint32{}
+	sizes := map[string]uint32{}
+	gangIds := []string{}
+	for i, item := range items {
+		if item.GangId == "" {
+			continue
+		}
+		size, seen := sizes[item.GangId]
+		if !seen {
+			gangIds = append(gangIds, item.GangId)
+			sizes[item.GangId] = item.GangSize
+		} else if size != item.GangSize {
+			return fmt.Errorf("job with index %v has gang size %d, other members of gang %s have size %d", i, item.GangSize, item.GangId, size)
+		}
+		members[item.GangId]++
+	}
+	for _, gangId := range gangIds {
+		if members[gangId] != sizes[gangId] {
+			return fmt.Errorf("gang %s has %d members in the request, all %d members have to be submitted together", gangId, members[gangId], sizes[gangId])
+		}
+	}
+	return nil
+}
+
 func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 	if spec != nil {
 		for i := range spec.Containers {
@@ -763,7 +1370,7 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 			}
 			for k, v := range repo.defaultJobLimits {
 				_, limitExists := c.Resources.Limits[v1.ResourceName(k)]
-				_, requestExists := c.Resources.Limits[v1.ResourceName(k)]
+				_, requestExists := c.Resources.Requests[v1.ResourceName(k)]
 				if !limitExists && !requestExists {
 					c.Resources.Requests[v1.ResourceName(k)] = v
 					c.Resources.Limits[v1.ResourceName(k)] = v
@@ -773,10 +1380,78 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 	}
 }
 
-func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte) *redis.Cmd {
+// Equal quantities expressed in different units (like 1000m and 1, or 1024Mi and 1Gi) are stored the same way
+func (repo *RedisJobRepository) normalizeResources(spec *v1.PodSpec) {
+	if spec == nil || repo.binaryResources == nil {
+		return
+	}
+	for i := range spec.InitContainers {
+		repo.normalizeResourceList(spec.InitContainers[i].Resources.Requests)
+		repo.normalizeResourceList(spec.InitContainers[i].Resources.Limits)
+	}
+	for i := range spec.Containers {
+		repo.normalizeResourceList(spec.Containers[i].Resources.Requests)
+		repo.normalizeResourceList(spec.Containers[i].Resources.Limits)
+	}
+}
+
+func (repo *RedisJobRepository) normalizeResourceList(resources v1.ResourceList) {
+	for name, quantity := range resources {
+		normalized := quantity.DeepCopy()
+		normalized.Format = resource.DecimalSI
+		if repo.binaryResources[name] {
+			normalized.Format = resource.BinarySI
+		}
+		// any arithmetic drops the string the quantity was parsed from, so it is formatted from its exact value
+		normalized.Add(resource.Quantity{})
+		resources[name] = normalized
+	}
+}
+
+// Job set ids are only unique within a queue
+func jobSetKey(queue string, jobSetId string) string {
+	return jobSetPrefix + queue + keySeparator + jobSetId
+}
+
+// Index of job sets created before it was scoped by queue, only read and cleaned up
+// as the results are always filtered by jobs of the queue
+func legacyJobSetKey(jobSetId string) string {
+	return jobSetPrefix + jobSetId
+}
+
+// Client ids are only unique within a queue
+func jobClientIdKey(queue string, clientId string) string {
+	return jobClientIdPrefix + queue + keySeparator + clientId
+}
+
+// Hash of the normalized pod specs, owner and queue of the job, json encoding sorts map keys so the hash does not
+// depend on map ordering
+func jobContentHash(job *api.Job) (string, error) {
+	content, e := json.Marshal(struct {
+		Queue    string
+		Owner    string
+		PodSpecs []*v1.PodSpec
+	}{job.Queue, job.Owner, job.GetAllPodSpecs()})
+	if e != nil {
+		return "", e
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func jobContentHashKey(queue string, contentHash string) string {
+	return jobContentHashPrefix + queue + keySeparator + contentHash
+}
+
+func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte, contentHash string) *redis.Cmd {
+	deadline := ""
+	if job.Deadline != nil {
+		deadline = strconv.FormatInt(job.Deadline.UnixNano(), 10)
+	}
 	return addJobScript.Run(db,
-		[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id, jobSetPrefix + job.JobSetId, jobClientIdPrefix + job.Queue + keySeparator + job.ClientId},
-		job.Id, job.Priority, *jobData, job.ClientId)
+		[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id, jobSetKey(job.Queue, job.JobSetId), jobClientIdKey(job.Queue, job.ClientId),
+			jobLeasedPrefix + job.Queue, jobContentHashKey(job.Queue, contentHash), jobDeadlinesKey},
+		job.Id, job.Priority, *jobData, job.ClientId, contentHash, deadline)
 }
 
 var addJobScript = redis.NewScript(`
@@ -784,27 +1459,63 @@ local queueKey = KEYS[1]
 local jobKey = KEYS[2]
 local jobSetKey = KEYS[3]
 local jobClientIdKey = KEYS[4]
+local leasedJobsKey = KEYS[5]
+local jobContentHashKey = KEYS[6]
+local deadlinesKey = KEYS[7]
 
 local jobId = ARGV[1]
 local jobPriority = ARGV[2]
 local jobData = ARGV[3]
 local clientId = ARGV[4]
+local contentHash = ARGV[5]
+local deadline = ARGV[6]
 
 if clientId ~= '' then
 	local existingJobId = redis.call('GET', jobClientIdKey)
 	if existingJobId then 
 		return existingJobId
 	end
+end
+
+if contentHash ~= '' then
+	local existingJobId = redis.call('GET', jobContentHashKey)
+	if existingJobId and (redis.call('ZSCORE', queueKey, existingJobId) or redis.call('ZSCORE', leasedJobsKey, existingJobId)) then
+		return existingJobId
+	end
+	redis.call('SET', jobContentHashKey, jobId, 'EX', 604800)
+end
+
+if clientId ~= '' then
 	redis.call('SET', jobClientIdKey, jobId, 'EX', 14400)
 end
 
 redis.call('SET', jobKey, jobData)
 redis.call('SADD', jobSetKey, jobId)
 redis.call('ZADD', queueKey, jobPriority, jobId)
+if deadline ~= '' then
+	redis.call('ZADD', deadlinesKey, deadline, jobId)
+end
 
 return jobId
 `)
 
+var updateLeasedJobScript = redis.NewScript(`
+local clusterAssociation = KEYS[1]
+local jobKey = KEYS[2]
+
+local clusterId = ARGV[1]
+local jobId = ARGV[2]
+local jobData = ARGV[3]
+
+local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
+if currentClusterId ~= clusterId or redis.call('EXISTS', jobKey) == 0 then
+	return 0
+end
+
+redis.call('SET', jobKey, jobData)
+return 1
+`)
+
 func leaseJob(db redis.Cmdable, queueName string, clusterId string, jobId string, now time.Time) *redis.Cmd {
 	return leaseJobScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
 		clusterId, jobId, float64(now.UnixNano()))
@@ -868,6 +1579,7 @@ if leasedTime ~= nil and leasedTime < deadline then
 		return 0
 	end
 end
+return 0
 `)
 
 func returnLease(db redis.Cmdable, clusterId string, queueName string, jobId string, created time.Time) *redis.Cmd {
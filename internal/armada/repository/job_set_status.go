@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/go-redis/redis"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const jobSetStatesPrefix = "JobSetStates:"
+const jobSetCountsPrefix = "JobSetCounts:"
+const jobSetStatusReadBatchSize = 500
+
+const (
+	jobSetStateQueued    = "queued"
+	jobSetStateLeased    = "leased"
+	jobSetStateRunning   = "running"
+	jobSetStateSucceeded = "succeeded"
+	jobSetStateFailed    = "failed"
+	jobSetStateCancelled = "cancelled"
+	jobSetStateDuplicate = "duplicate"
+)
+
+// Events which do not change the state of the job (e.g. utilisation or reprioritisation) are ignored
+func jobSetState(event api.Event) (string, bool) {
+	switch event.(type) {
+	case *api.JobSubmittedEvent, *api.JobQueuedEvent, *api.JobLeaseReturnedEvent, *api.JobLeaseExpiredEvent:
+		return jobSetStateQueued, true
+	case *api.JobLeasedEvent, *api.JobPendingEvent:
+		return jobSetStateLeased, true
+	case *api.JobRunningEvent:
+		return jobSetStateRunning, true
+	case *api.JobSucceededEvent:
+		return jobSetStateSucceeded, true
+	case *api.JobFailedEvent:
+		return jobSetStateFailed, true
+	case *api.JobCancelledEvent:
+		return jobSetStateCancelled, true
+	case *api.JobDuplicateFoundEvent:
+		return jobSetStateDuplicate, true
+	}
+	return "", false
+}
+
+// Finished jobs keep their state, so late events (e.g. running reported after succeeded) do not move them back
+func isFinishedJobSetState(state string) bool {
+	return state == jobSetStateSucceeded || state == jobSetStateFailed || state == jobSetStateCancelled || state == jobSetStateDuplicate
+}
+
+func getJobSetStatesKey(queue, jobSetId string) string {
+	return jobSetStatesPrefix + queue + ":" + jobSetId
+}
+
+func getJobSetCountsKey(queue, jobSetId string) string {
+	return jobSetCountsPrefix + queue + ":" + jobSetId
+}
+
+func updateJobSetState(db redis.Cmdable, queue, jobSetId, jobId, state string) *redis.Cmd {
+	return updateJobSetStateScript.Run(db, []string{getJobSetStatesKey(queue, jobSetId), getJobSetCountsKey(queue, jobSetId)}, jobId, state)
+}
+
+var updateJobSetStateScript = redis.NewScript(`
+local statesKey = KEYS[1]
+local countsKey = KEYS[2]
+
+local jobId = ARGV[1]
+local state = ARGV[2]
+
+local currentState = redis.call('HGET', statesKey, jobId)
+if currentState == state or currentState == 'succeeded' or currentState == 'failed' or currentState == 'cancelled' or currentState == 'duplicate' then
+	return 0
+end
+if currentState then
+	redis.call('HINCRBY', countsKey, currentState, -1)
+end
+redis.call('HSET', statesKey, jobId, state)
+redis.call('HINCRBY', countsKey, state, 1)
+return 1
+`)
+
+// GetJobSetStatus returns the number of jobs of the job set in each state, counted as events are reported.
+// Job sets without counters (with all events reported before the counters existed) are counted from their events.
+func (repo *RedisEventRepository) GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatus, error) {
+	values, e := repo.db.HGetAll(getJobSetCountsKey(queue, jobSetId)).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	counts := map[string]int32{}
+	if len(values) > 0 {
+		for state, value := range values {
+			count, e := strconv.ParseInt(value, 10, 32)
+			if e != nil {
+				return nil, e
+			}
+			counts[state] = int32(count)
+		}
+	} else {
+		counts, e = repo.countJobSetStatesFromEvents(queue, jobSetId)
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	return &api.JobSetStatus{
+		Queue:     queue,
+		JobSetId:  jobSetId,
+		Queued:    counts[jobSetStateQueued],
+		Leased:    counts[jobSetStateLeased],
+		Running:   counts[jobSetStateRunning],
+		Succeeded: counts[jobSetStateSucceeded],
+		Failed:    counts[jobSetStateFailed],
+		Cancelled: counts[jobSetStateCancelled],
+	}, nil
+}
+
+func (repo *RedisEventRepository) countJobSetStatesFromEvents(queue, jobSetId string) (map[string]int32, error) {
+	states := map[string]string{}
+	e := repo.IterateEvents(queue, jobSetId, "", jobSetStatusReadBatchSize, nil, func(msg *api.EventStreamMessage) error {
+		event, e := api.UnwrapEvent(msg.Message)
+		if e != nil {
+			return e
+		}
+		if state, ok := jobSetState(event); ok && !isFinishedJobSetState(states[event.GetJobId()]) {
+			states[event.GetJobId()] = state
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	counts := map[string]int32{}
+	for _, state := range states {
+		counts[state]++
+	}
+	return counts, nil
+}
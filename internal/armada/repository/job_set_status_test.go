@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestGetJobSetStatus_CountsJobsByState(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		reportEvents(t, r,
+			&api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobQueuedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1"},
+			&api.JobLeasedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job3", Queue: "queue1", JobSetId: "set1"},
+			&api.JobRunningEvent{JobId: "job3", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSucceededEvent{JobId: "job3", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job4", Queue: "queue1", JobSetId: "set1"},
+			&api.JobCancelledEvent{JobId: "job4", Queue: "queue1", JobSetId: "set1"},
+			&api.JobUtilisationEvent{JobId: "job4", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job5", Queue: "queue1", JobSetId: "set1"},
+			&api.JobDuplicateFoundEvent{JobId: "job5", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job6", Queue: "queue1", JobSetId: "set2"})
+
+		status, e := r.GetJobSetStatus("queue1", "set1")
+
+		assert.NoError(t, e)
+		assert.Equal(t, &api.JobSetStatus{Queue: "queue1", JobSetId: "set1", Queued: 1, Leased: 1, Succeeded: 1, Cancelled: 1}, status)
+	})
+}
+
+func TestGetJobSetStatus_FinishedJobsKeepTheirState(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		reportEvents(t, r,
+			&api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobFailedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobRunningEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"})
+
+		status, e := r.GetJobSetStatus("queue1", "set1")
+
+		assert.NoError(t, e)
+		assert.Equal(t, &api.JobSetStatus{Queue: "queue1", JobSetId: "set1", Failed: 1}, status)
+	})
+}
+
+func TestGetJobSetStatus_CountsEventsOfJobSetsWithoutCounters(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(r *RedisEventRepository, db *redis.Client) {
+		reportEvents(t, r,
+			&api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobRunningEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"},
+			&api.JobSubmittedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1"})
+		db.Del(getJobSetStatesKey("queue1", "set1"), getJobSetCountsKey("queue1", "set1"))
+
+		status, e := r.GetJobSetStatus("queue1", "set1")
+
+		assert.NoError(t, e)
+		assert.Equal(t, &api.JobSetStatus{Queue: "queue1", JobSetId: "set1", Queued: 1, Running: 1}, status)
+	})
+}
+
+func TestReportEvents_ExpiresJobSetCountersWithEvents(t *testing.T) {
+	withEventRepository(configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour}, func(r *RedisEventRepository, db *redis.Client) {
+		reportEvents(t, r, &api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"})
+
+		assert.True(t, db.TTL(getJobSetStatesKey("queue1", "set1")).Val() > 0)
+		assert.True(t, db.TTL(getJobSetCountsKey("queue1", "set1")).Val() > 0)
+	})
+}
+
+func reportEvents(t *testing.T, r *RedisEventRepository, events ...api.Event) {
+	messages := []*api.EventMessage{}
+	for _, event := range events {
+		message, e := api.Wrap(event)
+		assert.NoError(t, e)
+		messages = append(messages, message)
+	}
+	assert.NoError(t, r.ReportEvents(messages))
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
@@ -32,6 +33,126 @@ func TestJobAddDifferentQueuesCanHaveSameClientId(t *testing.T) {
 	})
 }
 
+func TestGetJobIdByClientId(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
+		job2 := addTestJobWithClientId(t, r, "queue2", "my-job-1")
+
+		jobId, e := r.GetJobIdByClientId("queue1", "my-job-1")
+		assert.NoError(t, e)
+		assert.Equal(t, job1.Id, jobId)
+
+		jobId, e = r.GetJobIdByClientId("queue2", "my-job-1")
+		assert.NoError(t, e)
+		assert.Equal(t, job2.Id, jobId)
+
+		jobId, e = r.GetJobIdByClientId("queue1", "unknown")
+		assert.NoError(t, e)
+		assert.Equal(t, "", jobId)
+	})
+}
+
+func TestAddJobs_DeduplicatesIdenticalJobsAcrossJobSets(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		nodeSelector := map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}
+		first := addContentDeduplicatedJobs(t, r, "set1", nodeSelector, true)
+		second := addContentDeduplicatedJobs(t, r, "set2", map[string]string{"d": "4", "c": "3", "b": "2", "a": "1"}, true)
+
+		assert.False(t, first[0].DuplicateDetected)
+		assert.True(t, second[0].DuplicateDetected)
+		assert.Equal(t, first[0].JobId, second[0].JobId)
+
+		different := addContentDeduplicatedJobs(t, r, "set2", map[string]string{"a": "other"}, true)
+		assert.False(t, different[0].DuplicateDetected)
+	})
+}
+
+func TestAddJobs_DeduplicatesAgainstLeasedJobs(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		first := addContentDeduplicatedJobs(t, r, "set1", nil, true)
+		leased, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{first[0].SubmittedJob})
+		assert.NoError(t, e)
+		assert.Len(t, leased, 1)
+
+		second := addContentDeduplicatedJobs(t, r, "set2", nil, true)
+		assert.True(t, second[0].DuplicateDetected)
+		assert.Equal(t, first[0].JobId, second[0].JobId)
+	})
+}
+
+func TestAddJobs_DoesNotDeduplicateContentWhenDisabled(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		first := addContentDeduplicatedJobs(t, r, "set1", nil, false)
+		second := addContentDeduplicatedJobs(t, r, "set2", nil, false)
+		assert.False(t, second[0].DuplicateDetected)
+		assert.NotEqual(t, first[0].JobId, second[0].JobId)
+	})
+}
+
+func TestAddJobs_DoesNotDeduplicateAgainstFinishedJobs(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		first := addContentDeduplicatedJobs(t, r, "set1", nil, true)
+		r.DeleteJobs([]*api.Job{first[0].SubmittedJob})
+
+		second := addContentDeduplicatedJobs(t, r, "set2", nil, true)
+		assert.False(t, second[0].DuplicateDetected)
+		assert.NotEqual(t, first[0].JobId, second[0].JobId)
+
+		third := addContentDeduplicatedJobs(t, r, "set3", nil, true)
+		assert.True(t, third[0].DuplicateDetected)
+		assert.Equal(t, second[0].JobId, third[0].JobId)
+	})
+}
+
+func TestAddJobs_DoesNotDeduplicateGangMembers(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		item := func() *api.JobSubmitRequestItem {
+			return &api.JobSubmitRequestItem{
+				GangId:   "gang",
+				GangSize: 2,
+				PodSpec:  &v1.PodSpec{Containers: []v1.Container{{Name: "container", Image: "image", Resources: testJobResources()}}},
+			}
+		}
+		jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+			Queue:           "queue1",
+			JobSetId:        "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{item(), item()},
+		}, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+
+		results, e := r.AddJobs(jobs, true)
+		assert.NoError(t, e)
+		assert.False(t, results[0].DuplicateDetected)
+		assert.False(t, results[1].DuplicateDetected)
+		assert.NotEqual(t, results[0].JobId, results[1].JobId)
+	})
+}
+
+func addContentDeduplicatedJobs(t *testing.T, r *RedisJobRepository, jobSetId string, nodeSelector map[string]string, deduplicateContent bool) []*SubmitJobResult {
+	jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+		Queue:    "queue1",
+		JobSetId: jobSetId,
+		JobRequestItems: []*api.JobSubmitRequestItem{{
+			Priority: 1,
+			PodSpec: &v1.PodSpec{
+				NodeSelector: nodeSelector,
+				Containers:   []v1.Container{{Name: "container", Image: "image", Args: []string{"sleep", "10"}, Resources: testJobResources()}},
+			},
+		}},
+	}, authorization.NewStaticPrincipal("user", []string{}))
+	assert.NoError(t, e)
+
+	results, e := r.AddJobs(jobs, deduplicateContent)
+	assert.NoError(t, e)
+	assert.NoError(t, results[0].Error)
+	return results
+}
+
+func testJobResources() v1.ResourceRequirements {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	return v1.ResourceRequirements{Limits: resources, Requests: resources}
+}
+
 func TestJobCanBeLeasedOnlyOnce(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 
@@ -60,7 +181,7 @@ func TestJobLeaseExpiry(t *testing.T) {
 		deadline := time.Now()
 		addLeasedJob(t, r, "queue1", "cluster1")
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, _, _, e := r.ExpireLeases("queue1", deadline, deadline, nil)
 		assert.Nil(t, e)
 
 		queued, e := r.PeekQueue("queue1", 10)
@@ -70,12 +191,39 @@ func TestJobLeaseExpiry(t *testing.T) {
 	})
 }
 
+func TestJobLeaseExpiry_KeepsLeasesOfProtectedJobsOnProtectedClusters(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		protectedJob := addLeasedProtectedJob(t, r, "queue1", "cluster1")
+		otherClusterJob := addLeasedProtectedJob(t, r, "queue1", "cluster2")
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+		deadline := time.Now()
+
+		expired, protected, clusters, e := r.ExpireLeases("queue1", deadline, deadline.Add(-time.Hour), map[string]bool{"cluster1": true})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(expired))
+		assert.Equal(t, 1, len(protected))
+		assert.Equal(t, protectedJob.Id, protected[0].Id)
+		assert.Equal(t, map[string]string{otherClusterJob.Id: "cluster2", job.Id: "cluster1", protectedJob.Id: "cluster1"}, clusters)
+
+		assert.ElementsMatch(t, []string{otherClusterJob.Id, job.Id}, queuedJobIds(t, r, "queue1"))
+
+		// kept lease is returned once
+		_, protected, _, e = r.ExpireLeases("queue1", deadline, deadline.Add(-time.Hour), map[string]bool{"cluster1": true})
+		assert.Nil(t, e)
+		assert.Empty(t, protected)
+
+		_, _, _, e = r.ExpireLeases("queue1", deadline, deadline, map[string]bool{"cluster1": true})
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{protectedJob.Id, otherClusterJob.Id, job.Id}, queuedJobIds(t, r, "queue1"))
+	})
+}
+
 func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, _, _, e := r.ExpireLeases("queue1", deadline, deadline, nil)
 		assert.Nil(t, e)
 
 		renewed, e := r.RenewLease("cluster1", []string{job.Id})
@@ -108,7 +256,7 @@ func TestDeletingExpiredJobShouldDeleteJobFromQueue(t *testing.T) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, _, _, e := r.ExpireLeases("queue1", deadline, deadline, nil)
 		assert.Nil(t, e)
 
 		deletionResult := r.DeleteJobs([]*api.Job{job})
@@ -164,6 +312,57 @@ func TestReturnLeaseForJobInQueueIsNoop(t *testing.T) {
 	})
 }
 
+func TestUpdateQueuedJobsPriority_ReordersQueue(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addTestJob(t, r, "queue1")
+		job2 := addTestJob(t, r, "queue1")
+
+		reprioritized, e := r.UpdateQueuedJobsPriority([]*api.Job{job2}, 0)
+		assert.NoError(t, e)
+		assert.Equal(t, []string{job2.Id}, jobIds(reprioritized))
+
+		queue, e := r.PeekQueue("queue1", 100)
+		assert.NoError(t, e)
+		assert.Equal(t, []string{job2.Id, job1.Id}, jobIds(queue))
+		assert.Equal(t, float64(0), queue[0].Priority)
+	})
+}
+
+func TestUpdateQueuedJobsPriority_OnlyChangesPriorityOfStoredJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		staleJob := *job
+		staleJob.Owner = "other-owner"
+
+		reprioritized, e := r.UpdateQueuedJobsPriority([]*api.Job{&staleJob}, 5)
+		assert.NoError(t, e)
+		assert.Len(t, reprioritized, 1)
+
+		jobs, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.NoError(t, e)
+		assert.Equal(t, float64(5), jobs[0].Priority)
+		assert.Equal(t, job.Owner, jobs[0].Owner)
+	})
+}
+
+func TestUpdateQueuedJobsPriority_KeepsPriorityOfLeasedJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+
+		reprioritized, e := r.UpdateQueuedJobsPriority([]*api.Job{job}, 5)
+		assert.NoError(t, e)
+		assert.Empty(t, reprioritized)
+
+		queue, e := r.PeekQueue("queue1", 100)
+		assert.NoError(t, e)
+		assert.Empty(t, queue)
+
+		jobs, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.NoError(t, e)
+		assert.Equal(t, float64(1), jobs[0].Priority)
+	})
+}
+
 func TestDeleteRunningJob(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
@@ -232,6 +431,66 @@ func TestGetActiveJobIds(t *testing.T) {
 	})
 }
 
+func TestGetActiveJobIds_IsolatesSameJobSetIdInDifferentQueues(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addTestJob(t, r, "queue1")
+		job2 := addTestJob(t, r, "queue2")
+		assert.Equal(t, job1.JobSetId, job2.JobSetId)
+
+		ids, e := r.GetActiveJobIds("queue1", job1.JobSetId)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job1.Id}, ids)
+
+		r.DeleteJobs([]*api.Job{job2})
+
+		ids, e = r.GetActiveJobIds("queue1", job1.JobSetId)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job1.Id}, ids)
+		ids, e = r.GetActiveJobIds("queue2", job2.JobSetId)
+		assert.Nil(t, e)
+		assert.Empty(t, ids)
+	})
+}
+
+func TestGetActiveJobIds_IncludesJobsFromLegacyJobSetIndex(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		r.db.SRem(jobSetKey(job.Queue, job.JobSetId), job.Id)
+		r.db.SAdd(legacyJobSetKey(job.JobSetId), job.Id)
+
+		ids, e := r.GetActiveJobIds("queue1", job.JobSetId)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job.Id}, ids)
+
+		ids, e = r.GetActiveJobIds("queue2", job.JobSetId)
+		assert.Nil(t, e)
+		assert.Empty(t, ids)
+	})
+}
+
+func TestGetActiveJobsSubmittedBefore(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		addTestJob(t, r, "queue2")
+
+		cutoff := time.Now()
+		addTestJob(t, r, "queue1")
+
+		jobs, e := r.GetActiveJobsSubmittedBefore("queue1", "", cutoff)
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, jobIds(jobs))
+
+		jobs, e = r.GetActiveJobsSubmittedBefore("queue1", "set1", cutoff)
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, jobIds(jobs))
+
+		jobs, e = r.GetActiveJobsSubmittedBefore("queue1", "otherSet", cutoff)
+		assert.Nil(t, e)
+		assert.Empty(t, jobs)
+	})
+}
+
 func TestGetLeasedJobIds(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		addTestJob(t, r, "queue1")
@@ -362,6 +621,30 @@ func TestGetJobRunInfos_ReturnStartTimeForCurrentAssociatedCluster(t *testing.T)
 	})
 }
 
+func TestGetJobCluster(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queued := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		finished := addLeasedJob(t, r, "queue1", "cluster2")
+		r.DeleteJobs([]*api.Job{finished})
+
+		clusterId, isLeased, e := r.GetJobCluster(queued.Id)
+		assert.NoError(t, e)
+		assert.Equal(t, "", clusterId)
+		assert.False(t, isLeased)
+
+		clusterId, isLeased, e = r.GetJobCluster(leased.Id)
+		assert.NoError(t, e)
+		assert.Equal(t, "cluster1", clusterId)
+		assert.True(t, isLeased)
+
+		clusterId, isLeased, e = r.GetJobCluster(finished.Id)
+		assert.NoError(t, e)
+		assert.Equal(t, "cluster2", clusterId)
+		assert.False(t, isLeased)
+	})
+}
+
 func TestGetQueueActiveJobSets(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		addTestJob(t, r, "queue1")
@@ -509,6 +792,362 @@ func TestIterateQueueJobs(t *testing.T) {
 	})
 }
 
+func TestRequeueHistoryIsKeptInOrder(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+
+		history, e := r.GetRequeueHistory(job.Id)
+		assert.NoError(t, e)
+		assert.Empty(t, history)
+
+		assert.NoError(t, r.AddRequeue(job.Id, &api.JobRequeue{Reason: api.RequeueReason_PodStuck, ClusterId: "cluster1"}))
+		assert.NoError(t, r.AddRequeue(job.Id, &api.JobRequeue{Reason: api.RequeueReason_LeaseExpired}))
+
+		history, e = r.GetRequeueHistory(job.Id)
+		assert.NoError(t, e)
+		assert.Equal(t, 2, len(history))
+		assert.Equal(t, api.RequeueReason_PodStuck, history[0].Reason)
+		assert.Equal(t, "cluster1", history[0].ClusterId)
+		assert.Equal(t, api.RequeueReason_LeaseExpired, history[1].Reason)
+	})
+}
+
+func TestLeaseCooldownGrowsWithConsecutiveReturns(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		otherJob := addTestJob(t, r, "queue1")
+
+		cooldowns, e := r.GetLeaseCooldowns([]string{job.Id, otherJob.Id})
+		assert.NoError(t, e)
+		assert.Empty(t, cooldowns)
+
+		for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+			cooldown, e := r.AddLeaseCooldown(job.Id, time.Second, 5*time.Second)
+			assert.NoError(t, e)
+			assert.Equal(t, expected, cooldown)
+		}
+
+		cooldowns, e = r.GetLeaseCooldowns([]string{job.Id, otherJob.Id})
+		assert.NoError(t, e)
+		assert.Equal(t, 1, len(cooldowns))
+		assert.True(t, cooldowns[job.Id].After(time.Now()))
+	})
+}
+
+func TestLeaseCooldownIsResetWhenJobStarts(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+
+		_, e := r.AddLeaseCooldown(job.Id, time.Second, time.Minute)
+		assert.NoError(t, e)
+		_, e = r.AddLeaseCooldown(job.Id, time.Second, time.Minute)
+		assert.NoError(t, e)
+
+		assert.NoError(t, r.UpdateStartTime(job.Id, "cluster1", time.Now()))
+
+		cooldowns, e := r.GetLeaseCooldowns([]string{job.Id})
+		assert.NoError(t, e)
+		assert.Empty(t, cooldowns)
+
+		cooldown, e := r.AddLeaseCooldown(job.Id, time.Second, time.Minute)
+		assert.NoError(t, e)
+		assert.Equal(t, time.Second, cooldown)
+	})
+}
+
+func TestCreateJobsValidatesMinKubernetesVersion(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		cpu := resource.MustParse("1")
+		requirements := v1.ResourceRequirements{Limits: v1.ResourceList{"cpu": cpu}, Requests: v1.ResourceList{"cpu": cpu}}
+		request := &api.JobSubmitRequest{
+			Queue:    "q1",
+			JobSetId: "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{
+				{
+					PodSpec:              &v1.PodSpec{Containers: []v1.Container{{Resources: requirements}}},
+					MinKubernetesVersion: "1.19",
+				},
+			},
+		}
+
+		jobs, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		assert.Equal(t, "1.19", jobs[0].MinKubernetesVersion)
+
+		request.JobRequestItems[0].MinKubernetesVersion = "latest"
+		_, e = r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+	})
+}
+
+func TestCreateJobsValidatesRequiredClusters(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		cpu := resource.MustParse("1")
+		requirements := v1.ResourceRequirements{Limits: v1.ResourceList{"cpu": cpu}, Requests: v1.ResourceList{"cpu": cpu}}
+		request := &api.JobSubmitRequest{
+			Queue:    "q1",
+			JobSetId: "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{
+				{
+					PodSpec:          &v1.PodSpec{Containers: []v1.Container{{Resources: requirements}}},
+					RequiredClusters: []string{"cluster1", "gpu"},
+				},
+			},
+		}
+
+		jobs, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		assert.Equal(t, []string{"cluster1", "gpu"}, jobs[0].RequiredClusters)
+
+		request.JobRequestItems[0].RequiredClusters = []string{""}
+		_, e = r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+	})
+}
+
+func TestCreateJobsValidatesServicePorts(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		cpu := resource.MustParse("1")
+		requirements := v1.ResourceRequirements{Limits: v1.ResourceList{"cpu": cpu}, Requests: v1.ResourceList{"cpu": cpu}}
+		request := &api.JobSubmitRequest{
+			Queue:    "q1",
+			JobSetId: "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{
+				{
+					PodSpec:      &v1.PodSpec{Containers: []v1.Container{{Resources: requirements}}},
+					ServicePorts: []*v1.ServicePort{{Name: "jupyter", Port: 8888}},
+				},
+			},
+		}
+
+		jobs, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		assert.Equal(t, int32(8888), jobs[0].ServicePorts[0].Port)
+
+		request.JobRequestItems[0].ServicePorts = []*v1.ServicePort{{Port: 0}}
+		_, e = r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+	})
+}
+
+func TestCreateJobsPersistsMaxRetries(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		cpu := resource.MustParse("1")
+		requirements := v1.ResourceRequirements{Limits: v1.ResourceList{"cpu": cpu}, Requests: v1.ResourceList{"cpu": cpu}}
+		request := &api.JobSubmitRequest{
+			Queue:    "q1",
+			JobSetId: "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{
+				{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: requirements}}}, MaxRetries: 3},
+			},
+		}
+
+		jobs, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		assert.Equal(t, uint32(3), jobs[0].MaxRetries)
+
+		request.JobRequestItems[0].ServicePorts = []*v1.ServicePort{{Port: 8888}}
+		_, e = r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+	})
+}
+
+func TestCreateJobsRejectsJobWithoutPodSpec(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		request := &api.JobSubmitRequest{Queue: "q1", JobSetId: "set1", JobRequestItems: []*api.JobSubmitRequestItem{{}}}
+
+		_, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+		assert.Contains(t, e.Error(), "job with index 0 has no pod spec")
+	})
+}
+
+func TestCreateJobsRejectsDeadlineInThePast(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		deadline := time.Now().Add(-time.Minute)
+		request := &api.JobSubmitRequest{Queue: "q1", JobSetId: "set1", JobRequestItems: []*api.JobSubmitRequestItem{
+			{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: testJobResources()}}}, Deadline: &deadline},
+		}}
+
+		_, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Error(t, e)
+		assert.Contains(t, e.Error(), "job with index 0 has deadline")
+	})
+}
+
+func TestGetJobsPastDeadline_ReturnsQueuedAndLeasedJobsUntilDeleted(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		now := time.Now()
+		queued := addTestJobWithDeadline(t, r, now.Add(time.Hour))
+		leased := addTestJobWithDeadline(t, r, now.Add(2*time.Hour))
+		addTestJobWithDeadline(t, r, now.Add(4*time.Hour))
+		addTestJob(t, r, "queue1")
+
+		leasedJobs, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{leased})
+		assert.NoError(t, e)
+		assert.Len(t, leasedJobs, 1)
+
+		jobs, e := r.GetJobsPastDeadline(now.Add(3 * time.Hour))
+		assert.NoError(t, e)
+		assert.Equal(t, []string{queued.Id, leased.Id}, jobIds(jobs))
+		assert.Equal(t, leased.Deadline.UnixNano(), jobs[1].Deadline.UnixNano())
+
+		r.DeleteJobs([]*api.Job{queued})
+		jobs, e = r.GetJobsPastDeadline(now.Add(3 * time.Hour))
+		assert.NoError(t, e)
+		assert.Equal(t, []string{leased.Id}, jobIds(jobs))
+	})
+}
+
+func TestGetQueueActiveJobIds_ReturnsQueuedAndLeasedJobsOnce(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		// a job moving between the queued and leased set is briefly in both
+		r.db.ZAdd(jobLeasedPrefix+"queue1", redis.Z{Member: queuedJob.Id, Score: 0})
+
+		ids, e := r.GetQueueActiveJobIds("queue1")
+		assert.NoError(t, e)
+		assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, ids)
+	})
+}
+
+func TestRemoveDeadlines_ReturnsOnlyJobsWithDeadlineRemoved(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		now := time.Now()
+		job := addTestJobWithDeadline(t, r, now.Add(time.Hour))
+
+		removed, e := r.RemoveDeadlines([]*api.Job{job})
+		assert.NoError(t, e)
+		assert.Equal(t, []string{job.Id}, jobIds(removed))
+
+		removed, e = r.RemoveDeadlines([]*api.Job{job})
+		assert.NoError(t, e)
+		assert.Empty(t, removed)
+
+		jobs, e := r.GetJobsPastDeadline(now.Add(3 * time.Hour))
+		assert.NoError(t, e)
+		assert.Empty(t, jobs)
+	})
+}
+
+func TestRestoreDeadlines_OnlyRestoresDeadlinesOfExistingJobs(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		now := time.Now()
+		job := addTestJobWithDeadline(t, r, now.Add(time.Hour))
+		deletedJob := addTestJobWithDeadline(t, r, now.Add(time.Hour))
+		_, e := r.RemoveDeadlines([]*api.Job{job, deletedJob})
+		assert.NoError(t, e)
+		r.DeleteJobs([]*api.Job{deletedJob})
+
+		assert.NoError(t, r.RestoreDeadlines([]*api.Job{job, deletedJob}))
+
+		jobs, e := r.GetJobsPastDeadline(now.Add(3 * time.Hour))
+		assert.NoError(t, e)
+		assert.Equal(t, []string{job.Id}, jobIds(jobs))
+		assert.Equal(t, int64(1), r.db.ZCard(jobDeadlinesKey).Val())
+	})
+}
+
+func addTestJobWithDeadline(t *testing.T, r *RedisJobRepository, deadline time.Time) *api.Job {
+	jobs, e := r.CreateJobs(&api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: []*api.JobSubmitRequestItem{
+		{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: testJobResources()}}}, Deadline: &deadline},
+	}}, authorization.NewStaticPrincipal("user", []string{}))
+	assert.NoError(t, e)
+	results, e := r.AddJobs(jobs, false)
+	assert.NoError(t, e)
+	assert.NoError(t, results[0].Error)
+	return jobs[0]
+}
+
+func TestGetExistingJobsByIds_ReadsInBatchesKeepingOrder(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		r.readConfig = configuration.JobRepositoryConfig{ReadBatchSize: 4, ReadConcurrency: 3}
+		ids := []string{}
+		for i := 0; i < 25; i++ {
+			ids = append(ids, addTestJob(t, r, "queue1").Id)
+		}
+		requestedIds := append([]string{"missing"}, ids...)
+
+		jobs, e := r.GetExistingJobsByIds(requestedIds)
+		assert.NoError(t, e)
+		assert.Equal(t, ids, jobIds(jobs))
+	})
+}
+
+func BenchmarkGetExistingJobsByIds(b *testing.B) {
+	configs := map[string]configuration.JobRepositoryConfig{
+		"single pipeline":          {},
+		"batches of 1000":          {ReadBatchSize: 1000, ReadConcurrency: 1},
+		"parallel batches of 1000": {ReadBatchSize: 1000, ReadConcurrency: 5},
+	}
+	withRepository(func(r *RedisJobRepository) {
+		ids := addBenchmarkJobs(b, r, 10000)
+		for name, config := range configs {
+			b.Run(name, func(b *testing.B) {
+				r.readConfig = config
+				for i := 0; i < b.N; i++ {
+					jobs, e := r.GetExistingJobsByIds(ids)
+					if e != nil || len(jobs) != len(ids) {
+						b.Fatalf("failed to read jobs: %v", e)
+					}
+				}
+			})
+		}
+	})
+}
+
+func addBenchmarkJobs(b *testing.B, r *RedisJobRepository, count int) []string {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	container := v1.Container{Name: "container", Resources: v1.ResourceRequirements{Limits: resources, Requests: resources}}
+	ids := []string{}
+	for len(ids) < count {
+		request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1"}
+		for i := 0; i < 1000; i++ {
+			request.JobRequestItems = append(request.JobRequestItems, &api.JobSubmitRequestItem{
+				Priority: 1,
+				PodSpec:  &v1.PodSpec{Containers: []v1.Container{container}},
+			})
+		}
+		jobs, e := r.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		if e != nil {
+			b.Fatal(e)
+		}
+		results, e := r.AddJobs(jobs, false)
+		if e != nil {
+			b.Fatal(e)
+		}
+		for _, result := range results {
+			ids = append(ids, result.JobId)
+		}
+	}
+	return ids
+}
+
+func TestUpdateLeasedJobMemory_UpdatesJobsLeasedByCluster(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		otherClusterJob := addLeasedJob(t, r, "queue1", "cluster2")
+		queuedJob := addTestJob(t, r, "queue1")
+		newMemory := map[string]resource.Quantity{"": resource.MustParse("1Gi")}
+
+		for _, job := range []*api.Job{leasedJob, otherClusterJob, queuedJob} {
+			e := r.UpdateLeasedJobMemory("cluster1", job.Id, 0, newMemory)
+			assert.NoError(t, e)
+		}
+
+		jobs, e := r.GetExistingJobsByIds([]string{leasedJob.Id, otherClusterJob.Id, queuedJob.Id})
+		assert.NoError(t, e)
+		assert.Equal(t, "1Gi", jobs[0].PodSpec.Containers[0].Resources.Limits.Memory().String())
+		assert.Equal(t, "1Gi", jobs[0].PodSpec.Containers[0].Resources.Requests.Memory().String())
+		assert.Equal(t, "512Mi", jobs[1].PodSpec.Containers[0].Resources.Limits.Memory().String())
+		assert.Equal(t, "512Mi", jobs[2].PodSpec.Containers[0].Resources.Limits.Memory().String())
+
+		e = r.UpdateLeasedJobMemory("cluster1", leasedJob.Id, 1, newMemory)
+		assert.Error(t, e)
+	})
+}
+
 func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster string) *api.Job {
 	job := addTestJob(t, r, queue)
 	leased, e := r.TryLeaseJobs(cluster, queue, []*api.Job{job})
@@ -518,6 +1157,40 @@ func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster str
 	return job
 }
 
+func queuedJobIds(t *testing.T, r *RedisJobRepository, queue string) []string {
+	queued, e := r.PeekQueue(queue, 10)
+	assert.Nil(t, e)
+	ids := []string{}
+	for _, job := range queued {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func addLeasedProtectedJob(t *testing.T, r *RedisJobRepository, queue string, cluster string) *api.Job {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+		Queue:    queue,
+		JobSetId: "set1",
+		JobRequestItems: []*api.JobSubmitRequestItem{{
+			Priority:    1,
+			Annotations: map[string]string{common.ProtectedJobAnnotation: "true"},
+			PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{Limits: resources, Requests: resources},
+			}}},
+		}},
+	}, authorization.NewStaticPrincipal("user", []string{}))
+	assert.NoError(t, e)
+	results, e := r.AddJobs(jobs, false)
+	assert.Nil(t, e)
+	assert.Empty(t, results[0].Error)
+
+	leased, e := r.TryLeaseJobs(cluster, queue, jobs)
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(leased))
+	return jobs[0]
+}
+
 func addTestJob(t *testing.T, r *RedisJobRepository, queue string) *api.Job {
 	return addTestJobWithClientId(t, r, queue, "")
 }
@@ -553,7 +1226,7 @@ func addTestJobWithRequirements(t *testing.T, r *RedisJobRepository, queue strin
 	}, authorization.NewStaticPrincipal("user", []string{}))
 	assert.NoError(t, e)
 
-	results, e := r.AddJobs(jobs)
+	results, e := r.AddJobs(jobs, false)
 	assert.Nil(t, e)
 	for i, result := range results {
 		assert.Empty(t, result.Error)
@@ -566,13 +1239,83 @@ func withRepository(action func(r *RedisJobRepository)) {
 	withRepositoryUsingJobDefaults(nil, action)
 }
 
+func TestCreateJobs_NormalizesEquivalentResourceQuantities(t *testing.T) {
+	withRepositoryUsingConfig(nil, configuration.JobRepositoryConfig{NormalizeResources: true, BinaryResources: []string{"memory"}}, func(r *RedisJobRepository) {
+		job1 := addTestJobWithResources(t, r, v1.ResourceList{
+			"cpu": resource.MustParse("1000m"), "memory": resource.MustParse("1024Mi"), "nvidia.com/gpu": resource.MustParse("1"),
+		})
+		job2 := addTestJobWithResources(t, r, v1.ResourceList{
+			"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi"), "nvidia.com/gpu": resource.MustParse("1000m"),
+		})
+		job3 := addTestJobWithResources(t, r, v1.ResourceList{
+			"cpu": resource.MustParse("1.0"), "memory": resource.MustParse("1073741824"), "nvidia.com/gpu": resource.MustParse("1e0"),
+		})
+
+		stored, e := r.GetExistingJobsByIds([]string{job1.Id, job2.Id, job3.Id})
+		assert.NoError(t, e)
+		expected := map[string]string{"cpu": "1", "memory": "1Gi", "nvidia.com/gpu": "1"}
+		for _, job := range stored {
+			assert.Equal(t, expected, resourceStrings(job.PodSpec.Containers[0].Resources.Requests))
+			assert.Equal(t, expected, resourceStrings(job.PodSpec.Containers[0].Resources.Limits))
+		}
+	})
+}
+
+func TestCreateJobs_NormalizationIsLossless(t *testing.T) {
+	withRepositoryUsingConfig(nil, configuration.JobRepositoryConfig{NormalizeResources: true, BinaryResources: []string{"memory"}}, func(r *RedisJobRepository) {
+		submitted := v1.ResourceList{
+			"cpu": resource.MustParse("1001m"), "memory": resource.MustParse("1000M"), "ephemeral-storage": resource.MustParse("1536Mi"), "small": resource.MustParse("1n"),
+		}
+		job := addTestJobWithResources(t, r, submitted)
+
+		stored, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.NoError(t, e)
+		requests := stored[0].PodSpec.Containers[0].Resources.Requests
+		assert.Equal(t, len(submitted), len(requests))
+		for name, quantity := range submitted {
+			assert.Equal(t, 0, quantity.Cmp(requests[name]), "quantity of %s changed", name)
+		}
+	})
+}
+
+func addTestJobWithResources(t *testing.T, r *RedisJobRepository, resources v1.ResourceList) *api.Job {
+	jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+		Queue:    "queue1",
+		JobSetId: "set1",
+		JobRequestItems: []*api.JobSubmitRequestItem{{
+			PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+				Name:      "container",
+				Image:     "image",
+				Resources: v1.ResourceRequirements{Requests: resources, Limits: resources.DeepCopy()},
+			}}},
+		}},
+	}, authorization.NewStaticPrincipal("user", []string{}))
+	assert.NoError(t, e)
+	results, e := r.AddJobs(jobs, false)
+	assert.NoError(t, e)
+	assert.NoError(t, results[0].Error)
+	return jobs[0]
+}
+
+func resourceStrings(resources v1.ResourceList) map[string]string {
+	result := map[string]string{}
+	for name, quantity := range resources {
+		result[string(name)] = quantity.String()
+	}
+	return result
+}
+
 func withRepositoryUsingJobDefaults(jobDefaultLimit common.ComputeResources, action func(r *RedisJobRepository)) {
+	withRepositoryUsingConfig(jobDefaultLimit, configuration.JobRepositoryConfig{}, action)
+}
+
+func withRepositoryUsingConfig(jobDefaultLimit common.ComputeResources, config configuration.JobRepositoryConfig, action func(r *RedisJobRepository)) {
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 	defer client.FlushDB()
 	defer client.Close()
 
 	client.FlushDB()
 
-	repo := NewRedisJobRepository(client, jobDefaultLimit)
+	repo := NewRedisJobRepository(client, jobDefaultLimit, config)
 	action(repo)
 }
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const podSpecTemplateHashKey = "PodSpecTemplate"
+
+type PodSpecTemplateRepository interface {
+	GetPodSpecTemplate(name string) (*api.PodSpecTemplate, error)
+	StorePodSpecTemplate(template *api.PodSpecTemplate) error
+	DeletePodSpecTemplate(name string) error
+}
+
+type RedisPodSpecTemplateRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisPodSpecTemplateRepository(db redis.UniversalClient) *RedisPodSpecTemplateRepository {
+	return &RedisPodSpecTemplateRepository{db: db}
+}
+
+// Returns redis.Nil error when the template does not exist
+func (r *RedisPodSpecTemplateRepository) GetPodSpecTemplate(name string) (*api.PodSpecTemplate, error) {
+	result, err := r.db.HGet(podSpecTemplateHashKey, name).Result()
+	if err != nil {
+		return nil, err
+	}
+	template := &api.PodSpecTemplate{}
+	e := proto.Unmarshal([]byte(result), template)
+	if e != nil {
+		return nil, e
+	}
+	return template, nil
+}
+
+// Creates the template or replaces the existing one, jobs submitted before keep the pod spec they were submitted with
+func (r *RedisPodSpecTemplateRepository) StorePodSpecTemplate(template *api.PodSpecTemplate) error {
+	data, e := proto.Marshal(template)
+	if e != nil {
+		return e
+	}
+	return r.db.HSet(podSpecTemplateHashKey, template.Name, data).Err()
+}
+
+func (r *RedisPodSpecTemplateRepository) DeletePodSpecTemplate(name string) error {
+	return r.db.HDel(podSpecTemplateHashKey, name).Err()
+}
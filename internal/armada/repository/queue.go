@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"fmt"
+
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
 
@@ -13,9 +15,14 @@ type QueueRepository interface {
 	GetAllQueues() ([]*api.Queue, error)
 	GetQueue(name string) (*api.Queue, error)
 	CreateQueue(queue *api.Queue) error
+	UpdateQueue(name string, update QueueUpdate) (*api.Queue, error)
+	CreateOrUpdateQueue(name string, update QueueUpdate) (*api.Queue, error)
 	DeleteQueue(name string) error
 }
 
+// Changes the queue, allQueues are all stored queues read in the same transaction, including the queue before the change
+type QueueUpdate func(queue *api.Queue, allQueues []*api.Queue) error
+
 type RedisQueueRepository struct {
 	db redis.UniversalClient
 }
@@ -29,7 +36,10 @@ func (r *RedisQueueRepository) GetAllQueues() ([]*api.Queue, error) {
 	if err != nil {
 		return nil, err
 	}
+	return unmarshalQueues(result)
+}
 
+func unmarshalQueues(result map[string]string) ([]*api.Queue, error) {
 	queues := make([]*api.Queue, 0)
 	for _, v := range result {
 		queue := &api.Queue{}
@@ -64,7 +74,69 @@ func (r *RedisQueueRepository) CreateQueue(queue *api.Queue) error {
 	return result.Err()
 }
 
+// Changes the stored queue with update and returns its previous state, fails if the queue does not exist.
+// All queues are read and the queue is written in one transaction, errors of update are returned as they are and nothing is written.
+func (r *RedisQueueRepository) UpdateQueue(name string, update QueueUpdate) (*api.Queue, error) {
+	return r.updateQueue(name, false, update)
+}
+
+// Same as UpdateQueue, but a queue which does not exist is created by update of an empty queue, with nil previous state
+func (r *RedisQueueRepository) CreateOrUpdateQueue(name string, update QueueUpdate) (*api.Queue, error) {
+	return r.updateQueue(name, true, update)
+}
+
+func (r *RedisQueueRepository) updateQueue(name string, create bool, update QueueUpdate) (*api.Queue, error) {
+	var previous *api.Queue
+	e := r.db.Watch(func(tx *redis.Tx) error {
+		stored, e := tx.HGetAll(queueHashKey).Result()
+		if e != nil {
+			return e
+		}
+		allQueues, e := unmarshalQueues(stored)
+		if e != nil {
+			return e
+		}
+		queue := &api.Queue{}
+		previous = nil
+		if existing, ok := stored[name]; ok {
+			previous = &api.Queue{}
+			if e := proto.Unmarshal([]byte(existing), previous); e != nil {
+				return e
+			}
+			queue = proto.Clone(previous).(*api.Queue)
+		} else if !create {
+			return &ErrQueueNotFound{QueueName: name}
+		}
+		if e := update(queue, allQueues); e != nil {
+			return e
+		}
+		queue.Name = name
+		data, e := proto.Marshal(queue)
+		if e != nil {
+			return e
+		}
+		_, e = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			pipe.HSet(queueHashKey, name, data)
+			return nil
+		})
+		return e
+	}, queueHashKey)
+
+	if e != nil {
+		return nil, e
+	}
+	return previous, nil
+}
+
 func (r *RedisQueueRepository) DeleteQueue(name string) error {
 	result := r.db.HDel(queueHashKey, name)
 	return result.Err()
 }
+
+type ErrQueueNotFound struct {
+	QueueName string
+}
+
+func (err *ErrQueueNotFound) Error() string {
+	return fmt.Sprintf("queue %s does not exist", err.QueueName)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestCreateOrUpdateQueue_CreatesMissingQueue(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository) {
+		assert.NoError(t, r.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+
+		var seen []*api.Queue
+		previous, e := r.CreateOrUpdateQueue("queue2", func(queue *api.Queue, allQueues []*api.Queue) error {
+			seen = allQueues
+			queue.PriorityFactor = 2
+			return nil
+		})
+
+		assert.NoError(t, e)
+		assert.Nil(t, previous)
+		assert.Len(t, seen, 1)
+		assert.Equal(t, "queue1", seen[0].Name)
+		queue, e := r.GetQueue("queue2")
+		assert.NoError(t, e)
+		assert.Equal(t, &api.Queue{Name: "queue2", PriorityFactor: 2}, queue)
+	})
+}
+
+func TestUpdateQueue_FailsForMissingQueue(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository) {
+		_, e := r.UpdateQueue("queue1", func(queue *api.Queue, allQueues []*api.Queue) error {
+			return nil
+		})
+
+		assert.IsType(t, &ErrQueueNotFound{}, e)
+		_, e = r.GetQueue("queue1")
+		assert.Equal(t, redis.Nil, e)
+	})
+}
+
+func withQueueRepository(action func(r *RedisQueueRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	action(NewRedisQueueRepository(client))
+}
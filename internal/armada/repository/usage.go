@@ -2,10 +2,12 @@ package repository
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -17,6 +19,7 @@ type Usage struct {
 const clusterReportKey = "Cluster:Report"
 const clusterLeasedReportKey = "Cluster:Leased"
 const clusterPrioritiesPrefix = "Cluster:Priority:"
+const quotaLeaseLockKey = "Cluster:QuotaLeaseLock"
 
 type UsageRepository interface {
 	GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error)
@@ -26,6 +29,9 @@ type UsageRepository interface {
 
 	UpdateCluster(report *api.ClusterUsageReport, priorities map[string]float64) error
 	UpdateClusterLeased(report *api.ClusterLeasedReport) error
+
+	TryLockQuotaLeasing(expiry time.Duration) (token string, locked bool, e error)
+	UnlockQuotaLeasing(token string) error
 }
 
 type RedisUsageRepository struct {
@@ -133,6 +139,35 @@ func (r *RedisUsageRepository) UpdateClusterLeased(report *api.ClusterLeasedRepo
 	return e
 }
 
+// Leasing of queues with resource quotas is serialized across all servers, so the leased reports used to check the
+// quotas include jobs leased by other lease calls. The lock expires in case a server holding it dies.
+// Returns the token of the acquired lock, unique for every lock so only its holder releases it
+func (r *RedisUsageRepository) TryLockQuotaLeasing(expiry time.Duration) (string, bool, error) {
+	token := util.NewULID()
+	locked, e := r.db.SetNX(quotaLeaseLockKey, token, expiry).Result()
+	if e != nil || !locked {
+		return "", false, e
+	}
+	return token, true, nil
+}
+
+func (r *RedisUsageRepository) UnlockQuotaLeasing(token string) error {
+	_, e := unlockQuotaLeasingScript.Run(r.db, []string{quotaLeaseLockKey}, token).Result()
+	return e
+}
+
+// Lock is only released by its holder, after expiry it could be held by another lease call already,
+// also one of the same cluster
+var unlockQuotaLeasingScript = redis.NewScript(`
+local lockKey = KEYS[1]
+local token = ARGV[1]
+
+if redis.call('GET', lockKey) == token then
+	return redis.call('DEL', lockKey)
+end
+return 0
+`)
+
 func toFloat64Map(result map[string]string) (map[string]float64, error) {
 	reports := make(map[string]float64)
 	for k, v := range result {
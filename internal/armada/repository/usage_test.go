@@ -48,6 +48,30 @@ func TestUpdateClusterLeased(t *testing.T) {
 	})
 }
 
+func TestTryLockQuotaLeasing(t *testing.T) {
+	withUsageRepository(func(r *RedisUsageRepository) {
+		token, locked, e := r.TryLockQuotaLeasing(time.Minute)
+		assert.NoError(t, e)
+		assert.True(t, locked)
+
+		_, locked, e = r.TryLockQuotaLeasing(time.Minute)
+		assert.NoError(t, e)
+		assert.False(t, locked)
+
+		assert.NoError(t, r.UnlockQuotaLeasing(token))
+		nextToken, locked, e := r.TryLockQuotaLeasing(time.Minute)
+		assert.NoError(t, e)
+		assert.True(t, locked)
+		assert.NotEqual(t, token, nextToken)
+
+		// only the holder releases the lock, a lock expired meanwhile doesn't release the next one
+		assert.NoError(t, r.UnlockQuotaLeasing(token))
+		_, locked, e = r.TryLockQuotaLeasing(time.Minute)
+		assert.NoError(t, e)
+		assert.False(t, locked)
+	})
+}
+
 func makeClusterLeasedReport(clusterId string, queueNames ...string) *api.ClusterLeasedReport {
 	cpuAndMemory := common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
 	queueReports := make([]*api.QueueLeasedReport, 0, len(queueNames))
@@ -1,8 +1,10 @@
 package scheduling
 
 import (
+	"sort"
 	"time"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -77,6 +79,83 @@ func GroupSchedulingInfoByPool(reports map[string]*api.ClusterSchedulingInfoRepo
 	return result
 }
 
+// CalculatePoolCapacity returns the largest node and total allocatable resources of each pool, sorted by pool name
+func CalculatePoolCapacity(reports map[string]*api.ClusterSchedulingInfoReport) []*api.PoolCapacity {
+	result := []*api.PoolCapacity{}
+	for pool, poolReports := range GroupSchedulingInfoByPool(reports) {
+		largestNode := common.ComputeResources{}
+		total := common.ComputeResources{}
+		for _, report := range poolReports {
+			for _, nodeType := range report.NodeTypes {
+				largestNode.Max(nodeType.AllocatableResources)
+			}
+			total.Add(report.TotalAllocatableResources)
+		}
+		result = append(result, &api.PoolCapacity{
+			Pool:                   pool,
+			LargestNodeAllocatable: largestNode,
+			TotalAllocatable:       total,
+			Clusters:               int32(len(poolReports)),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Pool < result[j].Pool })
+	return result
+}
+
+// Used and available resources are only known for clusters with a usage report, they are left empty for other clusters
+func CalculateClusterCapacityReport(
+	schedulingInfos map[string]*api.ClusterSchedulingInfoReport,
+	usageReports map[string]*api.ClusterUsageReport,
+	now time.Time) []*api.PoolCapacityReport {
+
+	result := []*api.PoolCapacityReport{}
+	for pool, poolSchedulingInfos := range GroupSchedulingInfoByPool(schedulingInfos) {
+		poolCapacity := &api.PoolCapacityReport{
+			Pool:        pool,
+			Allocatable: common.ComputeResources{},
+			Used:        common.ComputeResources{},
+			Available:   common.ComputeResources{},
+			Clusters:    []*api.ClusterCapacity{},
+		}
+		for id, schedulingInfo := range poolSchedulingInfos {
+			clusterCapacity := calculateClusterCapacity(schedulingInfo, usageReports[id], now)
+			common.ComputeResources(poolCapacity.Allocatable).Add(clusterCapacity.Allocatable)
+			common.ComputeResources(poolCapacity.Used).Add(clusterCapacity.Used)
+			common.ComputeResources(poolCapacity.Available).Add(clusterCapacity.Available)
+			if clusterCapacity.ReportAge > poolCapacity.ReportAge {
+				poolCapacity.ReportAge = clusterCapacity.ReportAge
+			}
+			poolCapacity.Clusters = append(poolCapacity.Clusters, clusterCapacity)
+		}
+		sort.Slice(poolCapacity.Clusters, func(i, j int) bool { return poolCapacity.Clusters[i].ClusterId < poolCapacity.Clusters[j].ClusterId })
+		result = append(result, poolCapacity)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Pool < result[j].Pool })
+	return result
+}
+
+func calculateClusterCapacity(schedulingInfo *api.ClusterSchedulingInfoReport, usageReport *api.ClusterUsageReport, now time.Time) *api.ClusterCapacity {
+	clusterCapacity := &api.ClusterCapacity{
+		ClusterId:   schedulingInfo.ClusterId,
+		Allocatable: common.ComputeResources(schedulingInfo.TotalAllocatableResources).DeepCopy(),
+		Used:        common.ComputeResources{},
+		Available:   common.ComputeResources{},
+		NodeTypes:   schedulingInfo.NodeTypes,
+		ReportAge:   now.Sub(schedulingInfo.ReportTime),
+	}
+	if usageReport == nil {
+		return clusterCapacity
+	}
+	used := common.ComputeResources(usageReport.ClusterCapacity).DeepCopy()
+	used.Sub(usageReport.ClusterAvailableCapacity)
+	clusterCapacity.Used = used
+	clusterCapacity.Available = common.ComputeResources(usageReport.ClusterAvailableCapacity).DeepCopy()
+	if usageAge := now.Sub(usageReport.ReportTime); usageAge > clusterCapacity.ReportAge {
+		clusterCapacity.ReportAge = usageAge
+	}
+	return clusterCapacity
+}
+
 func GetClusterReportIds(reports map[string]*api.ClusterUsageReport) []string {
 	var result []string
 	for id := range reports {
@@ -0,0 +1,94 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_CalculatePoolCapacity(t *testing.T) {
+	reports := map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {
+			ClusterId:  "cluster1",
+			Pool:       "cpu",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{
+				{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("8"), "memory": resource.MustParse("64Gi")}},
+				{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("16"), "memory": resource.MustParse("32Gi")}},
+			},
+			TotalAllocatableResources: common.ComputeResources{"cpu": resource.MustParse("80"), "memory": resource.MustParse("320Gi")},
+		},
+		"cluster2": {
+			ClusterId:                 "cluster2",
+			Pool:                      "cpu",
+			ReportTime:                time.Now(),
+			NodeTypes:                 []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("4")}}},
+			TotalAllocatableResources: common.ComputeResources{"cpu": resource.MustParse("20")},
+		},
+		"cluster3": {
+			ClusterId:                 "cluster3",
+			Pool:                      "gpu",
+			ReportTime:                time.Now(),
+			NodeTypes:                 []*api.NodeType{{AllocatableResources: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("8")}}},
+			TotalAllocatableResources: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("64")},
+		},
+	}
+
+	capacity := CalculatePoolCapacity(reports)
+
+	assert.Equal(t, 2, len(capacity))
+	assert.Equal(t, "cpu", capacity[0].Pool)
+	assert.Equal(t, int32(2), capacity[0].Clusters)
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("16"), "memory": resource.MustParse("64Gi")}.AsFloat(), common.ComputeResources(capacity[0].LargestNodeAllocatable).AsFloat())
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("320Gi")}.AsFloat(), common.ComputeResources(capacity[0].TotalAllocatable).AsFloat())
+	assert.Equal(t, "gpu", capacity[1].Pool)
+	assert.Equal(t, int32(1), capacity[1].Clusters)
+}
+
+func Test_CalculateClusterCapacityReport(t *testing.T) {
+	now := time.Now()
+	schedulingInfos := map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {
+			ClusterId:                 "cluster1",
+			Pool:                      "cpu",
+			ReportTime:                now.Add(-time.Minute),
+			NodeTypes:                 []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("8")}}},
+			TotalAllocatableResources: common.ComputeResources{"cpu": resource.MustParse("80")},
+		},
+		"cluster2": {
+			ClusterId:                 "cluster2",
+			Pool:                      "cpu",
+			ReportTime:                now.Add(-time.Minute),
+			TotalAllocatableResources: common.ComputeResources{"cpu": resource.MustParse("20")},
+		},
+	}
+	usageReports := map[string]*api.ClusterUsageReport{
+		"cluster1": {
+			ClusterId:                "cluster1",
+			ReportTime:               now.Add(-2 * time.Minute),
+			ClusterCapacity:          common.ComputeResources{"cpu": resource.MustParse("80")},
+			ClusterAvailableCapacity: common.ComputeResources{"cpu": resource.MustParse("30")},
+		},
+	}
+
+	pools := CalculateClusterCapacityReport(schedulingInfos, usageReports, now)
+
+	assert.Equal(t, 1, len(pools))
+	assert.Equal(t, "cpu", pools[0].Pool)
+	assert.Equal(t, 2*time.Minute, pools[0].ReportAge)
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("100")}.AsFloat(), common.ComputeResources(pools[0].Allocatable).AsFloat())
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("50")}.AsFloat(), common.ComputeResources(pools[0].Used).AsFloat())
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("30")}.AsFloat(), common.ComputeResources(pools[0].Available).AsFloat())
+
+	assert.Equal(t, 2, len(pools[0].Clusters))
+	assert.Equal(t, "cluster1", pools[0].Clusters[0].ClusterId)
+	assert.Equal(t, 1, len(pools[0].Clusters[0].NodeTypes))
+	assert.Equal(t, "cluster2", pools[0].Clusters[1].ClusterId)
+	assert.Equal(t, time.Minute, pools[0].Clusters[1].ReportAge)
+	assert.Empty(t, pools[0].Clusters[1].Used)
+}
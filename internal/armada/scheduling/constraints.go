@@ -0,0 +1,77 @@
+package scheduling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+// Annotations under this prefix are reserved for scheduling constraints
+const ConstraintAnnotationPrefix = "armada.io/"
+
+// ApplyConstraintAnnotations translates constraint annotations into node selector entries of the pod specs,
+// so they are taken into account when checking the job can be scheduled and when the pod is created.
+func ApplyConstraintAnnotations(annotations map[string]string, podSpecs []*v1.PodSpec, constraints []configuration.ConstraintAnnotation) error {
+	nodeLabels, e := ParseConstraintAnnotations(annotations, constraints)
+	if e != nil {
+		return e
+	}
+	for _, podSpec := range podSpecs {
+		if podSpec == nil {
+			continue
+		}
+		for label, value := range nodeLabels {
+			if current, exists := podSpec.NodeSelector[label]; exists && current != value {
+				return fmt.Errorf("constraint annotations require node label %s=%s, but node selector requires %s=%s", label, value, label, current)
+			}
+		}
+		for label, value := range nodeLabels {
+			if podSpec.NodeSelector == nil {
+				podSpec.NodeSelector = map[string]string{}
+			}
+			podSpec.NodeSelector[label] = value
+		}
+	}
+	return nil
+}
+
+// ParseConstraintAnnotations returns node labels required by the constraint annotations,
+// annotations under ConstraintAnnotationPrefix which are not configured constraints are rejected.
+func ParseConstraintAnnotations(annotations map[string]string, constraints []configuration.ConstraintAnnotation) (map[string]string, error) {
+	nodeLabelByName := make(map[string]string, len(constraints))
+	for _, constraint := range constraints {
+		nodeLabelByName[constraint.Name] = constraint.NodeLabel
+	}
+
+	nodeLabels := map[string]string{}
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, ConstraintAnnotationPrefix) {
+			continue
+		}
+		nodeLabel, ok := nodeLabelByName[strings.TrimPrefix(key, ConstraintAnnotationPrefix)]
+		if !ok {
+			return nil, fmt.Errorf("unknown scheduling constraint annotation %s, supported annotations are: %s", key, supportedConstraintAnnotations(constraints))
+		}
+		if value == "" {
+			return nil, fmt.Errorf("scheduling constraint annotation %s has no value", key)
+		}
+		nodeLabels[nodeLabel] = value
+	}
+	return nodeLabels, nil
+}
+
+func supportedConstraintAnnotations(constraints []configuration.ConstraintAnnotation) string {
+	if len(constraints) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(constraints))
+	for _, constraint := range constraints {
+		names = append(names, ConstraintAnnotationPrefix+constraint.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
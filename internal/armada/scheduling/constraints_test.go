@@ -0,0 +1,51 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+var testConstraints = []configuration.ConstraintAnnotation{
+	{Name: "requires-gpu-type", NodeLabel: "nvidia.com/gpu.product"},
+	{Name: "requires-zone", NodeLabel: "topology.kubernetes.io/zone"},
+}
+
+func Test_ApplyConstraintAnnotations_AddsNodeSelectorToAllPods(t *testing.T) {
+	podSpecs := []*v1.PodSpec{{}, {NodeSelector: map[string]string{"other": "label"}}}
+	annotations := map[string]string{
+		"armada.io/requires-gpu-type": "a100",
+		"unrelated.io/annotation":     "value",
+	}
+
+	e := ApplyConstraintAnnotations(annotations, podSpecs, testConstraints)
+
+	assert.NoError(t, e)
+	assert.Equal(t, map[string]string{"nvidia.com/gpu.product": "a100"}, podSpecs[0].NodeSelector)
+	assert.Equal(t, map[string]string{"nvidia.com/gpu.product": "a100", "other": "label"}, podSpecs[1].NodeSelector)
+}
+
+func Test_ApplyConstraintAnnotations_RejectsConflictWithNodeSelector(t *testing.T) {
+	podSpecs := []*v1.PodSpec{{NodeSelector: map[string]string{"topology.kubernetes.io/zone": "a"}}}
+
+	e := ApplyConstraintAnnotations(map[string]string{"armada.io/requires-zone": "b"}, podSpecs, testConstraints)
+
+	assert.Error(t, e)
+	assert.Equal(t, map[string]string{"topology.kubernetes.io/zone": "a"}, podSpecs[0].NodeSelector)
+}
+
+func Test_ParseConstraintAnnotations_RejectsUnknownAndEmptyConstraints(t *testing.T) {
+	_, e := ParseConstraintAnnotations(map[string]string{"armada.io/requires-gpu": "a100"}, testConstraints)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "armada.io/requires-gpu-type, armada.io/requires-zone")
+
+	_, e = ParseConstraintAnnotations(map[string]string{"armada.io/requires-zone": ""}, testConstraints)
+	assert.Error(t, e)
+
+	nodeLabels, e := ParseConstraintAnnotations(map[string]string{}, nil)
+	assert.NoError(t, e)
+	assert.Empty(t, nodeLabels)
+}
@@ -0,0 +1,141 @@
+package scheduling
+
+import (
+	"encoding/json"
+	"math/rand"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	floorPhase     = "floor"
+	assignPhase    = "assign"
+	remainderPhase = "remainder"
+)
+
+// DecisionTrace records how a single allocation cycle distributed resources between queues.
+// All methods are no-ops on nil trace, so cycles which are not sampled do not pay for tracing.
+type DecisionTrace struct {
+	ClusterId           string
+	Pool                string
+	Started             time.Time
+	Duration            time.Duration
+	ResourcesToSchedule common.ComputeResourcesFloat
+	Queues              []*QueueDecision // queues considered in the cycle, sorted by name
+	Steps               []*LeaseStep     // lease attempts in the order they were made
+	LeasedJobs          int
+
+	placements map[string]map[string]string
+}
+
+type QueueDecision struct {
+	Queue           string
+	Priority        float64
+	CurrentUsage    common.ComputeResources
+	Share           common.ComputeResourcesFloat // resources the queue is entitled to in this cycle
+	SchedulingLimit common.ComputeResourcesFloat
+}
+
+type LeaseStep struct {
+	Phase     string
+	Queue     string
+	Offered   common.ComputeResourcesFloat
+	Scheduled common.ComputeResourcesFloat
+	Jobs      []*LeasedJob
+	Error     string `json:",omitempty"`
+}
+
+type LeasedJob struct {
+	JobId      string
+	NodeLabels map[string]string // labels of the node type the job was matched to
+}
+
+func newDecisionTrace(
+	config configuration.DecisionTraceConfig,
+	request *api.LeaseRequest,
+	resourcesToSchedule common.ComputeResourcesFloat,
+	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	priorities map[*api.Queue]QueuePriorityInfo) *DecisionTrace {
+
+	if !config.Enabled || (config.SampleRate > 0 && rand.Float64() >= config.SampleRate) {
+		return nil
+	}
+
+	trace := &DecisionTrace{
+		ClusterId:           request.ClusterId,
+		Pool:                request.Pool,
+		Started:             time.Now(),
+		ResourcesToSchedule: resourcesToSchedule.DeepCopy(),
+		Queues:              make([]*QueueDecision, 0, len(queueSchedulingInfo)),
+		Steps:               []*LeaseStep{},
+		placements:          map[string]map[string]string{},
+	}
+	for queue, info := range queueSchedulingInfo {
+		trace.Queues = append(trace.Queues, &QueueDecision{
+			Queue:           queue.Name,
+			Priority:        priorities[queue].Priority,
+			CurrentUsage:    priorities[queue].CurrentUsage,
+			Share:           info.adjustedShare.DeepCopy(),
+			SchedulingLimit: info.remainingSchedulingLimit.DeepCopy(),
+		})
+	}
+	sort.Slice(trace.Queues, func(i, j int) bool { return trace.Queues[i].Queue < trace.Queues[j].Queue })
+	return trace
+}
+
+func (t *DecisionTrace) recordPlacements(leased []*api.Job, nodeTypeUsage map[*api.Job]nodeTypeUsedResources) {
+	if t == nil {
+		return
+	}
+	for _, job := range leased {
+		for nodeType := range nodeTypeUsage[job] {
+			t.placements[job.Id] = nodeType.labels
+		}
+	}
+}
+
+func (t *DecisionTrace) recordStep(phase string, queue *api.Queue, offered, remaining common.ComputeResourcesFloat, leased []*api.Job, err error) {
+	if t == nil {
+		return
+	}
+	scheduled := offered.DeepCopy()
+	if err == nil {
+		scheduled.Sub(remaining)
+	} else {
+		scheduled = common.ComputeResourcesFloat{}
+	}
+	step := &LeaseStep{
+		Phase:     phase,
+		Queue:     queue.Name,
+		Offered:   offered.DeepCopy(),
+		Scheduled: scheduled,
+		Jobs:      make([]*LeasedJob, 0, len(leased)),
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	for _, job := range leased {
+		step.Jobs = append(step.Jobs, &LeasedJob{JobId: job.Id, NodeLabels: t.placements[job.Id]})
+	}
+	t.Steps = append(t.Steps, step)
+	t.LeasedJobs += len(leased)
+}
+
+func (t *DecisionTrace) export() {
+	if t == nil {
+		return
+	}
+	t.Duration = time.Since(t.Started)
+	trace, e := json.Marshal(t)
+	if e != nil {
+		log.Errorf("Failed to serialise scheduling decision trace for cluster %s: %s", t.ClusterId, e)
+		return
+	}
+	log.WithField("clusterId", t.ClusterId).WithField("trace", string(trace)).Info("Scheduling decision trace")
+}
@@ -0,0 +1,92 @@
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_newDecisionTrace_OnlyTracesWhenEnabled(t *testing.T) {
+	request := &api.LeaseRequest{ClusterId: "c1"}
+
+	assert.Nil(t, newDecisionTrace(configuration.DecisionTraceConfig{}, request, nil, nil, nil))
+	assert.NotNil(t, newDecisionTrace(configuration.DecisionTraceConfig{Enabled: true}, request, nil, nil, nil))
+	assert.Nil(t, newDecisionTrace(configuration.DecisionTraceConfig{Enabled: true, SampleRate: 0.0000001}, request, nil, nil, nil))
+
+	// nil trace can be used without checks
+	var trace *DecisionTrace
+	trace.recordStep(assignPhase, &api.Queue{Name: "queue1"}, nil, nil, nil, nil)
+	trace.export()
+}
+
+func Test_distributeRemainder_RecordsDecisionTrace(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	scarcity := map[string]float64{"cpu": 1}
+	priorities := map[*api.Queue]QueuePriorityInfo{queue1: {Priority: 1}}
+	requestSize := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
+	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+		queue1: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
+	}
+	repository := &fakeJobQueue{
+		jobsByQueue: map[string][]*api.Job{
+			"queue1": {
+				&api.Job{Id: "job1", PodSpec: classicPodSpec},
+				&api.Job{Id: "job2", PodSpec: classicPodSpec},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+	defer cancel()
+	nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	nodes := []api.NodeInfo{{Name: "testNode", Labels: map[string]string{"type": "cpu"}, AllocatableResources: nodeResources, AvailableResources: nodeResources}}
+
+	c := leaseContext{
+		ctx:              ctx,
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		clusterId:        "c1",
+		nodeResources:    AggregateNodeTypeAllocations(nodes, nil),
+
+		resourceScarcity:    scarcity,
+		priorities:          priorities,
+		queueSchedulingInfo: SliceResourceWithLimits(scarcity, schedulingInfo, priorities, requestSize.AsFloat()),
+		queue:               repository,
+		queueCache:          map[string][]*api.Job{},
+	}
+	c.trace = newDecisionTrace(
+		configuration.DecisionTraceConfig{Enabled: true},
+		&api.LeaseRequest{ClusterId: "c1"}, requestSize.AsFloat(), c.queueSchedulingInfo, c.priorities)
+
+	jobs, e := c.distributeRemainder(1000)
+	assert.Nil(t, e)
+	assert.Equal(t, 2, len(jobs))
+
+	assert.Equal(t, 1, len(c.trace.Queues))
+	assert.Equal(t, "queue1", c.trace.Queues[0].Queue)
+	assert.Equal(t, 2, c.trace.LeasedJobs)
+
+	leasedJobs := []string{}
+	for _, step := range c.trace.Steps {
+		assert.Equal(t, remainderPhase, step.Phase)
+		assert.Equal(t, "queue1", step.Queue)
+		for _, job := range step.Jobs {
+			leasedJobs = append(leasedJobs, job.JobId)
+			assert.Equal(t, map[string]string{"type": "cpu"}, job.NodeLabels)
+		}
+	}
+	assert.Equal(t, []string{"job1", "job2"}, leasedJobs)
+	// queue is dropped after a step without suitable jobs
+	assert.Empty(t, c.trace.Steps[len(c.trace.Steps)-1].Jobs)
+
+	_, e = json.Marshal(c.trace)
+	assert.NoError(t, e)
+}
@@ -0,0 +1,190 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const maxReportedNodeTypesPerCluster = 3
+
+func ExplainSchedulingFeasibility(jobIndex int, job *api.Job, allClusterSchedulingInfos map[string]*api.ClusterSchedulingInfoReport) *api.JobSchedulingFeasibility {
+	result := &api.JobSchedulingFeasibility{JobIndex: int32(jobIndex)}
+
+	nodeTypesByPool := map[string][]*api.NodeType{}
+	for _, schedulingInfo := range allClusterSchedulingInfos {
+		result.Clusters = append(result.Clusters, explainClusterFeasibility(job, schedulingInfo))
+		nodeTypesByPool[schedulingInfo.Pool] = append(nodeTypesByPool[schedulingInfo.Pool], schedulingInfo.NodeTypes...)
+	}
+	sort.Slice(result.Clusters, func(i, j int) bool {
+		a, b := clusterReasonCount(result.Clusters[i]), clusterReasonCount(result.Clusters[j])
+		return a < b || a == b && result.Clusters[i].ClusterId < result.Clusters[j].ClusterId
+	})
+
+	jobRequest := common.TotalJobResourceRequest(job).AsFloat()
+	for pool, nodeTypes := range nodeTypesByPool {
+		largest := largestNodeType(jobRequest, nodeTypes)
+		if largest != nil {
+			result.LargestNodeTypes = append(result.LargestNodeTypes, &api.PoolNodeType{
+				Pool:                 pool,
+				Labels:               largest.Labels,
+				AllocatableResources: largest.AllocatableResources,
+			})
+		}
+	}
+	sort.Slice(result.LargestNodeTypes, func(i, j int) bool {
+		return result.LargestNodeTypes[i].Pool < result.LargestNodeTypes[j].Pool
+	})
+	return result
+}
+
+func FormatSchedulingFeasibility(feasibility *api.JobSchedulingFeasibility) string {
+	return fmt.Sprintf("job with index %d is not schedulable on any cluster: %s", feasibility.JobIndex, formatFeasibilityExplanations(feasibility))
+}
+
+func formatFeasibilityExplanations(feasibility *api.JobSchedulingFeasibility) string {
+	explanations := []string{}
+	for _, cluster := range feasibility.Clusters {
+		reasons := append([]string{}, cluster.Reasons...)
+		if len(cluster.NodeTypes) > 0 {
+			reasons = append(reasons, cluster.NodeTypes[0].Reasons...)
+		}
+		explanations = append(explanations, fmt.Sprintf("cluster %s (pool %s): %s", cluster.ClusterId, cluster.Pool, strings.Join(reasons, ", ")))
+	}
+	for _, nodeType := range feasibility.LargestNodeTypes {
+		explanations = append(explanations, fmt.Sprintf("largest node type in pool %s has %s", nodeType.Pool, common.ComputeResources(nodeType.AllocatableResources)))
+	}
+	if len(explanations) == 0 {
+		return "no active clusters"
+	}
+	return strings.Join(explanations, "; ")
+}
+
+func explainClusterFeasibility(job *api.Job, schedulingInfo *api.ClusterSchedulingInfoReport) *api.ClusterSchedulingFeasibility {
+	result := &api.ClusterSchedulingFeasibility{
+		ClusterId: schedulingInfo.ClusterId,
+		Pool:      schedulingInfo.Pool,
+	}
+
+	if !isLargeEnough(job, schedulingInfo.MinimumJobSize) {
+		result.Reasons = append(result.Reasons, fmt.Sprintf("job is smaller than cluster minimum job size %s", common.ComputeResources(schedulingInfo.MinimumJobSize)))
+	}
+	if !matchKubernetesVersion(job, schedulingInfo.KubernetesVersion) {
+		clusterVersion := schedulingInfo.KubernetesVersion
+		if clusterVersion == "" {
+			clusterVersion = "unknown"
+		}
+		result.Reasons = append(result.Reasons, fmt.Sprintf("cluster kubernetes version %s does not meet minimum version %s", clusterVersion, job.MinKubernetesVersion))
+	}
+	if !matchRequiredClusters(job, schedulingInfo.ClusterId, schedulingInfo.Pool) {
+		result.Reasons = append(result.Reasons, fmt.Sprintf("cluster is not one of required clusters %s", strings.Join(job.RequiredClusters, ", ")))
+	}
+	if len(schedulingInfo.NodeTypes) == 0 {
+		result.Reasons = append(result.Reasons, "cluster has no available nodes")
+	}
+
+	podSpecs := job.GetAllPodSpecs()
+	for _, nodeType := range schedulingInfo.NodeTypes {
+		nodeTypeResult := &api.NodeTypeSchedulingFeasibility{
+			Labels:               nodeType.Labels,
+			AllocatableResources: nodeType.AllocatableResources,
+		}
+		if !allowedOnNode(job, nodeType.Labels, schedulingInfo.PreemptibleNodes) {
+			nodeTypeResult.Reasons = append(nodeTypeResult.Reasons, "node type is preemptible, job does not tolerate preemptible nodes")
+		}
+		for i, podSpec := range podSpecs {
+			podSpec = withPreemptibleNodeTolerations(job, podSpec, schedulingInfo.PreemptibleNodes)
+			for _, reason := range explainNodeTypeMismatch(podSpec, nodeType) {
+				if len(podSpecs) > 1 {
+					reason = fmt.Sprintf("pod %d: %s", i, reason)
+				}
+				nodeTypeResult.Reasons = append(nodeTypeResult.Reasons, reason)
+			}
+		}
+		result.NodeTypes = append(result.NodeTypes, nodeTypeResult)
+	}
+	sort.SliceStable(result.NodeTypes, func(i, j int) bool {
+		return len(result.NodeTypes[i].Reasons) < len(result.NodeTypes[j].Reasons)
+	})
+	if len(result.NodeTypes) > maxReportedNodeTypesPerCluster {
+		result.NodeTypes = result.NodeTypes[:maxReportedNodeTypesPerCluster]
+	}
+	return result
+}
+
+func explainNodeTypeMismatch(podSpec *v1.PodSpec, nodeType *api.NodeType) []string {
+	reasons := []string{}
+
+	resourceRequest := common.TotalPodResourceRequest(podSpec)
+	nodeResources := common.ComputeResources(nodeType.AllocatableResources)
+	for _, name := range sortedKeys(resourceRequest) {
+		requested := resourceRequest[name]
+		available := nodeResources[name]
+		if requested.Cmp(available) > 0 {
+			reasons = append(reasons, fmt.Sprintf("insufficient %s: requested %s, node type has %s", name, requested.String(), available.String()))
+		}
+	}
+
+	selectorKeys := make([]string, 0, len(podSpec.NodeSelector))
+	for k := range podSpec.NodeSelector {
+		selectorKeys = append(selectorKeys, k)
+	}
+	sort.Strings(selectorKeys)
+	for _, k := range selectorKeys {
+		if nodeType.Labels == nil || nodeType.Labels[k] != podSpec.NodeSelector[k] {
+			reasons = append(reasons, fmt.Sprintf("node selector %s=%s does not match", k, podSpec.NodeSelector[k]))
+		}
+	}
+
+	for i := range nodeType.Taints {
+		taint := nodeType.Taints[i]
+		if taint.Effect != v1.TaintEffectPreferNoSchedule && !common.TolerationsTolerateTaint(podSpec.Tolerations, &taint) {
+			reasons = append(reasons, fmt.Sprintf("taint %s is not tolerated", taint.ToString()))
+		}
+	}
+	return reasons
+}
+
+// Largest node type relative to the job is the one able to fit the biggest fraction of its most constrained resource
+func largestNodeType(jobRequest common.ComputeResourcesFloat, nodeTypes []*api.NodeType) *api.NodeType {
+	var largest *api.NodeType
+	largestFraction := -1.0
+	for _, nodeType := range nodeTypes {
+		nodeResources := common.ComputeResources(nodeType.AllocatableResources).AsFloat()
+		fraction := math.Inf(1)
+		for name, requested := range jobRequest {
+			if requested > 0 {
+				fraction = math.Min(fraction, nodeResources[name]/requested)
+			}
+		}
+		if fraction > largestFraction || fraction == largestFraction && common.ComputeResources(nodeType.AllocatableResources).Dominates(largest.AllocatableResources) {
+			largest = nodeType
+			largestFraction = fraction
+		}
+	}
+	return largest
+}
+
+func clusterReasonCount(cluster *api.ClusterSchedulingFeasibility) int {
+	count := len(cluster.Reasons)
+	if len(cluster.NodeTypes) > 0 {
+		count += len(cluster.NodeTypes[0].Reasons)
+	}
+	return count
+}
+
+func sortedKeys(resources map[string]resource.Quantity) []string {
+	keys := make([]string, 0, len(resources))
+	for k := range resources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,95 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_ExplainSchedulingFeasibility_ReportsReasonsPerNodeType(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("4"), "memory": resource.MustParse("2Gi")}
+	job := &api.Job{PodSpec: &v1.PodSpec{
+		NodeSelector: map[string]string{"zone": "a"},
+		Containers:   []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}},
+	}}
+
+	small := &api.NodeType{
+		Labels:               map[string]string{"zone": "a"},
+		AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("4Gi")},
+	}
+	tainted := &api.NodeType{
+		Labels:               map[string]string{"zone": "b"},
+		Taints:               []v1.Taint{{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}},
+		AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("8"), "memory": resource.MustParse("8Gi")},
+	}
+
+	result := ExplainSchedulingFeasibility(2, job, map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "cpu", NodeTypes: []*api.NodeType{tainted, small}},
+		"cluster2": {ClusterId: "cluster2", Pool: "cpu"},
+	})
+
+	assert.Equal(t, int32(2), result.JobIndex)
+	assert.Len(t, result.Clusters, 2)
+
+	cluster1 := result.Clusters[0]
+	assert.Equal(t, "cluster1", cluster1.ClusterId)
+	assert.Empty(t, cluster1.Reasons)
+	assert.Equal(t, []string{"insufficient cpu: requested 4, node type has 2"}, cluster1.NodeTypes[0].Reasons)
+	assert.Equal(t, []string{"node selector zone=a does not match", "taint gpu=true:NoSchedule is not tolerated"}, cluster1.NodeTypes[1].Reasons)
+
+	assert.Equal(t, []string{"cluster has no available nodes"}, result.Clusters[1].Reasons)
+
+	assert.Len(t, result.LargestNodeTypes, 1)
+	assert.Equal(t, "cpu", result.LargestNodeTypes[0].Pool)
+	assert.Equal(t, tainted.AllocatableResources, result.LargestNodeTypes[0].AllocatableResources)
+
+	assert.Equal(t,
+		"job with index 2 is not schedulable on any cluster: "+
+			"cluster cluster1 (pool cpu): insufficient cpu: requested 4, node type has 2; "+
+			"cluster cluster2 (pool cpu): cluster has no available nodes; "+
+			"largest node type in pool cpu has cpu: 8, memory: 8Gi",
+		FormatSchedulingFeasibility(result))
+}
+
+func Test_ExplainSchedulingFeasibility_ReportsMinimumJobSize(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("1")}
+	job := &api.Job{PodSpec: &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}}}}
+
+	result := ExplainSchedulingFeasibility(0, job, map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {
+			ClusterId:      "cluster1",
+			MinimumJobSize: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("1")},
+			NodeTypes:      []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("2")}}},
+		},
+	})
+
+	assert.Equal(t, []string{"job is smaller than cluster minimum job size nvidia.com/gpu: 1"}, result.Clusters[0].Reasons)
+	assert.Empty(t, result.Clusters[0].NodeTypes[0].Reasons)
+}
+
+func Test_ExplainSchedulingFeasibility_ReportsKubernetesVersion(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{}, MinKubernetesVersion: "1.19"}
+
+	result := ExplainSchedulingFeasibility(0, job, map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", KubernetesVersion: "v1.18.2", NodeTypes: []*api.NodeType{{}}},
+		"cluster2": {ClusterId: "cluster2", NodeTypes: []*api.NodeType{{}}},
+	})
+
+	assert.Equal(t, []string{"cluster kubernetes version v1.18.2 does not meet minimum version 1.19"}, result.Clusters[0].Reasons)
+	assert.Equal(t, []string{"cluster kubernetes version unknown does not meet minimum version 1.19"}, result.Clusters[1].Reasons)
+}
+
+func Test_ExplainSchedulingFeasibility_ReportsRequiredClusters(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{}, RequiredClusters: []string{"cluster3", "gpu"}}
+
+	result := ExplainSchedulingFeasibility(0, job, map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "cpu", NodeTypes: []*api.NodeType{{}}},
+	})
+
+	assert.Equal(t, []string{"cluster is not one of required clusters cluster3, gpu"}, result.Clusters[0].Reasons)
+}
@@ -19,6 +19,7 @@ const maxJobsPerLease = 10000
 type JobQueue interface {
 	PeekClusterQueue(clusterId, queue string, limit int64) ([]*api.Job, error)
 	TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error)
+	ReturnLease(clusterId string, jobId string) (*api.Job, error)
 }
 
 type leaseContext struct {
@@ -28,15 +29,21 @@ type leaseContext struct {
 
 	ctx       context.Context
 	clusterId string
+	pool      string
 
 	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo
 	resourceScarcity    map[string]float64
 	priorities          map[*api.Queue]QueuePriorityInfo
+	floorReservations   map[*api.Queue]common.ComputeResourcesFloat
 
-	nodeResources  []*nodeTypeAllocation
-	minimumJobSize map[string]resource.Quantity
+	nodeResources     []*nodeTypeAllocation
+	minimumJobSize    map[string]resource.Quantity
+	kubernetesVersion string
+	preemptibleNodes  *api.PreemptibleNodes
 
 	queueCache map[string][]*api.Job
+
+	trace *DecisionTrace
 }
 
 func LeaseJobs(ctx context.Context,
@@ -47,10 +54,15 @@ func LeaseJobs(ctx context.Context,
 	nodeResources []*nodeTypeAllocation,
 	activeClusterReports map[string]*api.ClusterUsageReport,
 	activeClusterLeaseJobReports map[string]*api.ClusterLeasedReport,
+	totalActiveCapacity common.ComputeResources,
+	queueResourcesLeased map[string]common.ComputeResources,
 	clusterPriorities map[string]map[string]float64,
 	activeQueues []*api.Queue) ([]*api.Job, error) {
 
-	resourcesToSchedule := common.ComputeResources(request.Resources).AsFloat()
+	resourcesToSchedule := oversubscribedResources(
+		request.Resources,
+		totalAllocatableResources(request.Nodes),
+		config.GetResourceOversubscription(request.Pool))
 	currentClusterReport, ok := activeClusterReports[request.ClusterId]
 
 	totalCapacity := &common.ComputeResources{}
@@ -62,6 +74,7 @@ func LeaseJobs(ctx context.Context,
 	maxResourceToSchedulePerQueue := totalCapacity.MulByResource(config.MaximalResourceFractionToSchedulePerQueue)
 	maxResourcePerQueue := totalCapacity.MulByResource(config.MaximalResourceFractionPerQueue)
 	queueSchedulingInfo := calculateQueueSchedulingLimits(activeQueues, maxResourceToSchedulePerQueue, maxResourcePerQueue, totalCapacity, resourceAllocatedByQueue)
+	applyResourceQuotas(queueSchedulingInfo, queueResourcesLeased)
 
 	if ok {
 		capacity := common.ComputeResources(currentClusterReport.ClusterCapacity)
@@ -73,7 +86,8 @@ func LeaseJobs(ctx context.Context,
 	if scarcity == nil {
 		scarcity = ResourceScarcityFromReports(activeClusterReports)
 	}
-	activeQueueSchedulingInfo := SliceResourceWithLimits(scarcity, queueSchedulingInfo, activeQueuePriority, resourcesToSchedule)
+	floorReservations := reserveResourceFloors(activeQueuePriority, totalActiveCapacity, queueResourcesLeased, resourcesToSchedule)
+	activeQueueSchedulingInfo := sliceResourceWithReservations(scarcity, queueSchedulingInfo, activeQueuePriority, floorReservations, resourcesToSchedule)
 
 	lc := &leaseContext{
 		schedulingConfig: config,
@@ -81,19 +95,34 @@ func LeaseJobs(ctx context.Context,
 
 		ctx:       ctx,
 		clusterId: request.ClusterId,
+		pool:      request.Pool,
 
 		resourceScarcity:    scarcity,
 		queueSchedulingInfo: activeQueueSchedulingInfo,
 		priorities:          activeQueuePriority,
+		floorReservations:   floorReservations,
 		nodeResources:       nodeResources,
 		minimumJobSize:      request.MinimumJobSize,
+		kubernetesVersion:   request.KubernetesVersion,
+		preemptibleNodes:    request.PreemptibleNodes,
 
 		queueCache: map[string][]*api.Job{},
 
 		onJobsLeased: onJobLease,
+
+		trace: newDecisionTrace(config.DecisionTrace, request, resourcesToSchedule, activeQueueSchedulingInfo, activeQueuePriority),
 	}
 
-	return lc.scheduleJobs(maxJobsPerLease)
+	jobs, e := lc.scheduleJobs(jobLeaseLimit(request))
+	lc.trace.export()
+	return jobs, e
+}
+
+func jobLeaseLimit(request *api.LeaseRequest) int {
+	if request.MaxJobsToLease > 0 && request.MaxJobsToLease < maxJobsPerLease {
+		return int(request.MaxJobsToLease)
+	}
+	return maxJobsPerLease
 }
 
 func calculateQueueSchedulingLimits(
@@ -123,7 +152,8 @@ func calculateQueueSchedulingLimits(
 }
 
 func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
-	jobs := []*api.Job{}
+	jobs := c.leaseFloors(limit)
+	limit -= len(jobs)
 
 	if !c.schedulingConfig.UseProbabilisticSchedulingForAllResources {
 		assignedJobs, e := c.assignJobs(limit)
@@ -131,8 +161,8 @@ func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
 			log.Errorf("Error when leasing jobs for cluster %s: %s", c.clusterId, e)
 			return nil, e
 		}
-		jobs = assignedJobs
-		limit -= len(jobs)
+		jobs = append(jobs, assignedJobs...)
+		limit -= len(assignedJobs)
 	}
 
 	additionalJobs, e := c.distributeRemainder(limit)
@@ -151,12 +181,41 @@ func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
 	return jobs, nil
 }
 
+// Queues below their floor are offered the reserved part of their share before jobs of any queue are leased
+// by fair share, nothing already leased is preempted
+func (c *leaseContext) leaseFloors(limit int) []*api.Job {
+	jobs := []*api.Job{}
+	for _, queue := range queuesByReservation(c.resourceScarcity, c.floorReservations) {
+		if limit <= 0 || c.closeToDeadline() {
+			break
+		}
+		info, ok := c.queueSchedulingInfo[queue]
+		if !ok {
+			continue
+		}
+		slice := floorSlice(info, c.floorReservations[queue])
+		leased, remainder, e := c.leaseJobs(queue, slice, limit)
+		c.trace.recordStep(floorPhase, queue, slice, remainder, leased, e)
+		if e != nil {
+			log.Error(e)
+			continue
+		}
+		scheduled := slice.DeepCopy()
+		scheduled.Sub(remainder)
+		info.UpdateLimits(scheduled)
+		jobs = append(jobs, leased...)
+		limit -= len(leased)
+	}
+	return jobs
+}
+
 func (c *leaseContext) assignJobs(limit int) ([]*api.Job, error) {
 	jobs := make([]*api.Job, 0)
 	// TODO: parallelize
 	for queue, info := range c.queueSchedulingInfo {
 		// TODO: partition limit by priority instead
 		leased, remainder, e := c.leaseJobs(queue, info.adjustedShare, limit/len(c.queueSchedulingInfo))
+		c.trace.recordStep(assignPhase, queue, info.adjustedShare, remainder, leased, e)
 		if e != nil {
 			log.Error(e)
 			continue
@@ -197,6 +256,7 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 		amountToSchedule := remainder.DeepCopy()
 		amountToSchedule = amountToSchedule.LimitWith(c.queueSchedulingInfo[queue].remainingSchedulingLimit)
 		leased, remaining, e := c.leaseJobs(queue, amountToSchedule, 1)
+		c.trace.recordStep(remainderPhase, queue, amountToSchedule, remaining, leased, e)
 		if e != nil {
 			log.Error(e)
 			continue
@@ -230,7 +290,6 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 
 func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResourcesFloat, limit int) ([]*api.Job, common.ComputeResourcesFloat, error) {
 	jobs := make([]*api.Job, 0)
-	remainder := slice
 	for slice.IsValid() {
 		if limit <= 0 {
 			break
@@ -249,18 +308,28 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 		candidates := make([]*api.Job, 0)
 		candidateNodes := map[*api.Job]nodeTypeUsedResources{}
 		consumedNodeResources := nodeTypeUsedResources{}
+		gangs := groupGangs(topJobs)
 
 		for _, job := range topJobs {
-			requirement := common.TotalJobResourceRequest(job).AsFloat()
-			remainder = slice.DeepCopy()
-			remainder.Sub(requirement)
-			if isLargeEnough(job, c.minimumJobSize) && remainder.IsValid() {
-				newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources)
-				if ok {
-					slice = remainder
-					candidates = append(candidates, job)
-					candidateNodes[job] = newlyConsumed
-					consumedNodeResources.Add(newlyConsumed)
+			members := []*api.Job{job}
+			if job.GangId != "" {
+				members = gangs[gangKey(job)]
+				// gang is considered once, at its first member; members outside of this batch are never leased partially
+				if members[0] != job || len(members) != int(job.GangSize) {
+					continue
+				}
+				// gangs bigger than the limit can still be leased on their own
+				if len(candidates) > 0 && len(candidates)+len(members) > limit {
+					continue
+				}
+			}
+			remainder, placements, ok := c.matchJobs(members, slice, consumedNodeResources)
+			if ok {
+				slice = remainder
+				for _, member := range members {
+					candidates = append(candidates, member)
+					candidateNodes[member] = placements[member]
+					consumedNodeResources.Add(placements[member])
 				}
 			}
 			if len(candidates) >= limit {
@@ -273,11 +342,13 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 		if e != nil {
 			return nil, slice, e
 		}
+		leased = c.returnIncompleteGangs(leased)
 
 		jobs = append(jobs, leased...)
 		limit -= len(leased)
 
 		c.decreaseNodeResources(leased, candidateNodes)
+		c.trace.recordPlacements(leased, candidateNodes)
 
 		// stop scheduling round if we leased less then batch (either the slice is too small or queue is empty)
 		// TODO: should we look at next batch?
@@ -294,6 +365,66 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 	return jobs, slice, nil
 }
 
+// matchJobs checks that all the jobs fit together, this way gang members are leased all or none
+func (c *leaseContext) matchJobs(jobs []*api.Job, slice common.ComputeResourcesFloat, consumedNodeResources nodeTypeUsedResources) (
+	common.ComputeResourcesFloat, map[*api.Job]nodeTypeUsedResources, bool) {
+
+	remainder := slice.DeepCopy()
+	consumed := nodeTypeUsedResources(consumedNodeResources.DeepCopy())
+	placements := map[*api.Job]nodeTypeUsedResources{}
+	for _, job := range jobs {
+		remainder.Sub(common.TotalJobResourceRequest(job).AsFloat())
+		if !isLargeEnough(job, c.minimumJobSize) || !matchKubernetesVersion(job, c.kubernetesVersion) ||
+			!matchRequiredClusters(job, c.clusterId, c.pool) || !remainder.IsValid() {
+			return slice, nil, false
+		}
+		newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumed, c.preemptibleNodes)
+		if !ok {
+			return slice, nil, false
+		}
+		placements[job] = newlyConsumed
+		consumed.Add(newlyConsumed)
+	}
+	return remainder, placements, true
+}
+
+// TryLeaseJobs skips jobs leased or deleted in the meantime, the rest of such gang is returned to the queue
+func (c *leaseContext) returnIncompleteGangs(leased []*api.Job) []*api.Job {
+	leasedMembers := map[string]int{}
+	for _, job := range leased {
+		if job.GangId != "" {
+			leasedMembers[gangKey(job)]++
+		}
+	}
+
+	result := make([]*api.Job, 0, len(leased))
+	for _, job := range leased {
+		if job.GangId == "" || leasedMembers[gangKey(job)] == int(job.GangSize) {
+			result = append(result, job)
+			continue
+		}
+		if _, e := c.queue.ReturnLease(c.clusterId, job.Id); e != nil {
+			log.Errorf("Failed to return lease of job %s from incomplete gang %s: %s", job.Id, job.GangId, e)
+		}
+	}
+	return result
+}
+
+func groupGangs(jobs []*api.Job) map[string][]*api.Job {
+	gangs := map[string][]*api.Job{}
+	for _, job := range jobs {
+		if job.GangId != "" {
+			gangs[gangKey(job)] = append(gangs[gangKey(job)], job)
+		}
+	}
+	return gangs
+}
+
+// gang ids are unique within a job set
+func gangKey(job *api.Job) string {
+	return job.JobSetId + "/" + job.GangId
+}
+
 func (c *leaseContext) decreaseNodeResources(leased []*api.Job, nodeTypeUsage map[*api.Job]nodeTypeUsedResources) {
 	for _, j := range leased {
 		for nodeType, resources := range nodeTypeUsage[j] {
@@ -1,6 +1,7 @@
 package scheduling
 
 import (
+	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -10,22 +11,28 @@ import (
 )
 
 type LeaseManager struct {
-	jobRepository       repository.JobRepository
-	queueRepository     repository.QueueRepository
-	eventStore          repository.EventStore
-	leaseExpiryDuration time.Duration
+	jobRepository                   repository.JobRepository
+	queueRepository                 repository.QueueRepository
+	usageRepository                 repository.UsageRepository
+	eventStore                      repository.EventStore
+	leaseExpiryDuration             time.Duration
+	protectedJobLeaseExpiryDuration time.Duration
 }
 
 func NewLeaseManager(
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
+	usageRepository repository.UsageRepository,
 	eventStore repository.EventStore,
-	leaseExpiryDuration time.Duration) *LeaseManager {
+	leaseExpiryDuration time.Duration,
+	protectedJobLeaseExpiryDuration time.Duration) *LeaseManager {
 	return &LeaseManager{
-		jobRepository:       jobRepository,
-		queueRepository:     queueRepository,
-		eventStore:          eventStore,
-		leaseExpiryDuration: leaseExpiryDuration}
+		jobRepository:                   jobRepository,
+		queueRepository:                 queueRepository,
+		usageRepository:                 usageRepository,
+		eventStore:                      eventStore,
+		leaseExpiryDuration:             leaseExpiryDuration,
+		protectedJobLeaseExpiryDuration: protectedJobLeaseExpiryDuration}
 }
 
 func (l *LeaseManager) ExpireLeases() {
@@ -36,18 +43,31 @@ func (l *LeaseManager) ExpireLeases() {
 	}
 
 	deadline := time.Now().Add(-l.leaseExpiryDuration)
+	activeClusters := l.activeClusters()
+	protectedDeadline, protectedClusters := l.protectedJobLeases(deadline, activeClusters)
 	for _, queue := range queues {
-		jobs, e := l.jobRepository.ExpireLeases(queue.Name, deadline)
+		jobs, protectedJobs, clusters, e := l.jobRepository.ExpireLeases(queue.Name, deadline, protectedDeadline, protectedClusters)
 		now := time.Now()
 		if e != nil {
 			log.Error(e)
 		} else {
+			for _, job := range protectedJobs {
+				l.reportProtectedJob(job, clusters[job.Id], requeueReasonOfExpiredLease(clusters[job.Id], activeClusters), now)
+			}
 			for _, job := range jobs {
+				clusterId := clusters[job.Id]
+				reason := requeueReasonOfExpiredLease(clusterId, activeClusters)
+				e := l.jobRepository.AddRequeue(job.Id, &api.JobRequeue{Reason: reason, ClusterId: clusterId, Created: now})
+				if e != nil {
+					log.Errorf("Failed to record requeue of job %s: %v", job.Id, e)
+				}
+
 				event, e := api.Wrap(&api.JobLeaseExpiredEvent{
-					JobId:    job.Id,
-					Queue:    job.Queue,
-					JobSetId: job.JobSetId,
-					Created:  now,
+					JobId:         job.Id,
+					Queue:         job.Queue,
+					JobSetId:      job.JobSetId,
+					Created:       now,
+					RequeueReason: reason,
 				})
 				if e != nil {
 					log.Error(e)
@@ -61,3 +81,56 @@ func (l *LeaseManager) ExpireLeases() {
 		}
 	}
 }
+
+func (l *LeaseManager) reportProtectedJob(job *api.Job, clusterId string, requeueReason api.RequeueReason, now time.Time) {
+	reason := fmt.Sprintf("Lease was not renewed by cluster %s, lease of protected job is kept while the cluster reports usage", clusterId)
+	log.Infof("Not expiring lease of protected job %s (%s): %s", job.Id, requeueReason, reason)
+	event, e := api.Wrap(&api.JobProtectedEvent{
+		JobId:         job.Id,
+		Queue:         job.Queue,
+		JobSetId:      job.JobSetId,
+		Created:       now,
+		ClusterId:     clusterId,
+		Reason:        reason,
+		RequeueReason: requeueReason,
+	})
+	if e != nil {
+		log.Error(e)
+		return
+	}
+	e = l.eventStore.ReportEvents([]*api.EventMessage{event})
+	if e != nil {
+		log.Error(e)
+	}
+}
+
+// Protected jobs keep their lease while their cluster reports usage, so an executor failing to renew for a while
+// doesn't lose them to another cluster. Clusters which stopped reporting lose them like other jobs.
+func (l *LeaseManager) protectedJobLeases(deadline time.Time, activeClusters map[string]bool) (time.Time, map[string]bool) {
+	if l.protectedJobLeaseExpiryDuration <= l.leaseExpiryDuration {
+		return deadline, map[string]bool{}
+	}
+	return time.Now().Add(-l.protectedJobLeaseExpiryDuration), activeClusters
+}
+
+func (l *LeaseManager) activeClusters() map[string]bool {
+	activeClusters := map[string]bool{}
+	reports, e := l.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		log.Errorf("Failed to get cluster usage reports, leases of protected jobs expire as usual and all expired leases are reported as %s: %v", api.RequeueReason_LeaseExpired, e)
+		return activeClusters
+	}
+	for clusterId := range FilterActiveClusters(reports) {
+		activeClusters[clusterId] = true
+	}
+	return activeClusters
+}
+
+// Leases of clusters still reporting usage expired because the executor did not renew them,
+// other clusters likely crashed or lost connection to the server
+func requeueReasonOfExpiredLease(clusterId string, activeClusters map[string]bool) api.RequeueReason {
+	if activeClusters[clusterId] {
+		return api.RequeueReason_LeaseRenewalFailed
+	}
+	return api.RequeueReason_LeaseExpired
+}
@@ -0,0 +1,76 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestLeaseManager_ReportsKeptLeaseOfProtectedJobOnce(t *testing.T) {
+	withLeaseManager(func(l *LeaseManager, events *repository.RedisEventRepository) {
+		assert.NoError(t, l.queueRepository.CreateQueue(&api.Queue{Name: "queue1", PriorityFactor: 1}))
+		assert.NoError(t, l.usageRepository.UpdateCluster(&api.ClusterUsageReport{ClusterId: "cluster1", ReportTime: time.Now()}, map[string]float64{}))
+
+		resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+		jobs, e := l.jobRepository.CreateJobs(&api.JobSubmitRequest{
+			Queue:    "queue1",
+			JobSetId: "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{{
+				Annotations: map[string]string{common.ProtectedJobAnnotation: "true"},
+				PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+					Resources: v1.ResourceRequirements{Limits: resources, Requests: resources},
+				}}},
+			}},
+		}, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		_, e = l.jobRepository.AddJobs(jobs, false)
+		assert.NoError(t, e)
+		leased, e := l.jobRepository.TryLeaseJobs("cluster1", "queue1", jobs)
+		assert.NoError(t, e)
+		assert.Equal(t, 1, len(leased))
+
+		l.ExpireLeases()
+		l.ExpireLeases()
+
+		messages, _, e := events.ReadEvents("queue1", "set1", "", 100, -1, map[string]bool{"protected": true})
+		assert.NoError(t, e)
+		assert.Equal(t, 1, len(messages))
+		event := messages[0].Message.GetProtected()
+		assert.Equal(t, jobs[0].Id, event.JobId)
+		assert.Equal(t, "cluster1", event.ClusterId)
+		assert.Equal(t, api.RequeueReason_LeaseRenewalFailed, event.RequeueReason)
+
+		expired, _, e := events.ReadEvents("queue1", "set1", "", 100, -1, map[string]bool{"lease_expired": true})
+		assert.NoError(t, e)
+		assert.Empty(t, expired)
+	})
+}
+
+func withLeaseManager(action func(l *LeaseManager, events *repository.RedisEventRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	queueRepository := repository.NewRedisQueueRepository(client)
+	eventRepository := repository.NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: false}, queueRepository)
+	leaseManager := NewLeaseManager(
+		repository.NewRedisJobRepository(client, nil, configuration.JobRepositoryConfig{}),
+		queueRepository,
+		repository.NewRedisUsageRepository(client),
+		eventRepository,
+		0,
+		time.Hour)
+	action(leaseManager, eventRepository)
+}
@@ -2,6 +2,7 @@ package scheduling
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -29,6 +30,12 @@ func Test_minimumJobSize(t *testing.T) {
 	assert.False(t, isLargeEnough(job, common.ComputeResources{"gpu": resource.MustParse("1")}))
 }
 
+func Test_jobLeaseLimit(t *testing.T) {
+	assert.Equal(t, maxJobsPerLease, jobLeaseLimit(&api.LeaseRequest{}))
+	assert.Equal(t, 5, jobLeaseLimit(&api.LeaseRequest{MaxJobsToLease: 5}))
+	assert.Equal(t, maxJobsPerLease, jobLeaseLimit(&api.LeaseRequest{MaxJobsToLease: maxJobsPerLease + 1}))
+}
+
 func Test_distributeRemainder_highPriorityUserDoesNotBlockOthers(t *testing.T) {
 
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
@@ -81,7 +88,7 @@ func Test_distributeRemainder_highPriorityUserDoesNotBlockOthers(t *testing.T) {
 		},
 		onJobsLeased:  func(a []*api.Job) {},
 		clusterId:     "c1",
-		nodeResources: AggregateNodeTypeAllocations(nodes),
+		nodeResources: AggregateNodeTypeAllocations(nodes, nil),
 
 		resourceScarcity:    scarcity,
 		priorities:          priorities,
@@ -139,7 +146,7 @@ func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
 		onJobsLeased: func(a []*api.Job) {},
 		clusterId:    "c1",
 
-		nodeResources: AggregateNodeTypeAllocations(nodes),
+		nodeResources: AggregateNodeTypeAllocations(nodes, nil),
 
 		resourceScarcity:    scarcity,
 		priorities:          priorities,
@@ -153,6 +160,108 @@ func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
 	assert.Equal(t, 2, len(jobs))
 }
 
+func Test_distributeRemainder_LeasesOnlyJobsRequiringTheCluster(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	scarcity := map[string]float64{"cpu": 1}
+	priorities := map[*api.Queue]QueuePriorityInfo{queue1: {Priority: 1, CurrentUsage: common.ComputeResources{}}}
+	resourceLimit := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}.AsFloat()
+	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+		queue1: {remainingSchedulingLimit: resourceLimit, schedulingShare: resourceLimit, adjustedShare: resourceLimit},
+	}
+
+	anyCluster := &api.Job{Id: "any", PodSpec: classicPodSpec}
+	requiringCluster := &api.Job{Id: "cluster", PodSpec: classicPodSpec, RequiredClusters: []string{"c1"}}
+	requiringPool := &api.Job{Id: "pool", PodSpec: classicPodSpec, RequiredClusters: []string{"c3", "gpu"}}
+	requiringOtherCluster := &api.Job{Id: "other", PodSpec: classicPodSpec, RequiredClusters: []string{"c2"}}
+	repository := &fakeJobQueue{
+		jobsByQueue: map[string][]*api.Job{"queue1": {anyCluster, requiringCluster, requiringPool, requiringOtherCluster}},
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+	defer cancel()
+
+	nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
+
+	c := leaseContext{
+		ctx:              ctx,
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		clusterId:        "c1",
+		pool:             "gpu",
+
+		nodeResources: AggregateNodeTypeAllocations(nodes, nil),
+
+		resourceScarcity:    scarcity,
+		priorities:          priorities,
+		queueSchedulingInfo: SliceResourceWithLimits(scarcity, schedulingInfo, priorities, resourceLimit),
+		queue:               repository,
+		queueCache:          map[string][]*api.Job{},
+	}
+
+	jobs, e := c.distributeRemainder(1000)
+	assert.NoError(t, e)
+	assert.ElementsMatch(t, []*api.Job{anyCluster, requiringCluster, requiringPool}, jobs)
+	assert.Equal(t, []*api.Job{requiringOtherCluster}, repository.jobsByQueue["queue1"])
+}
+
+func Test_leaseJobs_LeasesOnlyWholeGangs(t *testing.T) {
+	incomplete := &api.Job{Id: "incomplete", JobSetId: "set", GangId: "incomplete", GangSize: 2, PodSpec: classicPodSpec}
+	tooBig := gang("too-big", 3)
+	fitting := gang("fitting", 2)
+	single := &api.Job{Id: "single", PodSpec: classicPodSpec}
+
+	queued := append([]*api.Job{incomplete}, tooBig...)
+	queued = append(queued, fitting...)
+	queued = append(queued, single)
+	repository := &fakeJobQueue{jobsByQueue: map[string][]*api.Job{"queue1": queued}}
+
+	slice := common.ComputeResources{"cpu": resource.MustParse("2.5"), "memory": resource.MustParse("1Gi")}.AsFloat()
+	jobs, _, e := gangLeaseContext(repository).leaseJobs(&api.Queue{Name: "queue1"}, slice, 100)
+
+	assert.NoError(t, e)
+	assert.Equal(t, fitting, jobs)
+	assert.Empty(t, repository.returned)
+}
+
+func Test_leaseJobs_ReturnsLeasesOfPartiallyLeasedGang(t *testing.T) {
+	members := gang("gang", 2)
+	repository := &fakeJobQueue{
+		jobsByQueue:   map[string][]*api.Job{"queue1": members},
+		alreadyLeased: map[string]bool{members[0].Id: true},
+	}
+
+	slice := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}.AsFloat()
+	jobs, _, e := gangLeaseContext(repository).leaseJobs(&api.Queue{Name: "queue1"}, slice, 100)
+
+	assert.NoError(t, e)
+	assert.Empty(t, jobs)
+	assert.Equal(t, []string{members[1].Id}, repository.returned)
+}
+
+func gang(gangId string, size int) []*api.Job {
+	members := []*api.Job{}
+	for i := 0; i < size; i++ {
+		members = append(members, &api.Job{
+			Id: fmt.Sprintf("%s-%d", gangId, i), JobSetId: "set", GangId: gangId, GangSize: uint32(size), PodSpec: classicPodSpec})
+	}
+	return members
+}
+
+func gangLeaseContext(repository *fakeJobQueue) *leaseContext {
+	nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
+	return &leaseContext{
+		ctx:              context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		clusterId:        "c1",
+		nodeResources:    AggregateNodeTypeAllocations(nodes, nil),
+		queue:            repository,
+		queueCache:       map[string][]*api.Job{},
+	}
+}
+
 func Test_calculateQueueSchedulingLimits(t *testing.T) {
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
 	activeQueues := []*api.Queue{queue1}
@@ -221,6 +330,9 @@ var classicPodSpec = &v1.PodSpec{
 
 type fakeJobQueue struct {
 	jobsByQueue map[string][]*api.Job
+	// jobs TryLeaseJobs does not lease, as if they were leased by another cluster already
+	alreadyLeased map[string]bool
+	returned      []string
 }
 
 func (r *fakeJobQueue) PeekClusterQueue(clusterId, queue string, limit int64) ([]*api.Job, error) {
@@ -246,5 +358,17 @@ outer:
 		remainingJobs = append(remainingJobs, j)
 	}
 	r.jobsByQueue[queue] = remainingJobs
-	return jobs, nil
+
+	leased := []*api.Job{}
+	for _, j := range jobs {
+		if !r.alreadyLeased[j.Id] {
+			leased = append(leased, j)
+		}
+	}
+	return leased, nil
+}
+
+func (r *fakeJobQueue) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	r.returned = append(r.returned, jobId)
+	return nil, nil
 }
@@ -2,10 +2,10 @@ package scheduling
 
 import (
 	"sort"
-	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
@@ -13,11 +13,15 @@ import (
 
 func CreateClusterSchedulingInfoReport(leaseRequest *api.LeaseRequest, nodeAllocations []*nodeTypeAllocation) *api.ClusterSchedulingInfoReport {
 	return &api.ClusterSchedulingInfoReport{
-		ClusterId:      leaseRequest.ClusterId,
-		Pool:           leaseRequest.Pool,
-		ReportTime:     time.Now(),
-		NodeTypes:      extractNodeTypes(nodeAllocations),
-		MinimumJobSize: leaseRequest.MinimumJobSize,
+		ClusterId:         leaseRequest.ClusterId,
+		Pool:              leaseRequest.Pool,
+		ReportTime:        time.Now(),
+		NodeTypes:         extractNodeTypes(nodeAllocations),
+		MinimumJobSize:    leaseRequest.MinimumJobSize,
+		KubernetesVersion: leaseRequest.KubernetesVersion,
+		PreemptibleNodes:  leaseRequest.PreemptibleNodes,
+
+		TotalAllocatableResources: totalAllocatableResources(leaseRequest.Nodes),
 	}
 }
 
@@ -37,9 +41,15 @@ func MatchSchedulingRequirements(job *api.Job, schedulingInfo *api.ClusterSchedu
 	if !isLargeEnough(job, schedulingInfo.MinimumJobSize) {
 		return false
 	}
+	if !matchKubernetesVersion(job, schedulingInfo.KubernetesVersion) {
+		return false
+	}
+	if !matchRequiredClusters(job, schedulingInfo.ClusterId, schedulingInfo.Pool) {
+		return false
+	}
 	for _, podSpec := range job.GetAllPodSpecs() {
 		// TODO: make sure there are enough nodes available for all the job pods
-		if !matchAnyNodeType(podSpec, schedulingInfo.NodeTypes) {
+		if !matchAnyNodeType(job, podSpec, schedulingInfo.NodeTypes, schedulingInfo.PreemptibleNodes) {
 			return false
 		}
 	}
@@ -61,26 +71,70 @@ func isLargeEnough(job *api.Job, minimumJobSize common.ComputeResources) bool {
 	return resourceRequest.IsValid()
 }
 
-func matchAnyNodeType(podSpec *v1.PodSpec, nodeTypes []*api.NodeType) bool {
+// Clusters which did not report their version are not considered for jobs requiring a minimum version
+func matchKubernetesVersion(job *api.Job, clusterVersion string) bool {
+	if job.MinKubernetesVersion == "" {
+		return true
+	}
+	minVersion, e := version.ParseGeneric(job.MinKubernetesVersion)
+	if e != nil {
+		return false
+	}
+	v, e := version.ParseGeneric(clusterVersion)
+	if e != nil {
+		return false
+	}
+	return v.AtLeast(minVersion)
+}
+
+// Required clusters are matched against both cluster id and pool
+func matchRequiredClusters(job *api.Job, clusterId string, pool string) bool {
+	if len(job.RequiredClusters) == 0 {
+		return true
+	}
+	for _, cluster := range job.RequiredClusters {
+		if cluster == clusterId || (pool != "" && cluster == pool) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyNodeType(job *api.Job, podSpec *v1.PodSpec, nodeTypes []*api.NodeType, preemptibleNodes *api.PreemptibleNodes) bool {
+	podSpec = withPreemptibleNodeTolerations(job, podSpec, preemptibleNodes)
 	for _, nodeType := range nodeTypes {
-		resourceRequest := common.TotalPodResourceRequest(podSpec).AsFloat()
-		nodeResources := common.ComputeResources(nodeType.AllocatableResources).AsFloat()
-		if fits(resourceRequest, nodeResources) && matchNodeSelector(podSpec, nodeType.Labels) && tolerates(podSpec, nodeType.Taints) {
+		if allowedOnNode(job, nodeType.Labels, preemptibleNodes) && common.PodFitsNodeType(podSpec, nodeType) {
 			return true
 		}
 	}
 	return false
 }
 
+// Pods are matched with the tolerations the executor gives them on clusters with preemptible nodes
+func withPreemptibleNodeTolerations(job *api.Job, podSpec *v1.PodSpec, preemptibleNodes *api.PreemptibleNodes) *v1.PodSpec {
+	if preemptibleNodes == nil {
+		return podSpec
+	}
+	spec := *podSpec
+	spec.Tolerations = common.PreemptibleNodeTolerations(podSpec.Tolerations, job.ToleratesPreemptibleNodes, preemptibleNodes.Taint)
+	return &spec
+}
+
+// Jobs not tolerating preemptible nodes get node affinity keeping them off the preemptible nodes
+func allowedOnNode(job *api.Job, labels map[string]string, preemptibleNodes *api.PreemptibleNodes) bool {
+	return job.ToleratesPreemptibleNodes || !common.IsPreemptibleNode(labels, preemptibleNodes.GetLabel(), preemptibleNodes.GetLabelValue())
+}
+
 func matchAnyNodeTypeAllocation(job *api.Job,
 	nodeAllocations []*nodeTypeAllocation,
-	alreadyConsumed nodeTypeUsedResources) (nodeTypeUsedResources, bool) {
+	alreadyConsumed nodeTypeUsedResources,
+	preemptibleNodes *api.PreemptibleNodes) (nodeTypeUsedResources, bool) {
 
 	newlyConsumed := nodeTypeUsedResources{}
 
 	for _, podSpec := range job.GetAllPodSpecs() {
 
-		nodeType, ok := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+		nodeType, ok := matchAnyNodeTypePodAllocation(job, podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, preemptibleNodes)
 
 		if !ok {
 			return nodeTypeUsedResources{}, false
@@ -93,11 +147,14 @@ func matchAnyNodeTypeAllocation(job *api.Job,
 }
 
 func matchAnyNodeTypePodAllocation(
+	job *api.Job,
 	podSpec *v1.PodSpec,
 	nodeAllocations []*nodeTypeAllocation,
 	alreadyConsumed nodeTypeUsedResources,
-	newlyConsumed nodeTypeUsedResources) (*nodeTypeAllocation, bool) {
+	newlyConsumed nodeTypeUsedResources,
+	preemptibleNodes *api.PreemptibleNodes) (*nodeTypeAllocation, bool) {
 	resourceRequest := common.TotalPodResourceRequest(podSpec).AsFloat()
+	podSpec = withPreemptibleNodeTolerations(job, podSpec, preemptibleNodes)
 
 	for _, node := range nodeAllocations {
 		available := node.availableResources.DeepCopy()
@@ -105,7 +162,7 @@ func matchAnyNodeTypePodAllocation(
 		available.Sub(newlyConsumed[node])
 		available.LimitWith(node.nodeSize.AsFloat())
 
-		if fits(resourceRequest, available) && matchNodeSelector(podSpec, node.labels) && tolerates(podSpec, node.taints) {
+		if allowedOnNode(job, node.labels, preemptibleNodes) && fits(resourceRequest, available) && common.MatchNodeSelector(podSpec, node.labels) && common.ToleratesTaints(podSpec.Tolerations, node.taints) {
 			return node, true
 		}
 	}
@@ -118,47 +175,14 @@ func fits(resourceRequest, availableResources common.ComputeResourcesFloat) bool
 	return r.IsValid()
 }
 
-func matchNodeSelector(podSpec *v1.PodSpec, labels map[string]string) bool {
-	for k, v := range podSpec.NodeSelector {
-		if labels == nil || labels[k] != v {
-			return false
-		}
-	}
-	return true
-}
-
-func tolerates(podSpec *v1.PodSpec, taints []v1.Taint) bool {
-	for _, taint := range taints {
-		// check only hard constraints
-		if taint.Effect == v1.TaintEffectPreferNoSchedule {
-			continue
-		}
-
-		if !tolerationsTolerateTaint(podSpec.Tolerations, &taint) {
-			return false
-		}
-	}
-	return true
-}
-
-// https://github.com/kubernetes/kubernetes/blob/master/pkg/apis/core/v1/helper/helpers.go#L427
-func tolerationsTolerateTaint(tolerations []v1.Toleration, taint *v1.Taint) bool {
-	for i := range tolerations {
-		if tolerations[i].ToleratesTaint(taint) {
-			return true
-		}
-	}
-	return false
-}
-
-func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
+func AggregateNodeTypeAllocations(nodes []api.NodeInfo, oversubscription map[string]float64) []*nodeTypeAllocation {
 	nodeTypesIndex := map[string]*nodeTypeAllocation{}
 
 	for _, n := range nodes {
-		description := createNodeDescription(&n)
+		description := common.NodeTypeDescription(n.Labels, n.Taints, n.AllocatableResources)
 		typeDescription, exists := nodeTypesIndex[description]
 
-		nodeAvailableResources := common.ComputeResources(n.AvailableResources).AsFloat()
+		nodeAvailableResources := oversubscribedResources(n.AvailableResources, n.AllocatableResources, oversubscription)
 
 		if !exists {
 			typeDescription = &nodeTypeAllocation{
@@ -186,20 +210,3 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 
 	return result
 }
-
-func createNodeDescription(n *api.NodeInfo) string {
-	data := []string{}
-	for k, v := range n.Labels {
-		data = append(data, "l"+k+"="+v)
-	}
-	for _, t := range n.Taints {
-		if t.Effect == v1.TaintEffectNoSchedule {
-			data = append(data, "t"+t.Key+"="+t.Value)
-		}
-	}
-	for k, v := range n.AllocatableResources {
-		data = append(data, "t"+k+"="+v.String())
-	}
-	sort.Strings(data)
-	return strings.Join(data, "|")
-}
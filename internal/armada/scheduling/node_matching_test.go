@@ -29,6 +29,31 @@ func Test_MatchSchedulingRequirements_labels(t *testing.T) {
 	}}))
 }
 
+func Test_MatchSchedulingRequirements_requiredClusters(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{}, RequiredClusters: []string{"cluster1", "gpu"}}
+	nodeTypes := []*api.NodeType{{}}
+
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{ClusterId: "cluster1", Pool: "cpu", NodeTypes: nodeTypes}))
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{ClusterId: "cluster2", Pool: "gpu", NodeTypes: nodeTypes}))
+	assert.False(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{ClusterId: "cluster2", Pool: "cpu", NodeTypes: nodeTypes}))
+
+	jobWithoutRequiredClusters := &api.Job{PodSpec: &v1.PodSpec{}}
+	assert.True(t, MatchSchedulingRequirements(jobWithoutRequiredClusters, &api.ClusterSchedulingInfoReport{ClusterId: "cluster2", NodeTypes: nodeTypes}))
+}
+
+func Test_MatchSchedulingRequirements_kubernetesVersion(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{}, MinKubernetesVersion: "1.19"}
+	nodeTypes := []*api.NodeType{{}}
+
+	assert.False(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: nodeTypes}))
+	assert.False(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: nodeTypes, KubernetesVersion: "v1.18.9"}))
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: nodeTypes, KubernetesVersion: "v1.19.0"}))
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: nodeTypes, KubernetesVersion: "v1.20.5-gke.1"}))
+
+	jobWithoutVersion := &api.Job{PodSpec: &v1.PodSpec{}}
+	assert.True(t, MatchSchedulingRequirements(jobWithoutVersion, &api.ClusterSchedulingInfoReport{NodeTypes: nodeTypes}))
+}
+
 func Test_MatchSchedulingRequirements_isAbleToFitOnAvailableNodes(t *testing.T) {
 	request := v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("2Gi")}
 	resourceRequirement := v1.ResourceRequirements{
@@ -51,6 +76,70 @@ func Test_MatchSchedulingRequirements_isAbleToFitOnAvailableNodes(t *testing.T)
 	}))
 }
 
+func Test_MatchSchedulingRequirements_accountsForInitContainers(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	initRequest := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("4Gi")}
+	job := &api.Job{PodSpec: &v1.PodSpec{
+		InitContainers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: initRequest, Requests: initRequest}}},
+		Containers: []v1.Container{
+			{Resources: v1.ResourceRequirements{Limits: request, Requests: request}},
+			{Resources: v1.ResourceRequirements{Limits: request, Requests: request}},
+		},
+	}}
+
+	assert.False(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{
+		NodeTypes: []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("3Gi")}}},
+	}))
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{
+		NodeTypes: []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("4Gi")}}},
+	}))
+}
+
+func Test_MatchSchedulingRequirements_preemptibleNodes(t *testing.T) {
+	preemptibleNodes := &api.PreemptibleNodes{Label: "spot", LabelValue: "true", Taint: "spot"}
+	spotTaint := v1.Taint{Key: "spot", Value: "true", Effect: v1.TaintEffectNoSchedule}
+	taintedSpotNodes := &api.ClusterSchedulingInfoReport{PreemptibleNodes: preemptibleNodes, NodeTypes: []*api.NodeType{
+		{Labels: map[string]string{"spot": "true"}, Taints: []v1.Taint{spotTaint}},
+	}}
+	untaintedSpotNodes := &api.ClusterSchedulingInfoReport{PreemptibleNodes: preemptibleNodes, NodeTypes: []*api.NodeType{
+		{Labels: map[string]string{"spot": "true"}},
+	}}
+	onDemandNodes := &api.ClusterSchedulingInfoReport{PreemptibleNodes: preemptibleNodes, NodeTypes: []*api.NodeType{
+		{Labels: map[string]string{"spot": "false"}},
+	}}
+
+	toleratingJob := &api.Job{PodSpec: &v1.PodSpec{}, ToleratesPreemptibleNodes: true}
+	assert.True(t, MatchSchedulingRequirements(toleratingJob, taintedSpotNodes))
+	assert.True(t, MatchSchedulingRequirements(toleratingJob, untaintedSpotNodes))
+	assert.True(t, MatchSchedulingRequirements(toleratingJob, onDemandNodes))
+
+	otherJob := &api.Job{PodSpec: &v1.PodSpec{Tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}}}}
+	assert.False(t, MatchSchedulingRequirements(otherJob, taintedSpotNodes))
+	assert.False(t, MatchSchedulingRequirements(otherJob, untaintedSpotNodes))
+	assert.True(t, MatchSchedulingRequirements(otherJob, onDemandNodes))
+
+	// clusters not reporting preemptible nodes match on the pod spec only
+	assert.True(t, MatchSchedulingRequirements(otherJob, &api.ClusterSchedulingInfoReport{NodeTypes: taintedSpotNodes.NodeTypes}))
+}
+
+func Test_matchAnyNodeTypeAllocation_preemptibleNodes(t *testing.T) {
+	preemptibleNodes := &api.PreemptibleNodes{Label: "spot"}
+	nodes := []*nodeTypeAllocation{{
+		labels:             map[string]string{"spot": "true"},
+		nodeSize:           makeResourceList(2, 2),
+		availableResources: makeResourceList(2, 2).AsFloat(),
+	}}
+	request := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	podSpec := &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}}}
+
+	_, ok := matchAnyNodeTypeAllocation(&api.Job{PodSpec: podSpec}, nodes, nodeTypeUsedResources{}, preemptibleNodes)
+	assert.False(t, ok)
+	_, ok = matchAnyNodeTypeAllocation(&api.Job{PodSpec: podSpec, ToleratesPreemptibleNodes: true}, nodes, nodeTypeUsedResources{}, preemptibleNodes)
+	assert.True(t, ok)
+	_, ok = matchAnyNodeTypeAllocation(&api.Job{PodSpec: podSpec}, nodes, nodeTypeUsedResources{}, nil)
+	assert.True(t, ok)
+}
+
 func Test_AggregateNodeTypesAllocations(t *testing.T) {
 
 	nodes := []api.NodeInfo{
@@ -71,7 +160,7 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 		},
 	}
 
-	aggregated := AggregateNodeTypeAllocations(nodes)
+	aggregated := AggregateNodeTypeAllocations(nodes, nil)
 	assert.Equal(t, []*nodeTypeAllocation{
 		{
 			taints:             nil,
@@ -88,51 +177,50 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 	}, aggregated)
 }
 
-func Test_fits(t *testing.T) {
-	available := makeResourceList(1, 10).AsFloat()
-
-	assert.False(t, fits(makeResourceList(2, 20).AsFloat(), available))
-	assert.False(t, fits(makeResourceList(2, 5).AsFloat(), available))
-	assert.True(t, fits(makeResourceList(1, 10).AsFloat(), available))
-}
-
-func Test_matchNodeSelector(t *testing.T) {
-	labels := map[string]string{
-		"A": "test",
-		"B": "test",
-	}
-	assert.False(t, matchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"C": "test"}}, labels))
-	assert.False(t, matchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"B": "42"}}, labels))
-	assert.True(t, matchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"A": "test"}}, labels))
-	assert.True(t, matchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"A": "test", "B": "test"}}, labels))
-}
-
-func Test_tolerates(t *testing.T) {
-	taints := []v1.Taint{
+func Test_AggregateNodeTypesAllocations_WithOversubscription(t *testing.T) {
+	nodes := []api.NodeInfo{
 		{
-			Key:    "A",
-			Value:  "test",
-			Effect: v1.TaintEffectNoSchedule,
+			Name:                 "n1",
+			AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")},
+			AvailableResources:   common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
 		},
 		{
-			Key:    "B",
-			Value:  "test",
-			Effect: v1.TaintEffectPreferNoSchedule,
+			Name:                 "n2",
+			AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")},
+			AvailableResources:   common.ComputeResources{"cpu": resource.MustParse("0"), "memory": resource.MustParse("0")},
 		},
 	}
 
-	podSpec := &v1.PodSpec{
-		Tolerations: []v1.Toleration{
-			{
-				Key:      "A",
-				Operator: v1.TolerationOpEqual,
-				Value:    "test",
-				Effect:   v1.TaintEffectNoSchedule,
-			},
-		}}
-
-	assert.False(t, tolerates(&v1.PodSpec{}, taints))
-	assert.True(t, tolerates(podSpec, taints))
+	aggregated := AggregateNodeTypeAllocations(nodes, map[string]float64{"cpu": 1.5})
+	assert.Equal(t, []*nodeTypeAllocation{
+		{
+			taints:             nil,
+			labels:             nil,
+			nodeSize:           common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")},
+			availableResources: common.ComputeResourcesFloat{"cpu": 5, "memory": 1024 * 1024 * 1024},
+		},
+	}, aggregated)
+
+	// reported node types keep raw allocatable resources
+	nodeTypes := extractNodeTypes(aggregated)
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")}, common.ComputeResources(nodeTypes[0].AllocatableResources))
+}
+
+func Test_oversubscribedResources(t *testing.T) {
+	available := makeResourceList(1, 10)
+	allocatable := makeResourceList(10, 100)
+
+	assert.Equal(t, available.AsFloat(), oversubscribedResources(available, allocatable, nil))
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 6, "memory": 10 * 1024 * 1024 * 1024}, oversubscribedResources(available, allocatable, map[string]float64{"cpu": 1.5}))
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 0, "memory": 10 * 1024 * 1024 * 1024}, oversubscribedResources(available, allocatable, map[string]float64{"cpu": 0.5}))
+}
+
+func Test_fits(t *testing.T) {
+	available := makeResourceList(1, 10).AsFloat()
+
+	assert.False(t, fits(makeResourceList(2, 20).AsFloat(), available))
+	assert.False(t, fits(makeResourceList(2, 5).AsFloat(), available))
+	assert.True(t, fits(makeResourceList(1, 10).AsFloat(), available))
 }
 
 func makeResourceList(cores int64, gigabytesRam int64) common.ComputeResources {
@@ -0,0 +1,29 @@
+package scheduling
+
+import (
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Oversubscription pretends allocatable resource of a cluster is multiplied by a per resource factor,
+// the extra capacity is added to the available resource used for scheduling.
+func oversubscribedResources(available, allocatable common.ComputeResources, oversubscription map[string]float64) common.ComputeResourcesFloat {
+	result := available.AsFloat()
+	for key, value := range allocatable {
+		factor, exists := oversubscription[key]
+		if !exists {
+			continue
+		}
+		result[key] += (factor - 1) * common.QuantityAsFloat64(value)
+	}
+	result.LimitToZero()
+	return result
+}
+
+func totalAllocatableResources(nodes []api.NodeInfo) common.ComputeResources {
+	total := common.ComputeResources{}
+	for _, n := range nodes {
+		total.Add(n.AllocatableResources)
+	}
+	return total
+}
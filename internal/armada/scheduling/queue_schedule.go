@@ -0,0 +1,127 @@
+package scheduling
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const minutesPerDay = 24 * 60
+
+var timeOfDayPattern = regexp.MustCompile(`^([0-9]{2}):([0-9]{2})$`)
+
+type schedulingWindow struct {
+	days  map[time.Weekday]bool
+	start int
+	end   int
+}
+
+func ValidateSchedulingWindows(windows []*api.QueueSchedulingWindow) error {
+	for _, w := range windows {
+		_, e := parseSchedulingWindow(w)
+		if e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// Queues without scheduling windows can be scheduled any time
+func FilterQueuesInSchedulingWindow(queues []*api.Queue, now time.Time, location *time.Location) []*api.Queue {
+	result := make([]*api.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if IsInSchedulingWindow(queue, now, location) {
+			result = append(result, queue)
+		}
+	}
+	return result
+}
+
+func FilterUnpausedQueues(queues []*api.Queue) []*api.Queue {
+	result := make([]*api.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if !queue.Paused {
+			result = append(result, queue)
+		}
+	}
+	return result
+}
+
+func IsInSchedulingWindow(queue *api.Queue, now time.Time, location *time.Location) bool {
+	if len(queue.SchedulingWindows) == 0 {
+		return true
+	}
+	localTime := now.In(location)
+	for _, w := range queue.SchedulingWindows {
+		window, e := parseSchedulingWindow(w)
+		if e != nil {
+			continue
+		}
+		if window.contains(localTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *schedulingWindow) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	today := t.Weekday()
+	yesterday := (today + 6) % 7
+
+	if w.start < w.end {
+		return w.onDay(today) && minute >= w.start && minute < w.end
+	}
+	return (w.onDay(today) && minute >= w.start) || (w.onDay(yesterday) && minute < w.end)
+}
+
+func (w *schedulingWindow) onDay(day time.Weekday) bool {
+	return len(w.days) == 0 || w.days[day]
+}
+
+func parseSchedulingWindow(window *api.QueueSchedulingWindow) (*schedulingWindow, error) {
+	start, e := parseTimeOfDay(window.Start)
+	if e != nil {
+		return nil, e
+	}
+	end, e := parseTimeOfDay(window.End)
+	if e != nil {
+		return nil, e
+	}
+	days := map[time.Weekday]bool{}
+	for _, d := range window.Days {
+		day, e := parseWeekday(d)
+		if e != nil {
+			return nil, e
+		}
+		days[day] = true
+	}
+	return &schedulingWindow{days: days, start: start, end: end}, nil
+}
+
+func parseTimeOfDay(value string) (int, error) {
+	match := timeOfDayPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	if minutes > 59 || hours*60+minutes > minutesPerDay {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+func parseWeekday(value string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := day.String()
+		if strings.EqualFold(value, name) || strings.EqualFold(value, name[:3]) {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid day of week %q", value)
+}
@@ -0,0 +1,73 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_IsInSchedulingWindow_WithoutWindows(t *testing.T) {
+	assert.True(t, IsInSchedulingWindow(&api.Queue{}, time.Now(), time.UTC))
+}
+
+func Test_IsInSchedulingWindow_SameDayWindow(t *testing.T) {
+	queue := &api.Queue{SchedulingWindows: []*api.QueueSchedulingWindow{{Start: "09:00", End: "17:30"}}}
+
+	assert.True(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T09:00:00Z"), time.UTC))
+	assert.True(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T17:29:00Z"), time.UTC))
+	assert.False(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T17:30:00Z"), time.UTC))
+	assert.False(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T08:59:00Z"), time.UTC))
+}
+
+func Test_IsInSchedulingWindow_OvernightWindowUsesStartDay(t *testing.T) {
+	// 2021-03-05 is Friday
+	queue := &api.Queue{SchedulingWindows: []*api.QueueSchedulingWindow{{Days: []string{"Fri"}, Start: "22:00", End: "06:00"}}}
+
+	assert.True(t, IsInSchedulingWindow(queue, utcTime("2021-03-05T23:00:00Z"), time.UTC))
+	assert.True(t, IsInSchedulingWindow(queue, utcTime("2021-03-06T05:00:00Z"), time.UTC))
+	assert.False(t, IsInSchedulingWindow(queue, utcTime("2021-03-06T23:00:00Z"), time.UTC))
+	assert.False(t, IsInSchedulingWindow(queue, utcTime("2021-03-05T05:00:00Z"), time.UTC))
+}
+
+func Test_IsInSchedulingWindow_UsesLocation(t *testing.T) {
+	queue := &api.Queue{SchedulingWindows: []*api.QueueSchedulingWindow{{Start: "22:00", End: "23:00"}}}
+	location := time.FixedZone("UTC+2", 2*60*60)
+
+	assert.True(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T20:30:00Z"), location))
+	assert.False(t, IsInSchedulingWindow(queue, utcTime("2021-03-01T22:30:00Z"), location))
+}
+
+func Test_FilterQueuesInSchedulingWindow(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1"}
+	queue2 := &api.Queue{Name: "queue2", SchedulingWindows: []*api.QueueSchedulingWindow{{Start: "00:00", End: "01:00"}}}
+
+	result := FilterQueuesInSchedulingWindow([]*api.Queue{queue1, queue2}, utcTime("2021-03-01T12:00:00Z"), time.UTC)
+	assert.Equal(t, []*api.Queue{queue1}, result)
+}
+
+func Test_FilterUnpausedQueues(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1"}
+	queue2 := &api.Queue{Name: "queue2", Paused: true}
+
+	assert.Equal(t, []*api.Queue{queue1}, FilterUnpausedQueues([]*api.Queue{queue1, queue2}))
+}
+
+func Test_ValidateSchedulingWindows(t *testing.T) {
+	assert.NoError(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Days: []string{"monday", "Sat"}, Start: "00:00", End: "24:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Start: "25:00", End: "01:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Start: "10", End: "11:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Start: "10:00pm", End: "11:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Start: "1:00", End: "11:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.QueueSchedulingWindow{{Days: []string{"Someday"}, Start: "10:00", End: "11:00"}}))
+}
+
+func utcTime(value string) time.Time {
+	t, e := time.Parse(time.RFC3339, value)
+	if e != nil {
+		panic(e)
+	}
+	return t
+}
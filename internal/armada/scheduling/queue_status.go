@@ -0,0 +1,186 @@
+package scheduling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ExplainQueueScheduling evaluates the queue the same way as LeaseJobs and returns why its jobs are not leased.
+// Active queues are the queues with queued jobs. Pool specific reasons are evaluated for every pool with active clusters, the queue is schedulable when at least
+// one pool has none of them.
+func ExplainQueueScheduling(
+	config *configuration.SchedulingConfig,
+	queue *api.Queue,
+	activeQueues []*api.Queue,
+	queuedJobs []*api.Job,
+	now time.Time,
+	activeClusterReports map[string]*api.ClusterUsageReport,
+	clusterLeasedReports map[string]*api.ClusterLeasedReport,
+	clusterPriorities map[string]map[string]float64,
+	activeClusterSchedulingInfos map[string]*api.ClusterSchedulingInfoReport) (*api.QueueSchedulingStatus, error) {
+
+	location, e := config.GetQueueScheduleLocation()
+	if e != nil {
+		return nil, e
+	}
+
+	reasons := []*api.QueueSchedulingStatusReason{}
+	if len(queuedJobs) == 0 {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_NoQueuedJobs, "", "queue has no queued jobs"))
+	}
+	if queue.Paused {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_QueuePaused, "", "queue is paused"))
+	}
+	if !IsInSchedulingWindow(queue, now, location) {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_OutsideSchedulingWindow, "",
+			"none of the %d scheduling windows is open at %s", len(queue.SchedulingWindows), now.In(location).Format("Mon 15:04 MST")))
+	}
+	queueResourcesLeased := QueueResourcesLeased(activeClusterReports, clusterLeasedReports)
+	if exhausted := resourcesOverQuota(queue, queueResourcesLeased[queue.Name]); len(exhausted) > 0 {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_QueueResourceLimitReached, "",
+			"leased and running jobs of the queue use all of its %s quota", strings.Join(exhausted, ", ")))
+	}
+	if len(queuedJobs) > 0 && !anyJobFeasible(queuedJobs, activeClusterSchedulingInfos) {
+		feasibility := ExplainSchedulingFeasibility(0, queuedJobs[0], activeClusterSchedulingInfos)
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_NoFeasibleCluster, "",
+			"none of the next %d queued jobs can be scheduled on any active cluster, job %s: %s",
+			len(queuedJobs), queuedJobs[0].Id, formatFeasibilityExplanations(feasibility)))
+	}
+	schedulable := len(reasons) == 0
+
+	queues := withQueue(FilterQueuesInSchedulingWindow(FilterUnpausedQueues(activeQueues), now, location), queue)
+	clusterReportsByPool := GroupByPool(activeClusterReports)
+	pools := make([]string, 0, len(clusterReportsByPool))
+	for pool := range clusterReportsByPool {
+		pools = append(pools, pool)
+	}
+	sort.Strings(pools)
+
+	totalActiveCapacity := TotalAvailableCapacity(activeClusterReports)
+	schedulableInAnyPool := false
+	for _, pool := range pools {
+		poolReasons := explainPoolScheduling(config, queue, queues, pool, clusterReportsByPool[pool], clusterLeasedReports, clusterPriorities, totalActiveCapacity, queueResourcesLeased)
+		schedulableInAnyPool = schedulableInAnyPool || len(poolReasons) == 0
+		reasons = append(reasons, poolReasons...)
+	}
+
+	return &api.QueueSchedulingStatus{
+		Queue:       queue.Name,
+		Schedulable: schedulable && schedulableInAnyPool,
+		Reasons:     reasons,
+	}, nil
+}
+
+func explainPoolScheduling(
+	config *configuration.SchedulingConfig,
+	queue *api.Queue,
+	queues []*api.Queue,
+	pool string,
+	poolClusterReports map[string]*api.ClusterUsageReport,
+	clusterLeasedReports map[string]*api.ClusterLeasedReport,
+	clusterPriorities map[string]map[string]float64,
+	totalActiveCapacity common.ComputeResources,
+	queueResourcesLeased map[string]common.ComputeResources) []*api.QueueSchedulingStatusReason {
+
+	totalCapacity := common.ComputeResources{}
+	used := common.ComputeResources{}
+	for _, report := range poolClusterReports {
+		totalCapacity.Add(report.ClusterAvailableCapacity)
+		for _, queueReport := range report.Queues {
+			used.Add(queueReport.Resources)
+		}
+	}
+	freeCapacity := totalCapacity.AsFloat()
+	freeCapacity.Sub(used.AsFloat())
+	freeCapacity.LimitToZero()
+
+	clusterIds := GetClusterReportIds(poolClusterReports)
+	resourceAllocatedByQueue := CombineLeasedReportResourceByQueue(FilterClusterLeasedReports(clusterIds, clusterLeasedReports))
+	queueSchedulingInfo := calculateQueueSchedulingLimits(
+		queues,
+		totalCapacity.MulByResource(config.MaximalResourceFractionToSchedulePerQueue),
+		totalCapacity.MulByResource(config.MaximalResourceFractionPerQueue),
+		&totalCapacity,
+		resourceAllocatedByQueue)
+
+	reasons := []*api.QueueSchedulingStatusReason{}
+	if limited := resourcesAtLimit(queueSchedulingInfo[queue].remainingSchedulingLimit, totalCapacity); len(limited) > 0 {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_QueueResourceLimitReached, pool,
+			"queue uses all %s it is allowed to use in pool %s", strings.Join(limited, ", "), pool))
+	}
+	if !anyPositive(freeCapacity) {
+		return append(reasons, queueReason(api.QueueNotScheduledReason_NoFreeCapacity, pool,
+			"running jobs use all %s available in pool %s", totalCapacity, pool))
+	}
+
+	poolClusterPriorities := make(map[string]map[string]float64, len(clusterIds))
+	for _, clusterId := range clusterIds {
+		if priorities, ok := clusterPriorities[clusterId]; ok {
+			poolClusterPriorities[clusterId] = priorities
+		}
+	}
+	queuePriorities := CalculateQueuesPriorityInfo(poolClusterPriorities, poolClusterReports, queues)
+	scarcity := config.GetResourceScarcity(pool)
+	if scarcity == nil {
+		scarcity = ResourceScarcityFromReports(poolClusterReports)
+	}
+	shares := SliceResourceWithFloors(scarcity, queueSchedulingInfo, queuePriorities, totalActiveCapacity, queueResourcesLeased, freeCapacity)
+	if info, ok := shares[queue]; ok && !anyPositive(info.schedulingShare) {
+		reasons = append(reasons, queueReason(api.QueueNotScheduledReason_StarvedByFairShare, pool,
+			"queue priority is %.2f, free resources in pool %s are shared between %d other active queues with lower usage",
+			queuePriorities[queue].Priority, pool, len(queues)-1))
+	}
+	return reasons
+}
+
+func queueReason(reason api.QueueNotScheduledReason, pool string, format string, args ...interface{}) *api.QueueSchedulingStatusReason {
+	return &api.QueueSchedulingStatusReason{Reason: reason, Pool: pool, Message: fmt.Sprintf(format, args...)}
+}
+
+func anyJobFeasible(jobs []*api.Job, activeClusterSchedulingInfos map[string]*api.ClusterSchedulingInfoReport) bool {
+	for _, job := range jobs {
+		if MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfos) {
+			return true
+		}
+	}
+	return false
+}
+
+// Queues are loaded separately, so the queue replaces active queue with the same name
+func withQueue(activeQueues []*api.Queue, queue *api.Queue) []*api.Queue {
+	result := make([]*api.Queue, 0, len(activeQueues)+1)
+	for _, q := range activeQueues {
+		if q.Name != queue.Name {
+			result = append(result, q)
+		}
+	}
+	return append(result, queue)
+}
+
+// Resources the pool does not have are not reported, their limit is always zero
+func resourcesAtLimit(remainingLimit common.ComputeResourcesFloat, totalCapacity common.ComputeResources) []string {
+	limited := []string{}
+	for resourceName, remaining := range remainingLimit {
+		capacity, ok := totalCapacity[resourceName]
+		if ok && capacity.Sign() > 0 && remaining <= 0 {
+			limited = append(limited, resourceName)
+		}
+	}
+	sort.Strings(limited)
+	return limited
+}
+
+func anyPositive(resources common.ComputeResourcesFloat) bool {
+	for _, quantity := range resources {
+		if quantity > 0 {
+			return true
+		}
+	}
+	return false
+}
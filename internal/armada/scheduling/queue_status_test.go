@@ -0,0 +1,165 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var queueStatusConfig = &configuration.SchedulingConfig{
+	MaximalResourceFractionToSchedulePerQueue: map[string]float64{"cpu": 1, "memory": 1},
+	MaximalResourceFractionPerQueue:           map[string]float64{"cpu": 1, "memory": 1},
+}
+
+func Test_ExplainQueueScheduling_SchedulableQueue(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{{Name: "queue1"}}, []*api.Job{jobRequestingCpu("job1", "1")},
+		time.Now(), clusterReports("10"), nil, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.True(t, status.Schedulable)
+	assert.Empty(t, status.Reasons)
+}
+
+func Test_ExplainQueueScheduling_ReportsAllReasons(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	queue := &api.Queue{
+		Name:              "queue1",
+		PriorityFactor:    1,
+		ResourceLimits:    map[string]float64{"cpu": 0.1},
+		SchedulingWindows: []*api.QueueSchedulingWindow{{Start: "20:00", End: "06:00"}},
+	}
+	leased := map[string]*api.ClusterLeasedReport{
+		"cluster1": {ClusterId: "cluster1", Queues: []*api.QueueLeasedReport{
+			{Name: "queue1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("1")}},
+		}},
+	}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{queue}, []*api.Job{jobRequestingCpu("job1", "20")},
+		now, clusterReports("10"), leased, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{
+		api.QueueNotScheduledReason_OutsideSchedulingWindow,
+		api.QueueNotScheduledReason_NoFeasibleCluster,
+		api.QueueNotScheduledReason_QueueResourceLimitReached,
+	}, reasonTypes(status))
+	assert.Equal(t, "none of the 1 scheduling windows is open at Tue 12:00 UTC", status.Reasons[0].Message)
+	assert.Contains(t, status.Reasons[1].Message, "job job1: cluster cluster1 (pool cpu): insufficient cpu: requested 20, node type has 10")
+	assert.Equal(t, "queue uses all cpu it is allowed to use in pool cpu", status.Reasons[2].Message)
+	assert.Equal(t, "cpu", status.Reasons[2].Pool)
+}
+
+func Test_ExplainQueueScheduling_ReportsPausedQueue(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1, Paused: true}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{queue}, []*api.Job{jobRequestingCpu("job1", "1")},
+		time.Now(), clusterReports("10"), nil, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{api.QueueNotScheduledReason_QueuePaused}, reasonTypes(status))
+}
+
+func Test_ExplainQueueScheduling_ReportsExhaustedQuota(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1, ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("2")}}
+	leased := map[string]*api.ClusterLeasedReport{
+		"cluster1": {ClusterId: "cluster1", Queues: []*api.QueueLeasedReport{
+			{Name: "queue1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("2")}},
+		}},
+	}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{queue}, []*api.Job{jobRequestingCpu("job1", "1")},
+		time.Now(), clusterReports("10"), leased, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{api.QueueNotScheduledReason_QueueResourceLimitReached}, reasonTypes(status))
+	assert.Equal(t, "leased and running jobs of the queue use all of its cpu quota", status.Reasons[0].Message)
+	assert.Empty(t, status.Reasons[0].Pool)
+}
+
+func Test_ExplainQueueScheduling_ReportsNoQueuedJobs(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{}, []*api.Job{},
+		time.Now(), clusterReports("10"), nil, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{api.QueueNotScheduledReason_NoQueuedJobs}, reasonTypes(status))
+}
+
+func Test_ExplainQueueScheduling_ReportsStarvationByFairShare(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	otherQueue := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	reports := clusterReports("10")
+	reports["cluster1"].Queues = []*api.QueueReport{{Name: "queue1", Resources: common.ComputeResources{"cpu": resource.MustParse("8")}}}
+	priorities := map[string]map[string]float64{"cluster1": {"queue1": 8}}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{{Name: "queue1"}, otherQueue}, []*api.Job{jobRequestingCpu("job1", "1")},
+		time.Now(), reports, nil, priorities, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{api.QueueNotScheduledReason_StarvedByFairShare}, reasonTypes(status))
+	assert.Equal(t, "queue priority is 8.00, free resources in pool cpu are shared between 1 other active queues with lower usage", status.Reasons[0].Message)
+
+	// other queue has the whole fair share
+	status, e = ExplainQueueScheduling(queueStatusConfig, otherQueue, []*api.Queue{queue, otherQueue}, []*api.Job{jobRequestingCpu("job2", "1")},
+		time.Now(), reports, nil, priorities, clusterSchedulingInfos("10"))
+	assert.NoError(t, e)
+	assert.True(t, status.Schedulable)
+}
+
+func Test_ExplainQueueScheduling_ReportsNoFreeCapacity(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	reports := clusterReports("10")
+	reports["cluster1"].Queues = []*api.QueueReport{{Name: "queue2", Resources: common.ComputeResources{"cpu": resource.MustParse("10")}}}
+
+	status, e := ExplainQueueScheduling(queueStatusConfig, queue, []*api.Queue{queue}, []*api.Job{jobRequestingCpu("job1", "1")},
+		time.Now(), reports, nil, nil, clusterSchedulingInfos("10"))
+
+	assert.NoError(t, e)
+	assert.False(t, status.Schedulable)
+	assert.Equal(t, []api.QueueNotScheduledReason{api.QueueNotScheduledReason_NoFreeCapacity}, reasonTypes(status))
+}
+
+func jobRequestingCpu(id string, cpu string) *api.Job {
+	request := v1.ResourceList{"cpu": resource.MustParse(cpu)}
+	return &api.Job{Id: id, Queue: "queue1", PodSpec: &v1.PodSpec{
+		Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}},
+	}}
+}
+
+func clusterReports(cpu string) map[string]*api.ClusterUsageReport {
+	capacity := common.ComputeResources{"cpu": resource.MustParse(cpu)}
+	return map[string]*api.ClusterUsageReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "cpu", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity.DeepCopy()},
+	}
+}
+
+func clusterSchedulingInfos(cpu string) map[string]*api.ClusterSchedulingInfoReport {
+	return map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "cpu", NodeTypes: []*api.NodeType{{
+			AllocatableResources: common.ComputeResources{"cpu": resource.MustParse(cpu)},
+		}}},
+	}
+}
+
+func reasonTypes(status *api.QueueSchedulingStatus) []api.QueueNotScheduledReason {
+	types := []api.QueueNotScheduledReason{}
+	for _, reason := range status.Reasons {
+		types = append(types, reason.Reason)
+	}
+	return types
+}
@@ -0,0 +1,183 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Checks floors of the queue together with floors of all other queues do not exceed total capacity
+func ValidateResourceFloors(queue *api.Queue, allQueues []*api.Queue) error {
+	totals := map[string]float64{}
+	for resourceName, floor := range queue.ResourceFloor {
+		if floor < 0 || floor > 1 {
+			return fmt.Errorf("floor of %s must be between 0 and 1", resourceName)
+		}
+		totals[resourceName] = floor
+	}
+	for _, other := range allQueues {
+		if other.Name == queue.Name {
+			continue
+		}
+		for resourceName, floor := range other.ResourceFloor {
+			if _, ok := totals[resourceName]; ok {
+				totals[resourceName] += floor
+			}
+		}
+	}
+
+	resourceNames := make([]string, 0, len(totals))
+	for resourceName := range totals {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+	for _, resourceName := range resourceNames {
+		if totals[resourceName] > 1 {
+			return fmt.Errorf("floors of all queues would reserve %.0f%% of %s capacity", totals[resourceName]*100, resourceName)
+		}
+	}
+	return nil
+}
+
+// Total available capacity of the clusters, floors are fractions of the capacity of all active clusters
+func TotalAvailableCapacity(clusterReports map[string]*api.ClusterUsageReport) common.ComputeResources {
+	totalCapacity := common.ComputeResources{}
+	for _, clusterReport := range clusterReports {
+		totalCapacity.Add(clusterReport.ClusterAvailableCapacity)
+	}
+	return totalCapacity
+}
+
+// Slices resource the same way as SliceResourceWithLimits, but first reserves for each queue the part of its floor
+// not covered by its leased and running jobs. Reserved resource counts as queue usage for the fair share of the rest,
+// so a queue gets the bigger of its fair share and its floor.
+func SliceResourceWithFloors(
+	resourceScarcity map[string]float64,
+	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	totalCapacity common.ComputeResources,
+	queueResourcesLeased map[string]common.ComputeResources,
+	quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+
+	reserved := reserveResourceFloors(queuePriorities, totalCapacity, queueResourcesLeased, quantityToSlice)
+	return sliceResourceWithReservations(resourceScarcity, queueSchedulingInfo, queuePriorities, reserved, quantityToSlice)
+}
+
+func sliceResourceWithReservations(
+	resourceScarcity map[string]float64,
+	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	reserved map[*api.Queue]common.ComputeResourcesFloat,
+	quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+
+	if len(reserved) == 0 {
+		return SliceResourceWithLimits(resourceScarcity, queueSchedulingInfo, queuePriorities, quantityToSlice)
+	}
+
+	remaining := quantityToSlice.DeepCopy()
+	prioritiesWithReserved := make(map[*api.Queue]QueuePriorityInfo, len(queuePriorities))
+	for queue, info := range queuePriorities {
+		if reservation, ok := reserved[queue]; ok {
+			remaining.Sub(reservation)
+			usage := info.CurrentUsage.DeepCopy()
+			usage.Add(asQuantities(reservation))
+			info = QueuePriorityInfo{Priority: info.Priority, CurrentUsage: usage}
+		}
+		prioritiesWithReserved[queue] = info
+	}
+	remaining.LimitToZero()
+
+	result := SliceResourceWithLimits(resourceScarcity, queueSchedulingInfo, prioritiesWithReserved, remaining)
+	for queue, reservation := range reserved {
+		info, ok := result[queue]
+		if !ok {
+			continue
+		}
+		share := info.schedulingShare.DeepCopy()
+		share.Add(reservation)
+		result[queue] = NewQueueSchedulingInfo(info.remainingSchedulingLimit, share, share.LimitWith(info.remainingSchedulingLimit))
+	}
+	return result
+}
+
+// Floors are covered by leased and running jobs of the queue on all active clusters rather than by the usage reported by
+// the clusters of the pool. Leases of each round are stored before the next round, so rounds of other clusters don't
+// reserve the same part of a floor again.
+// When reservations of all queues do not fit into the quantity to slice, they are scaled down proportionally
+func reserveResourceFloors(
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	totalCapacity common.ComputeResources,
+	queueResourcesLeased map[string]common.ComputeResources,
+	quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+
+	reserved := map[*api.Queue]common.ComputeResourcesFloat{}
+	totalReserved := common.ComputeResourcesFloat{}
+	for queue := range queuePriorities {
+		if len(queue.ResourceFloor) == 0 {
+			continue
+		}
+		reservation := totalCapacity.MulByResource(queue.ResourceFloor)
+		for resourceName := range reservation {
+			if _, ok := queue.ResourceFloor[resourceName]; !ok {
+				delete(reservation, resourceName)
+			}
+		}
+		reservation.Sub(queueResourcesLeased[queue.Name].AsFloat())
+		reservation.LimitToZero()
+		reserved[queue] = reservation
+		totalReserved.Add(reservation)
+	}
+
+	for resourceName, total := range totalReserved {
+		available := math.Max(0, quantityToSlice[resourceName])
+		if total <= available {
+			continue
+		}
+		for _, reservation := range reserved {
+			reservation[resourceName] *= available / total
+		}
+	}
+	return reserved
+}
+
+// Part of the queue share leased before any other share, the share of resources with a floor is capped at the
+// reservation while other resources are limited only by the share, jobs always need all of them
+func floorSlice(info *QueueSchedulingInfo, reservation common.ComputeResourcesFloat) common.ComputeResourcesFloat {
+	slice := info.adjustedShare.DeepCopy()
+	for resourceName, reserved := range reservation {
+		slice[resourceName] = math.Min(slice[resourceName], reserved)
+	}
+	return slice
+}
+
+// Queues furthest below their floors come first
+func queuesByReservation(resourceScarcity map[string]float64, reserved map[*api.Queue]common.ComputeResourcesFloat) []*api.Queue {
+	queues := make([]*api.Queue, 0, len(reserved))
+	for queue, reservation := range reserved {
+		if ResourcesFloatAsUsage(resourceScarcity, reservation) > 0 {
+			queues = append(queues, queue)
+		}
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		a := ResourcesFloatAsUsage(resourceScarcity, reserved[queues[i]])
+		b := ResourcesFloatAsUsage(resourceScarcity, reserved[queues[j]])
+		if a != b {
+			return a > b
+		}
+		return queues[i].Name < queues[j].Name
+	})
+	return queues
+}
+
+func asQuantities(resources common.ComputeResourcesFloat) common.ComputeResources {
+	result := common.ComputeResources{}
+	for resourceName, value := range resources {
+		result[resourceName] = *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+	}
+	return result
+}
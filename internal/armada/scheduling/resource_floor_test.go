@@ -0,0 +1,136 @@
+package scheduling
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_SliceResourceWithFloors_ReservesFloorForQueueWithLowFairShare(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2", ResourceFloor: map[string]float64{"cpu": 0.5}}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 1, CurrentUsage: common.ComputeResources{}},
+		q2: {Priority: 100, CurrentUsage: common.ComputeResources{}},
+	}
+	totalCapacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	resourceToSlice := common.ComputeResourcesFloat{"cpu": 8}
+
+	slices := SliceResourceWithFloors(scarcity, unlimitedSchedulingInfo(resourceToSlice, q1, q2), queuePriorities, totalCapacity, map[string]common.ComputeResources{}, resourceToSlice)
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 3}, slices[q1].adjustedShare)
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 5}, slices[q2].adjustedShare)
+}
+
+func Test_SliceResourceWithFloors_IgnoresFloorCoveredByLeasedJobs(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2", ResourceFloor: map[string]float64{"cpu": 0.2}}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 1, CurrentUsage: common.ComputeResources{}},
+		q2: {Priority: 1, CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("2")}},
+	}
+	totalCapacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	resourceToSlice := common.ComputeResourcesFloat{"cpu": 8}
+
+	queueResourcesLeased := map[string]common.ComputeResources{"q2": {"cpu": resource.MustParse("2")}}
+
+	withFloors := SliceResourceWithFloors(scarcity, unlimitedSchedulingInfo(resourceToSlice, q1, q2), queuePriorities, totalCapacity, queueResourcesLeased, resourceToSlice)
+	withoutFloors := SliceResourceWithLimits(scarcity, unlimitedSchedulingInfo(resourceToSlice, q1, q2), queuePriorities, resourceToSlice)
+
+	assert.Equal(t, withoutFloors, withFloors)
+}
+
+func Test_reserveResourceFloors_ScalesDownReservationsNotFittingIntoSlice(t *testing.T) {
+	q1 := &api.Queue{Name: "q1", ResourceFloor: map[string]float64{"cpu": 0.4}}
+	q2 := &api.Queue{Name: "q2", ResourceFloor: map[string]float64{"cpu": 0.4}}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 1, CurrentUsage: common.ComputeResources{}},
+		q2: {Priority: 1, CurrentUsage: common.ComputeResources{}},
+	}
+	totalCapacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	// leased on another cluster, not yet in the usage reports
+	queueResourcesLeased := map[string]common.ComputeResources{"q2": {"cpu": resource.MustParse("2")}}
+
+	reserved := reserveResourceFloors(queuePriorities, totalCapacity, queueResourcesLeased, common.ComputeResourcesFloat{"cpu": 3})
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 2}, reserved[q1])
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 1}, reserved[q2])
+}
+
+func Test_leaseFloors_LeasesReservationsBeforeFairShare(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2", ResourceFloor: map[string]float64{"cpu": 0.3}}
+	q3 := &api.Queue{Name: "q3", ResourceFloor: map[string]float64{"cpu": 0.1}}
+	repository := &fakeJobQueue{jobsByQueue: map[string][]*api.Job{
+		"q1": jobsOfQueue("q1", 5), "q2": jobsOfQueue("q2", 5), "q3": jobsOfQueue("q3", 5),
+	}}
+	share := common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("1Gi")}.AsFloat()
+
+	c := gangLeaseContext(repository)
+	c.resourceScarcity = scarcity
+	c.queueSchedulingInfo = map[*api.Queue]*QueueSchedulingInfo{
+		q1: NewQueueSchedulingInfo(share, share, share),
+		q2: NewQueueSchedulingInfo(share, share, share),
+		q3: NewQueueSchedulingInfo(share, share, share),
+	}
+	c.floorReservations = map[*api.Queue]common.ComputeResourcesFloat{q2: {"cpu": 3}, q3: {"cpu": 1}}
+
+	jobs := c.leaseFloors(100)
+
+	assert.Equal(t, []string{"q2-0", "q2-1", "q2-2", "q3-0"}, jobIdsOf(jobs))
+	assert.Equal(t, 1.0, c.queueSchedulingInfo[q2].adjustedShare["cpu"])
+	assert.Equal(t, 4.0, c.queueSchedulingInfo[q1].adjustedShare["cpu"])
+}
+
+func Test_queuesByReservation_SkipsQueuesWithFloorCovered(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2"}
+	q3 := &api.Queue{Name: "q3"}
+	reserved := map[*api.Queue]common.ComputeResourcesFloat{q1: {"cpu": 1}, q2: {"cpu": 0}, q3: {"cpu": 2}}
+
+	assert.Equal(t, []*api.Queue{q3, q1}, queuesByReservation(scarcity, reserved))
+}
+
+func jobsOfQueue(queue string, count int) []*api.Job {
+	jobs := []*api.Job{}
+	for i := 0; i < count; i++ {
+		jobs = append(jobs, &api.Job{Id: fmt.Sprintf("%s-%d", queue, i), Queue: queue, PodSpec: classicPodSpec})
+	}
+	return jobs
+}
+
+func jobIdsOf(jobs []*api.Job) []string {
+	ids := []string{}
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func TestValidateResourceFloors(t *testing.T) {
+	existing := []*api.Queue{
+		{Name: "q1", ResourceFloor: map[string]float64{"cpu": 0.6}},
+		{Name: "q2", ResourceFloor: map[string]float64{"cpu": 0.3, "memory": 0.9}},
+	}
+
+	assert.NoError(t, ValidateResourceFloors(&api.Queue{Name: "q3", ResourceFloor: map[string]float64{"cpu": 0.1}}, existing))
+	assert.NoError(t, ValidateResourceFloors(&api.Queue{Name: "q1", ResourceFloor: map[string]float64{"cpu": 0.7}}, existing))
+	assert.Error(t, ValidateResourceFloors(&api.Queue{Name: "q3", ResourceFloor: map[string]float64{"cpu": 0.2}}, existing))
+	assert.Error(t, ValidateResourceFloors(&api.Queue{Name: "q3", ResourceFloor: map[string]float64{"gpu": 1.5}}, existing))
+}
+
+func unlimitedSchedulingInfo(limit common.ComputeResourcesFloat, queues ...*api.Queue) map[*api.Queue]*QueueSchedulingInfo {
+	result := map[*api.Queue]*QueueSchedulingInfo{}
+	for _, queue := range queues {
+		result[queue] = NewQueueSchedulingInfo(limit, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{})
+	}
+	return result
+}
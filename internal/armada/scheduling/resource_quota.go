@@ -0,0 +1,59 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func ValidateResourceQuota(queue *api.Queue) error {
+	for resourceName, quota := range queue.ResourceQuota {
+		if quota.Sign() < 0 {
+			return fmt.Errorf("quota of %s can not be negative", resourceName)
+		}
+	}
+	return nil
+}
+
+// Resources of leased and running jobs of each queue on the active clusters
+func QueueResourcesLeased(
+	activeClusterReports map[string]*api.ClusterUsageReport,
+	clusterLeasedReports map[string]*api.ClusterLeasedReport) map[string]common.ComputeResources {
+	return CombineLeasedReportResourceByQueue(FilterClusterLeasedReports(GetClusterReportIds(activeClusterReports), clusterLeasedReports))
+}
+
+// Part of the quota of each resource not used by leased and running jobs, only resources with quota are returned
+func RemainingResourceQuota(queue *api.Queue, leased common.ComputeResources) common.ComputeResourcesFloat {
+	remaining := common.ComputeResourcesFloat{}
+	for resourceName, quota := range queue.ResourceQuota {
+		used := leased[resourceName]
+		remaining[resourceName] = math.Max(0, common.QuantityAsFloat64(quota)-common.QuantityAsFloat64(used))
+	}
+	return remaining
+}
+
+// Quotas apply to all clusters together, on top of the limits of the pool being scheduled.
+// Resources the pool has no limit for are limited by the quota alone.
+func applyResourceQuotas(queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo, queueResourcesLeased map[string]common.ComputeResources) {
+	for queue, info := range queueSchedulingInfo {
+		for resourceName, remaining := range RemainingResourceQuota(queue, queueResourcesLeased[queue.Name]) {
+			if limit, ok := info.remainingSchedulingLimit[resourceName]; !ok || remaining < limit {
+				info.remainingSchedulingLimit[resourceName] = remaining
+			}
+		}
+	}
+}
+
+func resourcesOverQuota(queue *api.Queue, leased common.ComputeResources) []string {
+	exhausted := []string{}
+	for resourceName, remaining := range RemainingResourceQuota(queue, leased) {
+		if remaining <= 0 {
+			exhausted = append(exhausted, resourceName)
+		}
+	}
+	sort.Strings(exhausted)
+	return exhausted
+}
@@ -0,0 +1,56 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_applyResourceQuotas_LimitsQueueByRemainingQuota(t *testing.T) {
+	q1 := &api.Queue{Name: "q1", ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("10"), "nvidia.com/gpu": resource.MustParse("1")}}
+	q2 := &api.Queue{Name: "q2"}
+	schedulingInfo := unlimitedSchedulingInfo(common.ComputeResourcesFloat{"cpu": 100, "memory": 100}, q1, q2)
+	leased := map[string]common.ComputeResources{
+		"q1": {"cpu": resource.MustParse("4")},
+		"q2": {"cpu": resource.MustParse("50")},
+	}
+
+	applyResourceQuotas(schedulingInfo, leased)
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 6, "memory": 100, "nvidia.com/gpu": 1}, schedulingInfo[q1].remainingSchedulingLimit)
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 100, "memory": 100}, schedulingInfo[q2].remainingSchedulingLimit)
+}
+
+func TestRemainingResourceQuota_IsZeroOverQuota(t *testing.T) {
+	queue := &api.Queue{Name: "q1", ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}}
+
+	remaining := RemainingResourceQuota(queue, common.ComputeResources{"cpu": resource.MustParse("12"), "memory": resource.MustParse("512Mi")})
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 0, "memory": 512 * 1024 * 1024}, remaining)
+	assert.Equal(t, []string{"cpu"}, resourcesOverQuota(queue, common.ComputeResources{"cpu": resource.MustParse("12")}))
+}
+
+func TestQueueResourcesLeased_CombinesReportsOfActiveClusters(t *testing.T) {
+	active := map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1"}, "c2": {ClusterId: "c2"}}
+	leased := map[string]*api.ClusterLeasedReport{
+		"c1": {ClusterId: "c1", Queues: []*api.QueueLeasedReport{{Name: "q1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("1")}}}},
+		"c2": {ClusterId: "c2", Queues: []*api.QueueLeasedReport{{Name: "q1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("2")}}}},
+		"c3": {ClusterId: "c3", Queues: []*api.QueueLeasedReport{{Name: "q1", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("4")}}}},
+	}
+
+	first := QueueResourcesLeased(active, leased)
+	// reports are not modified when combined
+	second := QueueResourcesLeased(active, leased)
+
+	assert.Equal(t, 3.0, first["q1"].AsFloat()["cpu"])
+	assert.Equal(t, 3.0, second["q1"].AsFloat()["cpu"])
+}
+
+func TestValidateResourceQuota(t *testing.T) {
+	assert.NoError(t, ValidateResourceQuota(&api.Queue{ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("0")}}))
+	assert.Error(t, ValidateResourceQuota(&api.Queue{ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("-1")}}))
+}
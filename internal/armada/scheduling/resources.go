@@ -108,7 +108,11 @@ func sliceResource(resourceScarcity map[string]float64, queuePriorities map[*api
 
 	shareResources := make(map[*api.Queue]common.ComputeResourcesFloat)
 	for queue, share := range shares {
-		shareResources[queue] = quantityToSlice.Mul(share / shareSum)
+		if shareSum > 0 {
+			shareResources[queue] = quantityToSlice.Mul(share / shareSum)
+		} else {
+			shareResources[queue] = quantityToSlice.Mul(0)
+		}
 	}
 	return shareResources
 }
@@ -192,7 +196,8 @@ func CombineLeasedReportResourceByQueue(reports map[string]*api.ClusterLeasedRep
 	for _, clusterReport := range reports {
 		for _, queueReport := range clusterReport.Queues {
 			if _, ok := resourceLeasedByQueue[queueReport.Name]; !ok {
-				resourceLeasedByQueue[queueReport.Name] = queueReport.ResourcesLeased
+				// copied as reports are combined again for different sets of clusters
+				resourceLeasedByQueue[queueReport.Name] = common.ComputeResources(queueReport.ResourcesLeased).DeepCopy()
 			} else {
 				resourceLeasedByQueue[queueReport.Name].Add(queueReport.ResourcesLeased)
 			}
@@ -29,6 +29,10 @@ import (
 )
 
 func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
+	if _, e := config.Scheduling.GetQueueScheduleLocation(); e != nil {
+		log.Fatalf("Invalid queueScheduleTimezone %q: %v", config.Scheduling.QueueScheduleTimezone, e)
+	}
+
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	grpcServer := createServer(config)
@@ -38,15 +42,21 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 	db := createRedisClient(&config.Redis)
 	eventsDb := createRedisClient(&config.EventsRedis)
 
-	jobRepository := repository.NewRedisJobRepository(db, config.Scheduling.DefaultJobLimits)
+	jobRepository := repository.NewRedisJobRepository(db, config.Scheduling.DefaultJobLimits, config.JobRepository)
 	usageRepository := repository.NewRedisUsageRepository(db)
 	queueRepository := repository.NewRedisQueueRepository(db)
-	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
+	podSpecTemplateRepository := repository.NewRedisPodSpecTemplateRepository(db)
+	var schedulingInfoRepository repository.SchedulingInfoRepository = repository.NewRedisSchedulingInfoRepository(db)
+	if config.Scheduling.SchedulingInfoCacheMaxAge > 0 {
+		schedulingInfoCache := cache.NewSchedulingInfoCache(schedulingInfoRepository, config.Scheduling.SchedulingInfoCacheMaxAge)
+		taskManager.Register(schedulingInfoCache.Refresh, config.Scheduling.SchedulingInfoCacheMaxAge, "refresh_scheduling_info_cache")
+		schedulingInfoRepository = schedulingInfoCache
+	}
 
 	queueCache := cache.NewQueueCache(queueRepository, jobRepository, schedulingInfoRepository)
 	taskManager.Register(queueCache.Refresh, config.Metrics.RefreshInterval, "refresh_queue_cache")
 
-	redisEventRepository := repository.NewRedisEventRepository(eventsDb, config.EventRetention)
+	redisEventRepository := repository.NewRedisEventRepository(eventsDb, config.EventRetention, queueRepository)
 	var eventStore repository.EventStore
 
 	// TODO: move this to task manager
@@ -111,13 +121,14 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 
 	permissions := authorization.NewPrincipalPermissionChecker(config.PermissionGroupMapping, config.PermissionScopeMapping)
 
-	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventStore, schedulingInfoRepository, &config.QueueManagement)
+	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, podSpecTemplateRepository, eventStore, schedulingInfoRepository, usageRepository, &config.Scheduling, &config.QueueManagement, config.EventRetention)
 	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository)
 	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueCache, queueRepository, usageRepository, eventStore, schedulingInfoRepository)
-	eventServer := server.NewEventServer(permissions, redisEventRepository, eventStore)
-	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventStore, config.Scheduling.Lease.ExpireAfter)
+	eventServer := server.NewEventServer(permissions, redisEventRepository, eventStore, jobRepository, config.EventApi.ReadBatchSize)
+	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, usageRepository, eventStore, config.Scheduling.Lease.ExpireAfter, config.Scheduling.Lease.ProtectedJobExpireAfter)
 
 	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
+	taskManager.Register(submitServer.CancelJobsPastDeadline, config.Scheduling.DeadlineCheckInterval, "job_deadline_cancellation")
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GrpcPort))
 	if err != nil {
@@ -2,37 +2,58 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/watch"
 	"github.com/G-Research/armada/pkg/api"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const defaultEventReadBatchSize = 500
+
 type EventServer struct {
 	permissions     authorization.PermissionChecker
 	eventRepository repository.EventRepository
 	eventStore      repository.EventStore
+	jobRepository   repository.JobRepository
+	readBatchSize   int64
 }
 
 func NewEventServer(
 	permissions authorization.PermissionChecker,
 	eventRepository repository.EventRepository,
-	eventStore repository.EventStore) *EventServer {
+	eventStore repository.EventStore,
+	jobRepository repository.JobRepository,
+	readBatchSize int64) *EventServer {
+
+	if readBatchSize <= 0 {
+		readBatchSize = defaultEventReadBatchSize
+	}
 
 	return &EventServer{
 		permissions:     permissions,
 		eventRepository: eventRepository,
-		eventStore:      eventStore}
+		eventStore:      eventStore,
+		jobRepository:   jobRepository,
+		readBatchSize:   readBatchSize}
 }
 
 func (s *EventServer) Report(ctx context.Context, message *api.EventMessage) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	s.updateJobMemory([]*api.EventMessage{message})
 	return &types.Empty{}, s.eventStore.ReportEvents([]*api.EventMessage{message})
 }
 
@@ -40,28 +61,49 @@ func (s *EventServer) ReportMultiple(ctx context.Context, message *api.EventList
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	s.updateJobMemory(message.Events)
 	return &types.Empty{}, s.eventStore.ReportEvents(message.Events)
 }
 
+// Jobs recreated with more memory are stored with it, so the resources of the job count against queue limits and quotas
+func (s *EventServer) updateJobMemory(messages []*api.EventMessage) {
+	for _, message := range messages {
+		event := message.GetMemoryIncreased()
+		if event == nil {
+			continue
+		}
+		e := s.jobRepository.UpdateLeasedJobMemory(event.ClusterId, event.JobId, int(event.PodNumber), event.MemoryLimits)
+		if e != nil {
+			log.Errorf("Failed to update memory of job %s: %v", event.JobId, e)
+		}
+	}
+}
+
 func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Event_GetJobSetEventsServer) error {
 	if e := checkPermission(s.permissions, stream.Context(), permissions.WatchAllEvents); e != nil {
 		return e
 	}
 
-	fromId := request.FromMessageId
+	if request.Queue == "" {
+		return status.Errorf(codes.InvalidArgument, "Queue is not specified")
+	}
 
-	var timeout time.Duration = -1
-	var stopAfter = ""
-	if request.Watch {
-		timeout = 5 * time.Second
-	} else {
-		lastId, e := s.eventRepository.GetLastMessageId(request.Queue, request.Id)
-		if e != nil {
-			return e
+	eventTypes, e := eventTypeFilter(request.EventTypes)
+	if e != nil {
+		return status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	if !request.Watch {
+		e := s.eventRepository.IterateEvents(request.Queue, request.Id, request.FromMessageId, s.readBatchSize, eventTypes, func(msg *api.EventStreamMessage) error {
+			return stream.Send(msg)
+		})
+		if e != nil && stream.Context().Err() != nil {
+			return nil
 		}
-		stopAfter = lastId
+		return e
 	}
 
+	fromId := request.FromMessageId
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -69,26 +111,170 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 		default:
 		}
 
-		messages, e := s.eventRepository.ReadEvents(request.Queue, request.Id, fromId, 500, timeout)
+		messages, lastId, e := s.eventRepository.ReadEvents(request.Queue, request.Id, fromId, s.readBatchSize, 5*time.Second, eventTypes)
 
 		if e != nil {
 			return e
 		}
 
-		stop := len(messages) == 0
+		fromId = lastId
 		for _, msg := range messages {
-			fromId = msg.Id
-			if fromId == stopAfter {
-				stop = true
-			}
 			e = stream.Send(msg)
 			if e != nil {
 				return e
 			}
 		}
+	}
+}
+
+func (s *EventServer) GetJobSetStatus(ctx context.Context, request *api.JobSetStatusRequest) (*api.JobSetStatus, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	if request.Queue == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Queue is not specified")
+	}
+	if request.JobSetId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Job set is not specified")
+	}
+	jobSetStatus, e := s.eventRepository.GetJobSetStatus(request.Queue, request.JobSetId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load status of job set %s: %v", request.JobSetId, e)
+	}
+	return jobSetStatus, nil
+}
+
+// WatchJobs folds the events of the job set into job states, it sends the states of all jobs first and then the jobs
+// changing phase or cluster. The stream ends once all jobs of the job set are finished.
+func (s *EventServer) WatchJobs(request *api.WatchJobsRequest, stream api.Event_WatchJobsServer) error {
+	if e := checkPermission(s.permissions, stream.Context(), permissions.WatchAllEvents); e != nil {
+		return e
+	}
+	if request.Queue == "" {
+		return status.Errorf(codes.InvalidArgument, "Queue is not specified")
+	}
+	if request.JobSetId == "" {
+		return status.Errorf(codes.InvalidArgument, "Job set is not specified")
+	}
+
+	watchContext := watch.NewWatchContext()
+	sent := map[string]*api.JobState{}
+	fromId := ""
+
+	e := s.eventRepository.IterateEvents(request.Queue, request.JobSetId, "", s.readBatchSize, nil, func(msg *api.EventStreamMessage) error {
+		fromId = msg.Id
+		processEventMessage(watchContext, msg)
+		return nil
+	})
+	if e != nil {
+		if stream.Context().Err() != nil {
+			return nil
+		}
+		return e
+	}
+	if e := stream.Send(&api.JobStateUpdate{Snapshot: true, Jobs: changedJobStates(watchContext, sent)}); e != nil {
+		return e
+	}
 
-		if !request.Watch && stop {
+	for !allJobsFinished(watchContext) {
+		select {
+		case <-stream.Context().Done():
 			return nil
+		default:
+		}
+
+		messages, lastId, e := s.eventRepository.ReadEvents(request.Queue, request.JobSetId, fromId, s.readBatchSize, 5*time.Second, nil)
+		if e != nil {
+			return e
+		}
+		fromId = lastId
+		for _, msg := range messages {
+			processEventMessage(watchContext, msg)
+		}
+
+		if changed := changedJobStates(watchContext, sent); len(changed) > 0 {
+			if e := stream.Send(&api.JobStateUpdate{Jobs: changed}); e != nil {
+				return e
+			}
 		}
 	}
+	return nil
+}
+
+func eventTypeFilter(eventTypes []string) (map[string]bool, error) {
+	if len(eventTypes) == 0 {
+		return nil, nil
+	}
+	known := map[string]bool{}
+	for _, name := range api.EventTypeNames() {
+		known[name] = true
+	}
+	filter := map[string]bool{}
+	for _, eventType := range eventTypes {
+		if !known[eventType] {
+			return nil, fmt.Errorf("unknown event type %s, expected one of %s", eventType, strings.Join(api.EventTypeNames(), ", "))
+		}
+		filter[eventType] = true
+	}
+	return filter, nil
+}
+
+func processEventMessage(watchContext *watch.WatchContext, msg *api.EventStreamMessage) {
+	event, e := api.UnwrapEvent(msg.Message)
+	if e != nil {
+		log.Errorf("Failed to unwrap event %s: %v", msg.Id, e)
+		return
+	}
+	watchContext.ProcessEvent(event)
+}
+
+// Records the returned states as sent, jobs are ordered by id
+func changedJobStates(watchContext *watch.WatchContext, sent map[string]*api.JobState) []*api.JobState {
+	changed := []*api.JobState{}
+	for jobId, info := range watchContext.GetCurrentState() {
+		state := &api.JobState{
+			JobId:              jobId,
+			Phase:              jobPhases[info.Status],
+			ClusterId:          info.ClusterId,
+			LastTransitionTime: info.LastUpdate,
+		}
+		if previous, ok := sent[jobId]; ok && previous.Phase == state.Phase && previous.ClusterId == state.ClusterId {
+			continue
+		}
+		sent[jobId] = state
+		changed = append(changed, state)
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].JobId < changed[j].JobId
+	})
+	return changed
+}
+
+var jobPhases = map[watch.JobStatus]api.JobPhase{
+	watch.Submitted: api.JobPhase_Submitted,
+	watch.Duplicate: api.JobPhase_Duplicate,
+	watch.Queued:    api.JobPhase_Queued,
+	watch.Leased:    api.JobPhase_Leased,
+	watch.Pending:   api.JobPhase_Pending,
+	watch.Running:   api.JobPhase_Running,
+	watch.Succeeded: api.JobPhase_Succeeded,
+	watch.Failed:    api.JobPhase_Failed,
+	watch.Cancelled: api.JobPhase_Cancelled,
+}
+
+// Jobs without known phase, e.g. with their submitted event removed by event retention, don't keep the watch open.
+// Job sets without jobs are finished as well.
+func allJobsFinished(watchContext *watch.WatchContext) bool {
+	for _, info := range watchContext.GetCurrentState() {
+		phase := jobPhases[info.Status]
+		if phase != api.JobPhase_UnspecifiedJobPhase && !isFinishedPhase(phase) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFinishedPhase(phase api.JobPhase) bool {
+	return phase == api.JobPhase_Succeeded || phase == api.JobPhase_Failed ||
+		phase == api.JobPhase_Cancelled || phase == api.JobPhase_Duplicate
 }
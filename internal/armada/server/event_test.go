@@ -2,13 +2,19 @@ package server
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/pkg/api"
@@ -17,65 +23,261 @@ import (
 func TestEventServer_ReportUsage(t *testing.T) {
 	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
 
+		queue := "queue1"
 		jobSetId := "set1"
 		stream := &eventStreamMock{}
 
-		reportEvent(t, s, &api.JobSubmittedEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobQueuedEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobLeasedEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobLeaseExpiredEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobPendingEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobRunningEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobUnableToScheduleEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobFailedEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobSucceededEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobReprioritizedEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobCancelledEvent{JobSetId: jobSetId})
-		reportEvent(t, s, &api.JobTerminatedEvent{JobSetId: jobSetId})
-
-		e := s.GetJobSetEvents(&api.JobSetRequest{Id: jobSetId, Watch: false}, stream)
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobQueuedEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobLeasedEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobLeaseExpiredEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobPendingEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobRunningEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobUnableToScheduleEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobFailedEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobSucceededEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobReprioritizedEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobCancelledEvent{Queue: queue, JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobTerminatedEvent{Queue: queue, JobSetId: jobSetId})
+
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: queue, Id: jobSetId, Watch: false}, stream)
 		assert.Nil(t, e)
 		assert.Equal(t, 12, len(stream.sendMessages))
 
 		lastMessage := stream.sendMessages[len(stream.sendMessages)-1]
-		reportEvent(t, s, &api.JobCancelledEvent{JobSetId: jobSetId})
-		e = s.GetJobSetEvents(&api.JobSetRequest{Id: jobSetId, FromMessageId: lastMessage.Id, Watch: false}, stream)
+		reportEvent(t, s, &api.JobCancelledEvent{Queue: queue, JobSetId: jobSetId})
+		e = s.GetJobSetEvents(&api.JobSetRequest{Queue: queue, Id: jobSetId, FromMessageId: lastMessage.Id, Watch: false}, stream)
 		assert.Nil(t, e)
 		assert.Equal(t, 13, len(stream.sendMessages),
 			"Just new messages should be added when reading from last one.")
 	})
 }
 
+func TestEventServer_Report_UpdatesMemoryOfRecreatedJobs(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		memory := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+		jobs, e := s.jobRepository.CreateJobs(&api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: []*api.JobSubmitRequestItem{{
+			PodSpec: &v1.PodSpec{Containers: []v1.Container{{Name: "main", Resources: v1.ResourceRequirements{Limits: memory, Requests: memory}}}},
+		}}}, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, e)
+		_, e = s.jobRepository.AddJobs(jobs, false)
+		assert.NoError(t, e)
+		_, e = s.jobRepository.TryLeaseJobs("cluster1", "queue1", jobs)
+		assert.NoError(t, e)
+
+		reportEvent(t, s, &api.JobMemoryIncreasedEvent{
+			JobId:        jobs[0].Id,
+			JobSetId:     "set1",
+			Queue:        "queue1",
+			ClusterId:    "cluster1",
+			MemoryLimits: map[string]resource.Quantity{"main": resource.MustParse("2Gi")},
+		})
+
+		updated, e := s.jobRepository.GetExistingJobsByIds([]string{jobs[0].Id})
+		assert.NoError(t, e)
+		assert.Equal(t, "2Gi", updated[0].PodSpec.Containers[0].Resources.Limits.Memory().String())
+		assert.Equal(t, "2Gi", updated[0].PodSpec.Containers[0].Resources.Requests.Memory().String())
+	})
+}
+
 func TestEventServer_GetJobSetEvents_EmptyStreamShouldNotFail(t *testing.T) {
 	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
 
 		stream := &eventStreamMock{}
-		e := s.GetJobSetEvents(&api.JobSetRequest{Id: "test", Watch: false}, stream)
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue1", Id: "test", Watch: false}, stream)
 		assert.Nil(t, e)
 		assert.Equal(t, 0, len(stream.sendMessages))
 	})
 }
 
+func TestEventServer_GetJobSetEvents_IsolatesSameJobSetIdInDifferentQueues(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue1", JobSetId: "set1", JobId: "job1"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue2", JobSetId: "set1", JobId: "job2"})
+		reportEvent(t, s, &api.JobQueuedEvent{Queue: "queue2", JobSetId: "set1", JobId: "job2"})
+
+		stream := &eventStreamMock{}
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue1", Id: "set1", Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stream.sendMessages))
+		assert.Equal(t, "job1", stream.sendMessages[0].Message.GetSubmitted().JobId)
+
+		stream = &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue2", Id: "set1", Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(stream.sendMessages))
+	})
+}
+
+func TestEventServer_GetJobSetEvents_RequiresQueue(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		e := s.GetJobSetEvents(&api.JobSetRequest{Id: "set1", Watch: false}, &eventStreamMock{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
+func TestEventServer_GetJobSetEvents_SendsOnlyFilteredEventTypes(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue1", JobSetId: "set1", JobId: "job1"})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: "queue1", JobSetId: "set1", JobId: "job2"})
+		reportEvent(t, s, &api.JobRunningEvent{Queue: "queue1", JobSetId: "set1", JobId: "job1"})
+		reportEvent(t, s, &api.JobFailedEvent{Queue: "queue1", JobSetId: "set1", JobId: "job1"})
+		reportEvent(t, s, &api.JobCancelledEvent{Queue: "queue1", JobSetId: "set1", JobId: "job2"})
+
+		stream := &eventStreamMock{}
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue1", Id: "set1", EventTypes: []string{"failed", "succeeded", "cancelled"}}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(stream.sendMessages))
+		assert.Equal(t, "job1", stream.sendMessages[0].Message.GetFailed().JobId)
+		assert.Equal(t, "job2", stream.sendMessages[1].Message.GetCancelled().JobId)
+	})
+}
+
+func TestEventServer_GetJobSetEvents_RejectsUnknownEventType(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: "queue1", Id: "set1", EventTypes: []string{"finished"}}, &eventStreamMock{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
 func TestEventServer_EventsShouldBeRemovedAfterEventRetentionTime(t *testing.T) {
 	eventRetention := configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Second * 2}
 	withEventServer(eventRetention, func(s *EventServer) {
+		queue := "queue1"
 		jobSetId := "set1"
 		stream := &eventStreamMock{}
-		reportEvent(t, s, &api.JobSubmittedEvent{JobSetId: jobSetId})
+		reportEvent(t, s, &api.JobSubmittedEvent{Queue: queue, JobSetId: jobSetId})
 
-		e := s.GetJobSetEvents(&api.JobSetRequest{Id: jobSetId, Watch: false}, stream)
+		e := s.GetJobSetEvents(&api.JobSetRequest{Queue: queue, Id: jobSetId, Watch: false}, stream)
 		assert.Nil(t, e)
 		assert.Equal(t, 1, len(stream.sendMessages))
 
 		time.Sleep(eventRetention.RetentionDuration + time.Millisecond*100)
 
 		stream = &eventStreamMock{}
-		e = s.GetJobSetEvents(&api.JobSetRequest{Id: jobSetId, Watch: false}, stream)
+		e = s.GetJobSetEvents(&api.JobSetRequest{Queue: queue, Id: jobSetId, Watch: false}, stream)
 		assert.Nil(t, e)
 		assert.Equal(t, 0, len(stream.sendMessages))
 	})
 }
 
+func TestEventServer_WatchJobs_FinishedJobSetSendsSnapshotOnly(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		now := time.Now().UTC()
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1", Created: now})
+		reportEvent(t, s, &api.JobCancelledEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1", Created: now.Add(time.Second)})
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: now})
+		reportEvent(t, s, &api.JobLeasedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", ClusterId: "cluster1", Created: now.Add(time.Second)})
+		reportEvent(t, s, &api.JobSucceededEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", ClusterId: "cluster1", Created: now.Add(2 * time.Second)})
+
+		stream := &jobStateStreamMock{ctx: context.Background()}
+		e := s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1", JobSetId: "set1"}, stream)
+
+		assert.NoError(t, e)
+		assert.Equal(t, []*api.JobStateUpdate{{
+			Snapshot: true,
+			Jobs: []*api.JobState{
+				{JobId: "job1", Phase: api.JobPhase_Succeeded, ClusterId: "cluster1", LastTransitionTime: now.Add(2 * time.Second)},
+				{JobId: "job2", Phase: api.JobPhase_Cancelled, LastTransitionTime: now.Add(time.Second)},
+			},
+		}}, stream.sentUpdates())
+	})
+}
+
+func TestEventServer_WatchJobs_SendsChangedJobsUntilJobSetFinishes(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		now := time.Now().UTC()
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: now})
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1", Created: now})
+
+		stream := &jobStateStreamMock{ctx: context.Background()}
+		done := make(chan error)
+		go func() {
+			done <- s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1", JobSetId: "set1"}, stream)
+		}()
+
+		assert.Eventually(t, func() bool { return len(stream.sentUpdates()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		reportEvent(t, s, &api.JobLeasedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", ClusterId: "cluster1", Created: now.Add(time.Second)})
+		reportEvent(t, s, &api.JobCancelledEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1", Created: now.Add(time.Second)})
+		reportEvent(t, s, &api.JobFailedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", ClusterId: "cluster1", Created: now.Add(2 * time.Second)})
+
+		select {
+		case e := <-done:
+			assert.NoError(t, e)
+		case <-time.After(10 * time.Second):
+			t.Fatal("watch did not end after all jobs finished")
+		}
+
+		updates := stream.sentUpdates()
+		assert.True(t, updates[0].Snapshot)
+		assert.Len(t, updates[0].Jobs, 2)
+		finalPhases := map[string]api.JobPhase{}
+		for _, update := range updates[1:] {
+			assert.False(t, update.Snapshot)
+			for _, job := range update.Jobs {
+				finalPhases[job.JobId] = job.Phase
+			}
+		}
+		assert.Equal(t, map[string]api.JobPhase{"job1": api.JobPhase_Failed, "job2": api.JobPhase_Cancelled}, finalPhases)
+	})
+}
+
+func TestEventServer_WatchJobs_EndsForJobSetsWithoutUnfinishedJobs(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		stream := &jobStateStreamMock{ctx: context.Background()}
+		e := s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1", JobSetId: "empty"}, stream)
+		assert.NoError(t, e)
+		assert.Len(t, stream.sentUpdates(), 1)
+
+		// submitted event removed by event retention
+		reportEvent(t, s, &api.JobUtilisationEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: time.Now()})
+		stream = &jobStateStreamMock{ctx: context.Background()}
+		e = s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1", JobSetId: "set1"}, stream)
+		assert.NoError(t, e)
+		assert.Len(t, stream.sentUpdates(), 1)
+	})
+}
+
+func TestEventServer_WatchJobs_EndsWhenClientDisconnects(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1", Created: time.Now()})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stream := &jobStateStreamMock{ctx: ctx}
+		e := s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1", JobSetId: "set1"}, stream)
+
+		assert.NoError(t, e)
+		assert.Len(t, stream.sentUpdates(), 1)
+	})
+}
+
+func TestEventServer_GetJobSetStatus(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job1", Queue: "queue1", JobSetId: "set1"})
+		reportEvent(t, s, &api.JobSubmittedEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1"})
+		reportEvent(t, s, &api.JobRunningEvent{JobId: "job2", Queue: "queue1", JobSetId: "set1"})
+
+		jobSetStatus, e := s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{Queue: "queue1", JobSetId: "set1"})
+
+		assert.NoError(t, e)
+		assert.Equal(t, &api.JobSetStatus{Queue: "queue1", JobSetId: "set1", Queued: 1, Running: 1}, jobSetStatus)
+
+		_, e = s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{JobSetId: "set1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
+func TestEventServer_WatchJobs_RequiresQueueAndJobSet(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		e := s.WatchJobs(&api.WatchJobsRequest{JobSetId: "set1"}, &jobStateStreamMock{ctx: context.Background()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+
+		e = s.WatchJobs(&api.WatchJobsRequest{Queue: "queue1"}, &jobStateStreamMock{ctx: context.Background()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(e))
+	})
+}
+
 func reportEvent(t *testing.T, s *EventServer, event api.Event) {
 	msg, _ := api.Wrap(event)
 	_, e := s.Report(context.Background(), msg)
@@ -87,8 +289,9 @@ func withEventServer(eventRetention configuration.EventRetentionPolicy, action f
 	// using real redis instance as miniredis does not support streams
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
-	repo := repository.NewRedisEventRepository(client, eventRetention)
-	server := NewEventServer(&FakePermissionChecker{}, repo, repo)
+	repo := repository.NewRedisEventRepository(client, eventRetention, repository.NewRedisQueueRepository(client))
+	jobRepo := repository.NewRedisJobRepository(client, nil, configuration.JobRepositoryConfig{})
+	server := NewEventServer(&FakePermissionChecker{}, repo, repo, jobRepo, 2)
 
 	client.FlushDB()
 
@@ -111,3 +314,27 @@ func (s *eventStreamMock) Context() context.Context {
 	return context.Background()
 
 }
+
+type jobStateStreamMock struct {
+	grpc.ServerStream
+	ctx     context.Context
+	mutex   sync.Mutex
+	updates []*api.JobStateUpdate
+}
+
+func (s *jobStateStreamMock) Send(m *api.JobStateUpdate) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.updates = append(s.updates, m)
+	return nil
+}
+
+func (s *jobStateStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *jobStateStreamMock) sentUpdates() []*api.JobStateUpdate {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]*api.JobStateUpdate{}, s.updates...)
+}
@@ -3,8 +3,10 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,6 +19,9 @@ import (
 	"github.com/G-Research/armada/pkg/api"
 )
 
+// Lock of lease calls without deadline expires after this long, in case the server holding it dies
+const quotaLeasingLockExpiry = time.Minute
+
 type AggregatedQueueServer struct {
 	permissions              authorization.PermissionChecker
 	schedulingConfig         configuration.SchedulingConfig
@@ -56,7 +61,7 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 
 	var res common.ComputeResources = request.Resources
 	if res.AsFloat().IsLessThan(q.schedulingConfig.MinimumResourceToSchedule) {
-		return &api.JobLease{}, nil
+		return &api.JobLease{ServerTime: time.Now()}, nil
 	}
 
 	queues, e := q.queueRepository.GetAllQueues()
@@ -69,6 +74,12 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
+	scheduleLocation, e := q.schedulingConfig.GetQueueScheduleLocation()
+	if e != nil {
+		return nil, e
+	}
+	activeQueues = scheduling.FilterQueuesInSchedulingWindow(scheduling.FilterUnpausedQueues(activeQueues), time.Now(), scheduleLocation)
+
 	usageReports, e := q.usageRepository.GetClusterUsageReports()
 	if e != nil {
 		return nil, e
@@ -79,7 +90,7 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
-	nodeResources := scheduling.AggregateNodeTypeAllocations(request.Nodes)
+	nodeResources := scheduling.AggregateNodeTypeAllocations(request.Nodes, q.schedulingConfig.GetResourceOversubscription(request.Pool))
 	clusterSchedulingInfo := scheduling.CreateClusterSchedulingInfoReport(request, nodeResources)
 	e = q.schedulingInfoRepository.UpdateClusterSchedulingInfo(clusterSchedulingInfo)
 	if e != nil {
@@ -94,6 +105,9 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
+	activeQueues, unlockQuotaLeasing := q.lockQuotaLeasing(ctx, request.ClusterId, activeQueues)
+	defer unlockQuotaLeasing()
+
 	clusterLeasedJobReports, e := q.usageRepository.GetClusterLeasedReports()
 	if e != nil {
 		return nil, e
@@ -108,6 +122,8 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		nodeResources,
 		activePoolClusterReports,
 		poolLeasedJobReports,
+		scheduling.TotalAvailableCapacity(activeClusterReports),
+		scheduling.QueueResourcesLeased(activeClusterReports, clusterLeasedJobReports),
 		clusterPriorities,
 		activeQueues)
 
@@ -122,11 +138,43 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 	}
 
 	jobLease := api.JobLease{
-		Job: jobs,
+		Job:        jobs,
+		ServerTime: time.Now(),
 	}
 	return &jobLease, nil
 }
 
+// Queues with resource quotas are leased only while holding the quota leasing lock, until the leased report of the cluster
+// is updated, otherwise they are left for the next lease call. Returns the queues to lease and the function releasing the lock.
+func (q *AggregatedQueueServer) lockQuotaLeasing(ctx context.Context, clusterId string, queues []*api.Queue) ([]*api.Queue, func()) {
+	queuesWithoutQuota := make([]*api.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if len(queue.ResourceQuota) == 0 {
+			queuesWithoutQuota = append(queuesWithoutQuota, queue)
+		}
+	}
+	if len(queuesWithoutQuota) == len(queues) {
+		return queues, func() {}
+	}
+
+	expiry := quotaLeasingLockExpiry
+	if deadline, ok := ctx.Deadline(); ok {
+		expiry = time.Until(deadline)
+	}
+	token, locked, e := q.usageRepository.TryLockQuotaLeasing(expiry)
+	if e != nil {
+		log.Errorf("Failed to lock leasing of queues with resource quotas for cluster %s: %v", clusterId, e)
+	}
+	if e != nil || !locked {
+		return queuesWithoutQuota, func() {}
+	}
+	return queues, func() {
+		if e := q.usageRepository.UnlockQuotaLeasing(token); e != nil {
+			log.Errorf("Failed to unlock leasing of queues with resource quotas for cluster %s: %v", clusterId, e)
+		}
+	}
+}
+
 func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.RenewLeaseRequest) (*api.IdList, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
@@ -140,6 +188,11 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 		return nil, e
 	}
 
+	countsAsRetry, err := q.returnCountsAsRetry(request)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check how many times the same job has been retried already
 	retries, err := q.jobRepository.GetNumberOfRetryAttempts(request.JobId)
 	if err != nil {
@@ -147,7 +200,7 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 	}
 
 	maxRetries := int(q.schedulingConfig.MaxRetries)
-	if retries >= maxRetries {
+	if countsAsRetry && retries >= maxRetries {
 		failureReason := fmt.Sprintf("Exceeded maximum number of retries: %d", maxRetries)
 		err = q.reportFailure(request.JobId, request.ClusterId, failureReason)
 		if err != nil {
@@ -162,11 +215,30 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 		return &types.Empty{}, nil
 	}
 
-	_, err = q.jobRepository.ReturnLease(request.ClusterId, request.JobId)
+	returnedJob, err := q.jobRepository.ReturnLease(request.ClusterId, request.JobId)
 	if err != nil {
 		return nil, err
 	}
+	if returnedJob != nil {
+		err = q.jobRepository.AddRequeue(request.JobId, &api.JobRequeue{
+			Reason:    request.Reason,
+			ClusterId: request.ClusterId,
+			Created:   time.Now(),
+		})
+		if err != nil {
+			log.Errorf("Failed to record requeue of job %s: %v", request.JobId, err)
+		}
+		if countsAsRetry && q.schedulingConfig.Lease.ReturnCooldown > 0 {
+			_, err = q.jobRepository.AddLeaseCooldown(request.JobId, q.schedulingConfig.Lease.ReturnCooldown, q.schedulingConfig.Lease.MaxReturnCooldown)
+			if err != nil {
+				log.Errorf("Failed to set lease cooldown of job %s: %v", request.JobId, err)
+			}
+		}
+	}
 
+	if !countsAsRetry {
+		return &types.Empty{}, nil
+	}
 	err = q.jobRepository.AddRetryAttempt(request.JobId)
 	if err != nil {
 		return nil, err
@@ -175,6 +247,24 @@ func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.Re
 	return &types.Empty{}, nil
 }
 
+// Jobs returned by executors shutting down never started and jobs moved off drained nodes did not fail,
+// they are leased again immediately and don't use up retries. Executors only return jobs on shutdown before they start,
+// so jobs which started on the cluster use up a retry whatever the reason the executor gives.
+func (q *AggregatedQueueServer) returnCountsAsRetry(request *api.ReturnLeaseRequest) (bool, error) {
+	switch request.Reason {
+	case api.RequeueReason_NodeDrain:
+		return false, nil
+	case api.RequeueReason_ExecutorShutdown:
+		runInfos, err := q.jobRepository.GetJobRunInfos([]string{request.JobId})
+		if err != nil {
+			return false, err
+		}
+		_, started := runInfos[request.JobId]
+		return started, nil
+	}
+	return true, nil
+}
+
 func (q *AggregatedQueueServer) ReportDone(ctx context.Context, idList *api.IdList) (*api.IdList, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
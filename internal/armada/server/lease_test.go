@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/cache"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -22,7 +25,7 @@ func TestAggregatedQueueServer_ReturnLeaseCallsRepositoryMethod(t *testing.T) {
 	jobId := "job-id-1"
 	job := &api.Job{Id: jobId}
 
-	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job})
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
 	assert.Nil(t, addJobsErr)
 
 	_, err := aggregatedQueueClient.ReturnLease(context.TODO(), &api.ReturnLeaseRequest{
@@ -36,6 +39,98 @@ func TestAggregatedQueueServer_ReturnLeaseCallsRepositoryMethod(t *testing.T) {
 	assert.Equal(t, jobId, mockJobRepository.returnLeaseArg2)
 }
 
+func TestAggregatedQueueServer_ReturningLeaseRecordsRequeueReason(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+
+	job := &api.Job{Id: "job-id-1"}
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
+	assert.Nil(t, addJobsErr)
+
+	_, err := aggregatedQueueClient.ReturnLease(context.TODO(), &api.ReturnLeaseRequest{
+		ClusterId: "cluster-1",
+		JobId:     job.Id,
+		Reason:    api.RequeueReason_NodeUnreachable,
+	})
+	assert.Nil(t, err)
+
+	history, err := mockJobRepository.GetRequeueHistory(job.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, api.RequeueReason_NodeUnreachable, history[0].Reason)
+	assert.Equal(t, "cluster-1", history[0].ClusterId)
+}
+
+func TestAggregatedQueueServer_ReturningLeaseSetsCooldown(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+	aggregatedQueueClient.schedulingConfig.Lease.ReturnCooldown = time.Second
+	aggregatedQueueClient.schedulingConfig.Lease.MaxReturnCooldown = time.Minute
+
+	job := &api.Job{Id: "job-id-1"}
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
+	assert.Nil(t, addJobsErr)
+
+	_, err := aggregatedQueueClient.ReturnLease(context.TODO(), &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: job.Id})
+	assert.Nil(t, err)
+
+	assert.Equal(t, time.Second, mockJobRepository.leaseCooldowns[job.Id])
+}
+
+func TestAggregatedQueueServer_ReturningLeaseOnExecutorShutdownDoesNotCountAsRetry(t *testing.T) {
+	assertReturningLeaseDoesNotCountAsRetry(t, api.RequeueReason_ExecutorShutdown)
+}
+
+func TestAggregatedQueueServer_ReturningLeaseOnNodeDrainDoesNotCountAsRetry(t *testing.T) {
+	assertReturningLeaseDoesNotCountAsRetry(t, api.RequeueReason_NodeDrain)
+}
+
+func assertReturningLeaseDoesNotCountAsRetry(t *testing.T, reason api.RequeueReason) {
+	maxRetries := 1
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(uint(maxRetries))
+	aggregatedQueueClient.schedulingConfig.Lease.ReturnCooldown = time.Second
+
+	job := &api.Job{Id: "job-id-1"}
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
+	assert.Nil(t, addJobsErr)
+
+	for i := 0; i < maxRetries+1; i++ {
+		_, err := aggregatedQueueClient.ReturnLease(context.TODO(), &api.ReturnLeaseRequest{
+			ClusterId: "cluster-1",
+			JobId:     job.Id,
+			Reason:    reason,
+		})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, maxRetries+1, mockJobRepository.returnLeaseCalls)
+	assert.Equal(t, 0, mockJobRepository.deleteJobsCalls)
+	assert.NotContains(t, mockJobRepository.leaseCooldowns, job.Id)
+	retries, err := mockJobRepository.GetNumberOfRetryAttempts(job.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, retries)
+}
+
+func TestAggregatedQueueServer_ReturningLeaseOfStartedJobOnExecutorShutdownCountsAsRetry(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+	aggregatedQueueClient.schedulingConfig.Lease.ReturnCooldown = time.Second
+
+	job := &api.Job{Id: "job-id-1"}
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
+	assert.Nil(t, addJobsErr)
+	assert.Nil(t, mockJobRepository.UpdateStartTime(job.Id, "cluster-1", time.Now()))
+
+	_, err := aggregatedQueueClient.ReturnLease(context.TODO(), &api.ReturnLeaseRequest{
+		ClusterId: "cluster-1",
+		JobId:     job.Id,
+		Reason:    api.RequeueReason_ExecutorShutdown,
+	})
+	assert.Nil(t, err)
+
+	retries, err := mockJobRepository.GetNumberOfRetryAttempts(job.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, retries)
+	assert.Contains(t, mockJobRepository.leaseCooldowns, job.Id)
+}
+
 func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesDeletesJob(t *testing.T) {
 	maxRetries := 5
 	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(uint(maxRetries))
@@ -44,7 +139,7 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesDeletesJob(t *tes
 	jobId := "job-id-1"
 	job := &api.Job{Id: jobId}
 
-	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job})
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
 	assert.Nil(t, addJobsErr)
 
 	for i := 0; i < maxRetries; i++ {
@@ -84,7 +179,7 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesSendsJobFailedEve
 		Queue:    queue,
 	}
 
-	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job})
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{job}, false)
 	assert.Nil(t, addJobsErr)
 
 	for i := 0; i < maxRetries; i++ {
@@ -110,6 +205,28 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesSendsJobFailedEve
 	assert.Equal(t, fmt.Sprintf("Exceeded maximum number of retries: %d", maxRetries), failedEvent.Reason)
 }
 
+func TestAggregatedQueueServer_LeasesQueuesWithQuotaOnlyWhileHoldingLock(t *testing.T) {
+	_, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+	withQuota := &api.Queue{Name: "with-quota", ResourceQuota: common.ComputeResources{"cpu": resource.MustParse("1")}}
+	withoutQuota := &api.Queue{Name: "without-quota"}
+	queues := []*api.Queue{withQuota, withoutQuota}
+
+	leased, unlock := aggregatedQueueClient.lockQuotaLeasing(context.Background(), "cluster-1", queues)
+	assert.Equal(t, queues, leased)
+
+	// overlapping calls of the same cluster don't release the lock of each other
+	leasedConcurrently, unlockConcurrently := aggregatedQueueClient.lockQuotaLeasing(context.Background(), "cluster-1", queues)
+	assert.Equal(t, []*api.Queue{withoutQuota}, leasedConcurrently)
+	unlockConcurrently()
+	leasedConcurrently, _ = aggregatedQueueClient.lockQuotaLeasing(context.Background(), "cluster-2", queues)
+	assert.Equal(t, []*api.Queue{withoutQuota}, leasedConcurrently)
+
+	unlock()
+	leased, unlock = aggregatedQueueClient.lockQuotaLeasing(context.Background(), "cluster-2", queues)
+	assert.Equal(t, queues, leased)
+	unlock()
+}
+
 func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
 	mockJobRepository := newMockJobRepository()
 	fakeEventStore := &fakeEventStore{}
@@ -129,8 +246,12 @@ func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobReposito
 }
 
 type mockJobRepository struct {
-	jobs       map[string]*api.Job
-	jobRetries map[string]int
+	jobs        map[string]*api.Job
+	jobRetries  map[string]int
+	jobRequeues map[string][]*api.JobRequeue
+
+	leaseCooldowns map[string]time.Duration
+	startTimes     map[string]time.Time
 
 	returnLeaseCalls int
 	deleteJobsCalls  int
@@ -144,6 +265,9 @@ func newMockJobRepository() *mockJobRepository {
 	return &mockJobRepository{
 		jobs:             make(map[string]*api.Job),
 		jobRetries:       make(map[string]int),
+		jobRequeues:      make(map[string][]*api.JobRequeue),
+		leaseCooldowns:   make(map[string]time.Duration),
+		startTimes:       make(map[string]time.Time),
 		returnLeaseCalls: 0,
 		deleteJobsCalls:  0,
 		returnLeaseArg1:  "",
@@ -160,7 +284,7 @@ func (repo *mockJobRepository) CreateJobs(request *api.JobSubmitRequest, princip
 	return []*api.Job{}, nil
 }
 
-func (repo *mockJobRepository) AddJobs(job []*api.Job) ([]*repository.SubmitJobResult, error) {
+func (repo *mockJobRepository) AddJobs(job []*api.Job, deduplicateContent bool) ([]*repository.SubmitJobResult, error) {
 	for _, j := range job {
 		repo.jobs[j.Id] = j
 	}
@@ -189,15 +313,15 @@ func (repo *mockJobRepository) RenewLease(clusterId string, jobIds []string) (re
 	return []string{}, nil
 }
 
-func (repo *mockJobRepository) ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error) {
-	return []*api.Job{}, nil
+func (repo *mockJobRepository) ExpireLeases(queue string, deadline time.Time, protectedDeadline time.Time, protectedClusters map[string]bool) (expired []*api.Job, protected []*api.Job, clusters map[string]string, e error) {
+	return []*api.Job{}, []*api.Job{}, map[string]string{}, nil
 }
 
 func (repo *mockJobRepository) ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error) {
 	repo.returnLeaseCalls++
 	repo.returnLeaseArg1 = clusterId
 	repo.returnLeaseArg2 = jobId
-	return nil, nil
+	return repo.jobs[jobId], nil
 }
 
 func (repo *mockJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
@@ -215,6 +339,14 @@ func (repo *mockJobRepository) GetActiveJobIds(queue string, jobSetId string) ([
 	return []string{}, nil
 }
 
+func (repo *mockJobRepository) GetActiveJobsSubmittedBefore(queue string, jobSetId string, submittedBefore time.Time) ([]*api.Job, error) {
+	return []*api.Job{}, nil
+}
+
+func (repo *mockJobRepository) GetQueueActiveJobIds(queue string) ([]string, error) {
+	return []string{}, nil
+}
+
 func (repo *mockJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 	return []*api.JobSetInfo{}, nil
 }
@@ -236,6 +368,56 @@ func (repo *mockJobRepository) GetNumberOfRetryAttempts(jobId string) (int, erro
 	return repo.jobRetries[jobId], nil
 }
 
+func (repo *mockJobRepository) AddRequeue(jobId string, requeue *api.JobRequeue) error {
+	repo.jobRequeues[jobId] = append(repo.jobRequeues[jobId], requeue)
+	return nil
+}
+
+func (repo *mockJobRepository) GetRequeueHistory(jobId string) ([]*api.JobRequeue, error) {
+	return repo.jobRequeues[jobId], nil
+}
+
+func (repo *mockJobRepository) AddLeaseCooldown(jobId string, cooldown time.Duration, maxCooldown time.Duration) (time.Duration, error) {
+	repo.leaseCooldowns[jobId] = cooldown
+	return cooldown, nil
+}
+
+func (repo *mockJobRepository) GetLeaseCooldowns(jobIds []string) (map[string]time.Time, error) {
+	return map[string]time.Time{}, nil
+}
+
+func (repo *mockJobRepository) SetCancelReason(jobIds []string, reason string) error {
+	return nil
+}
+
+func (repo *mockJobRepository) GetCancelReason(jobId string) (string, error) {
+	return "", nil
+}
+
+func (repo *mockJobRepository) GetJobIdByClientId(queue string, clientId string) (string, error) {
+	return "", nil
+}
+
+func (repo *mockJobRepository) UpdateQueuedJobsPriority(jobs []*api.Job, priority float64) ([]*api.Job, error) {
+	return []*api.Job{}, nil
+}
+
+func (repo *mockJobRepository) UpdateLeasedJobMemory(clusterId string, jobId string, podNumber int, newMemory map[string]resource.Quantity) error {
+	return nil
+}
+
+func (repo *mockJobRepository) GetJobsPastDeadline(now time.Time) ([]*api.Job, error) {
+	return []*api.Job{}, nil
+}
+
+func (repo *mockJobRepository) RemoveDeadlines(jobs []*api.Job) ([]*api.Job, error) {
+	return jobs, nil
+}
+
+func (repo *mockJobRepository) RestoreDeadlines(jobs []*api.Job) error {
+	return nil
+}
+
 func (repo *mockJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
 	return []*api.Job{}, nil
 }
@@ -253,11 +435,22 @@ func (repo *mockJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
 }
 
 func (repo *mockJobRepository) UpdateStartTime(jobId string, clusterId string, startTime time.Time) error {
+	repo.startTimes[jobId] = startTime
 	return nil
 }
 
 func (repo *mockJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repository.RunInfo, error) {
-	return map[string]*repository.RunInfo{}, nil
+	runInfos := map[string]*repository.RunInfo{}
+	for _, jobId := range jobIds {
+		if startTime, ok := repo.startTimes[jobId]; ok {
+			runInfos[jobId] = &repository.RunInfo{StartTime: startTime}
+		}
+	}
+	return runInfos, nil
+}
+
+func (repo *mockJobRepository) GetJobCluster(jobId string) (string, bool, error) {
+	return "", false, nil
 }
 
 type fakeQueueRepository struct{}
@@ -274,11 +467,21 @@ func (repo *fakeQueueRepository) CreateQueue(queue *api.Queue) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) UpdateQueue(name string, update repository.QueueUpdate) (*api.Queue, error) {
+	return &api.Queue{}, nil
+}
+
+func (repo *fakeQueueRepository) CreateOrUpdateQueue(name string, update repository.QueueUpdate) (*api.Queue, error) {
+	return nil, nil
+}
+
 func (repo *fakeQueueRepository) DeleteQueue(name string) error {
 	return nil
 }
 
-type fakeUsageRepository struct{}
+type fakeUsageRepository struct {
+	quotaLeasingLockedBy string
+}
 
 func (repo *fakeUsageRepository) GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error) {
 	return map[string]*api.ClusterUsageReport{}, nil
@@ -304,6 +507,21 @@ func (repo *fakeUsageRepository) UpdateClusterLeased(report *api.ClusterLeasedRe
 	return nil
 }
 
+func (repo *fakeUsageRepository) TryLockQuotaLeasing(expiry time.Duration) (string, bool, error) {
+	if repo.quotaLeasingLockedBy != "" {
+		return "", false, nil
+	}
+	repo.quotaLeasingLockedBy = util.NewULID()
+	return repo.quotaLeasingLockedBy, true, nil
+}
+
+func (repo *fakeUsageRepository) UnlockQuotaLeasing(token string) error {
+	if repo.quotaLeasingLockedBy == token {
+		repo.quotaLeasingLockedBy = ""
+	}
+	return nil
+}
+
 type fakeEventStore struct {
 	events []*api.EventMessage
 }
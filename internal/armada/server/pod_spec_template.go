@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/authorization/permissions"
+	"github.com/G-Research/armada/internal/common/validation"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func (server *SubmitServer) CreatePodSpecTemplate(ctx context.Context, template *api.PodSpecTemplate) (*types.Empty, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ManagePodSpecTemplates); e != nil {
+		return nil, e
+	}
+	if template.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Pod spec template name is not specified")
+	}
+	if e := validation.ValidatePodSpec(template.PodSpec); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid pod spec of template %s: %s", template.Name, e.Error())
+	}
+
+	e := server.podSpecTemplateRepository.StorePodSpecTemplate(template)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+
+	log.WithField("audit", "pod_spec_template").Infof("Pod spec template %s stored by %s", template.Name, authorization.GetPrincipal(ctx).GetName())
+	return &types.Empty{}, nil
+}
+
+func (server *SubmitServer) GetPodSpecTemplate(ctx context.Context, request *api.PodSpecTemplateRequest) (*api.PodSpecTemplate, error) {
+	template, e := server.podSpecTemplateRepository.GetPodSpecTemplate(request.Name)
+	if e == redis.Nil {
+		return nil, status.Errorf(codes.NotFound, "Pod spec template %s does not exist", request.Name)
+	}
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return template, nil
+}
+
+func (server *SubmitServer) DeletePodSpecTemplate(ctx context.Context, request *api.PodSpecTemplateRequest) (*types.Empty, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ManagePodSpecTemplates); e != nil {
+		return nil, e
+	}
+
+	e := server.podSpecTemplateRepository.DeletePodSpecTemplate(request.Name)
+	if e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	log.WithField("audit", "pod_spec_template").Infof("Pod spec template %s deleted by %s", request.Name, authorization.GetPrincipal(ctx).GetName())
+	return &types.Empty{}, nil
+}
+
+// Replaces template references of the request with the merged pod specs,
+// the jobs are then validated against queue policies like any other job
+func (server *SubmitServer) resolvePodSpecTemplates(req *api.JobSubmitRequest) error {
+	templates := map[string]*api.PodSpecTemplate{}
+	for i, item := range req.JobRequestItems {
+		if item.PodSpecTemplate == "" {
+			if len(item.ContainerOverrides) > 0 {
+				return status.Errorf(codes.InvalidArgument, "job with index %d has container overrides but no pod spec template", i)
+			}
+			continue
+		}
+		if item.PodSpec != nil || len(item.PodSpecs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "job with index %d sets both pod spec template %s and pod spec", i, item.PodSpecTemplate)
+		}
+
+		template, loaded := templates[item.PodSpecTemplate]
+		if !loaded {
+			var e error
+			template, e = server.podSpecTemplateRepository.GetPodSpecTemplate(item.PodSpecTemplate)
+			if e == redis.Nil {
+				return status.Errorf(codes.InvalidArgument, "job with index %d references pod spec template %s which does not exist", i, item.PodSpecTemplate)
+			}
+			if e != nil {
+				return status.Errorf(codes.Unavailable, "Could not load pod spec template: %s", e.Error())
+			}
+			templates[item.PodSpecTemplate] = template
+		}
+
+		podSpec := template.PodSpec.DeepCopy()
+		for _, override := range item.ContainerOverrides {
+			if e := applyContainerOverride(podSpec, override); e != nil {
+				return status.Errorf(codes.InvalidArgument, "job with index %d: %s", i, e.Error())
+			}
+		}
+		item.PodSpecs = []*v1.PodSpec{podSpec}
+	}
+	return nil
+}
+
+func applyContainerOverride(podSpec *v1.PodSpec, override *api.ContainerOverride) error {
+	container, e := findOverriddenContainer(podSpec, override.Name)
+	if e != nil {
+		return e
+	}
+	if override.Image != "" {
+		container.Image = override.Image
+	}
+	if len(override.Args) > 0 {
+		container.Args = override.Args
+	}
+	container.Resources.Requests = overrideResources(container.Resources.Requests, override.Resources.Requests)
+	container.Resources.Limits = overrideResources(container.Resources.Limits, override.Resources.Limits)
+	return nil
+}
+
+func findOverriddenContainer(podSpec *v1.PodSpec, name string) (*v1.Container, error) {
+	if name == "" {
+		if len(podSpec.Containers) != 1 {
+			return nil, fmt.Errorf("container override without name, but template has %d containers", len(podSpec.Containers))
+		}
+		return &podSpec.Containers[0], nil
+	}
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == name {
+			return &podSpec.Containers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("container override of %s, but template has no such container", name)
+}
+
+func overrideResources(resources v1.ResourceList, overrides v1.ResourceList) v1.ResourceList {
+	if len(overrides) == 0 {
+		return resources
+	}
+	if resources == nil {
+		resources = v1.ResourceList{}
+	}
+	for name, quantity := range overrides {
+		resources[name] = quantity
+	}
+	return resources
+}
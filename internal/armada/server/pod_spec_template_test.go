@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_applyContainerOverride_ChangesNamedContainer(t *testing.T) {
+	podSpec := &v1.PodSpec{Containers: []v1.Container{
+		{Name: "main", Image: "image:1", Args: []string{"run"}, Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+		}},
+		{Name: "sidecar", Image: "sidecar:1"},
+	}}
+
+	e := applyContainerOverride(podSpec, &api.ContainerOverride{
+		Name:      "main",
+		Args:      []string{"run", "--fast"},
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{"cpu": resource.MustParse("2")}},
+	})
+
+	assert.NoError(t, e)
+	main := podSpec.Containers[0]
+	assert.Equal(t, "image:1", main.Image)
+	assert.Equal(t, []string{"run", "--fast"}, main.Args)
+	assert.Equal(t, resource.MustParse("2"), main.Resources.Requests["cpu"])
+	assert.Equal(t, resource.MustParse("1Gi"), main.Resources.Requests["memory"])
+	assert.Equal(t, "sidecar:1", podSpec.Containers[1].Image)
+}
+
+func Test_applyContainerOverride_RequiresNameForMultipleContainers(t *testing.T) {
+	podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "main"}, {Name: "sidecar"}}}
+
+	assert.Error(t, applyContainerOverride(podSpec, &api.ContainerOverride{Image: "image:2"}))
+	assert.Error(t, applyContainerOverride(podSpec, &api.ContainerOverride{Name: "other", Image: "image:2"}))
+	assert.NoError(t, applyContainerOverride(&v1.PodSpec{Containers: []v1.Container{{Name: "main"}}}, &api.ContainerOverride{Image: "image:2"}))
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Setters of the queue fields UpdateQueue can change, by update mask path
+var queueUpdateFields = map[string]func(queue *api.Queue, update *api.Queue){
+	"priority_factor": func(queue *api.Queue, update *api.Queue) { queue.PriorityFactor = update.PriorityFactor },
+	"user_owners":     func(queue *api.Queue, update *api.Queue) { queue.UserOwners = update.UserOwners },
+	"group_owners":    func(queue *api.Queue, update *api.Queue) { queue.GroupOwners = update.GroupOwners },
+	"resource_limits": func(queue *api.Queue, update *api.Queue) { queue.ResourceLimits = update.ResourceLimits },
+	"event_retention": func(queue *api.Queue, update *api.Queue) { queue.EventRetention = update.EventRetention },
+	"event_retention.retention_duration": func(queue *api.Queue, update *api.Queue) {
+		retention := eventRetentionToUpdate(queue)
+		retention.RetentionDuration = update.GetEventRetention().GetRetentionDuration()
+		queue.EventRetention = emptyRetentionAsNil(retention)
+	},
+	"event_retention.max_length": func(queue *api.Queue, update *api.Queue) {
+		retention := eventRetentionToUpdate(queue)
+		retention.MaxLength = update.GetEventRetention().GetMaxLength()
+		queue.EventRetention = emptyRetentionAsNil(retention)
+	},
+	"scheduling_windows":      func(queue *api.Queue, update *api.Queue) { queue.SchedulingWindows = update.SchedulingWindows },
+	"max_pod_spec_size_bytes": func(queue *api.Queue, update *api.Queue) { queue.MaxPodSpecSizeBytes = update.MaxPodSpecSizeBytes },
+	"require_container_command": func(queue *api.Queue, update *api.Queue) {
+		queue.RequireContainerCommand = update.RequireContainerCommand
+	},
+	"resource_floor":       func(queue *api.Queue, update *api.Queue) { queue.ResourceFloor = update.ResourceFloor },
+	"allowed_volume_types": func(queue *api.Queue, update *api.Queue) { queue.AllowedVolumeTypes = update.AllowedVolumeTypes },
+	"require_empty_dir_size_limit": func(queue *api.Queue, update *api.Queue) {
+		queue.RequireEmptyDirSizeLimit = update.RequireEmptyDirSizeLimit
+	},
+	"default_pod_labels": func(queue *api.Queue, update *api.Queue) { queue.DefaultPodLabels = update.DefaultPodLabels },
+	"resource_quota":     func(queue *api.Queue, update *api.Queue) { queue.ResourceQuota = update.ResourceQuota },
+	"volume_claim_template": func(queue *api.Queue, update *api.Queue) {
+		queue.VolumeClaimTemplate = update.VolumeClaimTemplate
+	},
+}
+
+// Applies fields of the update mask of update to the stored queue, without mask all its settings except paused are replaced
+func applyQueueUpdate(queue *api.Queue, update *api.Queue) error {
+	paths := update.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paused := queue.Paused
+		*queue = *update
+		queue.Paused = paused
+		return nil
+	}
+	for _, path := range paths {
+		if _, ok := queueUpdateFields[path]; !ok {
+			return fmt.Errorf("queue field %s can not be updated, supported fields are: %s", path, strings.Join(queueUpdateFieldPaths(), ", "))
+		}
+	}
+	for _, path := range paths {
+		queueUpdateFields[path](queue, update)
+	}
+	return nil
+}
+
+func queueUpdateFieldPaths() []string {
+	paths := make([]string, 0, len(queueUpdateFields))
+	for path := range queueUpdateFields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func eventRetentionToUpdate(queue *api.Queue) *api.QueueEventRetention {
+	if queue.EventRetention == nil {
+		return &api.QueueEventRetention{}
+	}
+	return queue.EventRetention
+}
+
+func emptyRetentionAsNil(retention *api.QueueEventRetention) *api.QueueEventRetention {
+	if retention.RetentionDuration == 0 && retention.MaxLength == 0 {
+		return nil
+	}
+	return retention
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"math"
+	"sync"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Token bucket per queue, buckets are created with the first request of the queue and kept in memory of this server instance
+type queueRateLimiter struct {
+	qps      float32
+	burst    int
+	clock    flowcontrol.Clock
+	mutex    sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}
+
+// Zero rate disables limiting, zero burst allows the rate rounded up
+func newQueueRateLimiter(ratePerSecond float64, burst int, clock flowcontrol.Clock) *queueRateLimiter {
+	if burst <= 0 {
+		burst = int(math.Ceil(ratePerSecond))
+	}
+	return &queueRateLimiter{
+		qps:      float32(ratePerSecond),
+		burst:    burst,
+		clock:    clock,
+		limiters: map[string]flowcontrol.RateLimiter{},
+	}
+}
+
+func (l *queueRateLimiter) TryAccept(queue string) bool {
+	if l.qps <= 0 {
+		return true
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	limiter, ok := l.limiters[queue]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiterWithClock(l.qps, l.burst, l.clock)
+		l.limiters[queue] = limiter
+	}
+	return limiter.TryAccept()
+}
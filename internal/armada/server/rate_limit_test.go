@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestQueueRateLimiter_AllowsBurstThenRate(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	limiter := newQueueRateLimiter(2, 5, fakeClock)
+
+	for i := 0; i < 5; i++ {
+		assert.True(t, limiter.TryAccept("queue1"))
+	}
+	assert.False(t, limiter.TryAccept("queue1"))
+
+	fakeClock.Step(time.Second)
+	assert.True(t, limiter.TryAccept("queue1"))
+	assert.True(t, limiter.TryAccept("queue1"))
+	assert.False(t, limiter.TryAccept("queue1"))
+
+	fakeClock.Step(500 * time.Millisecond)
+	assert.True(t, limiter.TryAccept("queue1"))
+	assert.False(t, limiter.TryAccept("queue1"))
+}
+
+func TestQueueRateLimiter_LimitsQueuesSeparately(t *testing.T) {
+	limiter := newQueueRateLimiter(1, 1, clock.NewFakeClock(time.Now()))
+
+	assert.True(t, limiter.TryAccept("queue1"))
+	assert.False(t, limiter.TryAccept("queue1"))
+	assert.True(t, limiter.TryAccept("queue2"))
+}
+
+func TestQueueRateLimiter_DefaultsBurstToRate(t *testing.T) {
+	limiter := newQueueRateLimiter(2.5, 0, clock.NewFakeClock(time.Now()))
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.TryAccept("queue1"))
+	}
+	assert.False(t, limiter.TryAccept("queue1"))
+}
+
+func TestQueueRateLimiter_ZeroRateDisablesLimit(t *testing.T) {
+	limiter := newQueueRateLimiter(0, 0, clock.NewFakeClock(time.Now()))
+
+	for i := 0; i < 100; i++ {
+		assert.True(t, limiter.TryAccept("queue1"))
+	}
+}
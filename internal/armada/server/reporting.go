@@ -92,7 +92,7 @@ func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, cluster
 	}
 }
 
-func reportJobsCancelling(repository repository.EventStore, jobs []*api.Job) error {
+func reportJobsCancelling(repository repository.EventStore, jobs []*api.Job, reason string) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -101,6 +101,7 @@ func reportJobsCancelling(repository repository.EventStore, jobs []*api.Job) err
 			Queue:    job.Queue,
 			JobSetId: job.JobSetId,
 			Created:  now,
+			Reason:   reason,
 		})
 		if e != nil {
 			return e
@@ -111,7 +112,27 @@ func reportJobsCancelling(repository repository.EventStore, jobs []*api.Job) err
 	return e
 }
 
-func reportJobsCancelled(repository repository.EventStore, jobs []*api.Job) error {
+func reportJobsDeadlineExceeded(repository repository.EventStore, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobDeadlineExceededEvent{
+			JobId:    job.Id,
+			Queue:    job.Queue,
+			JobSetId: job.JobSetId,
+			Created:  now,
+			Deadline: *job.Deadline,
+		})
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+	}
+	e := repository.ReportEvents(events)
+	return e
+}
+
+func reportJobsCancelled(repository repository.EventStore, jobs []*api.Job, reason string) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -120,6 +141,27 @@ func reportJobsCancelled(repository repository.EventStore, jobs []*api.Job) erro
 			Queue:    job.Queue,
 			JobSetId: job.JobSetId,
 			Created:  now,
+			Reason:   reason,
+		})
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+	}
+	e := repository.ReportEvents(events)
+	return e
+}
+
+func reportJobsReprioritized(repository repository.EventStore, jobs []*api.Job, newPriority float64) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobReprioritizedEvent{
+			JobId:       job.Id,
+			Queue:       job.Queue,
+			JobSetId:    job.JobSetId,
+			Created:     now,
+			NewPriority: newPriority,
 		})
 		if e != nil {
 			return e
@@ -3,36 +3,62 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/common/validation"
 	"github.com/G-Research/armada/pkg/api"
 )
 
+const maxCancelReasonLength = 1024
+
+// Number of queued jobs checked against scheduling info of active clusters when explaining queue scheduling status
+const queueSchedulingStatusJobsToCheck = 100
+
 type SubmitServer struct {
 	permissions              authorization.PermissionChecker
 	jobRepository            repository.JobRepository
 	queueRepository          repository.QueueRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	usageRepository          repository.UsageRepository
+	schedulingConfig         *configuration.SchedulingConfig
 	queueManagementConfig    *configuration.QueueManagementConfig
+	eventRetention           configuration.EventRetentionPolicy
+	submitRateLimiter        *queueRateLimiter
+	// Named pod specs jobs can use instead of sending their own pod spec
+	podSpecTemplateRepository repository.PodSpecTemplateRepository
 }
 
 func NewSubmitServer(
 	permissions authorization.PermissionChecker,
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
+	podSpecTemplateRepository repository.PodSpecTemplateRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
-	queueManagementConfig *configuration.QueueManagementConfig) *SubmitServer {
+	usageRepository repository.UsageRepository,
+	schedulingConfig *configuration.SchedulingConfig,
+	queueManagementConfig *configuration.QueueManagementConfig,
+	eventRetention configuration.EventRetentionPolicy) *SubmitServer {
 
 	return &SubmitServer{
 		permissions:              permissions,
@@ -40,7 +66,13 @@ func NewSubmitServer(
 		queueRepository:          queueRepository,
 		eventStore:               eventStore,
 		schedulingInfoRepository: schedulingInfoRepository,
-		queueManagementConfig:    queueManagementConfig}
+		usageRepository:          usageRepository,
+		schedulingConfig:         schedulingConfig,
+		queueManagementConfig:    queueManagementConfig,
+		eventRetention:           eventRetention,
+		submitRateLimiter: newQueueRateLimiter(
+			queueManagementConfig.SubmitRatePerSecond, queueManagementConfig.SubmitBurst, clock.RealClock{}),
+		podSpecTemplateRepository: podSpecTemplateRepository}
 }
 
 func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfoRequest) (*api.QueueInfo, error) {
@@ -51,33 +83,389 @@ func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfo
 	if e != nil {
 		return nil, e
 	}
-	return &api.QueueInfo{
-		Name:          req.Name,
-		ActiveJobSets: jobSets,
+	queue, e := server.queueRepository.GetQueue(req.Name)
+	if e == redis.Nil {
+		queue = nil
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load queue %s: %v", req.Name, e)
+	}
+	eventRetention := repository.EffectiveEventRetention(queue, server.eventRetention)
+	info := &api.QueueInfo{
+		Name:           req.Name,
+		ActiveJobSets:  jobSets,
+		EventRetention: &eventRetention,
+	}
+	if queue != nil && len(queue.ResourceQuota) > 0 {
+		usageReports, e := server.usageRepository.GetClusterUsageReports()
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		clusterLeasedReports, e := server.usageRepository.GetClusterLeasedReports()
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		info.ResourceQuota = queue.ResourceQuota
+		info.ResourcesLeased = scheduling.QueueResourcesLeased(scheduling.FilterActiveClusters(usageReports), clusterLeasedReports)[req.Name]
+	}
+	return info, nil
+}
+
+func (server *SubmitServer) GetJobCluster(ctx context.Context, req *api.JobClusterRequest) (*api.JobClusterInfo, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	clusterId, leased, e := server.jobRepository.GetJobCluster(req.JobId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if clusterId == "" {
+		return nil, status.Errorf(codes.NotFound, "Job %s is not leased to any cluster", req.JobId)
+	}
+
+	pool := ""
+	schedulingInfos, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		log.Warnf("Failed to load pool of cluster %s: %v", clusterId, e)
+	} else if info, ok := schedulingInfos[clusterId]; ok {
+		pool = info.Pool
+	}
+
+	return &api.JobClusterInfo{
+		JobId:     req.JobId,
+		ClusterId: clusterId,
+		Pool:      pool,
+		Leased:    leased,
 	}, nil
 }
 
+func (server *SubmitServer) GetJobRequeueHistory(ctx context.Context, req *api.JobRequeueHistoryRequest) (*api.JobRequeueHistory, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	requeues, e := server.jobRepository.GetRequeueHistory(req.JobId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &api.JobRequeueHistory{JobId: req.JobId, Requeues: requeues}, nil
+}
+
+func (server *SubmitServer) GetJobCancelReason(ctx context.Context, req *api.JobCancelReasonRequest) (*api.JobCancelReason, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	reason, e := server.jobRepository.GetCancelReason(req.JobId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &api.JobCancelReason{JobId: req.JobId, Reason: reason}, nil
+}
+
+func (server *SubmitServer) GetJobIdByClientId(ctx context.Context, req *api.JobIdByClientIdRequest) (*api.JobIdByClientIdResponse, error) {
+	if req.Queue == "" || req.ClientId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify queue and client id")
+	}
+	if e := server.checkQueuePermission(ctx, req.Queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return nil, e
+	}
+	jobId, e := server.jobRepository.GetJobIdByClientId(req.Queue, req.ClientId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if jobId == "" {
+		return nil, status.Errorf(codes.NotFound, "No job with client id %s in queue %s", req.ClientId, req.Queue)
+	}
+	return &api.JobIdByClientIdResponse{JobId: jobId}, nil
+}
+
+// Capacity is available to every user, it does not reveal usage of other queues
+func (server *SubmitServer) GetPoolCapacity(ctx context.Context, req *api.PoolCapacityRequest) (*api.PoolCapacityResponse, error) {
+	schedulingInfos, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	activeSchedulingInfos := scheduling.FilterActiveClusterSchedulingInfoReports(schedulingInfos)
+	if req.Pool != "" {
+		poolSchedulingInfos := scheduling.GroupSchedulingInfoByPool(activeSchedulingInfos)[req.Pool]
+		if len(poolSchedulingInfos) == 0 {
+			return nil, status.Errorf(codes.NotFound, "No active cluster in pool %s", req.Pool)
+		}
+		activeSchedulingInfos = poolSchedulingInfos
+	}
+	return &api.PoolCapacityResponse{Pools: scheduling.CalculatePoolCapacity(activeSchedulingInfos)}, nil
+}
+
+// Like pool capacity available to every user, usage is reported per cluster and not per queue
+func (server *SubmitServer) GetClusterCapacityReport(ctx context.Context, req *api.ClusterCapacityReportRequest) (*api.ClusterCapacityReport, error) {
+	schedulingInfos, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	usageReports, e := server.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	activeSchedulingInfos := scheduling.FilterActiveClusterSchedulingInfoReports(schedulingInfos)
+	if req.Pool != "" {
+		poolSchedulingInfos := scheduling.GroupSchedulingInfoByPool(activeSchedulingInfos)[req.Pool]
+		if len(poolSchedulingInfos) == 0 {
+			return nil, status.Errorf(codes.NotFound, "No active cluster in pool %s", req.Pool)
+		}
+		activeSchedulingInfos = poolSchedulingInfos
+	}
+	pools := scheduling.CalculateClusterCapacityReport(activeSchedulingInfos, scheduling.FilterActiveClusters(usageReports), time.Now())
+	return &api.ClusterCapacityReport{Pools: pools}, nil
+}
+
+func (server *SubmitServer) GetQueueSchedulingStatus(ctx context.Context, req *api.QueueSchedulingStatusRequest) (*api.QueueSchedulingStatus, error) {
+	if req.Queue == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify queue")
+	}
+	if e := server.checkQueuePermission(ctx, req.Queue, false, permissions.SubmitJobs, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+
+	queue, e := server.queueRepository.GetQueue(req.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	activeQueues, e := server.jobRepository.FilterActiveQueues(queues)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	queuedJobs, e := server.jobRepository.PeekQueue(req.Queue, queueSchedulingStatusJobsToCheck)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	usageReports, e := server.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
+	clusterPriorities, e := server.usageRepository.GetClusterPriorities(scheduling.GetClusterReportIds(activeClusterReports))
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	clusterLeasedReports, e := server.usageRepository.GetClusterLeasedReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	schedulingInfos, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	result, e := scheduling.ExplainQueueScheduling(
+		server.schedulingConfig,
+		queue,
+		activeQueues,
+		queuedJobs,
+		time.Now(),
+		activeClusterReports,
+		clusterLeasedReports,
+		clusterPriorities,
+		scheduling.FilterActiveClusterSchedulingInfoReports(schedulingInfos))
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+	return result, nil
+}
+
 func (server *SubmitServer) CreateQueue(ctx context.Context, queue *api.Queue) (*types.Empty, error) {
 	if e := checkPermission(server.permissions, ctx, permissions.CreateQueue); e != nil {
 		return nil, e
 	}
 
+	queue.UpdateMask = nil
 	if len(queue.UserOwners) == 0 {
 		principal := authorization.GetPrincipal(ctx)
 		queue.UserOwners = []string{principal.GetName()}
 	}
 
-	if queue.PriorityFactor < 1.0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
+	if e := server.applyQueueDefaults(queue); e != nil {
+		return nil, e
+	}
+
+	if e := validateQueue(queue); e != nil {
+		return nil, e
+	}
+
+	// paused is only changed by PauseQueue and ResumeQueue, existing queues keep it and new queues are not paused
+	_, e := server.queueRepository.CreateOrUpdateQueue(queue.Name, func(existing *api.Queue, allQueues []*api.Queue) error {
+		if e := applyQueueUpdate(existing, queue); e != nil {
+			return e
+		}
+		return validateResourceFloors(existing, allQueues)
+	})
+	if _, isStatus := status.FromError(e); e != nil && isStatus {
+		return nil, e
 	}
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	return &types.Empty{}, nil
+}
 
-	e := server.queueRepository.CreateQueue(queue)
+// Changes only the fields of the update mask, without mask all settings are replaced. Paused is kept either way.
+func (server *SubmitServer) UpdateQueue(ctx context.Context, request *api.Queue) (*types.Empty, error) {
+	var updated *api.Queue
+	previous, e := server.queueRepository.UpdateQueue(request.Name, func(queue *api.Queue, allQueues []*api.Queue) error {
+		if !server.permissions.UserOwns(ctx, queue) {
+			if e := checkPermission(server.permissions, ctx, permissions.UpdateAnyQueue); e != nil {
+				return e
+			}
+		}
+		if e := applyQueueUpdate(queue, request); e != nil {
+			return status.Errorf(codes.InvalidArgument, e.Error())
+		}
+		if e := validateQueue(queue); e != nil {
+			return e
+		}
+		if e := validateResourceFloors(queue, allQueues); e != nil {
+			return e
+		}
+		updated = queue
+		return nil
+	})
+	if _, notFound := e.(*repository.ErrQueueNotFound); notFound {
+		return nil, status.Errorf(codes.NotFound, "Queue %s does not exist.", request.Name)
+	}
+	if _, isStatus := status.FromError(e); e != nil && isStatus {
+		return nil, e
+	}
 	if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
+
+	log.WithField("audit", "queue").Infof("Queue %s updated by %s from %s to %s",
+		request.Name, authorization.GetPrincipal(ctx).GetName(), previous.String(), updated.String())
 	return &types.Empty{}, nil
 }
 
+func (server *SubmitServer) PauseQueue(ctx context.Context, request *api.QueuePauseRequest) (*types.Empty, error) {
+	return server.setQueuePaused(ctx, request.Name, true)
+}
+
+func (server *SubmitServer) ResumeQueue(ctx context.Context, request *api.QueueResumeRequest) (*types.Empty, error) {
+	return server.setQueuePaused(ctx, request.Name, false)
+}
+
+// Only stops new leases, jobs stay queued and leased jobs keep running, so no events are reported
+func (server *SubmitServer) setQueuePaused(ctx context.Context, name string, paused bool) (*types.Empty, error) {
+	_, e := server.queueRepository.UpdateQueue(name, func(queue *api.Queue, allQueues []*api.Queue) error {
+		if !server.permissions.UserOwns(ctx, queue) {
+			if e := checkPermission(server.permissions, ctx, permissions.UpdateAnyQueue); e != nil {
+				return e
+			}
+		}
+		queue.Paused = paused
+		return nil
+	})
+	if _, notFound := e.(*repository.ErrQueueNotFound); notFound {
+		return nil, status.Errorf(codes.NotFound, "Queue %s does not exist.", name)
+	}
+	if _, isStatus := status.FromError(e); e != nil && isStatus {
+		return nil, e
+	}
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+
+	log.WithField("audit", "queue").Infof("Queue %s paused set to %t by %s", name, paused, authorization.GetPrincipal(ctx).GetName())
+	return &types.Empty{}, nil
+}
+
+// Fills settings the new queue does not set from its template, priority factor falls back to the server default
+func (server *SubmitServer) applyQueueDefaults(queue *api.Queue) error {
+	template := configuration.QueueTemplate{}
+	if queue.Template != "" {
+		found := false
+		for _, t := range server.queueManagementConfig.QueueTemplates {
+			if t.Name == queue.Template {
+				template, found = t, true
+				break
+			}
+		}
+		if !found {
+			return status.Errorf(codes.InvalidArgument, "Queue template %s does not exist.", queue.Template)
+		}
+	}
+
+	if queue.PriorityFactor == 0 {
+		queue.PriorityFactor = template.PriorityFactor
+	}
+	if queue.PriorityFactor == 0 {
+		queue.PriorityFactor = server.queueManagementConfig.DefaultPriorityFactor
+	}
+	if len(queue.ResourceLimits) == 0 && len(template.ResourceLimits) > 0 {
+		queue.ResourceLimits = make(map[string]float64, len(template.ResourceLimits))
+		for resource, limit := range template.ResourceLimits {
+			queue.ResourceLimits[resource] = limit
+		}
+	}
+	if len(queue.DefaultPodLabels) == 0 && len(template.DefaultPodLabels) > 0 {
+		queue.DefaultPodLabels = make(map[string]string, len(template.DefaultPodLabels))
+		for key, value := range template.DefaultPodLabels {
+			queue.DefaultPodLabels[key] = value
+		}
+	}
+	retention := template.EventRetention
+	if queue.EventRetention == nil && (retention.RetentionDuration != 0 || retention.MaxLength != 0) {
+		queue.EventRetention = &api.QueueEventRetention{RetentionDuration: retention.RetentionDuration, MaxLength: retention.MaxLength}
+	}
+	return nil
+}
+
+// allQueues are read in the transaction writing the queue, so queues changed concurrently can not exceed the capacity together
+func validateResourceFloors(queue *api.Queue, allQueues []*api.Queue) error {
+	if len(queue.ResourceFloor) == 0 {
+		return nil
+	}
+	if e := scheduling.ValidateResourceFloors(queue, allQueues); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue resource floor: %s", e.Error())
+	}
+	return nil
+}
+
+func validateQueue(queue *api.Queue) error {
+	if queue.PriorityFactor < 1.0 {
+		return status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
+	}
+
+	if queue.EventRetention != nil && (queue.EventRetention.RetentionDuration < 0 || queue.EventRetention.MaxLength < 0) {
+		return status.Errorf(codes.InvalidArgument, "Queue event retention can not be negative.")
+	}
+
+	if e := scheduling.ValidateSchedulingWindows(queue.SchedulingWindows); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue scheduling window: %s", e.Error())
+	}
+
+	for _, volumeType := range queue.AllowedVolumeTypes {
+		if !validation.IsVolumeType(volumeType) {
+			return status.Errorf(codes.InvalidArgument, "Unknown volume type %s, supported types are: %s", volumeType, strings.Join(validation.VolumeTypes(), ", "))
+		}
+	}
+
+	if e := validation.ValidatePodLabels(queue.DefaultPodLabels); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue default pod labels: %s", e.Error())
+	}
+
+	if e := validation.ValidateVolumeClaimTemplate(queue.VolumeClaimTemplate); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue volume claim template: %s", e.Error())
+	}
+
+	if e := scheduling.ValidateResourceQuota(queue); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue resource quota: %s", e.Error())
+	}
+	return nil
+}
+
 func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueDeleteRequest) (*types.Empty, error) {
 	if e := checkPermission(server.permissions, ctx, permissions.DeleteQueue); e != nil {
 		return nil, e
@@ -100,28 +488,194 @@ func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueD
 }
 
 func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
-	if e := server.checkQueuePermission(ctx, req.Queue, true, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+	// dry runs don't auto create queues
+	if e := server.checkQueuePermission(ctx, req.Queue, !req.DryRun, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return nil, e
+	}
+
+	if e := server.checkSubmitRate(req.Queue); e != nil {
+		return nil, e
+	}
+
+	return server.submitJobs(ctx, req)
+}
+
+func (server *SubmitServer) checkSubmitRate(queue string) error {
+	if !server.submitRateLimiter.TryAccept(queue) {
+		return status.Errorf(codes.ResourceExhausted,
+			"queue %s exceeded the rate of %.2f submit requests per second, retry later", queue, server.queueManagementConfig.SubmitRatePerSecond)
+	}
+	return nil
+}
+
+// Permission is checked once per stream, on the first request, the rate limit for every request of the stream.
+// Requests are submitted one after another, so jobs keep the order they were sent in and client id deduplication
+// applies across requests. When a request fails, jobs of earlier requests stay submitted.
+func (server *SubmitServer) SubmitJobsStream(stream api.Submit_SubmitJobsStreamServer) error {
+	ctx := stream.Context()
+	var first *api.JobSubmitRequest
+	itemIndex := 0
+	for {
+		req, e := stream.Recv()
+		if e == io.EOF {
+			return nil
+		}
+		if e != nil {
+			return e
+		}
+
+		if first == nil {
+			if e := server.checkQueuePermission(ctx, req.Queue, !req.DryRun, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+				return e
+			}
+			first = req
+		}
+		if e := applyStreamRequestDefaults(first, req); e != nil {
+			return streamSubmitError(itemIndex, e)
+		}
+		if e := server.checkSubmitRate(req.Queue); e != nil {
+			return streamSubmitError(itemIndex, e)
+		}
+		if len(req.JobRequestItems) == 0 {
+			continue
+		}
+
+		response, e := server.submitJobs(ctx, req)
+		if response != nil {
+			if e := stream.Send(&api.JobSubmitStreamResponse{
+				FirstItemIndex:   int32(itemIndex),
+				JobResponseItems: response.JobResponseItems,
+				DryRun:           response.DryRun,
+			}); e != nil {
+				return e
+			}
+			itemIndex += len(response.JobResponseItems)
+		}
+		if e != nil {
+			return streamSubmitError(itemIndex, e)
+		}
+	}
+}
+
+// Requests after the first one can leave queue and job set empty, dry run and rejecting when the queue is paused
+// are taken from the first request.
+func applyStreamRequestDefaults(first *api.JobSubmitRequest, req *api.JobSubmitRequest) error {
+	if req.Queue == "" {
+		req.Queue = first.Queue
+	}
+	if req.JobSetId == "" {
+		req.JobSetId = first.JobSetId
+	}
+	if req.Queue != first.Queue || req.JobSetId != first.JobSetId {
+		return status.Errorf(codes.InvalidArgument, "all requests of a submit stream must be for queue %s and job set %s", first.Queue, first.JobSetId)
+	}
+	req.DryRun = first.DryRun
+	req.RejectIfQueuePaused = first.RejectIfQueuePaused
+	return nil
+}
+
+// Keeps the code and details of the original error
+func streamSubmitError(failedItemIndex int, e error) error {
+	original := status.Convert(e)
+	st := status.New(original.Code(), fmt.Sprintf("submission stopped at job %d: %s", failedItemIndex, original.Message()))
+	withDetails, detailsError := st.WithDetails(&api.JobSubmitStreamFailure{FailedItemIndex: int32(failedItemIndex)})
+	if detailsError != nil {
+		log.Errorf("Failed to attach submit stream failure details: %v", detailsError)
+		return st.Err()
+	}
+	statusProto := withDetails.Proto()
+	statusProto.Details = append(statusProto.Details, original.Proto().Details...)
+	return status.ErrorProto(statusProto)
+}
+
+func (server *SubmitServer) submitJobs(ctx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	if len(req.JobRequestItems) == 0 {
+		if server.queueManagementConfig.AllowEmptySubmissions {
+			return &api.JobSubmitResponse{JobResponseItems: []*api.JobSubmitResponseItem{}, DryRun: req.DryRun}, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "submit request for job set %s contains no jobs", req.JobSetId)
+	}
+
+	if e := server.resolvePodSpecTemplates(req); e != nil {
+		return nil, e
+	}
+
+	if e := validatePodSpecsPresent(req); e != nil {
+		return nil, e
+	}
+
+	queue, e := server.queueRepository.GetQueue(req.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load queue: %s", e.Error())
+	}
+
+	if queue.Paused && req.RejectIfQueuePaused {
+		return nil, status.Errorf(codes.FailedPrecondition, "Queue %s is paused", queue.Name)
+	}
+
+	if e := server.validatePodSpecSize(req, queue); e != nil {
+		return nil, e
+	}
+
+	if e := validateContainerCommands(req, queue); e != nil {
+		return nil, e
+	}
+
+	if e := validateVolumes(req, queue); e != nil {
+		return nil, e
+	}
+
+	if e := server.validateEqualRequestAndLimit(req); e != nil {
 		return nil, e
 	}
 
+	server.roundResourceRequests(req)
+
+	server.applyDefaultLimits(req)
+
+	if e := server.validateProtectedJobs(req); e != nil {
+		return nil, e
+	}
+
+	if e := server.validateGangSizes(req); e != nil {
+		return nil, e
+	}
+
+	if e := server.applyConstraintAnnotations(req); e != nil {
+		return nil, e
+	}
+
+	applyDefaultPodLabels(req, queue)
+
 	principal := authorization.GetPrincipal(ctx)
 
 	jobs, e := server.jobRepository.CreateJobs(req, principal)
 	if e != nil {
 		return nil, status.Errorf(codes.InvalidArgument, e.Error())
 	}
+	for _, job := range jobs {
+		job.VolumeClaimTemplate = queue.VolumeClaimTemplate
+	}
 
-	e = server.validateJobsCanBeScheduled(jobs)
+	feasibility, e := server.validateJobsCanBeScheduled(jobs)
 	if e != nil {
 		return nil, status.Errorf(codes.InvalidArgument, e.Error())
 	}
+	if feasibility != nil {
+		return nil, schedulingFeasibilityError(feasibility)
+	}
+
+	if req.DryRun {
+		return dryRunResponse(jobs), nil
+	}
 
 	e = reportSubmitted(server.eventStore, jobs)
 	if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
 
-	submissionResults, e := server.jobRepository.AddJobs(jobs)
+	deduplicateContent := util.ContainsString(server.queueManagementConfig.ContentDeduplicationQueues, req.Queue)
+	submissionResults, e := server.jobRepository.AddJobs(jobs, deduplicateContent)
 	if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
@@ -160,29 +714,301 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 	return result, nil
 }
 
-func (server *SubmitServer) validateJobsCanBeScheduled(jobs []*api.Job) error {
+func dryRunResponse(jobs []*api.Job) *api.JobSubmitResponse {
+	result := &api.JobSubmitResponse{JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(jobs)), DryRun: true}
+	for _, job := range jobs {
+		result.JobResponseItems = append(result.JobResponseItems, &api.JobSubmitResponseItem{JobId: job.Id})
+	}
+	return result
+}
+
+func applyDefaultPodLabels(req *api.JobSubmitRequest, queue *api.Queue) {
+	if len(queue.DefaultPodLabels) == 0 {
+		return
+	}
+	for _, item := range req.JobRequestItems {
+		labels := make(map[string]string, len(queue.DefaultPodLabels)+len(item.Labels))
+		for key, value := range queue.DefaultPodLabels {
+			labels[key] = value
+		}
+		for key, value := range item.Labels {
+			labels[key] = value
+		}
+		item.Labels = labels
+	}
+}
+
+// Validations below expect every job to have pod specs
+func validatePodSpecsPresent(req *api.JobSubmitRequest) error {
+	for i, item := range req.JobRequestItems {
+		if item.PodSpec == nil && len(item.PodSpecs) == 0 {
+			return status.Errorf(codes.InvalidArgument, "job with index %d has no pod spec", i)
+		}
+		for j, podSpec := range item.PodSpecs {
+			if podSpec == nil {
+				return status.Errorf(codes.InvalidArgument, "job with index %d has empty pod spec at index %d", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func (server *SubmitServer) validatePodSpecSize(req *api.JobSubmitRequest, queue *api.Queue) error {
+	maxSize := server.queueManagementConfig.DefaultMaxPodSpecSizeBytes
+	if queue.MaxPodSpecSizeBytes > 0 {
+		maxSize = queue.MaxPodSpecSizeBytes
+	}
+	if maxSize == 0 {
+		return nil
+	}
+
+	for i, item := range req.JobRequestItems {
+		for j, podSpec := range item.GetAllPodSpecs() {
+			if size := podSpec.Size(); size > int(maxSize) {
+				return status.Errorf(codes.InvalidArgument,
+					"pod spec of job with index %d, pod: %d is %d bytes, which exceeds maximum pod spec size of %d bytes", i, j, size, maxSize)
+			}
+		}
+	}
+	return nil
+}
+
+func validateContainerCommands(req *api.JobSubmitRequest, queue *api.Queue) error {
+	if !queue.RequireContainerCommand {
+		return nil
+	}
+
+	for i, item := range req.JobRequestItems {
+		for j, podSpec := range item.GetAllPodSpecs() {
+			for _, container := range podSpec.Containers {
+				if len(container.Command) == 0 && len(container.Args) == 0 {
+					return status.Errorf(codes.InvalidArgument,
+						"container %s of job with index %d, pod: %d has no command or args, queue %s does not allow relying on the image entrypoint", container.Name, i, j, queue.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateVolumes(req *api.JobSubmitRequest, queue *api.Queue) error {
+	if len(queue.AllowedVolumeTypes) == 0 && !queue.RequireEmptyDirSizeLimit {
+		return nil
+	}
+	allowed := util.StringListToSet(queue.AllowedVolumeTypes)
+
+	for i, item := range req.JobRequestItems {
+		for j, podSpec := range item.GetAllPodSpecs() {
+			for _, volume := range podSpec.Volumes {
+				volumeType := validation.VolumeType(volume.VolumeSource)
+				if len(allowed) > 0 && !allowed[volumeType] {
+					return status.Errorf(codes.InvalidArgument,
+						"volume %s of job with index %d, pod: %d is of type %s, queue %s only allows volume types: %s",
+						volume.Name, i, j, volumeType, queue.Name, strings.Join(queue.AllowedVolumeTypes, ", "))
+				}
+				if queue.RequireEmptyDirSizeLimit && volume.EmptyDir != nil && volume.EmptyDir.SizeLimit == nil {
+					return status.Errorf(codes.InvalidArgument,
+						"emptyDir volume %s of job with index %d, pod: %d has no size limit, queue %s requires emptyDir volumes to set sizeLimit",
+						volume.Name, i, j, queue.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (server *SubmitServer) validateEqualRequestAndLimit(req *api.JobSubmitRequest) error {
+	for i, item := range req.JobRequestItems {
+		for j, podSpec := range item.GetAllPodSpecs() {
+			for _, container := range podSpec.Containers {
+				for _, resourceName := range server.queueManagementConfig.ResourcesRequiringEqualRequestAndLimit {
+					request, hasRequest := container.Resources.Requests[v1.ResourceName(resourceName)]
+					limit, hasLimit := container.Resources.Limits[v1.ResourceName(resourceName)]
+					if hasRequest && !hasLimit {
+						return status.Errorf(codes.InvalidArgument,
+							"container %s of job with index %d, pod: %d has %s request but no limit, both have to be set to the same value", container.Name, i, j, resourceName)
+					}
+					if hasLimit && !hasRequest {
+						return status.Errorf(codes.InvalidArgument,
+							"container %s of job with index %d, pod: %d has %s limit but no request, both have to be set to the same value", container.Name, i, j, resourceName)
+					}
+					if hasRequest && request.Cmp(limit) != 0 {
+						return status.Errorf(codes.InvalidArgument,
+							"container %s of job with index %d, pod: %d has %s request %s not equal to limit %s", container.Name, i, j, resourceName, request.String(), limit.String())
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Scheduling uses requests only, so soft limits can be added without changing where jobs fit
+func (server *SubmitServer) applyDefaultLimits(req *api.JobSubmitRequest) {
+	multipliers := server.queueManagementConfig.DefaultLimitMultipliers
+	if len(multipliers) == 0 {
+		return
+	}
+	for _, item := range req.JobRequestItems {
+		for _, podSpec := range item.GetAllPodSpecs() {
+			for i := range podSpec.InitContainers {
+				applyDefaultLimits(&podSpec.InitContainers[i].Resources, multipliers)
+			}
+			for i := range podSpec.Containers {
+				applyDefaultLimits(&podSpec.Containers[i].Resources, multipliers)
+			}
+		}
+	}
+}
+
+func applyDefaultLimits(resources *v1.ResourceRequirements, multipliers map[string]float64) {
+	for resourceName, multiplier := range multipliers {
+		request, hasRequest := resources.Requests[v1.ResourceName(resourceName)]
+		if _, hasLimit := resources.Limits[v1.ResourceName(resourceName)]; !hasRequest || hasLimit {
+			continue
+		}
+		if resources.Limits == nil {
+			resources.Limits = v1.ResourceList{}
+		}
+		resources.Limits[v1.ResourceName(resourceName)] = multiplyQuantity(v1.ResourceName(resourceName), request, multiplier)
+	}
+}
+
+// Rounds up to millicores for cpu and to whole units for other resources, multipliers below 1 are treated as 1
+func multiplyQuantity(resourceName v1.ResourceName, quantity resource.Quantity, multiplier float64) resource.Quantity {
+	if multiplier <= 1 {
+		return quantity.DeepCopy()
+	}
+	if resourceName == v1.ResourceCPU {
+		return *resource.NewMilliQuantity(int64(math.Ceil(float64(quantity.MilliValue())*multiplier)), quantity.Format)
+	}
+	return *resource.NewQuantity(int64(math.Ceil(float64(quantity.MilliValue())*multiplier/1000)), quantity.Format)
+}
+
+// Jobs are checked and stored with the rounded requests, so they are scheduled with the same requests their pods get
+func (server *SubmitServer) roundResourceRequests(req *api.JobSubmitRequest) {
+	granularity := server.queueManagementConfig.ResourceRequestGranularity
+	if len(granularity) == 0 {
+		return
+	}
+	for _, item := range req.JobRequestItems {
+		for _, podSpec := range item.GetAllPodSpecs() {
+			for i := range podSpec.InitContainers {
+				roundResourceRequests(&podSpec.InitContainers[i].Resources, granularity)
+			}
+			for i := range podSpec.Containers {
+				roundResourceRequests(&podSpec.Containers[i].Resources, granularity)
+			}
+		}
+	}
+}
+
+// Limits below the rounded request are raised to it, so rounding never makes the container invalid
+func roundResourceRequests(resources *v1.ResourceRequirements, granularity common.ComputeResources) {
+	for resourceName, step := range granularity {
+		request, hasRequest := resources.Requests[v1.ResourceName(resourceName)]
+		if !hasRequest {
+			continue
+		}
+		rounded := roundUpQuantity(request, step)
+		resources.Requests[v1.ResourceName(resourceName)] = rounded
+		if limit, hasLimit := resources.Limits[v1.ResourceName(resourceName)]; hasLimit && limit.Cmp(rounded) < 0 {
+			resources.Limits[v1.ResourceName(resourceName)] = rounded.DeepCopy()
+		}
+	}
+}
+
+func roundUpQuantity(quantity resource.Quantity, step resource.Quantity) resource.Quantity {
+	stepMilli := step.MilliValue()
+	if stepMilli <= 0 {
+		return quantity.DeepCopy()
+	}
+	milli := quantity.MilliValue()
+	rounded := resource.NewMilliQuantity((milli+stepMilli-1)/stepMilli*stepMilli, quantity.Format)
+	// quantities already rounded keep the units they were given in
+	if rounded.Cmp(quantity) == 0 {
+		return quantity.DeepCopy()
+	}
+	return *rounded
+}
+
+// Gangs are leased from the jobs considered at once, so bigger gangs would never be leased
+func (server *SubmitServer) validateGangSizes(req *api.JobSubmitRequest) error {
+	batchSize := server.schedulingConfig.QueueLeaseBatchSize
+	if batchSize == 0 {
+		return nil
+	}
+	for i, item := range req.JobRequestItems {
+		if item.GangSize > uint32(batchSize) {
+			return status.Errorf(codes.InvalidArgument,
+				"job with index %d has gang size %d, gangs can have at most %d members", i, item.GangSize, batchSize)
+		}
+	}
+	return nil
+}
+
+func (server *SubmitServer) validateProtectedJobs(req *api.JobSubmitRequest) error {
+	for _, queue := range server.queueManagementConfig.ProtectedJobQueues {
+		if queue == req.Queue {
+			return nil
+		}
+	}
+	for i, item := range req.JobRequestItems {
+		if _, protected := item.Annotations[common.ProtectedJobAnnotation]; protected {
+			return status.Errorf(codes.PermissionDenied,
+				"job with index %d has annotation %s, queue %s is not allowed to submit protected jobs", i, common.ProtectedJobAnnotation, req.Queue)
+		}
+	}
+	return nil
+}
+
+func (server *SubmitServer) applyConstraintAnnotations(req *api.JobSubmitRequest) error {
+	for i, item := range req.JobRequestItems {
+		e := scheduling.ApplyConstraintAnnotations(item.Annotations, item.GetAllPodSpecs(), server.queueManagementConfig.ConstraintAnnotations)
+		if e != nil {
+			return status.Errorf(codes.InvalidArgument, "job with index %d: %s", i, e.Error())
+		}
+	}
+	return nil
+}
+
+func (server *SubmitServer) validateJobsCanBeScheduled(jobs []*api.Job) (*api.JobSchedulingFeasibility, error) {
 	allClusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
 	if e != nil {
-		return e
+		return nil, e
 	}
 
 	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
 	for i, job := range jobs {
 		if !scheduling.MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfo) {
-			return fmt.Errorf("job with index %d is not schedulable on any cluster", i)
+			return scheduling.ExplainSchedulingFeasibility(i, job, activeClusterSchedulingInfo), nil
 		}
 	}
 
-	return nil
+	return nil, nil
+}
+
+func schedulingFeasibilityError(feasibility *api.JobSchedulingFeasibility) error {
+	st := status.New(codes.InvalidArgument, scheduling.FormatSchedulingFeasibility(feasibility))
+	withDetails, e := st.WithDetails(feasibility)
+	if e != nil {
+		log.Errorf("Failed to attach scheduling feasibility details: %v", e)
+		return st.Err()
+	}
+	return withDetails.Err()
 }
 
 func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCancelRequest) (*api.CancellationResult, error) {
+	if e := validateCancelReason(request.Reason); e != nil {
+		return nil, e
+	}
+
 	if request.JobId != "" {
 		jobs, e := server.jobRepository.GetExistingJobsByIds([]string{request.JobId})
 		if e != nil {
 			return nil, status.Errorf(codes.Internal, e.Error())
 		}
-		return server.cancelJobs(ctx, jobs[0].Queue, jobs)
+		return server.cancelJobs(ctx, jobs[0].Queue, jobs, request.Reason)
 	}
 
 	if request.JobSetId != "" && request.Queue != "" {
@@ -194,39 +1020,252 @@ func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCanc
 		if e != nil {
 			return nil, status.Errorf(codes.Internal, e.Error())
 		}
-		return server.cancelJobs(ctx, request.Queue, jobs)
+		return server.cancelJobs(ctx, request.Queue, jobs, request.Reason)
 	}
 	return nil, status.Errorf(codes.InvalidArgument, "Specify job id or queue with job set id")
 }
 
-func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobs []*api.Job) (*api.CancellationResult, error) {
-	if e := server.checkQueuePermission(ctx, queue, false, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+func (server *SubmitServer) CancelJobsSubmittedBefore(ctx context.Context, request *api.JobCancelSubmittedBeforeRequest) (*api.CancellationCount, error) {
+	if request.Queue == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Queue is not specified")
+	}
+	if request.SubmittedBefore.IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "Submitted before time is not specified")
+	}
+	if e := validateCancelReason(request.Reason); e != nil {
+		return nil, e
+	}
+
+	jobs, e := server.jobRepository.GetActiveJobsSubmittedBefore(request.Queue, request.JobSetId, request.SubmittedBefore)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+
+	result, e := server.cancelJobs(ctx, request.Queue, jobs, request.Reason)
+	if e != nil {
+		return nil, e
+	}
+	return &api.CancellationCount{CancelledCount: int32(len(result.CancelledIds))}, nil
+}
+
+func (server *SubmitServer) CancelJobsInQueue(ctx context.Context, request *api.JobCancelQueueRequest) (*api.QueueCancellationResult, error) {
+	if request.Queue == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Queue is not specified")
+	}
+	if e := validateCancelReason(request.Reason); e != nil {
+		return nil, e
+	}
+	if e := server.checkQueuePermission(ctx, request.Queue, false, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+		return nil, e
+	}
+
+	ids, e := server.jobRepository.GetQueueActiveJobIds(request.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	jobs, e := server.jobRepository.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+
+	cancelledIds, failedCount, e := server.cancelPermittedJobs(jobs, request.Reason)
+	if e != nil {
 		return nil, e
 	}
+	// jobs missing from the listing or not deleted have finished in the meantime
+	return &api.QueueCancellationResult{
+		CancelledCount:       int32(len(cancelledIds)),
+		AlreadyFinishedCount: int32(len(ids) - len(cancelledIds) - failedCount),
+	}, nil
+}
+
+// Only queued jobs are reprioritized, jobs which are already leased keep running with their original priority
+func (server *SubmitServer) ReprioritizeJobs(ctx context.Context, request *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
+	jobIds := request.JobIds
+	if len(jobIds) == 0 {
+		if request.JobSetId == "" || request.Queue == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Specify job ids or queue with job set id")
+		}
+		ids, e := server.jobRepository.GetActiveJobIds(request.Queue, request.JobSetId)
+		if e != nil {
+			return nil, status.Errorf(codes.Aborted, e.Error())
+		}
+		jobIds = ids
+	}
+
+	jobs, e := server.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
 
-	e := reportJobsCancelling(server.eventStore, jobs)
+	checkedQueues := map[string]bool{}
+	for _, job := range jobs {
+		if checkedQueues[job.Queue] {
+			continue
+		}
+		if e := server.checkQueuePermission(ctx, job.Queue, false, permissions.ReprioritizeJobs, permissions.ReprioritizeAnyJobs); e != nil {
+			return nil, e
+		}
+		checkedQueues[job.Queue] = true
+	}
+
+	reprioritized, e := server.jobRepository.UpdateQueuedJobsPriority(jobs, request.NewPriority)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+	e = reportJobsReprioritized(server.eventStore, reprioritized, request.NewPriority)
 	if e != nil {
 		return nil, status.Errorf(codes.Unknown, e.Error())
 	}
 
+	reprioritizedIds := util.StringListToSet(jobIdsOf(reprioritized))
+	existingIds := util.StringListToSet(jobIdsOf(jobs))
+	response := &api.JobReprioritizeResponse{ReprioritizedIds: []string{}, NotReprioritized: map[string]string{}}
+	for _, id := range jobIds {
+		if reprioritizedIds[id] {
+			response.ReprioritizedIds = append(response.ReprioritizedIds, id)
+		} else if existingIds[id] {
+			response.NotReprioritized[id] = "job is already leased, priority of leased and running jobs can not be changed"
+		} else {
+			response.NotReprioritized[id] = "job not found, it might have already finished"
+		}
+	}
+	return response, nil
+}
+
+func jobIdsOf(jobs []*api.Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func validateCancelReason(reason string) error {
+	if len(reason) > maxCancelReasonLength {
+		return status.Errorf(codes.InvalidArgument, "Cancel reason is %d characters long, which exceeds the limit of %d", len(reason), maxCancelReasonLength)
+	}
+	return nil
+}
+
+func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobs []*api.Job, reason string) (*api.CancellationResult, error) {
+	if e := server.checkQueuePermission(ctx, queue, false, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+		return nil, e
+	}
+
+	cancelledIds, _, e := server.cancelPermittedJobs(jobs, reason)
+	if e != nil {
+		return nil, e
+	}
+	return &api.CancellationResult{cancelledIds}, nil
+}
+
+// Cancels queued and leased jobs past their deadline, pods of leased jobs are deleted by executors once renewing
+// the lease of the cancelled jobs fails.
+// Deadlines are removed before the jobs are reported, so each job is reported once, also with several server instances.
+func (server *SubmitServer) CancelJobsPastDeadline() {
+	jobs, e := server.jobRepository.GetJobsPastDeadline(time.Now())
+	if e != nil {
+		log.Errorf("Failed to load jobs past their deadline: %s", e)
+		return
+	}
+	if len(jobs) == 0 {
+		return
+	}
+	jobs, e = server.jobRepository.RemoveDeadlines(jobs)
+	if e != nil {
+		log.Errorf("Failed to remove deadlines of jobs past their deadline: %s", e)
+		return
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	if e := reportJobsDeadlineExceeded(server.eventStore, jobs); e != nil {
+		log.Errorf("Failed to report jobs past their deadline: %s", e)
+		server.restoreDeadlines(jobs)
+		return
+	}
+	reasons, jobsByReason := groupJobsByDeadlineReason(jobs)
+	for _, reason := range reasons {
+		cancelledIds, _, e := server.cancelPermittedJobs(jobsByReason[reason], reason)
+		if e != nil {
+			log.Errorf("Failed to cancel %d jobs past their deadline: %s", len(jobsByReason[reason]), e)
+		}
+		server.restoreDeadlines(jobsNotIn(jobsByReason[reason], cancelledIds))
+	}
+}
+
+// Jobs which were not cancelled are handled again on the next check
+func (server *SubmitServer) restoreDeadlines(jobs []*api.Job) {
+	if len(jobs) == 0 {
+		return
+	}
+	if e := server.jobRepository.RestoreDeadlines(jobs); e != nil {
+		log.Errorf("Failed to restore deadlines of %d jobs which were not cancelled: %s", len(jobs), e)
+	}
+}
+
+func jobsNotIn(jobs []*api.Job, jobIds []string) []*api.Job {
+	excluded := util.StringListToSet(jobIds)
+	remaining := []*api.Job{}
+	for _, job := range jobs {
+		if !excluded[job.Id] {
+			remaining = append(remaining, job)
+		}
+	}
+	return remaining
+}
+
+// Jobs with the same deadline are cancelled together, reasons are in order of the first job with each deadline
+func groupJobsByDeadlineReason(jobs []*api.Job) ([]string, map[string][]*api.Job) {
+	reasons := []string{}
+	jobsByReason := map[string][]*api.Job{}
+	for _, job := range jobs {
+		reason := fmt.Sprintf("job not finished by its deadline %s", job.Deadline.Format(time.RFC3339))
+		if _, ok := jobsByReason[reason]; !ok {
+			reasons = append(reasons, reason)
+		}
+		jobsByReason[reason] = append(jobsByReason[reason], job)
+	}
+	return reasons, jobsByReason
+}
+
+// Returns ids of cancelled jobs and number of jobs which failed to be cancelled,
+// the remaining jobs were already finished and are not deleted
+func (server *SubmitServer) cancelPermittedJobs(jobs []*api.Job, reason string) ([]string, int, error) {
+	e := reportJobsCancelling(server.eventStore, jobs, reason)
+	if e != nil {
+		return nil, 0, status.Errorf(codes.Unknown, e.Error())
+	}
+
 	deletionResult := server.jobRepository.DeleteJobs(jobs)
 	cancelled := []*api.Job{}
 	cancelledIds := []string{}
+	failedCount := 0
 	for job, err := range deletionResult {
 		if err != nil {
 			log.Errorf("Error when cancelling job id %s: %s", job.Id, err.Error())
+			failedCount++
 		} else {
 			cancelled = append(cancelled, job)
 			cancelledIds = append(cancelledIds, job.Id)
 		}
 	}
 
-	e = reportJobsCancelled(server.eventStore, cancelled)
+	if reason != "" && len(cancelledIds) > 0 {
+		// jobs are already cancelled, the reason is still part of the cancelled events
+		if e := server.jobRepository.SetCancelReason(cancelledIds, reason); e != nil {
+			log.Errorf("Failed to store cancel reason of jobs %s: %s", strings.Join(cancelledIds, ","), e)
+		}
+	}
+
+	e = reportJobsCancelled(server.eventStore, cancelled, reason)
 	if e != nil {
-		return nil, status.Errorf(codes.Unknown, e.Error())
+		return nil, 0, status.Errorf(codes.Unknown, e.Error())
 	}
 
-	return &api.CancellationResult{cancelledIds}, nil
+	return cancelledIds, failedCount, nil
 }
 
 func (server *SubmitServer) checkQueuePermission(
@@ -242,9 +1281,9 @@ func (server *SubmitServer) checkQueuePermission(
 			server.queueManagementConfig.AutoCreateQueues &&
 			server.permissions.UserHasPermission(ctx, permissions.SubmitAnyJobs) {
 
-			queue = &api.Queue{
-				Name:           queueName,
-				PriorityFactor: server.queueManagementConfig.DefaultPriorityFactor,
+			queue = &api.Queue{Name: queueName}
+			if e := server.applyQueueDefaults(queue); e != nil {
+				return e
 			}
 			e := server.queueRepository.CreateQueue(queue)
 			if e != nil {
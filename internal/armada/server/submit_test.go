@@ -3,15 +3,24 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/clock"
 
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common"
@@ -53,95 +62,1562 @@ func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled_ReturnsFeasibilityDetails(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].NodeSelector = map[string]string{"gpu": "true"}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "node selector gpu=true does not match")
+		assert.Len(t, st.Proto().Details, 1)
+
+		feasibility := &api.JobSchedulingFeasibility{}
+		assert.NoError(t, feasibility.Unmarshal(st.Proto().Details[0].Value))
+		assert.Len(t, feasibility.Clusters, 1)
+		assert.Equal(t, []string{"node selector gpu=true does not match"}, feasibility.Clusters[0].NodeTypes[0].Reasons)
+		assert.Len(t, feasibility.LargestNodeTypes, 1)
+	})
+}
+
+func TestSubmitServer_SubmitJob_WhenInitContainerCannotBeScheduled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+		initRequest := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("200Gi")}
+		jobRequest.JobRequestItems[0].PodSpecs[0].InitContainers = []v1.Container{{
+			Name:      "download",
+			Image:     "index.docker.io/library/ubuntu:latest",
+			Resources: v1.ResourceRequirements{Limits: initRequest, Requests: initRequest},
+		}}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "insufficient memory")
+	})
+}
+
+func TestSubmitServer_SubmitJob_StoresGang(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 2)
+		for _, item := range jobRequest.JobRequestItems {
+			item.GangId = "gang"
+			item.GangSize = 2
+		}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		assert.Equal(t, "gang", jobs[0].GangId)
+		assert.Equal(t, uint32(2), jobs[0].GangSize)
+	})
+}
+
+func TestSubmitServer_SubmitJob_ResolvesPodSpecTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		templateName := util.NewULID()
+		template := createJobRequestItems(1)[0].PodSpecs[0]
+		_, err := s.CreatePodSpecTemplate(context.Background(), &api.PodSpecTemplate{Name: templateName, PodSpec: template})
+		assert.NoError(t, err)
+
+		request := &api.JobSubmitRequest{Queue: "test", JobSetId: util.NewULID(), JobRequestItems: []*api.JobSubmitRequestItem{{
+			PodSpecTemplate: templateName,
+			ContainerOverrides: []*api.ContainerOverride{{
+				Image: "busybox:latest",
+				Resources: v1.ResourceRequirements{
+					Limits:   v1.ResourceList{"cpu": resource.MustParse("2")},
+					Requests: v1.ResourceList{"cpu": resource.MustParse("2")},
+				},
+			}},
+		}}}
+		response, err := s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		container := jobs[0].PodSpecs[0].Containers[0]
+		assert.Equal(t, "busybox:latest", container.Image)
+		assert.Equal(t, template.Containers[0].Args, container.Args)
+		assert.Equal(t, resource.MustParse("2"), container.Resources.Requests["cpu"])
+		assert.Equal(t, resource.MustParse("512Mi"), container.Resources.Requests["memory"])
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsInvalidPodSpecTemplateReferences(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		templateName := util.NewULID()
+		_, err := s.CreatePodSpecTemplate(context.Background(), &api.PodSpecTemplate{Name: templateName, PodSpec: createJobRequestItems(1)[0].PodSpecs[0]})
+		assert.NoError(t, err)
+
+		missingTemplate := createJobRequest(util.NewULID(), 0)
+		missingTemplate.JobRequestItems = []*api.JobSubmitRequestItem{{PodSpecTemplate: util.NewULID()}}
+
+		templateAndPodSpec := createJobRequest(util.NewULID(), 1)
+		templateAndPodSpec.JobRequestItems[0].PodSpecTemplate = templateName
+
+		unknownContainer := createJobRequest(util.NewULID(), 0)
+		unknownContainer.JobRequestItems = []*api.JobSubmitRequestItem{{
+			PodSpecTemplate:    templateName,
+			ContainerOverrides: []*api.ContainerOverride{{Name: "other", Image: "busybox:latest"}},
+		}}
+
+		// overridden resources have to fit on a cluster like resources of any other job
+		tooBig := createJobRequest(util.NewULID(), 0)
+		tooBig.JobRequestItems = []*api.JobSubmitRequestItem{{
+			PodSpecTemplate: templateName,
+			ContainerOverrides: []*api.ContainerOverride{{Resources: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("1000")},
+				Requests: v1.ResourceList{"cpu": resource.MustParse("1000")},
+			}}},
+		}}
+
+		for _, request := range []*api.JobSubmitRequest{missingTemplate, templateAndPodSpec, unknownContainer, tooBig} {
+			_, err = s.SubmitJobs(context.Background(), request)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
+func TestSubmitServer_PodSpecTemplates(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		templateName := util.NewULID()
+		_, err := s.CreatePodSpecTemplate(context.Background(), &api.PodSpecTemplate{Name: templateName, PodSpec: &v1.PodSpec{}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		podSpec := createJobRequestItems(1)[0].PodSpecs[0]
+		_, err = s.CreatePodSpecTemplate(context.Background(), &api.PodSpecTemplate{Name: templateName, PodSpec: podSpec})
+		assert.NoError(t, err)
+
+		template, err := s.GetPodSpecTemplate(context.Background(), &api.PodSpecTemplateRequest{Name: templateName})
+		assert.NoError(t, err)
+		assert.Equal(t, podSpec.Containers[0].Image, template.PodSpec.Containers[0].Image)
+
+		_, err = s.DeletePodSpecTemplate(context.Background(), &api.PodSpecTemplateRequest{Name: templateName})
+		assert.NoError(t, err)
+		_, err = s.GetPodSpecTemplate(context.Background(), &api.PodSpecTemplateRequest{Name: templateName})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		s.permissions = &denyingPermissionChecker{}
+		_, err = s.CreatePodSpecTemplate(context.Background(), &api.PodSpecTemplate{Name: templateName, PodSpec: podSpec})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsInvalidGangs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		incomplete := createJobRequest(util.NewULID(), 2)
+		for _, item := range incomplete.JobRequestItems {
+			item.GangId = "gang"
+			item.GangSize = 3
+		}
+		_, err := s.SubmitJobs(context.Background(), incomplete)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "gang gang has 2 members in the request")
+
+		withoutSize := createJobRequest(util.NewULID(), 1)
+		withoutSize.JobRequestItems[0].GangId = "gang"
+		_, err = s.SubmitJobs(context.Background(), withoutSize)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		s.schedulingConfig.QueueLeaseBatchSize = 2
+		tooBig := createJobRequest(util.NewULID(), 3)
+		for _, item := range tooBig.JobRequestItems {
+			item.GangId = "gang"
+			item.GangSize = 3
+		}
+		_, err = s.SubmitJobs(context.Background(), tooBig)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "gangs can have at most")
+	})
+}
+
+func TestSubmitServer_SubmitJob_DryRunDoesNotStoreJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 2)
+		jobRequest.DryRun = true
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+		assert.True(t, response.DryRun)
+		assert.Len(t, response.JobResponseItems, 2)
+		assert.NotEmpty(t, response.JobResponseItems[0].JobId)
+
+		ids, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+
+		messages, _, err := events.ReadEvents("test", jobSetId, "", 100, time.Millisecond, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
+
+func TestSubmitServer_SubmitJob_DryRunWhenPodCannotBeScheduled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].NodeSelector = map[string]string{"gpu": "true"}
+		jobRequest.DryRun = true
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_DryRunDoesNotCreateQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.AutoCreateQueues = true
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.Queue = util.NewULID()
+		jobRequest.DryRun = true
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.queueRepository.GetQueue(jobRequest.Queue)
+		assert.Equal(t, redis.Nil, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsOversizedPodSpec(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.DefaultMaxPodSpecSizeBytes = 100
+		jobRequest := createJobRequest(util.NewULID(), 1)
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), fmt.Sprintf("is %d bytes", jobRequest.JobRequestItems[0].PodSpecs[0].Size()))
+	})
+}
+
+func TestSubmitServer_SubmitJob_UsesQueueMaxPodSpecSize(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.DefaultMaxPodSpecSizeBytes = 100
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: "test", MaxPodSpecSizeBytes: 100000})
+		assert.NoError(t, err)
+
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsContainerWithoutCommandWhenQueueRequiresIt(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: "test", PriorityFactor: 1, RequireContainerCommand: true})
+		assert.NoError(t, err)
+
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Args = nil
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "has no command or args")
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsVolumeTypeNotAllowedByQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{
+			Name:               "test",
+			PriorityFactor:     1,
+			AllowedVolumeTypes: []string{"persistentVolumeClaim", "emptyDir"},
+		})
+		assert.NoError(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].Volumes = []v1.Volume{
+			{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}},
+			{Name: "host", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/"}}},
+		}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "volume host of job with index 0, pod: 0 is of type hostPath")
+
+		jobRequest.JobRequestItems[0].PodSpecs[0].Volumes = jobRequest.JobRequestItems[0].PodSpecs[0].Volumes[:1]
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsEmptyDirWithoutSizeLimitWhenQueueRequiresIt(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 1, RequireEmptyDirSizeLimit: true})
+		assert.NoError(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].Volumes = []v1.Volume{{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "emptyDir volume scratch of job with index 0, pod: 0 has no size limit")
+
+		sizeLimit := resource.MustParse("1Gi")
+		jobRequest.JobRequestItems[0].PodSpecs[0].Volumes[0].EmptyDir.SizeLimit = &sizeLimit
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_AddsQueueDefaultPodLabels(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:             queue,
+			PriorityFactor:   1,
+			DefaultPodLabels: map[string]string{"team": "ml", "cost-center": "42"},
+		})
+		assert.NoError(t, err)
+
+		items := createJobRequestItems(1)
+		items[0].Labels = map[string]string{"team": "research"}
+		response, err := s.SubmitJobs(context.Background(), &api.JobSubmitRequest{Queue: queue, JobSetId: "set", JobRequestItems: items})
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "research", "cost-center": "42"}, jobs[0].Labels)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsReservedDefaultPodLabels(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:             util.NewULID(),
+			PriorityFactor:   1,
+			DefaultPodLabels: map[string]string{"armada_job_id": "job"},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "label armada_job_id is reserved")
+	})
+}
+
+func TestSubmitServer_SubmitJob_CopiesQueueVolumeClaimTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		template := &api.VolumeClaimTemplate{Name: "scratch", MountPath: "/scratch", Storage: resource.MustParse("10Gi")}
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 1, VolumeClaimTemplate: template})
+		assert.NoError(t, err)
+
+		response, err := s.SubmitJobs(context.Background(), &api.JobSubmitRequest{Queue: queue, JobSetId: "set", JobRequestItems: createJobRequestItems(1)})
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		assert.Equal(t, template, jobs[0].VolumeClaimTemplate)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsInvalidVolumeClaimTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:                util.NewULID(),
+			PriorityFactor:      1,
+			VolumeClaimTemplate: &api.VolumeClaimTemplate{Name: "scratch", MountPath: "scratch", Storage: resource.MustParse("10Gi")},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_PauseQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 1})
+		assert.NoError(t, err)
+
+		_, err = s.PauseQueue(context.Background(), &api.QueuePauseRequest{Name: queue})
+		assert.NoError(t, err)
+
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+		jobRequest.Queue = queue
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		jobRequest.RejectIfQueuePaused = true
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		messages, _, err := events.ReadEvents(queue, jobSetId, "", 100, time.Millisecond, nil)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 2})
+		assert.NoError(t, err)
+		paused, err := s.queueRepository.GetQueue(queue)
+		assert.NoError(t, err)
+		assert.True(t, paused.Paused)
+		assert.Equal(t, 2.0, paused.PriorityFactor)
+
+		_, err = s.ResumeQueue(context.Background(), &api.QueueResumeRequest{Name: queue})
+		assert.NoError(t, err)
+		resumed, err := s.queueRepository.GetQueue(queue)
+		assert.NoError(t, err)
+		assert.False(t, resumed.Paused)
+
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_PauseQueue_WhenQueueDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.PauseQueue(context.Background(), &api.QueuePauseRequest{Name: util.NewULID()})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_UpdateQueue_RejectsUnknownVolumeType(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 1, AllowedVolumeTypes: []string{"pvc"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "Unknown volume type pvc")
+	})
+}
+
+func TestSubmitServer_SubmitJob_OnlyAllowsProtectedJobsInConfiguredQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].Annotations = map[string]string{common.ProtectedJobAnnotation: "true"}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "queue test is not allowed to submit protected jobs")
+
+		s.queueManagementConfig.ProtectedJobQueues = []string{"test"}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsGpuRequestWithoutLimit(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ResourcesRequiringEqualRequestAndLimit = []string{"nvidia.com/gpu"}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "container Container 0 of job with index 0, pod: 0 has nvidia.com/gpu request but no limit")
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsGpuRequestNotEqualToLimit(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ResourcesRequiringEqualRequestAndLimit = []string{"nvidia.com/gpu"}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		resources := jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources
+		resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+		resources.Limits["nvidia.com/gpu"] = resource.MustParse("2")
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "has nvidia.com/gpu request 1 not equal to limit 2")
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsUnknownConstraintAnnotation(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ConstraintAnnotations = []configuration.ConstraintAnnotation{{Name: "requires-gpu-type", NodeLabel: "gpu-type"}}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].Annotations = map[string]string{"armada.io/requires-gpu-typo": "a100"}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "unknown scheduling constraint annotation armada.io/requires-gpu-typo")
+	})
+}
+
+func TestSubmitServer_SubmitJob_ChecksConstraintAnnotationsCanBeScheduled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ConstraintAnnotations = []configuration.ConstraintAnnotation{{Name: "requires-gpu-type", NodeLabel: "gpu-type"}}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].Annotations = map[string]string{"armada.io/requires-gpu-type": "a100"}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, map[string]string{"gpu-type": "a100"}, jobRequest.JobRequestItems[0].PodSpecs[0].NodeSelector)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsEmptySubmission(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 0))
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "contains no jobs")
+	})
+}
+
+func TestSubmitServer_SubmitJob_AcceptsEmptySubmissionWhenAllowed(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.AllowEmptySubmissions = true
+		jobSetId := util.NewULID()
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 0))
+
+		assert.NoError(t, err)
+		assert.Empty(t, response.JobResponseItems)
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
+
+func TestSubmitServer_SubmitJob_AppliesDefaultLimitMultiplierToRequestOnlyResources(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.DefaultLimitMultipliers = map[string]float64{"cpu": 1.5, "memory": 2}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		resources := &jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources
+		resources.Limits = v1.ResourceList{"memory": resource.MustParse("768Mi")}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		stored := jobs[0].PodSpecs[0].Containers[0].Resources
+		assert.Equal(t, "1", stored.Requests.Cpu().String())
+		assert.Equal(t, "1500m", stored.Limits.Cpu().String())
+		assert.Equal(t, "512Mi", stored.Requests.Memory().String())
+		assert.Equal(t, "768Mi", stored.Limits.Memory().String())
+	})
+}
+
+func TestSubmitServer_SubmitJob_RoundsResourceRequestsUpToGranularity(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ResourceRequestGranularity = common.ComputeResources{
+			"cpu":    resource.MustParse("100m"),
+			"memory": resource.MustParse("128Mi"),
+		}
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		resources := &jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources
+		resources.Requests = v1.ResourceList{"cpu": resource.MustParse("137m"), "memory": resource.MustParse("500Mi")}
+		resources.Limits = v1.ResourceList{"cpu": resource.MustParse("150m"), "memory": resource.MustParse("1Gi")}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		stored := jobs[0].PodSpecs[0].Containers[0].Resources
+		assert.Equal(t, "200m", stored.Requests.Cpu().String())
+		assert.Equal(t, "200m", stored.Limits.Cpu().String())
+		assert.Equal(t, "512Mi", stored.Requests.Memory().String())
+		assert.Equal(t, "1Gi", stored.Limits.Memory().String())
+	})
+}
+
+func TestRoundUpQuantity_NeverRoundsBelowRequest(t *testing.T) {
+	steps := []string{"100m", "250m", "1", "128Mi", "1Gi"}
+	quantities := []string{"1m", "99m", "100m", "101m", "137m", "1", "1500m", "7", "1Ki", "500Mi", "512Mi", "513Mi", "3Gi", "10G"}
+	for _, step := range steps {
+		stepQuantity := resource.MustParse(step)
+		for _, quantity := range quantities {
+			requested := resource.MustParse(quantity)
+			rounded := roundUpQuantity(requested, stepQuantity)
+			assert.True(t, rounded.Cmp(requested) >= 0, "%s rounded to %s with step %s", quantity, rounded.String(), step)
+			assert.Equal(t, int64(0), rounded.MilliValue()%stepQuantity.MilliValue(), "%s rounded to %s with step %s", quantity, rounded.String(), step)
+		}
+	}
+
+	unchanged := roundUpQuantity(resource.MustParse("1"), resource.MustParse("100m"))
+	assert.Equal(t, "1", unchanged.String())
+	withoutStep := roundUpQuantity(resource.MustParse("137m"), resource.Quantity{})
+	assert.Equal(t, "137m", withoutStep.String())
+}
+
+func TestSubmitServer_SubmitJob_RejectsLimitBelowRequest(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources.Limits["cpu"] = resource.MustParse("500m")
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "container Container 0 has cpu limit 500m below request 1")
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsRequestWithoutLimitWithoutMultiplier(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		delete(jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources.Limits, "cpu")
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "container Container 0 has cpu request but no limit")
+	})
+}
+
+func Test_multiplyQuantity(t *testing.T) {
+	assertQuantity(t, "1500m", multiplyQuantity(v1.ResourceCPU, resource.MustParse("1"), 1.5))
+	assertQuantity(t, "334m", multiplyQuantity(v1.ResourceCPU, resource.MustParse("100m"), 3.333))
+	assertQuantity(t, "1Gi", multiplyQuantity(v1.ResourceMemory, resource.MustParse("512Mi"), 2))
+	assertQuantity(t, "1395864372", multiplyQuantity(v1.ResourceMemory, resource.MustParse("1Gi"), 1.3))
+	assertQuantity(t, "2", multiplyQuantity(v1.ResourceName("nvidia.com/gpu"), resource.MustParse("1"), 1.1))
+	// multipliers below 1 would produce limits below requests
+	assertQuantity(t, "2", multiplyQuantity(v1.ResourceCPU, resource.MustParse("2"), 0.5))
+}
+
+func assertQuantity(t *testing.T, expected string, actual resource.Quantity) {
+	assert.Equal(t, expected, actual.String())
+}
+
+func TestSubmitServer_SubmitJob_RejectsJobWithoutPodSpec(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.ResourcesRequiringEqualRequestAndLimit = []string{"nvidia.com/gpu"}
+		jobRequest := createJobRequest(util.NewULID(), 2)
+		jobRequest.JobRequestItems[1].PodSpecs = nil
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "job with index 1 has no pod spec")
+
+		jobRequest.JobRequestItems[1].PodSpecs = []*v1.PodSpec{nil}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "job with index 1 has empty pod spec at index 0")
+	})
+}
+
+func TestSubmitServer_GetJobCluster_ReturnsNotFoundForJobNeverLeased(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		_, err = s.GetJobCluster(context.Background(), &api.JobClusterRequest{JobId: response.JobResponseItems[0].JobId})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetJobCluster_ReturnsClusterAndPoolOfLeasedJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("test-cluster", "test", jobs)
+		assert.NoError(t, err)
+		err = s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "test-cluster", Pool: "cpu", ReportTime: time.Now()})
+		assert.NoError(t, err)
+
+		info, err := s.GetJobCluster(context.Background(), &api.JobClusterRequest{JobId: jobs[0].Id})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &api.JobClusterInfo{JobId: jobs[0].Id, ClusterId: "test-cluster", Pool: "cpu", Leased: true}, info)
+	})
+}
+
+func TestSubmitServer_GetJobRequeueHistory_ReturnsRequeuesOfJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+		err = s.jobRepository.AddRequeue(jobId, &api.JobRequeue{Reason: api.RequeueReason_LeaseRenewalFailed, ClusterId: "test-cluster"})
+		assert.NoError(t, err)
+
+		history, err := s.GetJobRequeueHistory(context.Background(), &api.JobRequeueHistoryRequest{JobId: jobId})
+
+		assert.NoError(t, err)
+		assert.Equal(t, jobId, history.JobId)
+		assert.Len(t, history.Requeues, 1)
+		assert.Equal(t, api.RequeueReason_LeaseRenewalFailed, history.Requeues[0].Reason)
+		assert.Equal(t, "test-cluster", history.Requeues[0].ClusterId)
+	})
+}
+
 func TestSubmitServer_SubmitJob_AddsExpectedEventsInCorrectOrder(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
-		jobRequest := createJobRequest(jobSetId, 1)
+		jobRequest := createJobRequest(jobSetId, 1)
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, len(messages), 2)
+
+		firstEvent := messages[0]
+		secondEvent := messages[1]
+
+		//First event should be submitted
+		assert.NotNil(t, firstEvent.Message.GetSubmitted())
+		//Second event should be queued
+		assert.NotNil(t, secondEvent.Message.GetQueued())
+	})
+}
+
+func TestSubmitServer_SubmitJob_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 5)
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobIds := make([]string, 0, 5)
+
+		for _, jobItem := range response.JobResponseItems {
+			jobIds = append(jobIds, jobItem.JobId)
+		}
+
+		//Get jobs for jobIds returned
+		jobs, _ := s.jobRepository.GetExistingJobsByIds(jobIds)
+		jobSet := make(map[string]*api.Job, 5)
+		for _, job := range jobs {
+			jobSet[job.Id] = job
+		}
+
+		//Confirm submitted spec and created spec line up, using order of returned jobIds to correlate submitted to created
+		for i := 0; i < len(jobRequest.JobRequestItems); i++ {
+			requestItem := jobRequest.JobRequestItems[i]
+			returnedId := jobIds[i]
+			createdJob := jobSet[returnedId]
+
+			assert.NotNil(t, createdJob)
+			assert.Equal(t, requestItem.PodSpec, createdJob.PodSpec)
+		}
+	})
+}
+
+func TestSubmitServer_SubmitJobs_HandlesDoubleSubmit(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+
+		result, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		result2, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		assert.Equal(t, result.JobResponseItems[0].JobId, result2.JobResponseItems[0].JobId)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, len(messages), 4)
+
+		submitted := messages[0].Message.GetSubmitted()
+		queued := messages[1].Message.GetQueued()
+		submitted2 := messages[2].Message.GetSubmitted()
+		duplicateFound := messages[3].Message.GetDuplicateFound()
+
+		assert.NotNil(t, submitted)
+		assert.NotNil(t, queued)
+		assert.NotNil(t, submitted2)
+		assert.NotNil(t, duplicateFound)
+
+		assert.Equal(t, duplicateFound.OriginalJobId, submitted.JobId)
+		assert.Equal(t, duplicateFound.JobId, submitted2.JobId)
+	})
+}
+
+func TestSubmitServer_SubmitJobsStream_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		first := createJobRequest(jobSetId, 2)
+		second := &api.JobSubmitRequest{JobRequestItems: createJobRequestItems(2)}
+		// resubmitted client id is deduplicated across requests of the stream
+		second.JobRequestItems[1].ClientId = first.JobRequestItems[0].ClientId
+		stream := &submitStreamMock{requests: []*api.JobSubmitRequest{first, second}}
+
+		err := s.SubmitJobsStream(stream)
+		assert.NoError(t, err)
+
+		assert.Len(t, stream.responses, 2)
+		assert.Equal(t, int32(0), stream.responses[0].FirstItemIndex)
+		assert.Equal(t, int32(2), stream.responses[1].FirstItemIndex)
+		assert.Equal(t, stream.responses[0].JobResponseItems[0].JobId, stream.responses[1].JobResponseItems[1].JobId)
+
+		requestItems := append(first.JobRequestItems, second.JobRequestItems[0])
+		responseItems := append(stream.responses[0].JobResponseItems, stream.responses[1].JobResponseItems[0])
+		for i, responseItem := range responseItems {
+			jobs, err := s.jobRepository.GetExistingJobsByIds([]string{responseItem.JobId})
+			assert.NoError(t, err)
+			assert.Len(t, jobs, 1)
+			assert.Equal(t, requestItems[i].PodSpecs[0].Containers[0].Name, jobs[0].PodSpecs[0].Containers[0].Name)
+			assert.Equal(t, jobSetId, jobs[0].JobSetId)
+		}
+	})
+}
+
+func TestSubmitServer_SubmitJobsStream_KeepsSubmittedJobsAndReportsFailedItem(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		first := createJobRequest(jobSetId, 2)
+		second := createJobRequest(jobSetId, 1)
+		second.JobRequestItems[0].PodSpecs[0].NodeSelector = map[string]string{"gpu": "true"}
+		third := createJobRequest(jobSetId, 1)
+		stream := &submitStreamMock{requests: []*api.JobSubmitRequest{first, second, third}}
+
+		err := s.SubmitJobsStream(stream)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "submission stopped at job 2")
+		assert.Len(t, st.Proto().Details, 2)
+		failure := &api.JobSubmitStreamFailure{}
+		assert.NoError(t, failure.Unmarshal(st.Proto().Details[0].Value))
+		assert.Equal(t, int32(2), failure.FailedItemIndex)
+		feasibility := &api.JobSchedulingFeasibility{}
+		assert.NoError(t, feasibility.Unmarshal(st.Proto().Details[1].Value))
+		assert.Len(t, feasibility.Clusters, 1)
+
+		assert.Len(t, stream.responses, 1)
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{
+			stream.responses[0].JobResponseItems[0].JobId,
+			stream.responses[0].JobResponseItems[1].JobId,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, jobs, 2)
+	})
+}
+
+func TestSubmitServer_SubmitJobsStream_RejectsRequestForOtherJobSet(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		stream := &submitStreamMock{requests: []*api.JobSubmitRequest{
+			createJobRequest(util.NewULID(), 1),
+			createJobRequest(util.NewULID(), 1),
+		}}
+
+		err := s.SubmitJobsStream(stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "submission stopped at job 1")
+		assert.Len(t, stream.responses, 1)
+	})
+}
+
+func TestSubmitServer_GetQueueInfo_ReturnsEffectiveEventRetention(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queueName := util.NewULID()
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: queueName, EventRetention: &api.QueueEventRetention{MaxLength: 100}})
+		assert.NoError(t, err)
+
+		info, err := s.GetQueueInfo(context.Background(), &api.QueueInfoRequest{Name: queueName})
+		assert.NoError(t, err)
+		assert.Equal(t, &api.QueueEventRetention{RetentionDuration: time.Hour, MaxLength: 100}, info.EventRetention)
+
+		info, err = s.GetQueueInfo(context.Background(), &api.QueueInfoRequest{Name: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, &api.QueueEventRetention{RetentionDuration: time.Hour}, info.EventRetention)
+	})
+}
+
+func TestSubmitServer_GetQueueInfo_ReturnsResourceQuotaAndLeasedResources(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queueName := util.NewULID()
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:           queueName,
+			PriorityFactor: 1,
+			ResourceQuota:  common.ComputeResources{"cpu": resource.MustParse("10")},
+		})
+		assert.NoError(t, err)
+		err = s.usageRepository.UpdateCluster(&api.ClusterUsageReport{
+			ClusterId:                "test-cluster",
+			ReportTime:               time.Now(),
+			ClusterCapacity:          common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+			ClusterAvailableCapacity: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+		}, map[string]float64{})
+		assert.NoError(t, err)
+		err = s.usageRepository.UpdateClusterLeased(&api.ClusterLeasedReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			Queues:     []*api.QueueLeasedReport{{Name: queueName, ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("4")}}},
+		})
+		assert.NoError(t, err)
+
+		info, err := s.GetQueueInfo(context.Background(), &api.QueueInfoRequest{Name: queueName})
+		assert.NoError(t, err)
+		quota, leased := info.ResourceQuota["cpu"], info.ResourcesLeased["cpu"]
+		assert.Equal(t, "10", quota.String())
+		assert.Equal(t, "4", leased.String())
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsNegativeResourceQuota(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:           util.NewULID(),
+			PriorityFactor: 1,
+			ResourceQuota:  common.ComputeResources{"cpu": resource.MustParse("-1")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsResourceFloorsOverCapacity(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: util.NewULID(), PriorityFactor: 1, ResourceFloor: map[string]float64{"cpu": 0.6}})
+		assert.NoError(t, err)
+
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: util.NewULID(), PriorityFactor: 1, ResourceFloor: map[string]float64{"cpu": 0.6}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_UpdateQueue_RejectsResourceFloorsOverCapacity(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: util.NewULID(), PriorityFactor: 1, ResourceFloor: map[string]float64{"cpu": 0.6}})
+		assert.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 1, ResourceFloor: map[string]float64{"cpu": 0.4}})
+		assert.NoError(t, err)
+
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 1, ResourceFloor: map[string]float64{"cpu": 0.5}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateQueue_UsesQueueTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.QueueTemplates = []configuration.QueueTemplate{{
+			Name:             "batch",
+			PriorityFactor:   50,
+			ResourceLimits:   map[string]float64{"cpu": 0.5},
+			EventRetention:   configuration.QueueTemplateEventRetention{RetentionDuration: time.Hour},
+			DefaultPodLabels: map[string]string{"team": "batch"},
+		}}
+
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "batch-queue", Template: "batch"})
+		assert.NoError(t, err)
+		queue, err := s.queueRepository.GetQueue("batch-queue")
+		assert.NoError(t, err)
+		assert.Equal(t, 50.0, queue.PriorityFactor)
+		assert.Equal(t, map[string]float64{"cpu": 0.5}, queue.ResourceLimits)
+		assert.Equal(t, time.Hour, queue.EventRetention.RetentionDuration)
+		assert.Equal(t, "batch", queue.Template)
+		assert.Equal(t, map[string]string{"team": "batch"}, queue.DefaultPodLabels)
+
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "custom-queue", Template: "batch", PriorityFactor: 2})
+		assert.NoError(t, err)
+		queue, err = s.queueRepository.GetQueue("custom-queue")
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, queue.PriorityFactor)
+		assert.Equal(t, map[string]float64{"cpu": 0.5}, queue.ResourceLimits)
+	})
+}
+
+func TestSubmitServer_CreateQueue_UsesDefaultPriorityFactor(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.queueManagementConfig.DefaultPriorityFactor = 10
+
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "default-queue"})
+		assert.NoError(t, err)
+		queue, err := s.queueRepository.GetQueue("default-queue")
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, queue.PriorityFactor)
+	})
+}
+
+func TestSubmitServer_CreateQueue_DoesNotChangePaused(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "paused-queue", PriorityFactor: 1, Paused: true})
+		assert.NoError(t, err)
+		queue, err := s.queueRepository.GetQueue("paused-queue")
+		assert.NoError(t, err)
+		assert.False(t, queue.Paused)
+
+		_, err = s.PauseQueue(context.Background(), &api.QueuePauseRequest{Name: "paused-queue"})
+		assert.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "paused-queue", PriorityFactor: 2})
+		assert.NoError(t, err)
+		queue, err = s.queueRepository.GetQueue("paused-queue")
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, queue.PriorityFactor)
+		assert.True(t, queue.Paused)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsUnknownTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "queue", Template: "missing"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetPoolCapacity(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "gpu-cluster",
+			Pool:       "gpu",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("32"), "nvidia.com/gpu": resource.MustParse("8")},
+			}},
+			TotalAllocatableResources: common.ComputeResources{"cpu": resource.MustParse("320"), "nvidia.com/gpu": resource.MustParse("80")},
+		})
+		assert.NoError(t, err)
+
+		capacity, err := s.GetPoolCapacity(context.Background(), &api.PoolCapacityRequest{Pool: "gpu"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(capacity.Pools))
+		largestGpu := capacity.Pools[0].LargestNodeAllocatable["nvidia.com/gpu"]
+		totalGpu := capacity.Pools[0].TotalAllocatable["nvidia.com/gpu"]
+		assert.Equal(t, int64(8), largestGpu.Value())
+		assert.Equal(t, int64(80), totalGpu.Value())
+
+		capacity, err = s.GetPoolCapacity(context.Background(), &api.PoolCapacityRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(capacity.Pools))
+
+		_, err = s.GetPoolCapacity(context.Background(), &api.PoolCapacityRequest{Pool: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetClusterCapacityReport(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:                 "gpu-cluster",
+			Pool:                      "gpu",
+			ReportTime:                time.Now(),
+			NodeTypes:                 []*api.NodeType{{AllocatableResources: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("8")}}},
+			TotalAllocatableResources: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("80")},
+		})
+		assert.NoError(t, err)
+		err = s.usageRepository.UpdateCluster(&api.ClusterUsageReport{
+			ClusterId:                "gpu-cluster",
+			Pool:                     "gpu",
+			ReportTime:               time.Now(),
+			ClusterCapacity:          common.ComputeResources{"nvidia.com/gpu": resource.MustParse("80")},
+			ClusterAvailableCapacity: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("24")},
+		}, map[string]float64{})
+		assert.NoError(t, err)
+
+		report, err := s.GetClusterCapacityReport(context.Background(), &api.ClusterCapacityReportRequest{Pool: "gpu"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(report.Pools))
+		assert.Equal(t, 1, len(report.Pools[0].Clusters))
+		usedGpu := report.Pools[0].Used["nvidia.com/gpu"]
+		availableGpu := report.Pools[0].Clusters[0].Available["nvidia.com/gpu"]
+		assert.Equal(t, int64(56), usedGpu.Value())
+		assert.Equal(t, int64(24), availableGpu.Value())
+		assert.Equal(t, 1, len(report.Pools[0].Clusters[0].NodeTypes))
+
+		_, err = s.GetClusterCapacityReport(context.Background(), &api.ClusterCapacityReportRequest{Pool: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// outdated usage reports are ignored, so the free gpus do not affect scheduling in other tests
+		err = s.usageRepository.UpdateCluster(&api.ClusterUsageReport{ClusterId: "gpu-cluster", Pool: "gpu", ReportTime: time.Now().Add(-time.Hour)}, map[string]float64{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_GetQueueSchedulingStatus(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: queue, PriorityFactor: 1})
+		assert.NoError(t, err)
+
+		schedulingStatus, err := s.GetQueueSchedulingStatus(context.Background(), &api.QueueSchedulingStatusRequest{Queue: queue})
+		assert.NoError(t, err)
+		assert.False(t, schedulingStatus.Schedulable)
+		assert.Equal(t, 1, len(schedulingStatus.Reasons))
+		assert.Equal(t, api.QueueNotScheduledReason_NoQueuedJobs, schedulingStatus.Reasons[0].Reason)
+
+		_, err = s.SubmitJobs(context.Background(), &api.JobSubmitRequest{Queue: queue, JobSetId: "set", JobRequestItems: createJobRequestItems(1)})
+		assert.NoError(t, err)
+		err = s.usageRepository.UpdateCluster(&api.ClusterUsageReport{
+			ClusterId:                "test-cluster",
+			ReportTime:               time.Now(),
+			ClusterCapacity:          common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+			ClusterAvailableCapacity: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+		}, map[string]float64{})
+		assert.NoError(t, err)
+
+		schedulingStatus, err = s.GetQueueSchedulingStatus(context.Background(), &api.QueueSchedulingStatusRequest{Queue: queue})
+		assert.NoError(t, err)
+		assert.True(t, schedulingStatus.Schedulable)
+		assert.Empty(t, schedulingStatus.Reasons)
+	})
+}
+
+func TestSubmitServer_GetQueueSchedulingStatus_WhenQueueDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.GetQueueSchedulingStatus(context.Background(), &api.QueueSchedulingStatusRequest{Queue: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_UpdateQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{
+			Name:           "test",
+			PriorityFactor: 2,
+			UserOwners:     []string{"new-owner"},
+			GroupOwners:    []string{"new-team"},
+		})
+		assert.NoError(t, err)
+
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, queue.PriorityFactor)
+		assert.Equal(t, []string{"new-owner"}, queue.UserOwners)
+		assert.Equal(t, []string{"new-team"}, queue.GroupOwners)
+	})
+}
+
+func TestSubmitServer_UpdateQueue_ChangesOnlyFieldsOfUpdateMask(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{
+			Name:                     "test",
+			PriorityFactor:           2,
+			UserOwners:               []string{"owner"},
+			ResourceLimits:           map[string]float64{"cpu": 0.5},
+			EventRetention:           &api.QueueEventRetention{RetentionDuration: time.Hour, MaxLength: 100},
+			AllowedVolumeTypes:       []string{"emptyDir"},
+			RequireEmptyDirSizeLimit: true,
+			DefaultPodLabels:         map[string]string{"team": "ml"},
+			ResourceQuota:            map[string]resource.Quantity{"cpu": resource.MustParse("10")},
+		})
+		assert.NoError(t, err)
+		_, err = s.PauseQueue(context.Background(), &api.QueuePauseRequest{Name: "test"})
+		assert.NoError(t, err)
+
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{
+			Name:           "test",
+			PriorityFactor: 3,
+			EventRetention: &api.QueueEventRetention{MaxLength: 50},
+			UpdateMask:     &types.FieldMask{Paths: []string{"priority_factor", "event_retention.max_length"}},
+		})
+		assert.NoError(t, err)
+
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.NoError(t, err)
+		assert.Equal(t, 3.0, queue.PriorityFactor)
+		assert.Equal(t, &api.QueueEventRetention{RetentionDuration: time.Hour, MaxLength: 50}, queue.EventRetention)
+		assert.Equal(t, []string{"owner"}, queue.UserOwners)
+		assert.Equal(t, map[string]float64{"cpu": 0.5}, queue.ResourceLimits)
+		assert.Equal(t, []string{"emptyDir"}, queue.AllowedVolumeTypes)
+		assert.True(t, queue.RequireEmptyDirSizeLimit)
+		assert.Equal(t, map[string]string{"team": "ml"}, queue.DefaultPodLabels)
+		assert.Equal(t, resource.MustParse("10"), queue.ResourceQuota["cpu"])
+		assert.True(t, queue.Paused)
+		assert.Nil(t, queue.UpdateMask)
+	})
+}
+
+func TestSubmitServer_UpdateQueue_RejectsUnknownUpdateMaskField(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{
+			Name:       "test",
+			Paused:     true,
+			UpdateMask: &types.FieldMask{Paths: []string{"paused"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.NoError(t, err)
+		assert.False(t, queue.Paused)
+	})
+}
+
+func TestSubmitServer_UpdateQueue_WhenQueueDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{Name: "missing", PriorityFactor: 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.queueRepository.GetQueue("missing")
+		assert.Equal(t, redis.Nil, err)
+	})
+}
+
+func TestSubmitServer_UpdateQueue_RequiresOwnershipOrPermission(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.permissions = &denyingPermissionChecker{}
+
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 5})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.NoError(t, err)
+		assert.NotEqual(t, 5.0, queue.PriorityFactor)
+	})
+}
+
+func TestSubmitServer_CancelJobsSubmittedBefore(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		assert.NoError(t, err)
+
+		cutoff := time.Now()
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.NoError(t, err)
+
+		result, err := s.CancelJobsSubmittedBefore(context.Background(), &api.JobCancelSubmittedBeforeRequest{
+			Queue:           "test",
+			JobSetId:        jobSetId,
+			SubmittedBefore: cutoff,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), result.CancelledCount)
+
+		remaining, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(remaining))
+	})
+}
+
+func TestSubmitServer_CancelJobsSubmittedBefore_RequiresQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CancelJobsSubmittedBefore(context.Background(), &api.JobCancelSubmittedBeforeRequest{SubmittedBefore: time.Now()})
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_RejectsRequestsOverRateLimit(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.submitRateLimiter = newQueueRateLimiter(1, 2, clock.NewFakeClock(time.Now()))
+		jobSetId := util.NewULID()
+
+		for i := 0; i < 2; i++ {
+			_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+			assert.NoError(t, err)
+		}
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJobsStream_RejectsRequestsOverRateLimit(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.submitRateLimiter = newQueueRateLimiter(1, 2, clock.NewFakeClock(time.Now()))
+		jobSetId := util.NewULID()
+		stream := &submitStreamMock{requests: []*api.JobSubmitRequest{
+			createJobRequest(jobSetId, 1),
+			createJobRequest(jobSetId, 1),
+			createJobRequest(jobSetId, 1),
+		}}
+
+		err := s.SubmitJobsStream(stream)
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), "submission stopped at job 2")
+		assert.Len(t, stream.responses, 2)
+	})
+}
+
+func TestSubmitServer_CancelJobsInQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		queue := util.NewULID()
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: queue, PriorityFactor: 1})
+		assert.NoError(t, err)
+
+		for _, jobSetId := range []string{util.NewULID(), util.NewULID()} {
+			request := createJobRequest(jobSetId, 2)
+			request.Queue = queue
+			_, err = s.SubmitJobs(context.Background(), request)
+			assert.NoError(t, err)
+		}
+
+		result, err := s.CancelJobsInQueue(context.Background(), &api.JobCancelQueueRequest{Queue: queue, Reason: "cleanup"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), result.CancelledCount)
+		assert.Equal(t, int32(0), result.AlreadyFinishedCount)
+
+		remaining, err := s.jobRepository.GetQueueActiveJobIds(queue)
+		assert.NoError(t, err)
+		assert.Empty(t, remaining)
+
+		result, err = s.CancelJobsInQueue(context.Background(), &api.JobCancelQueueRequest{Queue: queue})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), result.CancelledCount)
+	})
+}
+
+func TestSubmitServer_CancelJobsInQueue_RequiresPermission(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		s.permissions = &denyingPermissionChecker{}
+		_, err = s.CancelJobsInQueue(context.Background(), &api.JobCancelQueueRequest{Queue: "test"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CancelJobsInQueue_WhenQueueDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CancelJobsInQueue(context.Background(), &api.JobCancelQueueRequest{Queue: util.NewULID()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CancelJobs_RecordsReason(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		_, err = s.CancelJobs(context.Background(), &api.JobCancelRequest{JobId: jobId, Reason: strings.Repeat("a", maxCancelReasonLength+1)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		result, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{JobId: jobId, Reason: "superseded by rerun"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{jobId}, result.CancelledIds)
+
+		cancelReason, err := s.GetJobCancelReason(context.Background(), &api.JobCancelReasonRequest{JobId: jobId})
+		assert.NoError(t, err)
+		assert.Equal(t, &api.JobCancelReason{JobId: jobId, Reason: "superseded by rerun"}, cancelReason)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, "superseded by rerun", messages[len(messages)-2].Message.GetCancelling().Reason)
+		assert.Equal(t, "superseded by rerun", messages[len(messages)-1].Message.GetCancelled().Reason)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsDeadlineInThePast(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		deadline := time.Now().Add(-time.Second)
+		jobRequest.JobRequestItems[0].Deadline = &deadline
 
 		_, err := s.SubmitJobs(context.Background(), jobRequest)
-		assert.Empty(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CancelJobsPastDeadline(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 2)
+		deadline := time.Now().Add(time.Hour)
+		for _, item := range request.JobRequestItems {
+			item.Deadline = &deadline
+		}
+		jobs, err := s.jobRepository.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, err)
+		// jobs can not be submitted with a deadline in the past
+		pastDeadline := time.Now().Add(-time.Minute)
+		jobs[0].Deadline = &pastDeadline
+		_, err = s.jobRepository.AddJobs(jobs, false)
+		assert.NoError(t, err)
+
+		s.CancelJobsPastDeadline()
+
+		remaining, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{jobs[1].Id}, remaining)
 
 		messages, err := readJobEvents(events, jobSetId)
 		assert.NoError(t, err)
-		assert.Equal(t, len(messages), 2)
+		assert.Len(t, messages, 3)
+		assert.Equal(t, jobs[0].Id, messages[0].Message.GetDeadlineExceeded().JobId)
+		assert.Equal(t, pastDeadline.UnixNano(), messages[0].Message.GetDeadlineExceeded().Deadline.UnixNano())
+		assert.Contains(t, messages[2].Message.GetCancelled().Reason, "job not finished by its deadline")
 
-		firstEvent := messages[0]
-		secondEvent := messages[1]
+		s.CancelJobsPastDeadline()
 
-		//First event should be submitted
-		assert.NotNil(t, firstEvent.Message.GetSubmitted())
-		//Second event should be queued
-		assert.NotNil(t, secondEvent.Message.GetQueued())
+		messages, err = readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 3)
 	})
 }
 
-func TestSubmitServer_SubmitJob_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t *testing.T) {
+func TestSubmitServer_CancelJobsPastDeadline_RetriesJobsWhichFailedToBeCancelled(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
-		jobRequest := createJobRequest(jobSetId, 5)
+		request := createJobRequest(jobSetId, 1)
+		deadline := time.Now().Add(time.Hour)
+		request.JobRequestItems[0].Deadline = &deadline
+		jobs, err := s.jobRepository.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, err)
+		pastDeadline := time.Now().Add(-time.Minute)
+		jobs[0].Deadline = &pastDeadline
+		_, err = s.jobRepository.AddJobs(jobs, false)
+		assert.NoError(t, err)
 
-		response, err := s.SubmitJobs(context.Background(), jobRequest)
-		assert.Empty(t, err)
+		eventStore := s.eventStore
+		s.eventStore = &cancellingFailingEventStore{EventStore: eventStore}
+		s.CancelJobsPastDeadline()
 
-		jobIds := make([]string, 0, 5)
+		remaining, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{jobs[0].Id}, remaining)
+		pastDeadlineJobs, err := s.jobRepository.GetJobsPastDeadline(time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, pastDeadlineJobs, 1)
 
-		for _, jobItem := range response.JobResponseItems {
-			jobIds = append(jobIds, jobItem.JobId)
+		s.eventStore = eventStore
+		s.CancelJobsPastDeadline()
+
+		remaining, err = s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+}
+
+type cancellingFailingEventStore struct {
+	repository.EventStore
+}
+
+func (es *cancellingFailingEventStore) ReportEvents(messages []*api.EventMessage) error {
+	for _, message := range messages {
+		if message.GetCancelling() != nil {
+			return fmt.Errorf("failed to report cancelling event")
 		}
+	}
+	return es.EventStore.ReportEvents(messages)
+}
 
-		//Get jobs for jobIds returned
-		jobs, _ := s.jobRepository.GetExistingJobsByIds(jobIds)
-		jobSet := make(map[string]*api.Job, 5)
+func TestSubmitServer_CancelJobsPastDeadline_CancelsJobsWithSameDeadlineTogether(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 2)
+		deadline := time.Now().Add(time.Hour)
+		for _, item := range request.JobRequestItems {
+			item.Deadline = &deadline
+		}
+		jobs, err := s.jobRepository.CreateJobs(request, authorization.NewStaticPrincipal("user", []string{}))
+		assert.NoError(t, err)
+		pastDeadline := time.Now().Add(-time.Minute)
 		for _, job := range jobs {
-			jobSet[job.Id] = job
+			job.Deadline = &pastDeadline
 		}
+		_, err = s.jobRepository.AddJobs(jobs, false)
+		assert.NoError(t, err)
 
-		//Confirm submitted spec and created spec line up, using order of returned jobIds to correlate submitted to created
-		for i := 0; i < len(jobRequest.JobRequestItems); i++ {
-			requestItem := jobRequest.JobRequestItems[i]
-			returnedId := jobIds[i]
-			createdJob := jobSet[returnedId]
+		s.CancelJobsPastDeadline()
 
-			assert.NotNil(t, createdJob)
-			assert.Equal(t, requestItem.PodSpec, createdJob.PodSpec)
-		}
+		remaining, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.NoError(t, err)
+		assert.Empty(t, remaining)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 6)
 	})
 }
 
-func TestSubmitServer_SubmitJobs_HandlesDoubleSubmit(t *testing.T) {
+func TestSubmitServer_ReprioritizeJobs(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
-		jobRequest := createJobRequest(jobSetId, 1)
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		assert.NoError(t, err)
+		queuedId := response.JobResponseItems[0].JobId
+		leasedId := response.JobResponseItems[1].JobId
 
-		result, err := s.SubmitJobs(context.Background(), jobRequest)
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{leasedId})
+		assert.NoError(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("test-cluster", "test", jobs)
 		assert.NoError(t, err)
 
-		result2, err := s.SubmitJobs(context.Background(), jobRequest)
+		result, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{Queue: "test", JobSetId: jobSetId, NewPriority: 5})
 		assert.NoError(t, err)
+		assert.Equal(t, []string{queuedId}, result.ReprioritizedIds)
+		assert.Contains(t, result.NotReprioritized[leasedId], "already leased")
 
-		assert.Equal(t, result.JobResponseItems[0].JobId, result2.JobResponseItems[0].JobId)
+		jobs, err = s.jobRepository.GetExistingJobsByIds([]string{queuedId, leasedId})
+		assert.NoError(t, err)
+		assert.Equal(t, float64(5), jobs[0].Priority)
+		assert.Equal(t, float64(0), jobs[1].Priority)
 
 		messages, err := readJobEvents(events, jobSetId)
 		assert.NoError(t, err)
-		assert.Equal(t, len(messages), 4)
+		reprioritized := messages[len(messages)-1].Message.GetReprioritized()
+		assert.Equal(t, queuedId, reprioritized.JobId)
+		assert.Equal(t, float64(5), reprioritized.NewPriority)
+	})
+}
 
-		submitted := messages[0].Message.GetSubmitted()
-		queued := messages[1].Message.GetQueued()
-		submitted2 := messages[2].Message.GetSubmitted()
-		duplicateFound := messages[3].Message.GetDuplicateFound()
+func TestSubmitServer_ReprioritizeJobs_ReportsUnknownJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		missingId := util.NewULID()
+		result, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{JobIds: []string{missingId}, NewPriority: 5})
+		assert.NoError(t, err)
+		assert.Empty(t, result.ReprioritizedIds)
+		assert.Contains(t, result.NotReprioritized[missingId], "not found")
 
-		assert.NotNil(t, submitted)
-		assert.NotNil(t, queued)
-		assert.NotNil(t, submitted2)
-		assert.NotNil(t, duplicateFound)
+		_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{Queue: "test", NewPriority: 5})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
 
-		assert.Equal(t, duplicateFound.OriginalJobId, submitted.JobId)
-		assert.Equal(t, duplicateFound.JobId, submitted2.JobId)
+func TestSubmitServer_ReprioritizeJobs_RequiresPermission(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		s.permissions = &denyingPermissionChecker{}
+		_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+			JobIds:      []string{response.JobResponseItems[0].JobId},
+			NewPriority: 5,
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetJobIdByClientId(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClientId = "client-1"
+		response, err := s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+
+		result, err := s.GetJobIdByClientId(context.Background(), &api.JobIdByClientIdRequest{Queue: "test", ClientId: "client-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, response.JobResponseItems[0].JobId, result.JobId)
+
+		_, err = s.GetJobIdByClientId(context.Background(), &api.JobIdByClientIdRequest{Queue: "test", ClientId: "client-2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.GetJobIdByClientId(context.Background(), &api.JobIdByClientIdRequest{Queue: "test"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetJobIdByClientId_ReturnsOriginalJobOfDuplicate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		clientId := util.NewULID()
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClientId = clientId
+		original, err := s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+
+		duplicate, err := s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, original.JobResponseItems[0].JobId, duplicate.JobResponseItems[0].JobId)
+
+		result, err := s.GetJobIdByClientId(context.Background(), &api.JobIdByClientIdRequest{Queue: "test", ClientId: clientId})
+		assert.NoError(t, err)
+		assert.Equal(t, original.JobResponseItems[0].JobId, result.JobId)
 	})
 }
 
 func readJobEvents(events repository.EventRepository, jobSetId string) ([]*api.EventStreamMessage, error) {
-	messages, err := events.ReadEvents("test", jobSetId, "", 100, 5*time.Second)
+	messages, _, err := events.ReadEvents("test", jobSetId, "", 100, 5*time.Second, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -195,12 +1671,21 @@ func createJobRequestItems(numberOfJobs int) []*api.JobSubmitRequestItem {
 func withSubmitServer(action func(s *SubmitServer, events repository.EventRepository)) {
 	// using real redis instance as miniredis does not support streams
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
 
-	jobRepo := repository.NewRedisJobRepository(client, nil)
+	jobRepo := repository.NewRedisJobRepository(client, nil, configuration.JobRepositoryConfig{})
 	queueRepo := repository.NewRedisQueueRepository(client)
-	eventRepo := repository.NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: false})
+	podSpecTemplateRepo := repository.NewRedisPodSpecTemplateRepository(client)
+	eventRetention := configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour}
+	eventRepo := repository.NewRedisEventRepository(client, eventRetention, queueRepo)
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(client)
-	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, eventRepo, schedulingInfoRepository, &configuration.QueueManagementConfig{DefaultPriorityFactor: 1})
+	usageRepository := repository.NewRedisUsageRepository(client)
+	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, podSpecTemplateRepo, eventRepo, schedulingInfoRepository, usageRepository,
+		&configuration.SchedulingConfig{}, &configuration.QueueManagementConfig{DefaultPriorityFactor: 1},
+		eventRetention)
 
 	err := queueRepo.CreateQueue(&api.Queue{Name: "test"})
 	if err != nil {
@@ -220,3 +1705,37 @@ func withSubmitServer(action func(s *SubmitServer, events repository.EventReposi
 
 	action(server, eventRepo)
 }
+
+type denyingPermissionChecker struct{}
+
+func (denyingPermissionChecker) UserOwns(ctx context.Context, obj authorization.Owned) bool {
+	return false
+}
+
+func (denyingPermissionChecker) UserHasPermission(ctx context.Context, perm permissions.Permission) bool {
+	return false
+}
+
+type submitStreamMock struct {
+	grpc.ServerStream
+	requests  []*api.JobSubmitRequest
+	responses []*api.JobSubmitStreamResponse
+}
+
+func (s *submitStreamMock) Recv() (*api.JobSubmitRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *submitStreamMock) Send(m *api.JobSubmitStreamResponse) error {
+	s.responses = append(s.responses, m)
+	return nil
+}
+
+func (s *submitStreamMock) Context() context.Context {
+	return context.Background()
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 )
 
+const DefaultTimeout = 10 * time.Second
+
 func ContextWithDefaultTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), 10*time.Second)
+	return context.WithTimeout(context.Background(), DefaultTimeout)
 }
@@ -1,3 +1,7 @@
 package common
 
 const PodNamePrefix string = "armada-"
+
+// Jobs with this annotation keep running where Armada would otherwise remove their pods to run them elsewhere,
+// they can only be submitted to queues allowed by the server configuration
+const ProtectedJobAnnotation = "armadaproject.io/protected"
@@ -0,0 +1,103 @@
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Checker returns nil when healthy, otherwise the reason it is not
+type Checker interface {
+	Check() error
+}
+
+type CheckerFunc func() error
+
+func (f CheckerFunc) Check() error {
+	return f()
+}
+
+// MultiChecker fails when any of its checkers fails, checkers can be added while it is being served
+type MultiChecker struct {
+	mutex    sync.Mutex
+	names    []string
+	checkers []Checker
+}
+
+func NewMultiChecker() *MultiChecker {
+	return &MultiChecker{}
+}
+
+func (c *MultiChecker) Add(name string, checker Checker) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.names = append(c.names, name)
+	c.checkers = append(c.checkers, checker)
+}
+
+func (c *MultiChecker) Check() error {
+	c.mutex.Lock()
+	names := c.names
+	checkers := c.checkers
+	c.mutex.Unlock()
+
+	failures := []string{}
+	for i, checker := range checkers {
+		if e := checker.Check(); e != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", names[i], e))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// StartupCompleteChecker fails until MarkComplete is called
+type StartupCompleteChecker struct {
+	complete int32
+}
+
+func NewStartupCompleteChecker() *StartupCompleteChecker {
+	return &StartupCompleteChecker{}
+}
+
+func (c *StartupCompleteChecker) MarkComplete() {
+	atomic.StoreInt32(&c.complete, 1)
+}
+
+func (c *StartupCompleteChecker) Check() error {
+	if atomic.LoadInt32(&c.complete) == 0 {
+		return fmt.Errorf("startup is not complete")
+	}
+	return nil
+}
+
+// Liveness is served on /health and readiness on /ready
+type Checks struct {
+	Liveness  *MultiChecker
+	Readiness *MultiChecker
+}
+
+func NewChecks() *Checks {
+	return &Checks{Liveness: NewMultiChecker(), Readiness: NewMultiChecker()}
+}
+
+func (c *Checks) RegisterHandlers(mux *http.ServeMux) {
+	mux.Handle("/health", Handler(c.Liveness))
+	mux.Handle("/ready", Handler(c.Readiness))
+}
+
+// Handler responds with 204 when the checker passes and 503 with the reason otherwise
+func Handler(checker Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e := checker.Check(); e != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintln(w, e.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
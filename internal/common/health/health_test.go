@@ -0,0 +1,53 @@
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiChecker_ReportsAllFailures(t *testing.T) {
+	checker := NewMultiChecker()
+	assert.NoError(t, checker.Check())
+
+	checker.Add("passing", CheckerFunc(func() error { return nil }))
+	checker.Add("first", CheckerFunc(func() error { return fmt.Errorf("first failed") }))
+	checker.Add("second", CheckerFunc(func() error { return fmt.Errorf("second failed") }))
+
+	assert.EqualError(t, checker.Check(), "first: first failed; second: second failed")
+}
+
+func TestStartupCompleteChecker(t *testing.T) {
+	checker := NewStartupCompleteChecker()
+	assert.Error(t, checker.Check())
+
+	checker.MarkComplete()
+	assert.NoError(t, checker.Check())
+}
+
+func TestChecks_ServesLivenessAndReadiness(t *testing.T) {
+	checks := NewChecks()
+	startup := NewStartupCompleteChecker()
+	checks.Readiness.Add("startup", startup)
+	mux := http.NewServeMux()
+	checks.RegisterHandlers(mux)
+
+	health := serve(mux, "/health")
+	assert.Equal(t, http.StatusNoContent, health.Code)
+
+	ready := serve(mux, "/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, ready.Code)
+	assert.Equal(t, "startup: startup is not complete\n", ready.Body.String())
+
+	startup.MarkComplete()
+	assert.Equal(t, http.StatusNoContent, serve(mux, "/ready").Code)
+}
+
+func serve(handler http.Handler, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+	return recorder
+}
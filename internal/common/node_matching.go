@@ -0,0 +1,108 @@
+package common
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Pod fits a node type when its resource request fits the node size, the node labels match its node selector and
+// it tolerates the hard taints of the node
+func PodFitsAnyNodeType(podSpec *v1.PodSpec, nodeTypes []*api.NodeType) bool {
+	for _, nodeType := range nodeTypes {
+		if PodFitsNodeType(podSpec, nodeType) {
+			return true
+		}
+	}
+	return false
+}
+
+func PodFitsNodeType(podSpec *v1.PodSpec, nodeType *api.NodeType) bool {
+	available := ComputeResources(nodeType.AllocatableResources).AsFloat()
+	available.Sub(TotalPodResourceRequest(podSpec).AsFloat())
+	return available.IsValid() && MatchNodeSelector(podSpec, nodeType.Labels) && ToleratesTaints(podSpec.Tolerations, nodeType.Taints)
+}
+
+func MatchNodeSelector(podSpec *v1.PodSpec, labels map[string]string) bool {
+	for k, v := range podSpec.NodeSelector {
+		if labels == nil || labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func ToleratesTaints(tolerations []v1.Toleration, taints []v1.Taint) bool {
+	for i := range taints {
+		// check only hard constraints
+		if taints[i].Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !TolerationsTolerateTaint(tolerations, &taints[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// https://github.com/kubernetes/kubernetes/blob/master/pkg/apis/core/v1/helper/helpers.go#L427
+func TolerationsTolerateTaint(tolerations []v1.Toleration, taint *v1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tolerations of pods on clusters with preemptible nodes tainted with the taint. Pods of jobs tolerating preemptible nodes
+// tolerate the taint, other pods lose their tolerations of it, tolerations of all taints included.
+// The tolerations are copied, as the pod spec is shared with the job.
+func PreemptibleNodeTolerations(tolerations []v1.Toleration, toleratesPreemptibleNodes bool, taint string) []v1.Toleration {
+	if taint == "" {
+		return tolerations
+	}
+	result := make([]v1.Toleration, 0, len(tolerations)+1)
+	for _, toleration := range tolerations {
+		if toleratesPreemptibleNodes {
+			if toleration.Key == taint && toleration.Operator == v1.TolerationOpExists && toleration.Effect == "" {
+				return tolerations
+			}
+			result = append(result, toleration)
+		} else if toleration.Key != taint && !(toleration.Key == "" && toleration.Operator == v1.TolerationOpExists) {
+			result = append(result, toleration)
+		}
+	}
+	if toleratesPreemptibleNodes {
+		result = append(result, v1.Toleration{Key: taint, Operator: v1.TolerationOpExists})
+	}
+	return result
+}
+
+// Nodes with the label are preemptible, with the label value or with any value when the label value is empty
+func IsPreemptibleNode(labels map[string]string, label string, labelValue string) bool {
+	if label == "" {
+		return false
+	}
+	value, exists := labels[label]
+	return exists && (labelValue == "" || value == labelValue)
+}
+
+// Nodes with the same description are of the same node type
+func NodeTypeDescription(labels map[string]string, taints []v1.Taint, allocatableResources ComputeResources) string {
+	data := []string{}
+	for k, v := range labels {
+		data = append(data, "l"+k+"="+v)
+	}
+	for _, t := range taints {
+		data = append(data, "t"+t.Key+"="+t.Value+":"+string(t.Effect))
+	}
+	for k, v := range allocatableResources {
+		data = append(data, "r"+k+"="+v.String())
+	}
+	sort.Strings(data)
+	return strings.Join(data, "|")
+}
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPodFitsAnyNodeType(t *testing.T) {
+	nodeTypes := []*api.NodeType{{AllocatableResources: ComputeResources{"memory": resource.MustParse("2Gi")}}}
+	request := func(memory string) *v1.PodSpec {
+		resources := v1.ResourceList{v1.ResourceMemory: resource.MustParse(memory)}
+		return &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Limits: resources, Requests: resources}}}}
+	}
+
+	assert.True(t, PodFitsAnyNodeType(request("2Gi"), nodeTypes))
+	assert.False(t, PodFitsAnyNodeType(request("3Gi"), nodeTypes))
+}
+
+func TestMatchNodeSelector(t *testing.T) {
+	labels := map[string]string{
+		"A": "test",
+		"B": "test",
+	}
+	assert.False(t, MatchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"C": "test"}}, labels))
+	assert.False(t, MatchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"B": "42"}}, labels))
+	assert.True(t, MatchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"A": "test"}}, labels))
+	assert.True(t, MatchNodeSelector(&v1.PodSpec{NodeSelector: map[string]string{"A": "test", "B": "test"}}, labels))
+}
+
+func TestToleratesTaints(t *testing.T) {
+	taints := []v1.Taint{
+		{
+			Key:    "A",
+			Value:  "test",
+			Effect: v1.TaintEffectNoSchedule,
+		},
+		{
+			Key:    "B",
+			Value:  "test",
+			Effect: v1.TaintEffectPreferNoSchedule,
+		},
+	}
+
+	podSpec := &v1.PodSpec{
+		Tolerations: []v1.Toleration{
+			{
+				Key:      "A",
+				Operator: v1.TolerationOpEqual,
+				Value:    "test",
+				Effect:   v1.TaintEffectNoSchedule,
+			},
+		}}
+
+	assert.False(t, ToleratesTaints(nil, taints))
+	assert.True(t, ToleratesTaints(podSpec.Tolerations, taints))
+}
+
+func TestNodeTypeDescription(t *testing.T) {
+	resources := ComputeResources{"cpu": resource.MustParse("4")}
+	noSchedule := []v1.Taint{{Key: "A", Value: "test", Effect: v1.TaintEffectNoSchedule}}
+	noExecute := []v1.Taint{{Key: "A", Value: "test", Effect: v1.TaintEffectNoExecute}}
+
+	assert.Equal(t,
+		NodeTypeDescription(map[string]string{"a": "1", "b": "2"}, noSchedule, resources),
+		NodeTypeDescription(map[string]string{"b": "2", "a": "1"}, noSchedule, resources))
+	assert.NotEqual(t,
+		NodeTypeDescription(nil, noSchedule, resources),
+		NodeTypeDescription(nil, noExecute, resources))
+	assert.NotEqual(t,
+		NodeTypeDescription(nil, nil, resources),
+		NodeTypeDescription(nil, nil, ComputeResources{"cpu": resource.MustParse("8")}))
+}
@@ -314,3 +314,32 @@ func CalculateTotalResourceRequest(pods []*v1.Pod) ComputeResources {
 	}
 	return totalResources
 }
+
+// Sets the memory limit of the named containers to the new memory, memory requests grow in the same proportion
+// up to the new memory. Containers without memory limit have their memory request set to the new memory.
+func IncreaseContainerMemory(spec *v1.PodSpec, newMemory map[string]resource.Quantity) {
+	for _, containers := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			if memory, exists := newMemory[containers[i].Name]; exists {
+				increaseContainerMemory(&containers[i], memory)
+			}
+		}
+	}
+}
+
+func increaseContainerMemory(container *v1.Container, newMemory resource.Quantity) {
+	memory, exists := container.Resources.Limits[v1.ResourceMemory]
+	if exists {
+		container.Resources.Limits[v1.ResourceMemory] = newMemory.DeepCopy()
+	} else if memory, exists = container.Resources.Requests[v1.ResourceMemory]; !exists || memory.Value() == 0 {
+		return
+	}
+	if request, exists := container.Resources.Requests[v1.ResourceMemory]; exists {
+		ratio := float64(newMemory.Value()) / float64(memory.Value())
+		newRequest := *resource.NewQuantity(int64(math.Ceil(float64(request.Value())*ratio)), request.Format)
+		if newRequest.Cmp(newMemory) > 0 {
+			newRequest = newMemory.DeepCopy()
+		}
+		container.Resources.Requests[v1.ResourceMemory] = newRequest
+	}
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -16,8 +17,10 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/weaveworks/promrus"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/common/health"
 	"github.com/G-Research/armada/internal/common/logging"
 )
 
@@ -53,7 +56,7 @@ func LoadConfig(config interface{}, defaultPath string, overrideConfig string) *
 	v.SetEnvPrefix("ARMADA")
 	v.AutomaticEnv()
 
-	err := v.Unmarshal(config, addDecodeHook(quantityDecodeHook))
+	err := v.Unmarshal(config, addDecodeHook(quantityDecodeHook, kubernetesObjectDecodeHook))
 
 	if err != nil {
 		log.Error(err)
@@ -64,14 +67,16 @@ func LoadConfig(config interface{}, defaultPath string, overrideConfig string) *
 }
 
 func UnmarshalKey(v *viper.Viper, key string, item interface{}) error {
-	return v.UnmarshalKey(key, item, addDecodeHook(quantityDecodeHook))
+	return v.UnmarshalKey(key, item, addDecodeHook(quantityDecodeHook, kubernetesObjectDecodeHook))
 }
 
-func addDecodeHook(hook mapstructure.DecodeHookFuncType) viper.DecoderConfigOption {
+func addDecodeHook(hooks ...mapstructure.DecodeHookFuncType) viper.DecoderConfigOption {
 	return func(c *mapstructure.DecoderConfig) {
-		c.DecodeHook = mapstructure.ComposeDecodeHookFunc(
-			c.DecodeHook,
-			hook)
+		composed := []mapstructure.DecodeHookFunc{c.DecodeHook}
+		for _, hook := range hooks {
+			composed = append(composed, hook)
+		}
+		c.DecodeHook = mapstructure.ComposeDecodeHookFunc(composed...)
 	}
 }
 
@@ -86,6 +91,51 @@ func quantityDecodeHook(
 	return resource.ParseQuantity(fmt.Sprintf("%v", data))
 }
 
+// Kubernetes objects inline some of their fields (like the source of a volume), so they are decoded as json the same way Kubernetes does
+func kubernetesObjectDecodeHook(
+	from reflect.Type,
+	to reflect.Type,
+	data interface{}) (interface{}, error) {
+
+	if from.Kind() != reflect.Map || (to != reflect.TypeOf(v1.Container{}) && to != reflect.TypeOf(v1.Volume{})) {
+		return data, nil
+	}
+	encoded, e := json.Marshal(withStringKeys(data))
+	if e != nil {
+		return nil, e
+	}
+	result := reflect.New(to)
+	if e := json.Unmarshal(encoded, result.Interface()); e != nil {
+		return nil, e
+	}
+	return result.Elem().Interface(), nil
+}
+
+func withStringKeys(data interface{}) interface{} {
+	switch value := data.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			result[fmt.Sprintf("%v", k)] = withStringKeys(v)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			result[k] = withStringKeys(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(value))
+		for _, v := range value {
+			result = append(result, withStringKeys(v))
+		}
+		return result
+	default:
+		return data
+	}
+}
+
 func ConfigureCommandLineLogging() {
 	commandLineFormatter := new(logging.CommandLineFormatter)
 	log.SetFormatter(commandLineFormatter)
@@ -114,12 +164,34 @@ func ServeMetrics(port uint16) (shutdown func()) {
 }
 
 func ServeMetricsFor(port uint16, gatherer prometheus.Gatherer) (shutdown func()) {
+	return ServeHttp(port, metricsMux(gatherer))
+}
+
+// Health checks are served on the metrics port when healthPort is 0 or the metrics port
+func ServeMetricsAndHealthFor(metricsPort uint16, healthPort uint16, gatherer prometheus.Gatherer, checks *health.Checks) (shutdown func()) {
+	mux := metricsMux(gatherer)
+	if healthPort == 0 || healthPort == metricsPort {
+		checks.RegisterHandlers(mux)
+		return ServeHttp(metricsPort, mux)
+	}
+
+	healthMux := http.NewServeMux()
+	checks.RegisterHandlers(healthMux)
+	shutdownMetrics := ServeHttp(metricsPort, mux)
+	shutdownHealth := ServeHttp(healthPort, healthMux)
+	return func() {
+		shutdownHealth()
+		shutdownMetrics()
+	}
+}
+
+func metricsMux(gatherer prometheus.Gatherer) *http.ServeMux {
 	hook := promrus.MustNewPrometheusHook()
 	log.AddHook(hook)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
-	return ServeHttp(port, mux)
+	return mux
 }
 
 func ServeHttp(port uint16, mux http.Handler) (shutdown func()) {
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestUnmarshalKey_DecodesKubernetesObjects(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	err := v.ReadConfig(strings.NewReader(`
+sidecar:
+  containers:
+    - name: log-agent
+      resources:
+        requests: {memory: 64Mi}
+      volumeMounts:
+        - name: logs
+          mountPath: /var/log/job
+  volumes:
+    - name: logs
+      emptyDir: {}
+`))
+	assert.NoError(t, err)
+
+	config := struct {
+		Containers []v1.Container
+		Volumes    []v1.Volume
+	}{}
+	err = UnmarshalKey(v, "sidecar", &config)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/var/log/job", config.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, resource.MustParse("64Mi"), config.Containers[0].Resources.Requests[v1.ResourceMemory])
+	assert.NotNil(t, config.Volumes[0].EmptyDir)
+}
@@ -1,25 +1,34 @@
 package task
 
 import (
+	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
 )
 
 type task struct {
-	function    func()
+	function    func(ctx context.Context)
 	interval    time.Duration
+	timeout     time.Duration
 	metricName  string
 	stopChannel chan bool
+	// unix nanoseconds of registration or of the end of the last run
+	lastFinished int64
 }
 
-// BackgroundTaskManager is not threadsafe, it should only be accessed from a single thread.
+// BackgroundTaskManager is not threadsafe, it should only be accessed from a single thread, apart from CheckRunning.
 type BackgroundTaskManager struct {
 	tasks         []*task
 	metricsPrefix string
 	wg            *sync.WaitGroup
+	mutex         sync.Mutex
+	stopped       bool
 }
 
 func NewBackgroundTaskManager(metricsPrefix string) *BackgroundTaskManager {
@@ -31,14 +40,50 @@ func NewBackgroundTaskManager(metricsPrefix string) *BackgroundTaskManager {
 }
 
 func (m *BackgroundTaskManager) Register(backgroundTask func(), interval time.Duration, metricName string) {
+	m.RegisterWithTimeout(WithoutContext(backgroundTask), interval, 0, metricName)
+}
+
+// WithoutContext adapts task not taking context, such task is not interrupted on timeout
+func WithoutContext(backgroundTask func()) func(ctx context.Context) {
+	return func(ctx context.Context) { backgroundTask() }
+}
+
+// RegisterWithTimeout registers task which context is cancelled when a run takes longer than timeout, 0 means no timeout.
+// The schedule carries on after a timeout, but no new run starts until the previous one returns.
+func (m *BackgroundTaskManager) RegisterWithTimeout(backgroundTask func(ctx context.Context), interval time.Duration, timeout time.Duration, metricName string) {
 	task := &task{
-		function:    backgroundTask,
-		interval:    interval,
-		metricName:  metricName,
-		stopChannel: make(chan bool),
+		function:     backgroundTask,
+		interval:     interval,
+		timeout:      timeout,
+		metricName:   metricName,
+		stopChannel:  make(chan bool),
+		lastFinished: time.Now().UnixNano(),
 	}
 	m.startBackgroundTask(task)
+	m.mutex.Lock()
 	m.tasks = append(m.tasks, task)
+	m.mutex.Unlock()
+}
+
+// CheckRunning fails once the tasks are stopped, or when a task has no run finished for longer than its interval plus
+// stallTimeout, 0 stallTimeout only checks the tasks are not stopped.
+func (m *BackgroundTaskManager) CheckRunning(stallTimeout time.Duration) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.stopped {
+		return fmt.Errorf("background tasks are stopped")
+	}
+	if stallTimeout <= 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, task := range m.tasks {
+		lastFinished := time.Unix(0, atomic.LoadInt64(&task.lastFinished))
+		if now.Sub(lastFinished) > task.interval+stallTimeout {
+			return fmt.Errorf("background task %s has not finished a run since %s", task.metricName, lastFinished.Format(time.RFC3339))
+		}
+	}
+	return nil
 }
 
 func (m *BackgroundTaskManager) StopAll(timeout time.Duration) bool {
@@ -53,13 +98,15 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 			Help:    "Background loop " + task.metricName + " latency in seconds",
 			Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
 		})
+	var taskTimeoutCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: m.metricsPrefix + task.metricName + "_timeouts_total",
+			Help: "Number of background loop " + task.metricName + " runs which exceeded their timeout",
+		})
 
 	m.wg.Add(1)
 	go func() {
-		start := time.Now()
-		task.function()
-		duration := time.Since(start)
-		taskDurationHistogram.Observe(duration.Seconds())
+		finished := runTask(task, taskDurationHistogram, taskTimeoutCounter)
 
 		for {
 			select {
@@ -68,14 +115,50 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 				m.wg.Done()
 				return
 			}
-			innerStart := time.Now()
-			task.function()
-			innerDuration := time.Since(innerStart)
-			taskDurationHistogram.Observe(innerDuration.Seconds())
+			select {
+			case <-finished:
+			default:
+				log.Warnf("Skipping background task %s, its previous run is still running after timeout", task.metricName)
+				continue
+			}
+			finished = runTask(task, taskDurationHistogram, taskTimeoutCounter)
 		}
 	}()
 }
 
+// Waits until the task run returns or times out, the returned channel is closed once the run returns
+func runTask(task *task, taskDurationHistogram prometheus.Histogram, taskTimeoutCounter prometheus.Counter) chan struct{} {
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if task.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, task.timeout)
+	}
+
+	finished := make(chan struct{})
+	start := time.Now()
+	go func() {
+		defer close(finished)
+		defer cancel()
+		task.function(ctx)
+		taskDurationHistogram.Observe(time.Since(start).Seconds())
+		atomic.StoreInt64(&task.lastFinished, time.Now().UnixNano())
+	}()
+
+	if task.timeout <= 0 {
+		<-finished
+		return finished
+	}
+	timer := time.NewTimer(task.timeout)
+	defer timer.Stop()
+	select {
+	case <-finished:
+	case <-timer.C:
+		taskTimeoutCounter.Inc()
+		log.Warnf("Background task %s timed out after %s", task.metricName, task.timeout)
+		cancel()
+	}
+	return finished
+}
+
 func (m *BackgroundTaskManager) waitForShutdownCompletion(timeout time.Duration) bool {
 	c := make(chan struct{})
 	go func() {
@@ -91,6 +174,9 @@ func (m *BackgroundTaskManager) waitForShutdownCompletion(timeout time.Duration)
 }
 
 func (m *BackgroundTaskManager) stopTasks() {
+	m.mutex.Lock()
+	m.stopped = true
+	m.mutex.Unlock()
 	for _, task := range m.tasks {
 		task.stopChannel <- true
 	}
@@ -0,0 +1,84 @@
+package task
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterWithTimeout_CancelsRunsExceedingTimeout(t *testing.T) {
+	taskManager := NewBackgroundTaskManager("test_")
+	var runs, cancelled int32
+
+	taskManager.RegisterWithTimeout(func(ctx context.Context) {
+		atomic.AddInt32(&runs, 1)
+		<-ctx.Done()
+		atomic.AddInt32(&cancelled, 1)
+	}, 10*time.Millisecond, 20*time.Millisecond, "cancelled_task")
+
+	time.Sleep(200 * time.Millisecond)
+	assert.False(t, taskManager.StopAll(time.Second))
+	assert.True(t, atomic.LoadInt32(&runs) > 2)
+	assert.True(t, atomic.LoadInt32(&cancelled) >= atomic.LoadInt32(&runs)-1)
+}
+
+func TestRegisterWithTimeout_SkipsRunsWhilePreviousRunIsStillRunning(t *testing.T) {
+	taskManager := NewBackgroundTaskManager("test_")
+	var runs, running, maxRunning int32
+	release := make(chan bool)
+
+	taskManager.RegisterWithTimeout(func(ctx context.Context) {
+		atomic.AddInt32(&runs, 1)
+		if current := atomic.AddInt32(&running, 1); current > atomic.LoadInt32(&maxRunning) {
+			atomic.StoreInt32(&maxRunning, current)
+		}
+		defer atomic.AddInt32(&running, -1)
+		// ignores its context
+		<-release
+	}, 10*time.Millisecond, 10*time.Millisecond, "stuck_task")
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&runs))
+
+	close(release)
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, taskManager.StopAll(time.Second))
+	assert.True(t, atomic.LoadInt32(&runs) > 1)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+}
+
+func TestRegister_RunsTaskOnInterval(t *testing.T) {
+	taskManager := NewBackgroundTaskManager("test_")
+	var runs int32
+
+	taskManager.Register(func() { atomic.AddInt32(&runs, 1) }, 10*time.Millisecond, "plain_task")
+
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, taskManager.StopAll(time.Second))
+	assert.True(t, atomic.LoadInt32(&runs) > 2)
+}
+
+func TestCheckRunning_FailsForStalledTasksAndAfterStop(t *testing.T) {
+	taskManager := NewBackgroundTaskManager("test_")
+	release := make(chan bool)
+
+	taskManager.Register(func() {}, 10*time.Millisecond, "running_task")
+	assert.NoError(t, taskManager.CheckRunning(50*time.Millisecond))
+
+	taskManager.Register(func() { <-release }, 10*time.Millisecond, "stalled_task")
+	time.Sleep(100 * time.Millisecond)
+	e := taskManager.CheckRunning(50 * time.Millisecond)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "stalled_task")
+	assert.NoError(t, taskManager.CheckRunning(0))
+
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, taskManager.CheckRunning(50*time.Millisecond))
+
+	assert.False(t, taskManager.StopAll(time.Second))
+	assert.EqualError(t, taskManager.CheckRunning(0), "background tasks are stopped")
+}
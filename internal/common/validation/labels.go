@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+// Labels the executor sets on every pod to identify the job
+var reservedPodLabels = map[string]bool{
+	domain.JobId:     true,
+	domain.Queue:     true,
+	domain.PodNumber: true,
+	domain.PodCount:  true,
+}
+
+func ValidatePodLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if reservedPodLabels[key] {
+			return fmt.Errorf("label %s is reserved", key)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %s: %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid value of label %s: %s", key, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePodLabels(t *testing.T) {
+	assert.NoError(t, ValidatePodLabels(map[string]string{"team": "ml", "example.com/cost-center": "42"}))
+	assert.NoError(t, ValidatePodLabels(nil))
+
+	assert.Error(t, ValidatePodLabels(map[string]string{"armada_job_id": "job"}))
+	assert.Error(t, ValidatePodLabels(map[string]string{"armada_queue_id": "queue"}))
+	assert.Error(t, ValidatePodLabels(map[string]string{"not a label": "value"}))
+	assert.Error(t, ValidatePodLabels(map[string]string{"team": "not a value"}))
+}
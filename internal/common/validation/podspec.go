@@ -23,21 +23,27 @@ func ValidatePodSpec(spec *v1.PodSpec) error {
 			return fmt.Errorf("container %v have no resource requests specified", container.Name)
 		}
 
-		if !resourceListEquals(container.Resources.Requests, container.Resources.Limits) {
-			return fmt.Errorf("container %v does not havee resource request and limit equal (this is currently not supported)", container.Name)
+		if e := validateLimitsNotBelowRequests(container); e != nil {
+			return e
 		}
 	}
 	return nil
 }
 
-func resourceListEquals(a v1.ResourceList, b v1.ResourceList) bool {
-	if len(a) != len(b) {
-		return false
+func validateLimitsNotBelowRequests(container v1.Container) error {
+	for name, request := range container.Resources.Requests {
+		limit, hasLimit := container.Resources.Limits[name]
+		if !hasLimit {
+			return fmt.Errorf("container %v has %v request but no limit", container.Name, name)
+		}
+		if limit.Cmp(request) < 0 {
+			return fmt.Errorf("container %v has %v limit %v below request %v", container.Name, name, limit.String(), request.String())
+		}
 	}
-	for k, v := range a {
-		if v != b[k] {
-			return false
+	for name := range container.Resources.Limits {
+		if _, hasRequest := container.Resources.Requests[name]; !hasRequest {
+			return fmt.Errorf("container %v has %v limit but no request", container.Name, name)
 		}
 	}
-	return true
+	return nil
 }
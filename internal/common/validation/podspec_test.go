@@ -43,3 +43,32 @@ func Test_ValidatePodSpec_checkForResources(t *testing.T) {
 		}},
 	}))
 }
+
+func Test_ValidatePodSpec_allowsLimitsAboveRequests(t *testing.T) {
+	assert.NoError(t, ValidatePodSpec(podSpecWithCpu("2", "1")))
+}
+
+func Test_ValidatePodSpec_rejectsLimitsBelowRequests(t *testing.T) {
+	e := ValidatePodSpec(podSpecWithCpu("1", "2"))
+
+	assert.EqualError(t, e, "container main has cpu limit 1 below request 2")
+}
+
+func Test_ValidatePodSpec_rejectsRequestWithoutLimit(t *testing.T) {
+	spec := podSpecWithCpu("1", "1")
+	spec.Containers[0].Resources.Requests["memory"] = resource.MustParse("1Gi")
+
+	assert.EqualError(t, ValidatePodSpec(spec), "container main has memory request but no limit")
+}
+
+func podSpecWithCpu(limit string, request string) *v1.PodSpec {
+	return &v1.PodSpec{
+		Containers: []v1.Container{{
+			Name: "main",
+			Resources: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{"cpu": resource.MustParse(limit)},
+				Requests: v1.ResourceList{"cpu": resource.MustParse(request)},
+			},
+		}},
+	}
+}
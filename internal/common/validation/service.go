@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Kubernetes requires names of ports when a service has more than one
+func ValidateServicePorts(ports []*v1.ServicePort) error {
+	names := map[string]bool{}
+	for _, port := range ports {
+		if port == nil {
+			return fmt.Errorf("empty service port")
+		}
+		if port.Port <= 0 || port.Port > 65535 {
+			return fmt.Errorf("service port %d is not between 1 and 65535", port.Port)
+		}
+		if len(ports) > 1 && port.Name == "" {
+			return fmt.Errorf("service port %d has no name, names are required when there are multiple ports", port.Port)
+		}
+		if names[port.Name] {
+			return fmt.Errorf("service port name %s is not unique", port.Name)
+		}
+		names[port.Name] = true
+	}
+	return nil
+}
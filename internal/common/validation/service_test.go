@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestValidateServicePorts(t *testing.T) {
+	assert.NoError(t, ValidateServicePorts(nil))
+	assert.NoError(t, ValidateServicePorts([]*v1.ServicePort{{Port: 8888}}))
+	assert.NoError(t, ValidateServicePorts([]*v1.ServicePort{{Name: "jupyter", Port: 8888}, {Name: "spark-ui", Port: 4040}}))
+
+	assert.Error(t, ValidateServicePorts([]*v1.ServicePort{{Port: 0}}))
+	assert.Error(t, ValidateServicePorts([]*v1.ServicePort{{Port: 70000}}))
+	assert.Error(t, ValidateServicePorts([]*v1.ServicePort{{Name: "jupyter", Port: 8888}, {Port: 4040}}))
+	assert.Error(t, ValidateServicePorts([]*v1.ServicePort{{Name: "ui", Port: 8888}, {Name: "ui", Port: 4040}}))
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Volume type names are the json names of the v1.VolumeSource fields, e.g. hostPath or persistentVolumeClaim
+var volumeTypes = volumeSourceFieldsByType()
+
+func VolumeType(source v1.VolumeSource) string {
+	value := reflect.ValueOf(source)
+	for volumeType, field := range volumeTypes {
+		if !value.Field(field).IsNil() {
+			return volumeType
+		}
+	}
+	return ""
+}
+
+func IsVolumeType(volumeType string) bool {
+	_, exists := volumeTypes[volumeType]
+	return exists
+}
+
+func VolumeTypes() []string {
+	result := make([]string, 0, len(volumeTypes))
+	for volumeType := range volumeTypes {
+		result = append(result, volumeType)
+	}
+	sort.Strings(result)
+	return result
+}
+
+var volumeClaimAccessModes = map[v1.PersistentVolumeAccessMode]bool{
+	v1.ReadWriteOnce: true,
+	v1.ReadOnlyMany:  true,
+	v1.ReadWriteMany: true,
+}
+
+func ValidateVolumeClaimTemplate(template *api.VolumeClaimTemplate) error {
+	if template == nil {
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(template.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid volume name %s: %s", template.Name, strings.Join(errs, ", "))
+	}
+	if !path.IsAbs(template.MountPath) {
+		return fmt.Errorf("mount path %q is not absolute", template.MountPath)
+	}
+	if template.Storage.Sign() <= 0 {
+		return fmt.Errorf("storage must be positive")
+	}
+	for _, accessMode := range template.AccessModes {
+		if !volumeClaimAccessModes[v1.PersistentVolumeAccessMode(accessMode)] {
+			return fmt.Errorf("unknown access mode %s", accessMode)
+		}
+	}
+	return nil
+}
+
+func volumeSourceFieldsByType() map[string]int {
+	sourceType := reflect.TypeOf(v1.VolumeSource{})
+	fields := make(map[string]int, sourceType.NumField())
+	for i := 0; i < sourceType.NumField(); i++ {
+		name := strings.Split(sourceType.Field(i).Tag.Get("json"), ",")[0]
+		fields[name] = i
+	}
+	return fields
+}
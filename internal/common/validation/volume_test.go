@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestVolumeType(t *testing.T) {
+	assert.Equal(t, "hostPath", VolumeType(v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{}}))
+	assert.Equal(t, "persistentVolumeClaim", VolumeType(v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}))
+	assert.Equal(t, "", VolumeType(v1.VolumeSource{}))
+
+	assert.True(t, IsVolumeType("configMap"))
+	assert.False(t, IsVolumeType("pvc"))
+	assert.Contains(t, VolumeTypes(), "emptyDir")
+}
+
+func TestValidateVolumeClaimTemplate(t *testing.T) {
+	valid := func() *api.VolumeClaimTemplate {
+		return &api.VolumeClaimTemplate{Name: "scratch", MountPath: "/scratch", Storage: resource.MustParse("10Gi"), AccessModes: []string{"ReadWriteOnce"}}
+	}
+	assert.NoError(t, ValidateVolumeClaimTemplate(nil))
+	assert.NoError(t, ValidateVolumeClaimTemplate(valid()))
+
+	template := valid()
+	template.Name = "Scratch_Volume"
+	assert.Error(t, ValidateVolumeClaimTemplate(template))
+
+	template = valid()
+	template.MountPath = "scratch"
+	assert.Error(t, ValidateVolumeClaimTemplate(template))
+
+	template = valid()
+	template.Storage = resource.Quantity{}
+	assert.Error(t, ValidateVolumeClaimTemplate(template))
+
+	template = valid()
+	template.AccessModes = []string{"ReadWriteSometimes"}
+	assert.Error(t, ValidateVolumeClaimTemplate(template))
+}
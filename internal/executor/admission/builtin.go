@@ -0,0 +1,77 @@
+package admission
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+)
+
+// LabelStamping adds labels and annotations to every pod, values already present on the pod are kept
+type LabelStamping struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func (p *LabelStamping) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	pod = pod.DeepCopy()
+	pod.Labels = stamp(pod.Labels, p.Labels)
+	pod.Annotations = stamp(pod.Annotations, p.Annotations)
+	return pod, nil
+}
+
+// NodeSelectorStamping sets node selectors on every pod, overriding selectors of the job with the same key
+type NodeSelectorStamping struct {
+	NodeSelector map[string]string
+}
+
+func (p *NodeSelectorStamping) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	pod = pod.DeepCopy()
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = make(map[string]string, len(p.NodeSelector))
+	}
+	for k, v := range p.NodeSelector {
+		pod.Spec.NodeSelector[k] = v
+	}
+	return pod, nil
+}
+
+// FromConfig returns the configured built-in plugins followed by the additional plugins, in the order given
+func FromConfig(config configuration.AdmissionConfiguration, kubernetesClient kubernetes.Interface, additional ...Plugin) Plugin {
+	plugins := Chain{}
+	if len(config.QueueNamespace.Mapping) > 0 || config.QueueNamespace.Prefix != "" {
+		plugins = append(plugins, NewQueueNamespace(config.QueueNamespace, kubernetesClient))
+	}
+	if len(config.StampLabels) > 0 || len(config.StampAnnotations) > 0 {
+		plugins = append(plugins, &LabelStamping{Labels: config.StampLabels, Annotations: config.StampAnnotations})
+	}
+	if len(config.StampNodeSelector) > 0 {
+		plugins = append(plugins, &NodeSelectorStamping{NodeSelector: config.StampNodeSelector})
+	}
+	if len(config.NodePressure.QueueSeconds) > 0 {
+		plugins = append(plugins, NewNodePressureToleration(config.NodePressure))
+	}
+	if len(config.Sidecar.Containers) > 0 || len(config.Sidecar.Volumes) > 0 {
+		plugins = append(plugins, NewSidecarInjection(config.Sidecar))
+	}
+	plugins = append(plugins, additional...)
+	if len(plugins) == 0 {
+		return NoOp{}
+	}
+	return plugins
+}
+
+func stamp(existing map[string]string, values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return existing
+	}
+	if existing == nil {
+		existing = make(map[string]string, len(values))
+	}
+	for k, v := range values {
+		if _, present := existing[k]; !present {
+			existing[k] = v
+		}
+	}
+	return existing
+}
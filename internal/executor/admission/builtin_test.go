@@ -0,0 +1,97 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestLabelStamping_KeepsExistingLabels(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"armada_job_id": "job-1"}}}
+	plugin := &LabelStamping{Labels: map[string]string{"armada_job_id": "other", "cost-centre": "research"}}
+
+	admitted, err := plugin.Admit(pod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"armada_job_id": "job-1", "cost-centre": "research"}, admitted.Labels)
+	assert.Equal(t, map[string]string{"armada_job_id": "job-1"}, pod.Labels)
+}
+
+func TestNodeSelectorStamping_OverridesJobSelector(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeSelector: map[string]string{"zone": "a", "gpu": "true"}}}
+	plugin := &NodeSelectorStamping{NodeSelector: map[string]string{"zone": "b"}}
+
+	admitted, err := plugin.Admit(pod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"zone": "b", "gpu": "true"}, admitted.Spec.NodeSelector)
+}
+
+func TestFromConfig(t *testing.T) {
+	assert.Equal(t, NoOp{}, FromConfig(configuration.AdmissionConfiguration{}, nil))
+
+	plugin := FromConfig(configuration.AdmissionConfiguration{
+		StampLabels:       map[string]string{"team": "a"},
+		StampNodeSelector: map[string]string{"zone": "b"},
+	}, nil)
+	admitted, err := plugin.Admit(&v1.Pod{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a", admitted.Labels["team"])
+	assert.Equal(t, "b", admitted.Spec.NodeSelector["zone"])
+}
+
+func TestFromConfig_RunsAdditionalPluginsAfterBuiltInPlugins(t *testing.T) {
+	plugin := FromConfig(configuration.AdmissionConfiguration{StampLabels: map[string]string{"team": "a"}}, nil,
+		pluginFunc(func(pod *v1.Pod) (*v1.Pod, error) {
+			pod = pod.DeepCopy()
+			pod.Labels["team"] = pod.Labels["team"] + "-checked"
+			return pod, nil
+		}))
+
+	admitted, err := plugin.Admit(&v1.Pod{})
+	assert.NoError(t, err)
+	assert.Equal(t, "a-checked", admitted.Labels["team"])
+}
+
+func TestChain_RestoresArmadaMetadata(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Labels:      map[string]string{domain.JobId: "job-1", domain.Queue: "queue1"},
+		Annotations: map[string]string{domain.JobOwner: "user1"},
+	}}
+	chain := Chain{pluginFunc(func(pod *v1.Pod) (*v1.Pod, error) {
+		pod = pod.DeepCopy()
+		pod.Labels = map[string]string{domain.JobId: "other", "team": "a"}
+		pod.Annotations = nil
+		return pod, nil
+	})}
+
+	admitted, err := chain.Admit(pod)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{domain.JobId: "job-1", domain.Queue: "queue1", "team": "a"}, admitted.Labels)
+	assert.Equal(t, map[string]string{domain.JobOwner: "user1"}, admitted.Annotations)
+}
+
+func TestChain_StopsOnRejection(t *testing.T) {
+	called := false
+	chain := Chain{
+		pluginFunc(func(pod *v1.Pod) (*v1.Pod, error) { return nil, Reject("not allowed") }),
+		pluginFunc(func(pod *v1.Pod) (*v1.Pod, error) { called = true; return pod, nil }),
+	}
+
+	_, err := chain.Admit(&v1.Pod{})
+
+	assert.IsType(t, &Rejection{}, err)
+	assert.False(t, called)
+}
+
+type pluginFunc func(pod *v1.Pod) (*v1.Pod, error)
+
+func (f pluginFunc) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	return f(pod)
+}
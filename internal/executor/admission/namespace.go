@@ -0,0 +1,92 @@
+package admission
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+var invalidNamespaceCharacters = regexp.MustCompile("[^a-z0-9-]+")
+
+// QueueNamespace moves pods into the namespace of their queue, taken from the mapping or made up of prefix and queue name.
+// Pods of queues with neither keep the namespace they were submitted with.
+type QueueNamespace struct {
+	mapping          map[string]string
+	prefix           string
+	createNamespaces bool
+	kubernetesClient kubernetes.Interface
+
+	mutex             sync.Mutex
+	createdNamespaces map[string]bool
+}
+
+func NewQueueNamespace(config configuration.QueueNamespaceConfiguration, kubernetesClient kubernetes.Interface) *QueueNamespace {
+	mapping := make(map[string]string, len(config.Mapping))
+	for queue, namespace := range config.Mapping {
+		// configuration keys are case insensitive
+		mapping[strings.ToLower(queue)] = namespace
+	}
+	return &QueueNamespace{
+		mapping:           mapping,
+		prefix:            config.Prefix,
+		createNamespaces:  config.CreateNamespaces,
+		kubernetesClient:  kubernetesClient,
+		createdNamespaces: map[string]bool{},
+	}
+}
+
+func (p *QueueNamespace) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	namespace := p.namespaceOf(pod.Labels[domain.Queue])
+	if namespace == "" {
+		return pod, nil
+	}
+	if p.createNamespaces {
+		if err := p.ensureNamespace(namespace); err != nil {
+			return nil, err
+		}
+	}
+	pod = pod.DeepCopy()
+	pod.Namespace = namespace
+	return pod, nil
+}
+
+func (p *QueueNamespace) namespaceOf(queue string) string {
+	if queue == "" {
+		return ""
+	}
+	if namespace, ok := p.mapping[strings.ToLower(queue)]; ok {
+		return namespace
+	}
+	if p.prefix == "" {
+		return ""
+	}
+	name := invalidNamespaceCharacters.ReplaceAllString(strings.ToLower(p.prefix+queue), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.Trim(name, "-")
+}
+
+func (p *QueueNamespace) ensureNamespace(namespace string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.createdNamespaces[namespace] {
+		return nil
+	}
+	_, err := p.kubernetesClient.CoreV1().Namespaces().Create(context.Background(),
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	p.createdNamespaces[namespace] = true
+	return nil
+}
@@ -0,0 +1,51 @@
+package admission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestQueueNamespace_UsesMappingBeforePrefix(t *testing.T) {
+	plugin := NewQueueNamespace(configuration.QueueNamespaceConfiguration{
+		Mapping: map[string]string{"research": "team-research"},
+		Prefix:  "armada-",
+	}, fake.NewSimpleClientset())
+
+	assert.Equal(t, "team-research", admitQueuePod(t, plugin, "Research").Namespace)
+	assert.Equal(t, "armada-ml-team", admitQueuePod(t, plugin, "ML_Team").Namespace)
+}
+
+func TestQueueNamespace_KeepsNamespaceWithoutMappingAndPrefix(t *testing.T) {
+	plugin := NewQueueNamespace(configuration.QueueNamespaceConfiguration{
+		Mapping: map[string]string{"research": "team-research"},
+	}, fake.NewSimpleClientset())
+
+	assert.Equal(t, "default", admitQueuePod(t, plugin, "other").Namespace)
+}
+
+func TestQueueNamespace_CreatesNamespaceOnce(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	plugin := NewQueueNamespace(configuration.QueueNamespaceConfiguration{Prefix: "armada-", CreateNamespaces: true}, client)
+
+	admitQueuePod(t, plugin, "research")
+	admitQueuePod(t, plugin, "research")
+	assert.Equal(t, 1, len(client.Fake.Actions()))
+
+	_, err := client.CoreV1().Namespaces().Get(context.Background(), "armada-research", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func admitQueuePod(t *testing.T, plugin Plugin, queue string) *v1.Pod {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Labels: map[string]string{domain.Queue: queue}}}
+	admitted, err := plugin.Admit(pod)
+	assert.NoError(t, err)
+	return admitted
+}
@@ -0,0 +1,77 @@
+package admission
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Plugin is called for every pod right before the executor creates it in the cluster.
+// It returns the pod to create, which can be mutated, or an error. Returning a Rejection fails the job,
+// any other error is treated as a failed submission and the lease is returned.
+type Plugin interface {
+	Admit(pod *v1.Pod) (*v1.Pod, error)
+}
+
+type Rejection struct {
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("pod rejected by admission plugin: %s", r.Reason)
+}
+
+func Reject(format string, args ...interface{}) error {
+	return &Rejection{Reason: fmt.Sprintf(format, args...)}
+}
+
+type NoOp struct{}
+
+func (NoOp) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	return pod, nil
+}
+
+// Labels and annotations the executor relies on to track the job, like its id, start with this prefix
+const armadaMetadataPrefix = "armada_"
+
+// Chain runs plugins in order, each one receives the pod returned by the previous one.
+// Armada labels and annotations changed or removed by a plugin are restored.
+type Chain []Plugin
+
+func (c Chain) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	labels := armadaMetadata(pod.Labels)
+	annotations := armadaMetadata(pod.Annotations)
+	for _, plugin := range c {
+		admitted, err := plugin.Admit(pod)
+		if err != nil {
+			return nil, err
+		}
+		pod = admitted
+	}
+	pod.Labels = restore(pod.Labels, labels)
+	pod.Annotations = restore(pod.Annotations, annotations)
+	return pod, nil
+}
+
+func armadaMetadata(values map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range values {
+		if strings.HasPrefix(k, armadaMetadataPrefix) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func restore(values map[string]string, original map[string]string) map[string]string {
+	for k, v := range original {
+		if current, present := values[k]; !present || current != v {
+			if values == nil {
+				values = make(map[string]string, len(original))
+			}
+			values[k] = v
+		}
+	}
+	return values
+}
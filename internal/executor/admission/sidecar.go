@@ -0,0 +1,62 @@
+package admission
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+// SidecarInjection adds the configured containers and volumes to every pod, in configuration order.
+// Containers and volumes with a name already used by the pod are skipped, so the job can override them and pods admitted twice are unchanged.
+// Added containers are declared as sidecars, so jobs finish once their own containers do.
+type SidecarInjection struct {
+	containers []v1.Container
+	volumes    []v1.Volume
+}
+
+func NewSidecarInjection(config configuration.SidecarInjectionConfiguration) *SidecarInjection {
+	return &SidecarInjection{containers: config.Containers, volumes: config.Volumes}
+}
+
+func (p *SidecarInjection) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	pod = pod.DeepCopy()
+
+	containerNames := map[string]bool{}
+	for _, container := range pod.Spec.InitContainers {
+		containerNames[container.Name] = true
+	}
+	for _, container := range pod.Spec.Containers {
+		containerNames[container.Name] = true
+	}
+	sidecars := []string{}
+	for _, container := range p.containers {
+		if !containerNames[container.Name] {
+			pod.Spec.Containers = append(pod.Spec.Containers, *container.DeepCopy())
+			sidecars = append(sidecars, container.Name)
+		}
+	}
+
+	volumeNames := map[string]bool{}
+	for _, volume := range pod.Spec.Volumes {
+		volumeNames[volume.Name] = true
+	}
+	for _, volume := range p.volumes {
+		if !volumeNames[volume.Name] {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, *volume.DeepCopy())
+		}
+	}
+
+	if len(sidecars) > 0 {
+		if existing := pod.Annotations[domain.SidecarContainers]; existing != "" {
+			sidecars = append([]string{existing}, sidecars...)
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[domain.SidecarContainers] = strings.Join(sidecars, ",")
+	}
+	return pod, nil
+}
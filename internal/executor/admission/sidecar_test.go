@@ -0,0 +1,68 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestSidecarInjection_AddsContainersAndVolumes(t *testing.T) {
+	plugin := NewSidecarInjection(sidecarConfig())
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.SidecarContainers: "proxy"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main"}, {Name: "proxy"}}},
+	}
+
+	admitted, err := plugin.Admit(pod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"main", "proxy", "log-agent"}, containerNames(admitted))
+	assert.Equal(t, "logs", admitted.Spec.Volumes[0].Name)
+	assert.Equal(t, "proxy,log-agent", admitted.Annotations[domain.SidecarContainers])
+	assert.Equal(t, []string{"main", "proxy"}, containerNames(pod))
+}
+
+func TestSidecarInjection_KeepsContainersAndVolumesOfTheJob(t *testing.T) {
+	plugin := NewSidecarInjection(sidecarConfig())
+	pod := &v1.Pod{Spec: v1.PodSpec{
+		Containers: []v1.Container{{Name: "main"}, {Name: "log-agent", Image: "custom-agent"}},
+		Volumes:    []v1.Volume{{Name: "logs", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/logs"}}}},
+	}}
+
+	admitted, err := plugin.Admit(pod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, pod, admitted)
+}
+
+func TestSidecarInjection_IsIdempotent(t *testing.T) {
+	plugin := NewSidecarInjection(sidecarConfig())
+	pod := &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main"}}}}
+
+	once, err := plugin.Admit(pod)
+	assert.NoError(t, err)
+	twice, err := plugin.Admit(once)
+	assert.NoError(t, err)
+
+	assert.Equal(t, once, twice)
+}
+
+func sidecarConfig() configuration.SidecarInjectionConfiguration {
+	return configuration.SidecarInjectionConfiguration{
+		Containers: []v1.Container{{Name: "log-agent", Image: "log-agent:1.0", VolumeMounts: []v1.VolumeMount{{Name: "logs", MountPath: "/logs"}}}},
+		Volumes:    []v1.Volume{{Name: "logs", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}},
+	}
+}
+
+func containerNames(pod *v1.Pod) []string {
+	names := []string{}
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+	return names
+}
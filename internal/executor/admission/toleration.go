@@ -0,0 +1,71 @@
+package admission
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+var defaultNodePressureTaints = []string{
+	"node.kubernetes.io/memory-pressure",
+	"node.kubernetes.io/disk-pressure",
+	"node.kubernetes.io/pid-pressure",
+}
+
+// NodePressureToleration sets how long pods of each queue tolerate NoExecute node pressure taints before they are evicted.
+// Tolerations of the job for these taints are replaced, pods of queues without configuration are left unchanged.
+type NodePressureToleration struct {
+	taints       []string
+	queueSeconds map[string]int64
+}
+
+func NewNodePressureToleration(config configuration.NodePressureTolerationConfiguration) *NodePressureToleration {
+	taints := config.Taints
+	if len(taints) == 0 {
+		taints = defaultNodePressureTaints
+	}
+	queueSeconds := make(map[string]int64, len(config.QueueSeconds))
+	for queue, seconds := range config.QueueSeconds {
+		// configuration keys are case insensitive
+		queueSeconds[strings.ToLower(queue)] = seconds
+	}
+	return &NodePressureToleration{taints: taints, queueSeconds: queueSeconds}
+}
+
+func (p *NodePressureToleration) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	seconds, ok := p.queueSeconds[strings.ToLower(pod.Labels[domain.Queue])]
+	if !ok {
+		return pod, nil
+	}
+
+	pod = pod.DeepCopy()
+	tolerations := make([]v1.Toleration, 0, len(pod.Spec.Tolerations)+len(p.taints))
+	for _, toleration := range pod.Spec.Tolerations {
+		if !p.isPressureTaint(toleration.Key) {
+			tolerations = append(tolerations, toleration)
+		}
+	}
+	for _, taint := range p.taints {
+		toleration := v1.Toleration{Key: taint, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute}
+		// negative seconds tolerate the taint indefinitely
+		if seconds >= 0 {
+			tolerationSeconds := seconds
+			toleration.TolerationSeconds = &tolerationSeconds
+		}
+		tolerations = append(tolerations, toleration)
+	}
+	pod.Spec.Tolerations = tolerations
+	return pod, nil
+}
+
+func (p *NodePressureToleration) isPressureTaint(key string) bool {
+	for _, taint := range p.taints {
+		if taint == key {
+			return true
+		}
+	}
+	return false
+}
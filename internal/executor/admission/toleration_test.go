@@ -0,0 +1,47 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestNodePressureToleration_SetsTolerationSecondsOfQueue(t *testing.T) {
+	plugin := NewNodePressureToleration(configuration.NodePressureTolerationConfiguration{
+		Taints:       []string{"node.kubernetes.io/memory-pressure"},
+		QueueSeconds: map[string]int64{"critical": 3600, "batch": 0, "system": -1},
+	})
+
+	critical := admitQueuePod(t, plugin, "Critical").Spec.Tolerations
+	assert.Equal(t, 1, len(critical))
+	assert.Equal(t, "node.kubernetes.io/memory-pressure", critical[0].Key)
+	assert.Equal(t, v1.TaintEffectNoExecute, critical[0].Effect)
+	assert.Equal(t, int64(3600), *critical[0].TolerationSeconds)
+
+	assert.Equal(t, int64(0), *admitQueuePod(t, plugin, "batch").Spec.Tolerations[0].TolerationSeconds)
+	assert.Nil(t, admitQueuePod(t, plugin, "system").Spec.Tolerations[0].TolerationSeconds)
+	assert.Empty(t, admitQueuePod(t, plugin, "other").Spec.Tolerations)
+}
+
+func TestNodePressureToleration_ReplacesTolerationsOfJob(t *testing.T) {
+	plugin := NewNodePressureToleration(configuration.NodePressureTolerationConfiguration{QueueSeconds: map[string]int64{"batch": 10}})
+	pod := &v1.Pod{Spec: v1.PodSpec{Tolerations: []v1.Toleration{
+		{Key: "node.kubernetes.io/disk-pressure", Operator: v1.TolerationOpExists},
+		{Key: "gpu", Operator: v1.TolerationOpExists},
+	}}}
+	pod.Labels = map[string]string{domain.Queue: "batch"}
+
+	admitted, err := plugin.Admit(pod)
+	assert.NoError(t, err)
+	assert.Equal(t, 1+len(defaultNodePressureTaints), len(admitted.Spec.Tolerations))
+	assert.Equal(t, "gpu", admitted.Spec.Tolerations[0].Key)
+	for _, toleration := range admitted.Spec.Tolerations[1:] {
+		assert.Equal(t, int64(10), *toleration.TolerationSeconds)
+	}
+	// original pod is not modified
+	assert.Equal(t, 2, len(pod.Spec.Tolerations))
+}
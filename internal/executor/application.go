@@ -1,6 +1,8 @@
 package executor
 
 import (
+	ctx "context"
+	"fmt"
 	"os"
 	"sync"
 	"time"
@@ -8,8 +10,11 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 
+	"github.com/G-Research/armada/internal/common/health"
 	"github.com/G-Research/armada/internal/common/task"
+	"github.com/G-Research/armada/internal/executor/admission"
 	"github.com/G-Research/armada/internal/executor/cluster"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/context"
@@ -18,11 +23,16 @@ import (
 	"github.com/G-Research/armada/internal/executor/metrics/pod_metrics"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/service"
+	"github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client"
 )
 
-func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGroup) {
+const shutdownTimeout = 2 * time.Second
+const apiBackoffInitialInterval = time.Second
+
+// Admission plugins compiled into the executor are passed as plugins, they run after the configured built-in plugins
+func StartUp(config configuration.ExecutorConfiguration, healthChecks *health.Checks, plugins ...admission.Plugin) (func(), *sync.WaitGroup) {
 
 	kubernetesClientProvider, err := cluster.NewKubernetesClientProvider(&config.Kubernetes)
 
@@ -34,7 +44,9 @@ func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGrou
 	clusterContext := context.NewClusterContext(
 		config.Application,
 		2*time.Minute,
-		kubernetesClientProvider)
+		kubernetesClientProvider,
+		admission.FromConfig(config.Kubernetes.Admission, kubernetesClientProvider.Client(), plugins...))
+	healthChecks.Readiness.Add("informers", health.CheckerFunc(clusterContext.CheckInformersSynced))
 
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
@@ -42,10 +54,10 @@ func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGrou
 	taskManager := task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix)
 	taskManager.Register(clusterContext.ProcessPodsToDelete, config.Task.PodDeletionInterval, "pod_deletion")
 
-	return StartUpWithContext(config, clusterContext, kubernetesClientProvider, taskManager, wg)
+	return StartUpWithContext(config, clusterContext, kubernetesClientProvider, taskManager, wg, healthChecks)
 }
 
-func StartUpWithContext(config configuration.ExecutorConfiguration, clusterContext context.ClusterContext, kubernetesClientProvider cluster.KubernetesClientProvider, taskManager *task.BackgroundTaskManager, wg *sync.WaitGroup) (func(), *sync.WaitGroup) {
+func StartUpWithContext(config configuration.ExecutorConfiguration, clusterContext context.ClusterContext, kubernetesClientProvider cluster.KubernetesClientProvider, taskManager *task.BackgroundTaskManager, wg *sync.WaitGroup, healthChecks *health.Checks) (func(), *sync.WaitGroup) {
 
 	conn, err := createConnectionToApi(config)
 	if err != nil {
@@ -59,9 +71,28 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
-		eventClient)
+		eventClient,
+		config.Task.MissingJobEventReconciliationConcurrency,
+		config.Kubernetes.ReportedNodeLabels,
+		config.Kubernetes.InstanceTypeLabel,
+		config.Kubernetes.NodeOomDetectionQPS,
+		config.Kubernetes.NodeOomDetectionBurst,
+		config.Kubernetes.FailedPodLogLines,
+		config.Kubernetes.FailedPodLogMaxBytes,
+		config.Kubernetes.FailedPodLogQPS,
+		config.Kubernetes.FailedPodLogBurst,
+		config.Kubernetes.FailedPodLogMaxConcurrentFetches)
 
 	jobContext := job_context.NewClusterJobContext(clusterContext)
+	serverClock := util.NewServerClock(config.ClockSkew.WarningThreshold, config.ClockSkew.UseServerTime)
+	var apiBackoff *util.ApiBackoff
+	if config.Task.ApiBackoffMaxInterval > 0 {
+		apiBackoff = util.NewApiBackoff(apiBackoffInitialInterval, config.Task.ApiBackoffMaxInterval)
+	}
+	var renewalBackoff *util.ApiBackoff
+	if config.Task.ApiRenewalBackoffMaxInterval > 0 {
+		renewalBackoff = util.NewApiBackoff(apiBackoffInitialInterval, config.Task.ApiRenewalBackoffMaxInterval)
+	}
 
 	jobLeaseService := service.NewJobLeaseService(
 		clusterContext,
@@ -69,41 +100,92 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 		queueClient,
 		config.Kubernetes.MinimumPodAge,
 		config.Kubernetes.FailedPodExpiry,
-		config.Kubernetes.MinimumJobSize)
-
+		config.Kubernetes.MinimumJobSize,
+		config.Kubernetes.PreemptibleNodes,
+		config.Task.JobLeaseRenewalMaxRetries,
+		config.Task.JobLeaseRenewalRetryBackoff,
+		config.Task.JobLeaseRenewalInterval,
+		config.Task.MinimumLeaseHoldTime,
+		serverClock,
+		apiBackoff,
+		renewalBackoff)
+
+	var gpuUtilisationCollector service.GpuUtilisationCollector
+	if config.GpuUtilisation.DcgmExporterPort > 0 {
+		gpuUtilisationCollector = service.NewDcgmGpuUtilisationCollector(config.GpuUtilisation)
+	}
 	queueUtilisationService := service.NewMetricsServerQueueUtilisationService(
-		clusterContext)
+		clusterContext,
+		gpuUtilisationCollector)
 
 	clusterUtilisationService := service.NewClusterUtilisationService(
 		clusterContext,
 		queueUtilisationService,
 		usageClient,
 		config.Kubernetes.TrackedNodeLabels,
-		config.Kubernetes.ToleratedTaints)
+		config.Kubernetes.ToleratedTaints,
+		config.Kubernetes.OversubscriptionFactor,
+		renewalBackoff,
+		config.Kubernetes.NodeDrain.Taints,
+		config.Kubernetes.NodeDrain.Annotations)
 
 	stuckPodDetector := service.NewPodProgressMonitorService(
 		clusterContext,
 		jobContext,
 		eventReporter,
 		jobLeaseService,
-		config.Kubernetes.StuckPodExpiry)
+		clusterUtilisationService,
+		config.Kubernetes.StuckPodExpiry,
+		config.Kubernetes.ImagePullTimeout,
+		config.Kubernetes.MissingVolumeExpiry,
+		config.Kubernetes.UnknownPodExpiry,
+		config.Kubernetes.DeleteDeadlineExceededPods,
+		serverClock,
+		config.Task.MinimumLeaseHoldTime)
+
+	podNamer, err := service.NewPodNamer(config.Kubernetes.PodNameTemplate)
+	if err != nil {
+		log.Errorf("Invalid pod name template: %s", err)
+		os.Exit(-1)
+	}
 
 	clusterAllocationService := service.NewClusterAllocationService(
 		clusterContext,
 		eventReporter,
 		jobLeaseService,
-		clusterUtilisationService)
+		clusterUtilisationService,
+		config.Kubernetes.MaxInFlightLeases,
+		config.Kubernetes.MaxConcurrentPodSubmissions,
+		config.Kubernetes.PriorityClassBands,
+		config.Kubernetes.OomRetry,
+		podNamer,
+		config.Kubernetes.PreemptibleNodes)
 
 	pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, queueUtilisationService)
 
-	taskManager.Register(clusterUtilisationService.ReportClusterUtilisation, config.Task.UtilisationReportingInterval, "utilisation_reporting")
-	taskManager.Register(clusterAllocationService.AllocateSpareClusterCapacity, config.Task.AllocateSpareClusterCapacityInterval, "job_lease_request")
-	taskManager.Register(jobLeaseService.ManageJobLeases, config.Task.JobLeaseRenewalInterval, "job_lease_renewal")
-	taskManager.Register(eventReporter.ReportMissingJobEvents, config.Task.MissingJobEventReconciliationInterval, "event_reconciliation")
-	taskManager.Register(stuckPodDetector.HandleStuckPods, config.Task.StuckPodScanInterval, "stuck_pod")
+	registerTask := func(backgroundTask func(ctx.Context), interval time.Duration, name string) {
+		taskManager.RegisterWithTimeout(backgroundTask, interval, config.Task.Timeouts[name], name)
+	}
+
+	registerTask(clusterUtilisationService.ReportClusterUtilisation, config.Task.UtilisationReportingInterval, "utilisation_reporting")
+	registerTask(task.WithoutContext(clusterAllocationService.AllocateSpareClusterCapacity), config.Task.AllocateSpareClusterCapacityInterval, "job_lease_request")
+	registerTask(task.WithoutContext(jobLeaseService.ManageJobLeases), config.Task.JobLeaseRenewalInterval, "job_lease_renewal")
+	registerTask(task.WithoutContext(eventReporter.ReportMissingJobEvents), config.Task.MissingJobEventReconciliationInterval, "event_reconciliation")
+	registerTask(task.WithoutContext(stuckPodDetector.HandleStuckPods), config.Task.StuckPodScanInterval, "stuck_pod")
+
+	if len(config.Kubernetes.NodeDrain.Taints) > 0 || len(config.Kubernetes.NodeDrain.Annotations) > 0 {
+		registerTask(task.WithoutContext(func() {
+			drainingNodes, err := clusterUtilisationService.GetDrainingNodes()
+			if err != nil {
+				log.Errorf("Failed to get draining nodes because %s", err)
+				return
+			}
+			jobLeaseService.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+		}), config.Task.NodeDrainScanInterval, "node_drain")
+	}
 
 	if config.Metric.ExposeQueueUsageMetrics {
-		taskManager.Register(queueUtilisationService.RefreshUtilisationData, config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
+		registerTask(task.WithoutContext(queueUtilisationService.RefreshUtilisationData), config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
 
 		if config.Task.UtilisationEventReportingInterval > 0 {
 			podUtilisationReporter := service.NewUtilisationEventReporter(
@@ -111,17 +193,34 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 				queueUtilisationService,
 				eventReporter,
 				config.Task.UtilisationEventReportingInterval)
-			taskManager.Register(podUtilisationReporter.ReportUtilisationEvents, config.Task.UtilisationEventProcessingInterval, "pod_utilisation_event_reporting")
+			registerTask(task.WithoutContext(podUtilisationReporter.ReportUtilisationEvents), config.Task.UtilisationEventProcessingInterval, "pod_utilisation_event_reporting")
 		}
 	}
 
+	healthChecks.Liveness.Add("background_tasks", health.CheckerFunc(func() error {
+		return taskManager.CheckRunning(config.Health.TaskStallTimeout)
+	}))
+	healthChecks.Readiness.Add("api_connection", health.CheckerFunc(func() error {
+		if state := conn.GetState(); state != connectivity.Ready {
+			return fmt.Errorf("connection to %s is %s", config.ApiConnection.ArmadaUrl, state)
+		}
+		return nil
+	}))
+	healthChecks.Readiness.Add("utilisation_reporting", health.CheckerFunc(func() error {
+		return clusterUtilisationService.CheckUtilisationReported(config.Health.MaxUtilisationReportAge)
+	}))
+
 	return func() {
+		// tasks are stopped first, so no jobs are leased while leases are returned, both share the shutdown timeout
+		returnLeasesCtx, cancel := ctx.WithTimeout(ctx.Background(), shutdownTimeout)
+		if taskManager.StopAll(shutdownTimeout / 2) {
+			log.Warnf("Graceful shutdown timed out")
+		}
+		jobLeaseService.ReturnLeases(returnLeasesCtx, eventReporter)
+		cancel()
 		stopReporter <- true
 		clusterContext.Stop()
 		conn.Close()
-		if taskManager.StopAll(2 * time.Second) {
-			log.Warnf("Graceful shutdown timed out")
-		}
 		log.Infof("Shutdown complete")
 		wg.Done()
 	}, wg
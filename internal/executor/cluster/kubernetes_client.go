@@ -29,6 +29,9 @@ func NewKubernetesClientProvider(kubernetesConfig *configuration.KubernetesConfi
 
 	config.Burst = 10000
 	config.QPS = 10000
+	if kubernetesConfig.PodMutationQPS > 0 {
+		config.Wrap(podMutationRateLimit(kubernetesConfig.PodMutationQPS, kubernetesConfig.PodMutationBurst))
+	}
 
 	client, err := kubernetes.NewForConfig(config)
 	if err != nil {
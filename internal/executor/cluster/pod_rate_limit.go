@@ -0,0 +1,46 @@
+package cluster
+
+import (
+	"net/http"
+	"strings"
+
+	"k8s.io/client-go/transport"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Requests creating, deleting or patching pods wait for the rate limiter, other requests are not limited.
+// The limiter is shared by all clients created from the same config, including impersonating ones.
+type podMutationRateLimitedRoundTripper struct {
+	rateLimiter flowcontrol.RateLimiter
+	delegate    http.RoundTripper
+}
+
+func podMutationRateLimit(qps float32, burst int) transport.WrapperFunc {
+	if burst < 1 {
+		burst = 1
+	}
+	rateLimiter := flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &podMutationRateLimitedRoundTripper{rateLimiter: rateLimiter, delegate: rt}
+	}
+}
+
+func (rt *podMutationRateLimitedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isPodMutation(req) {
+		if err := rt.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return rt.delegate.RoundTrip(req)
+}
+
+func isPodMutation(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodDelete, http.MethodPatch:
+	default:
+		return false
+	}
+	// pod requests look like /api/v1/namespaces/<namespace>/pods[/<name>[/<subresource>]]
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	return len(segments) >= 5 && segments[0] == "api" && segments[2] == "namespaces" && segments[4] == "pods"
+}
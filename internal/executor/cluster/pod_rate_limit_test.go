@@ -0,0 +1,56 @@
+package cluster
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPodMutation(t *testing.T) {
+	assert.True(t, isPodMutation(makeRequest(t, http.MethodPost, "/api/v1/namespaces/default/pods")))
+	assert.True(t, isPodMutation(makeRequest(t, http.MethodDelete, "/api/v1/namespaces/default/pods/pod-1")))
+	assert.True(t, isPodMutation(makeRequest(t, http.MethodPatch, "/api/v1/namespaces/default/pods/pod-1")))
+
+	assert.False(t, isPodMutation(makeRequest(t, http.MethodGet, "/api/v1/namespaces/default/pods/pod-1")))
+	assert.False(t, isPodMutation(makeRequest(t, http.MethodPost, "/api/v1/namespaces/default/events")))
+	assert.False(t, isPodMutation(makeRequest(t, http.MethodPatch, "/api/v1/nodes/pods")))
+}
+
+func TestPodMutationRateLimit_OnlyLimitsPodMutations(t *testing.T) {
+	delegate := &countingRoundTripper{}
+	rt := podMutationRateLimit(1, 1)(delegate)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		_, err := rt.RoundTrip(makeRequest(t, http.MethodGet, "/api/v1/namespaces/default/pods"))
+		assert.NoError(t, err)
+	}
+	_, err := rt.RoundTrip(makeRequest(t, http.MethodPost, "/api/v1/namespaces/default/pods"))
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	_, err = rt.RoundTrip(makeRequest(t, http.MethodPost, "/api/v1/namespaces/default/pods"))
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) > 500*time.Millisecond)
+	assert.Equal(t, 12, delegate.calls)
+}
+
+type countingRoundTripper struct {
+	calls int
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.calls++
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func makeRequest(t *testing.T, method string, path string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://kubernetes"+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
@@ -3,6 +3,9 @@ package configuration
 import (
 	"time"
 
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/client"
 )
@@ -13,25 +16,127 @@ type ApplicationConfiguration struct {
 }
 
 type KubernetesConfiguration struct {
-	ImpersonateUsers  bool
-	TrackedNodeLabels []string
-	ToleratedTaints   []string
-	MinimumPodAge     time.Duration
-	FailedPodExpiry   time.Duration
-	StuckPodExpiry    time.Duration
-	MinimumJobSize    common.ComputeResources
+	ImpersonateUsers    bool
+	TrackedNodeLabels   []string
+	ReportedNodeLabels  []string
+	ToleratedTaints     []string
+	MinimumPodAge       time.Duration
+	FailedPodExpiry     time.Duration
+	StuckPodExpiry      time.Duration
+	ImagePullTimeout    time.Duration // how long scheduled pods can be pulling images before they are stuck, 0 uses StuckPodExpiry
+	MissingVolumeExpiry time.Duration
+	UnknownPodExpiry    time.Duration
+	MinimumJobSize      common.ComputeResources
+	MaxInFlightLeases   int
+	// Number of jobs whose pods are created in parallel, 0 or 1 submits jobs one by one
+	MaxConcurrentPodSubmissions int
+	PodMutationQPS              float32 // Rate limit of pod create, delete and patch requests to the API server, 0 disables the limit
+	PodMutationBurst            int
+	// Rate limit of node lookups used to detect containers killed by node memory pressure, 0 disables the detection
+	NodeOomDetectionQPS   float32
+	NodeOomDetectionBurst int
+	// Last lines of logs of failed pod containers attached to the failed event, 0 disables the capture
+	FailedPodLogLines int64
+	// Maximum size of logs attached to one failed event, older log lines are dropped first, 0 uses 64KiB
+	FailedPodLogMaxBytes int
+	// Rate limit of log captures of failed pods, failures over the limit are reported without logs, 0 disables the limit
+	FailedPodLogQPS   float32
+	FailedPodLogBurst int
+	// Number of failed pods whose logs are captured at the same time, 0 does not limit the concurrency
+	FailedPodLogMaxConcurrentFetches int
+	// Node label with the instance type, e.g. node.kubernetes.io/instance-type, reported in events of finished jobs
+	InstanceTypeLabel string
+	// Template of the first part of pod names with {{queue}}, {{jobSet}} and {{owner}} placeholders, followed by the job id
+	// and pod number. Empty names pods armada-<job id>-<pod number>
+	PodNameTemplate string
+	// Report jobs done and delete their pods as soon as a pod exceeds activeDeadlineSeconds, instead of after FailedPodExpiry
+	DeleteDeadlineExceededPods bool
+	PriorityClassBands         []PriorityClassBand
+	// Per resource factor allocatable resources of nodes are multiplied by when reported to the server, resources without a factor are not oversubscribed
+	OversubscriptionFactor map[string]float64
+	Admission              AdmissionConfiguration
+	NodeDrain              NodeDrainConfiguration
+	OomRetry               OomRetryConfiguration
+	PreemptibleNodes       PreemptibleNodeConfiguration
+}
+
+// Pods of jobs tolerating preemptible (spot) nodes tolerate their taint, pods of other jobs get node affinity
+// keeping them off nodes with the label and don't tolerate the taint
+type PreemptibleNodeConfiguration struct {
+	Label      string // key of the node label of preemptible nodes, e.g. cloud.google.com/gke-spot
+	LabelValue string // value of the label on preemptible nodes, empty matches nodes with any value
+	Taint      string // key of the taint of preemptible nodes
+}
+
+// Pods of jobs with retries killed for exceeding their memory limit are recreated with the memory of the killed containers
+// multiplied by MemoryFactor, factors up to 1 disable it for jobs not setting their own factor
+type OomRetryConfiguration struct {
+	MemoryFactor float64
+	MaxMemory    resource.Quantity // the increased memory never exceeds it, zero means no limit
+}
+
+// Jobs on nodes about to be removed are returned to the server before the node goes away
+type NodeDrainConfiguration struct {
+	Taints      []string // keys of node taints marking the node for removal, e.g. ToBeDeletedByClusterAutoscaler
+	Annotations []string // keys of node annotations marking the node for removal
+}
+
+// Built-in admission plugins applied to every pod before it is created
+type AdmissionConfiguration struct {
+	StampLabels       map[string]string
+	StampAnnotations  map[string]string
+	StampNodeSelector map[string]string
+	QueueNamespace    QueueNamespaceConfiguration
+	NodePressure      NodePressureTolerationConfiguration
+	Sidecar           SidecarInjectionConfiguration
+}
+
+type QueueNamespaceConfiguration struct {
+	Mapping          map[string]string // queue name to namespace
+	Prefix           string            // queues without mapping use namespace made of prefix and queue name, empty disables it
+	CreateNamespaces bool
+}
+
+type NodePressureTolerationConfiguration struct {
+	Taints       []string         // keys of NoExecute node pressure taints, memory, disk and pid pressure when empty
+	QueueSeconds map[string]int64 // toleration seconds by queue name, negative tolerates the taints indefinitely
+}
+
+type SidecarInjectionConfiguration struct {
+	Containers []v1.Container
+	Volumes    []v1.Volume
+}
+
+// Jobs with Armada priority up to MaximumPriority (lower number means more important job)
+// are submitted with PriorityClassName, the band with the lowest matching MaximumPriority is used
+type PriorityClassBand struct {
+	MaximumPriority   float64
+	PriorityClassName string
 }
 
 type TaskConfiguration struct {
-	UtilisationReportingInterval          time.Duration
-	MissingJobEventReconciliationInterval time.Duration
-	JobLeaseRenewalInterval               time.Duration
-	AllocateSpareClusterCapacityInterval  time.Duration
-	StuckPodScanInterval                  time.Duration
-	PodDeletionInterval                   time.Duration
-	QueueUsageDataRefreshInterval         time.Duration
-	UtilisationEventProcessingInterval    time.Duration
-	UtilisationEventReportingInterval     time.Duration
+	UtilisationReportingInterval             time.Duration
+	MissingJobEventReconciliationInterval    time.Duration
+	MissingJobEventReconciliationConcurrency int
+	JobLeaseRenewalInterval                  time.Duration
+	JobLeaseRenewalMaxRetries                int
+	JobLeaseRenewalRetryBackoff              time.Duration
+	AllocateSpareClusterCapacityInterval     time.Duration
+	StuckPodScanInterval                     time.Duration
+	PodDeletionInterval                      time.Duration
+	QueueUsageDataRefreshInterval            time.Duration
+	UtilisationEventProcessingInterval       time.Duration
+	UtilisationEventReportingInterval        time.Duration
+	Timeouts                                 map[string]time.Duration // run timeout by task name, for example utilisation_reporting
+	// Longest delay between calls to an unavailable server for leasing, lease returns and done reports, 0 disables the backoff
+	ApiBackoffMaxInterval time.Duration
+	// Longest delay between lease renewals and utilisation reports to an unavailable server, 0 disables their backoff.
+	// Backed off separately from leasing, so it can be kept below lease expiry and health.maxUtilisationReportAge
+	ApiRenewalBackoffMaxInterval time.Duration
+	// How often nodes are checked for NodeDrain taints and annotations
+	NodeDrainScanInterval time.Duration
+	// Leases of jobs with retryable stuck pods, and of jobs on draining nodes or not started on shutdown, are not returned before their pods are this old
+	MinimumLeaseHoldTime time.Duration
 }
 
 type MetricConfiguration struct {
@@ -39,11 +144,37 @@ type MetricConfiguration struct {
 	ExposeQueueUsageMetrics bool
 }
 
+// GPU utilisation of pods is scraped from the DCGM exporter on the internal address of every node with GPUs
+type GpuUtilisationConfiguration struct {
+	DcgmExporterPort uint16 // 0 disables GPU utilisation scraping
+	DcgmExporterPath string // defaults to /metrics
+	Timeout          time.Duration
+}
+
+// Liveness is served on /health and readiness on /ready
+type HealthConfiguration struct {
+	Port uint16 // 0 serves the checks on the metrics port
+	// Readiness fails when the last successful utilisation report is older, 0 only requires one successful report
+	MaxUtilisationReportAge time.Duration
+	// Liveness fails when a background task has not finished a run for this long after its interval was due, 0 only checks the tasks are not stopped
+	TaskStallTimeout time.Duration
+}
+
+// Skew is measured from server time in job lease responses
+type ClockSkewConfiguration struct {
+	WarningThreshold time.Duration // skew logged as a warning, 0 disables the warning
+	// Use the server clock corrected by the measured skew to compute pod age and expiry, instead of the local clock
+	UseServerTime bool
+}
+
 type ExecutorConfiguration struct {
-	Metric        MetricConfiguration
-	Application   ApplicationConfiguration
-	ApiConnection client.ApiConnectionDetails
+	Metric         MetricConfiguration
+	GpuUtilisation GpuUtilisationConfiguration
+	Health         HealthConfiguration
+	Application    ApplicationConfiguration
+	ApiConnection  client.ApiConnectionDetails
 
 	Kubernetes KubernetesConfiguration
 	Task       TaskConfiguration
+	ClockSkew  ClockSkewConfiguration
 }
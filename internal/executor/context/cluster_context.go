@@ -4,13 +4,18 @@ import (
 	ctx "context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	informer "k8s.io/client-go/informers/core/v1"
@@ -18,6 +23,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
+	"github.com/G-Research/armada/internal/executor/admission"
 	"github.com/G-Research/armada/internal/executor/cluster"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/domain"
@@ -25,6 +31,8 @@ import (
 )
 
 const podByUIDIndex = "podUID"
+const maxConcurrentAnnotationPatches = 10
+const podLogsTimeout = 10 * time.Second
 
 type ClusterContext interface {
 	AddPodEventHandler(handler cache.ResourceEventHandlerFuncs)
@@ -33,12 +41,23 @@ type ClusterContext interface {
 	GetAllPods() ([]*v1.Pod, error)
 	GetActiveBatchPods() ([]*v1.Pod, error)
 	GetNodes() ([]*v1.Node, error)
+	GetNode(nodeName string) (*v1.Node, error)
 	GetNodeStatsSummary(*v1.Node) (*v1alpha1.Summary, error)
 	GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
+	GetNodeEvents(nodeName string) ([]*v1.Event, error)
+	// Returns the last tailLines lines of the container logs, fetched from the kubelet of the pod node
+	GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error)
+	GetServerVersion() (string, error)
 
 	SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error)
+	SubmitService(service *v1.Service, owner string) (*v1.Service, error)
+	SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error)
 	AddAnnotation(pod *v1.Pod, annotations map[string]string) error
+	// Annotates pods concurrently, failures are returned as *AnnotationError
+	AddAnnotationToPods(pods []*v1.Pod, annotations map[string]string) error
 	DeletePods(pods []*v1.Pod)
+	// Pods are killed immediately when the grace period is 0, the same as DeletePods
+	DeletePodsWithGracePeriod(pods []*v1.Pod, gracePeriodSeconds int64)
 
 	GetClusterId() string
 	GetClusterPool() string
@@ -51,12 +70,15 @@ type KubernetesClusterContext struct {
 	pool                     string
 	submittedPods            util.PodCache
 	podsToDelete             util.PodCache
+	deletionGracePeriods     map[string]int64
+	deletionGracePeriodsLock sync.Mutex
 	podInformer              informer.PodInformer
 	nodeInformer             informer.NodeInformer
 	stopper                  chan struct{}
 	kubernetesClient         kubernetes.Interface
 	kubernetesClientProvider cluster.KubernetesClientProvider
 	eventInformer            informer.EventInformer
+	admissionPlugin          admission.Plugin
 }
 
 func (c *KubernetesClusterContext) GetClusterId() string {
@@ -70,7 +92,8 @@ func (c *KubernetesClusterContext) GetClusterPool() string {
 func NewClusterContext(
 	configuration configuration.ApplicationConfiguration,
 	minTimeBetweenRepeatDeletionCalls time.Duration,
-	kubernetesClientProvider cluster.KubernetesClientProvider) *KubernetesClusterContext {
+	kubernetesClientProvider cluster.KubernetesClientProvider,
+	admissionPlugin admission.Plugin) *KubernetesClusterContext {
 
 	kubernetesClient := kubernetesClientProvider.Client()
 
@@ -81,12 +104,14 @@ func NewClusterContext(
 		pool:                     configuration.Pool,
 		submittedPods:            util.NewTimeExpiringPodCache(time.Minute, time.Second, "submitted_job"),
 		podsToDelete:             util.NewTimeExpiringPodCache(minTimeBetweenRepeatDeletionCalls, time.Second, "deleted_job"),
+		deletionGracePeriods:     map[string]int64{},
 		stopper:                  make(chan struct{}),
 		podInformer:              factory.Core().V1().Pods(),
 		nodeInformer:             factory.Core().V1().Nodes(),
 		eventInformer:            factory.Core().V1().Events(),
 		kubernetesClient:         kubernetesClient,
 		kubernetesClientProvider: kubernetesClientProvider,
+		admissionPlugin:          admissionPlugin,
 	}
 
 	context.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
@@ -114,6 +139,24 @@ func NewClusterContext(
 	return context
 }
 
+// CheckInformersSynced fails until the pod, node and event informers have synced
+func (c *KubernetesClusterContext) CheckInformersSynced() error {
+	notSynced := []string{}
+	if !c.podInformer.Informer().HasSynced() {
+		notSynced = append(notSynced, "pod")
+	}
+	if !c.nodeInformer.Informer().HasSynced() {
+		notSynced = append(notSynced, "node")
+	}
+	if !c.eventInformer.Informer().HasSynced() {
+		notSynced = append(notSynced, "event")
+	}
+	if len(notSynced) > 0 {
+		return fmt.Errorf("%s informers have not synced", strings.Join(notSynced, ", "))
+	}
+	return nil
+}
+
 func indexPodByUID(obj interface{}) (strings []string, err error) {
 	event := obj.(*v1.Event)
 	if event.InvolvedObject.Kind != "Pod" || event.InvolvedObject.UID == "" {
@@ -126,7 +169,9 @@ func (c *KubernetesClusterContext) AddPodEventHandler(handler cache.ResourceEven
 	c.podInformer.Informer().AddEventHandler(handler)
 }
 
+// Pods queued for deletion are deleted before stopping, the pod deletion task doesn't run after shutdown
 func (c *KubernetesClusterContext) Stop() {
+	c.ProcessPodsToDelete()
 	close(c.stopper)
 }
 
@@ -171,10 +216,47 @@ func (c *KubernetesClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error
 	return eventsTyped, nil
 }
 
+// Node events are not cached by the event informer index, they are listed from the API server
+func (c *KubernetesClusterContext) GetNodeEvents(nodeName string) ([]*v1.Event, error) {
+	selector := fields.Set{"involvedObject.kind": "Node", "involvedObject.name": nodeName}.AsSelector().String()
+	events, err := c.kubernetesClient.CoreV1().Events("").List(ctx.Background(), metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*v1.Event, 0, len(events.Items))
+	for i := range events.Items {
+		result = append(result, &events.Items[i])
+	}
+	return result, nil
+}
+
 func (c *KubernetesClusterContext) GetNodes() ([]*v1.Node, error) {
 	return c.nodeInformer.Lister().List(labels.Everything())
 }
 
+func (c *KubernetesClusterContext) GetNode(nodeName string) (*v1.Node, error) {
+	return c.nodeInformer.Lister().Get(nodeName)
+}
+
+func (c *KubernetesClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	timeoutCtx, cancel := ctx.WithTimeout(ctx.Background(), podLogsTimeout)
+	defer cancel()
+	request := c.kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{Container: containerName, TailLines: &tailLines})
+	logs, err := request.DoRaw(timeoutCtx)
+	if err != nil {
+		return "", err
+	}
+	return string(logs), nil
+}
+
+func (c *KubernetesClusterContext) GetServerVersion() (string, error) {
+	info, err := c.kubernetesClient.Discovery().ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return info.GitVersion, nil
+}
+
 func (c *KubernetesClusterContext) GetNodeStatsSummary(node *v1.Node) (*v1alpha1.Summary, error) {
 	request := c.kubernetesClient.
 		CoreV1().
@@ -201,6 +283,15 @@ func (c *KubernetesClusterContext) GetNodeStatsSummary(node *v1.Node) (*v1alpha1
 
 func (c *KubernetesClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error) {
 
+	admittedPod, err := c.admissionPlugin.Admit(pod)
+	if err != nil {
+		if rejection, ok := err.(*admission.Rejection); ok {
+			return nil, errors.NewForbidden(schema.GroupResource{Resource: "pods"}, pod.Name, rejection)
+		}
+		return nil, err
+	}
+	pod = admittedPod
+
 	c.submittedPods.Add(pod)
 	ownerClient, err := c.kubernetesClientProvider.ClientForUser(owner)
 	if err != nil {
@@ -215,6 +306,19 @@ func (c *KubernetesClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod
 	return returnedPod, err
 }
 
+func (c *KubernetesClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	ownerClient, err := c.kubernetesClientProvider.ClientForUser(owner)
+	if err != nil {
+		return nil, err
+	}
+	return ownerClient.CoreV1().Services(service.Namespace).Create(ctx.Background(), service, metav1.CreateOptions{})
+}
+
+// Claims come from the queue settings rather than the job, so they are created by the executor and owners don't need permission to create them
+func (c *KubernetesClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	return c.kubernetesClient.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx.Background(), claim, metav1.CreateOptions{})
+}
+
 func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	patch := &domain.Patch{
 		MetaData: metav1.ObjectMeta{
@@ -232,24 +336,80 @@ func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[st
 	return nil
 }
 
+// AnnotationError lists errors of pods which failed to be annotated by pod name
+type AnnotationError struct {
+	Failures map[string]error
+}
+
+func (e *AnnotationError) Error() string {
+	names := make([]string, 0, len(e.Failures))
+	for name := range e.Failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("pod %s: %s", name, e.Failures[name]))
+	}
+	return fmt.Sprintf("failed to annotate %d pods: %s", len(e.Failures), strings.Join(messages, "; "))
+}
+
+func (c *KubernetesClusterContext) AddAnnotationToPods(pods []*v1.Pod, annotations map[string]string) error {
+	failures := map[string]error{}
+	mutex := sync.Mutex{}
+	limit := make(chan bool, maxConcurrentAnnotationPatches)
+	wg := sync.WaitGroup{}
+	for _, pod := range pods {
+		wg.Add(1)
+		limit <- true
+		go func(pod *v1.Pod) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			if err := c.AddAnnotation(pod, annotations); err != nil {
+				mutex.Lock()
+				failures[pod.Name] = err
+				mutex.Unlock()
+			}
+		}(pod)
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return &AnnotationError{Failures: failures}
+	}
+	return nil
+}
+
 func (c *KubernetesClusterContext) DeletePods(pods []*v1.Pod) {
+	c.DeletePodsWithGracePeriod(pods, 0)
+}
+
+// Grace periods are kept by job id until ProcessPodsToDelete deletes the pods
+func (c *KubernetesClusterContext) DeletePodsWithGracePeriod(pods []*v1.Pod, gracePeriodSeconds int64) {
+	c.deletionGracePeriodsLock.Lock()
+	defer c.deletionGracePeriodsLock.Unlock()
 	for _, podToDelete := range pods {
-		c.podsToDelete.AddIfNotExists(podToDelete)
+		if c.podsToDelete.AddIfNotExists(podToDelete) && gracePeriodSeconds > 0 {
+			c.deletionGracePeriods[util.ExtractJobId(podToDelete)] = gracePeriodSeconds
+		}
 	}
 }
 
 func (c *KubernetesClusterContext) ProcessPodsToDelete() {
 	pods := c.podsToDelete.GetAll()
 
-	deleteOptions := createPodDeletionDeleteOptions()
 	for _, podToDelete := range pods {
 		if podToDelete == nil {
 			continue
 		}
-		err := c.kubernetesClient.CoreV1().Pods(podToDelete.Namespace).Delete(ctx.Background(), podToDelete.Name, deleteOptions)
 		jobId := util.ExtractJobId(podToDelete)
+		deleteOptions := createPodDeletionDeleteOptions(c.takeDeletionGracePeriod(jobId))
+		err := c.kubernetesClient.CoreV1().Pods(podToDelete.Namespace).Delete(ctx.Background(), podToDelete.Name, deleteOptions)
 		if err == nil || errors.IsNotFound(err) {
 			c.podsToDelete.Update(jobId, nil)
+			c.deleteService(podToDelete)
+			c.deleteVolumeClaim(podToDelete)
 		} else {
 			log.Errorf("Failed to delete pod %s/%s because %s", podToDelete.Namespace, podToDelete.Name, err)
 			c.podsToDelete.Delete(jobId)
@@ -257,10 +417,42 @@ func (c *KubernetesClusterContext) ProcessPodsToDelete() {
 	}
 }
 
-func createPodDeletionDeleteOptions() metav1.DeleteOptions {
-	gracePeriod := int64(0)
+// Pods whose deletion fails are removed from podsToDelete, so the next DeletePodsWithGracePeriod sets the grace period again
+func (c *KubernetesClusterContext) takeDeletionGracePeriod(jobId string) int64 {
+	c.deletionGracePeriodsLock.Lock()
+	defer c.deletionGracePeriodsLock.Unlock()
+	gracePeriod := c.deletionGracePeriods[jobId]
+	delete(c.deletionGracePeriods, jobId)
+	return gracePeriod
+}
+
+// Services also have the pod as owner, so Kubernetes removes them eventually even when this deletion fails
+func (c *KubernetesClusterContext) deleteService(pod *v1.Pod) {
+	serviceName, ok := pod.Annotations[domain.JobServiceName]
+	if !ok {
+		return
+	}
+	err := c.kubernetesClient.CoreV1().Services(pod.Namespace).Delete(ctx.Background(), serviceName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Failed to delete service %s/%s because %s", pod.Namespace, serviceName, err)
+	}
+}
+
+// Claims also have the pod as owner, so Kubernetes removes them eventually even when this deletion fails
+func (c *KubernetesClusterContext) deleteVolumeClaim(pod *v1.Pod) {
+	claimName, ok := pod.Annotations[domain.JobVolumeClaimName]
+	if !ok {
+		return
+	}
+	err := c.kubernetesClient.CoreV1().PersistentVolumeClaims(pod.Namespace).Delete(ctx.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Failed to delete volume claim %s/%s because %s", pod.Namespace, claimName, err)
+	}
+}
+
+func createPodDeletionDeleteOptions(gracePeriodSeconds int64) metav1.DeleteOptions {
 	deleteOptions := metav1.DeleteOptions{
-		GracePeriodSeconds: &gracePeriod,
+		GracePeriodSeconds: &gracePeriodSeconds,
 	}
 	return deleteOptions
 }
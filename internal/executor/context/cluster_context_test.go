@@ -2,6 +2,7 @@ package context
 
 import (
 	ctx "context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -15,10 +16,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	clientTesting "k8s.io/client-go/testing"
 
 	util2 "github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/admission"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/util"
@@ -43,6 +46,7 @@ func setupTestWithMinRepeatedDeletePeriod(minRepeatedDeletePeriod time.Duration)
 		configuration.ApplicationConfiguration{ClusterId: "test-cluster-1", Pool: "pool"},
 		minRepeatedDeletePeriod,
 		clientProvider,
+		admission.NoOp{},
 	)
 
 	return clusterContext, clientProvider
@@ -65,6 +69,65 @@ func TestKubernetesClusterContext_SubmitPod(t *testing.T) {
 	assert.Equal(t, createAction.GetObject(), pod)
 }
 
+func TestKubernetesClusterContext_SubmitPod_CreatesPodReturnedByAdmissionPlugin(t *testing.T) {
+	clusterContext, client := setupTest()
+	clusterContext.admissionPlugin = &admission.LabelStamping{Labels: map[string]string{"cost-centre": "research"}}
+
+	pod := createBatchPod()
+	client.Fake.ClearActions()
+
+	_, err := clusterContext.SubmitPod(pod, "user1")
+	assert.Nil(t, err)
+
+	createAction, ok := client.Fake.Actions()[0].(clientTesting.CreateAction)
+	assert.True(t, ok)
+	assert.Equal(t, "research", createAction.GetObject().(*v1.Pod).Labels["cost-centre"])
+}
+
+func TestKubernetesClusterContext_SubmitPod_CreatesPodWithInjectedSidecar(t *testing.T) {
+	clusterContext, client := setupTest()
+	clusterContext.admissionPlugin = admission.FromConfig(configuration.AdmissionConfiguration{
+		Sidecar: configuration.SidecarInjectionConfiguration{Containers: []v1.Container{{Name: "log-agent", Image: "log-agent:1.0"}}},
+	}, client)
+
+	pod := createBatchPod()
+	client.Fake.ClearActions()
+
+	_, err := clusterContext.SubmitPod(pod, "user1")
+	assert.Nil(t, err)
+
+	createAction, ok := client.Fake.Actions()[0].(clientTesting.CreateAction)
+	assert.True(t, ok)
+	submittedPod := createAction.GetObject().(*v1.Pod)
+	lastContainer := submittedPod.Spec.Containers[len(submittedPod.Spec.Containers)-1]
+	assert.Equal(t, "log-agent", lastContainer.Name)
+	assert.Equal(t, "log-agent:1.0", lastContainer.Image)
+	assert.Equal(t, pod.Labels[domain.JobId], submittedPod.Labels[domain.JobId])
+}
+
+func TestKubernetesClusterContext_SubmitPod_ReturnsForbiddenWhenAdmissionPluginRejectsPod(t *testing.T) {
+	clusterContext, client := setupTest()
+	clusterContext.admissionPlugin = admissionFunc(func(pod *v1.Pod) (*v1.Pod, error) {
+		return nil, admission.Reject("no gpu jobs allowed")
+	})
+
+	client.Fake.ClearActions()
+	_, err := clusterContext.SubmitPod(createBatchPod(), "user1")
+
+	assert.True(t, errors2.IsForbidden(err))
+	assert.Equal(t, 0, len(client.Fake.Actions()))
+}
+
+func TestKubernetesClusterContext_GetPodLogs_ReturnsContainerLogs(t *testing.T) {
+	clusterContext, _ := setupTest()
+	pod := createBatchPod()
+
+	logs, err := clusterContext.GetPodLogs(pod, "main", 50)
+	assert.NoError(t, err)
+	// fake clientset always serves the same logs
+	assert.Equal(t, "fake logs", logs)
+}
+
 func TestKubernetesClusterContext_ProcessPodsToDelete_DoesNotCallClient_WhenNoPodsMarkedForDeletion(t *testing.T) {
 	clusterContext, client := setupTest()
 
@@ -91,6 +154,104 @@ func TestKubernetesClusterContext_ProcessPodsToDelete_CallDeleteOnClient_WhenPod
 	assert.Equal(t, deleteAction.GetName(), pod.Name)
 }
 
+func TestKubernetesClusterContext_ProcessPodsToDelete_DeletesServiceOfPod(t *testing.T) {
+	clusterContext, client := setupTest()
+
+	pod := createBatchPod()
+	pod.Annotations = map[string]string{domain.JobServiceName: "armada-service"}
+	submitPodsWithWait(t, clusterContext, pod)
+	_, err := clusterContext.SubmitService(&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "armada-service", Namespace: pod.Namespace}}, "user")
+	assert.Nil(t, err)
+
+	client.Fake.ClearActions()
+	clusterContext.DeletePods([]*v1.Pod{pod})
+	clusterContext.ProcessPodsToDelete()
+
+	assert.Equal(t, len(client.Fake.Actions()), 2)
+	assert.True(t, client.Fake.Actions()[0].Matches("delete", "pods"))
+	assert.True(t, client.Fake.Actions()[1].Matches("delete", "services"))
+
+	_, err = client.CoreV1().Services(pod.Namespace).Get(ctx.Background(), "armada-service", metav1.GetOptions{})
+	assert.True(t, errors2.IsNotFound(err))
+}
+
+func TestKubernetesClusterContext_ProcessPodsToDelete_DeletesVolumeClaimOfPod(t *testing.T) {
+	clusterContext, client := setupTest()
+
+	pod := createBatchPod()
+	pod.Annotations = map[string]string{domain.JobVolumeClaimName: "armada-claim"}
+	submitPodsWithWait(t, clusterContext, pod)
+	_, err := clusterContext.SubmitPersistentVolumeClaim(&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "armada-claim", Namespace: pod.Namespace}})
+	assert.Nil(t, err)
+
+	client.Fake.ClearActions()
+	clusterContext.DeletePods([]*v1.Pod{pod})
+	clusterContext.ProcessPodsToDelete()
+
+	assert.Equal(t, len(client.Fake.Actions()), 2)
+	assert.True(t, client.Fake.Actions()[0].Matches("delete", "pods"))
+	assert.True(t, client.Fake.Actions()[1].Matches("delete", "persistentvolumeclaims"))
+
+	_, err = client.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx.Background(), "armada-claim", metav1.GetOptions{})
+	assert.True(t, errors2.IsNotFound(err))
+}
+
+func TestKubernetesClusterContext_DeletePodsWithGracePeriod_PreventsRepeatedDeleteCallsToClient(t *testing.T) {
+	clusterContext, client := setupTest()
+	recordingClient := &deleteOptionsRecordingClient{Interface: client}
+	clusterContext.kubernetesClient = recordingClient
+
+	pod := createSubmittedBatchPod(t, clusterContext)
+
+	client.Fake.ClearActions()
+	clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, 60)
+	clusterContext.ProcessPodsToDelete()
+	assert.Equal(t, len(client.Fake.Actions()), 1)
+	assert.True(t, client.Fake.Actions()[0].Matches("delete", "pods"))
+	assert.Equal(t, pod.Name, client.Fake.Actions()[0].(clientTesting.DeleteAction).GetName())
+	assert.Equal(t, []int64{60}, recordingClient.podDeleteGracePeriods)
+
+	client.Fake.ClearActions()
+	clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, 60)
+	clusterContext.ProcessPodsToDelete()
+	assert.Equal(t, len(client.Fake.Actions()), 0)
+}
+
+func TestKubernetesClusterContext_DeletePods_DeletesImmediately(t *testing.T) {
+	clusterContext, client := setupTest()
+	recordingClient := &deleteOptionsRecordingClient{Interface: client}
+	clusterContext.kubernetesClient = recordingClient
+
+	pod := createSubmittedBatchPod(t, clusterContext)
+	gracePeriod := int64(30)
+	pod.DeletionGracePeriodSeconds = &gracePeriod
+
+	client.Fake.ClearActions()
+	clusterContext.DeletePods([]*v1.Pod{pod})
+	clusterContext.ProcessPodsToDelete()
+	assert.Equal(t, len(client.Fake.Actions()), 1)
+	assert.True(t, client.Fake.Actions()[0].Matches("delete", "pods"))
+	assert.Equal(t, []int64{0}, recordingClient.podDeleteGracePeriods)
+}
+
+func TestKubernetesClusterContext_DeletePodsWithGracePeriod_KeepsGracePeriodForRetryAfterFailedDelete(t *testing.T) {
+	clusterContext, client := setupTest()
+	recordingClient := &deleteOptionsRecordingClient{Interface: client}
+	clusterContext.kubernetesClient = recordingClient
+
+	pod := createSubmittedBatchPod(t, clusterContext)
+	client.Fake.PrependReactor("delete", "pods", func(action clientTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("server error")
+	})
+
+	clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, 60)
+	clusterContext.ProcessPodsToDelete()
+	clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, 60)
+	clusterContext.ProcessPodsToDelete()
+
+	assert.Equal(t, []int64{60, 60}, recordingClient.podDeleteGracePeriods)
+}
+
 func TestKubernetesClusterContext_ProcessPodsToDelete_PreventsRepeatedDeleteCallsToClient_OnClientSuccess(t *testing.T) {
 	clusterContext, client := setupTest()
 
@@ -200,6 +361,46 @@ func TestKubernetesClusterContext_AddAnnotation_ReturnsError_OnClientError(t *te
 	assert.NotNil(t, err)
 }
 
+func TestKubernetesClusterContext_AddAnnotationToPods(t *testing.T) {
+	clusterContext, client := setupTest()
+	pods := []*v1.Pod{createSubmittedBatchPod(t, clusterContext), createSubmittedBatchPod(t, clusterContext), createSubmittedBatchPod(t, clusterContext)}
+	client.Fake.ClearActions()
+
+	annotationsToAdd := map[string]string{"test": "\\"}
+	err := clusterContext.AddAnnotationToPods(pods, annotationsToAdd)
+	assert.NoError(t, err)
+
+	patchedPods := map[string]bool{}
+	for _, action := range client.Fake.Actions() {
+		if patch, ok := action.(clientTesting.PatchAction); ok {
+			patchedPods[patch.GetName()] = true
+			patched := &domain.Patch{}
+			assert.NoError(t, json.Unmarshal(patch.GetPatch(), patched))
+			assert.Equal(t, annotationsToAdd, patched.MetaData.Annotations)
+		}
+	}
+	assert.Equal(t, map[string]bool{pods[0].Name: true, pods[1].Name: true, pods[2].Name: true}, patchedPods)
+}
+
+func TestKubernetesClusterContext_AddAnnotationToPods_ReturnsFailedPods(t *testing.T) {
+	clusterContext, client := setupTest()
+	pods := []*v1.Pod{createSubmittedBatchPod(t, clusterContext), createSubmittedBatchPod(t, clusterContext)}
+	client.Fake.PrependReactor("patch", "pods", func(action clientTesting.Action) (bool, runtime.Object, error) {
+		if action.(clientTesting.PatchAction).GetName() == pods[1].Name {
+			return true, nil, errors.New("server error")
+		}
+		return false, nil, nil
+	})
+
+	err := clusterContext.AddAnnotationToPods(pods, map[string]string{"test": "annotation"})
+
+	annotationError, ok := err.(*AnnotationError)
+	assert.True(t, ok)
+	assert.Len(t, annotationError.Failures, 1)
+	assert.Contains(t, annotationError.Failures, pods[1].Name)
+	assert.Contains(t, err.Error(), pods[1].Name)
+}
+
 func TestKubernetesClusterContext_GetAllPods(t *testing.T) {
 	clusterContext, _ := setupTest()
 
@@ -434,3 +635,38 @@ func (p *FakeClientProvider) Client() kubernetes.Interface {
 func (p *FakeClientProvider) ClientConfig() *rest.Config {
 	return nil
 }
+
+type admissionFunc func(pod *v1.Pod) (*v1.Pod, error)
+
+func (f admissionFunc) Admit(pod *v1.Pod) (*v1.Pod, error) {
+	return f(pod)
+}
+
+// The fake client does not record delete options of its actions
+type deleteOptionsRecordingClient struct {
+	kubernetes.Interface
+	podDeleteGracePeriods []int64
+}
+
+func (c *deleteOptionsRecordingClient) CoreV1() corev1.CoreV1Interface {
+	return &deleteOptionsRecordingCoreV1{CoreV1Interface: c.Interface.CoreV1(), client: c}
+}
+
+type deleteOptionsRecordingCoreV1 struct {
+	corev1.CoreV1Interface
+	client *deleteOptionsRecordingClient
+}
+
+func (c *deleteOptionsRecordingCoreV1) Pods(namespace string) corev1.PodInterface {
+	return &deleteOptionsRecordingPods{PodInterface: c.CoreV1Interface.Pods(namespace), client: c.client}
+}
+
+type deleteOptionsRecordingPods struct {
+	corev1.PodInterface
+	client *deleteOptionsRecordingClient
+}
+
+func (p *deleteOptionsRecordingPods) Delete(c ctx.Context, name string, opts metav1.DeleteOptions) error {
+	p.client.podDeleteGracePeriods = append(p.client.podDeleteGracePeriods, *opts.GracePeriodSeconds)
+	return p.PodInterface.Delete(c, name, opts)
+}
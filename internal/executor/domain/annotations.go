@@ -0,0 +1,12 @@
+package domain
+
+// Comma separated names of containers which are not waited for to determine the job has finished
+const SidecarContainers = "armadaproject.io/sidecar-containers"
+
+// Jobs with retries killed for exceeding their memory limit are retried with the memory of the killed containers
+// multiplied by the factor, up to the max memory. The executor sets them from its configuration for jobs without them.
+const OomRetryMemoryFactor = "armadaproject.io/oom-retry-memory-factor"
+const OomRetryMaxMemory = "armadaproject.io/oom-retry-max-memory"
+
+// When "true", the job is only reported as running once its pod is ready, if any of its containers has a readiness probe
+const RunningWhenReady = "armadaproject.io/running-when-ready"
@@ -6,4 +6,26 @@ const (
 	PodCount  = "armada_pod_count"
 	JobSetId  = "armada_jobset_id"
 	Queue     = "armada_queue_id"
+
+	// Annotations with RFC 3339 times used to report scheduling latency of the job
+	JobSubmittedTime = "armada_job_submitted_time"
+	JobLeasedTime    = "armada_job_leased_time"
+
+	// Annotation with name of the service exposing ports of the job pods, deleted together with the pods
+	JobServiceName = "armada_job_service_name"
+	// Annotations with name and template of the volume claim provisioned for the pod from the claim template of its job, deleted together with the pod
+	JobVolumeClaimName     = "armada_job_volume_claim_name"
+	JobVolumeClaimTemplate = "armada_job_volume_claim_template"
+	// Annotations of jobs with retries, pods failed because of the infrastructure are recreated until the attempt exceeds the retries
+	JobMaxRetries = "armada_job_max_retries"
+	JobAttempt    = "armada_job_attempt"
+	// Memory increased for the attempt after the previous attempt was killed for exceeding its memory limit
+	JobMemoryIncrease = "armada_job_memory_increase"
+	// Owner of the job, recreated pods are submitted on behalf of the owner
+	JobOwner = "armada_job_owner"
+	// Value of the configured instance type label of the node the pod was scheduled on
+	NodeInstanceType = "armada_node_instance_type"
+	// Annotation of pods deleted because their node is drained, their state is no longer reported and the lease
+	// of their job is returned once they are gone
+	JobDrained = "armada_job_drained"
 )
@@ -1,4 +1,17 @@
 package domain
 
+import "github.com/G-Research/armada/internal/common"
+
 const AcceleratorDutyCycle = "armadaproject.io/accelerator-duty-cycle"
 const AcceleratorMemory = "armadaproject.io/accelerator-memory"
+
+// Pod network throughput in bytes per second, summed over all pod network interfaces
+const NetworkReceiveRate = "armadaproject.io/network-receive-bytes-per-second"
+const NetworkTransmitRate = "armadaproject.io/network-transmit-bytes-per-second"
+
+// Network throughput is only reported in job utilisation, it is removed from usage reported by queue and resource type
+func WithoutNetworkThroughput(resources common.ComputeResources) common.ComputeResources {
+	delete(resources, NetworkReceiveRate)
+	delete(resources, NetworkTransmitRate)
+	return resources
+}
@@ -3,6 +3,7 @@ package fake
 import (
 	"sync"
 
+	"github.com/G-Research/armada/internal/common/health"
 	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/executor"
 	"github.com/G-Research/armada/internal/executor/configuration"
@@ -10,8 +11,8 @@ import (
 	"github.com/G-Research/armada/internal/executor/metrics"
 )
 
-func StartUp(config configuration.ExecutorConfiguration, nodes []*context.NodeSpec) (func(), *sync.WaitGroup) {
+func StartUp(config configuration.ExecutorConfiguration, nodes []*context.NodeSpec, healthChecks *health.Checks) (func(), *sync.WaitGroup) {
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
-	return executor.StartUpWithContext(config, context.NewFakeClusterContext(config.Application, nodes), nil, task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix), wg)
+	return executor.StartUpWithContext(config, context.NewFakeClusterContext(config.Application, nodes), nil, task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix), wg, healthChecks)
 }
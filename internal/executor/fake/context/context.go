@@ -97,10 +97,27 @@ func (c *FakeClusterContext) GetNodes() ([]*v1.Node, error) {
 	return c.nodes, nil
 }
 
+func (c *FakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
+	for _, node := range c.nodes {
+		if node.Name == nodeName {
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("node %s not found", nodeName)
+}
+
 func (c *FakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
 	return []*v1.Event{}, nil
 }
 
+func (c *FakeClusterContext) GetNodeEvents(nodeName string) ([]*v1.Event, error) {
+	return []*v1.Event{}, nil
+}
+
+func (c *FakeClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	return "", nil
+}
+
 func (c *FakeClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error) {
 	saved := c.savePod(pod)
 
@@ -198,6 +215,14 @@ func (c *FakeClusterContext) extractSleepTime(pod *v1.Pod) float32 {
 	return 1
 }
 
+func (c *FakeClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	return service, nil
+}
+
+func (c *FakeClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	return claim, nil
+}
+
 func (c *FakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	c.rwLock.Lock()
 	defer c.rwLock.Unlock()
@@ -212,6 +237,19 @@ func (c *FakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]s
 	return nil
 }
 
+func (c *FakeClusterContext) AddAnnotationToPods(pods []*v1.Pod, annotations map[string]string) error {
+	failures := map[string]error{}
+	for _, pod := range pods {
+		if err := c.AddAnnotation(pod, annotations); err != nil {
+			failures[pod.Name] = err
+		}
+	}
+	if len(failures) > 0 {
+		return &context.AnnotationError{Failures: failures}
+	}
+	return nil
+}
+
 func (c *FakeClusterContext) DeletePods(pods []*v1.Pod) {
 	go func() {
 		// wait a little before actual delete
@@ -226,6 +264,10 @@ func (c *FakeClusterContext) DeletePods(pods []*v1.Pod) {
 	}()
 }
 
+func (c *FakeClusterContext) DeletePodsWithGracePeriod(pods []*v1.Pod, gracePeriodSeconds int64) {
+	c.DeletePods(pods)
+}
+
 func (c *FakeClusterContext) GetClusterId() string {
 	return c.clusterId
 }
@@ -234,6 +276,10 @@ func (c FakeClusterContext) GetClusterPool() string {
 	return c.pool
 }
 
+func (c *FakeClusterContext) GetServerVersion() (string, error) {
+	return "v1.20.0", nil
+}
+
 func (c FakeClusterContext) GetNodeStatsSummary(node *v1.Node) (*v1alpha1.Summary, error) {
 	return &v1alpha1.Summary{}, nil
 }
@@ -307,36 +353,5 @@ func (c *FakeClusterContext) isSchedulableOn(pod *v1.Pod, n *v1.Node) bool {
 		return false
 	}
 
-	// labels
-	for k, v := range pod.Spec.NodeSelector {
-		if n.Labels == nil {
-			return false
-		}
-		nodeValue, exists := n.Labels[k]
-		if !exists || nodeValue != v {
-			return false
-		}
-	}
-
-	// taints
-	for _, t := range n.Spec.Taints {
-		// check only hard constraints
-		if t.Effect == v1.TaintEffectPreferNoSchedule {
-			continue
-		}
-		if !tolerationsTolerateTaint(pod.Spec.Tolerations, &t) {
-			return false
-		}
-	}
-	return true
-}
-
-// https://github.com/kubernetes/kubernetes/blob/master/pkg/apis/core/v1/helper/helpers.go#L427
-func tolerationsTolerateTaint(tolerations []v1.Toleration, taint *v1.Taint) bool {
-	for i := range tolerations {
-		if tolerations[i].ToleratesTaint(taint) {
-			return true
-		}
-	}
-	return false
+	return common.MatchNodeSelector(&pod.Spec, n.Labels) && common.ToleratesTaints(pod.Spec.Tolerations, n.Spec.Taints)
 }
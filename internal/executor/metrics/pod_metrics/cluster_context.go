@@ -1,6 +1,8 @@
 package pod_metrics
 
 import (
+	"time"
+
 	"github.com/google/martian/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -19,6 +21,7 @@ const (
 	leasedPhase       = "Leased"
 	queueLabel        = "queue"
 	phaseLabel        = "phase"
+	poolLabel         = "pool"
 	resourceTypeLabel = "resourceType"
 )
 
@@ -63,8 +66,9 @@ type ClusterContextMetrics struct {
 	utilisationService      service.UtilisationService
 	queueUtilisationService service.PodUtilisationService
 
-	knownQueues   map[string]bool
-	podCountTotal *prometheus.CounterVec
+	knownQueues        map[string]bool
+	podCountTotal      *prometheus.CounterVec
+	podStartupDuration *prometheus.HistogramVec
 }
 
 func ExposeClusterContextMetrics(context context.ClusterContext, utilisationService service.UtilisationService, queueUtilisationService service.PodUtilisationService) *ClusterContextMetrics {
@@ -79,6 +83,13 @@ func ExposeClusterContextMetrics(context context.ClusterContext, utilisationServ
 				Help: "Counter for pods in different phases by queue",
 			},
 			[]string{queueLabel, phaseLabel}),
+		podStartupDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    metrics.ArmadaExecutorMetricsPrefix + "job_pod_startup_seconds",
+				Help:    "Time from job submission to its pod running by queue and pool",
+				Buckets: prometheus.ExponentialBuckets(0.25, 2, 12),
+			},
+			[]string{queueLabel, poolLabel}),
 	}
 
 	context.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
@@ -96,6 +107,9 @@ func ExposeClusterContextMetrics(context context.ClusterContext, utilisationServ
 				return
 			}
 			m.reportPhase(newPod)
+			if newPod.Status.Phase == v1.PodRunning {
+				m.reportStartup(newPod, time.Now())
+			}
 		},
 	})
 	prometheus.MustRegister(m)
@@ -110,6 +124,33 @@ func (m *ClusterContextMetrics) reportPhase(pod *v1.Pod) {
 	m.podCountTotal.WithLabelValues(queue, string(pod.Status.Phase)).Inc()
 }
 
+// Pods already running when the executor starts are only added, so their startup is not observed again
+func (m *ClusterContextMetrics) reportStartup(pod *v1.Pod, runningTime time.Time) {
+	queue, present := pod.Labels[domain.Queue]
+	if !present {
+		return
+	}
+	duration, ok := startupDuration(pod, runningTime)
+	if !ok {
+		return
+	}
+	m.podStartupDuration.WithLabelValues(queue, m.context.GetClusterPool()).Observe(duration.Seconds())
+}
+
+// Pods created by older executors do not have the submitted time annotation
+func startupDuration(pod *v1.Pod, runningTime time.Time) (time.Duration, bool) {
+	submittedTime, err := time.Parse(time.RFC3339Nano, pod.Annotations[domain.JobSubmittedTime])
+	if err != nil {
+		return 0, false
+	}
+	duration := runningTime.Sub(submittedTime)
+	if duration < 0 {
+		// submitted time comes from the server clock
+		duration = 0
+	}
+	return duration, true
+}
+
 type podMetric struct {
 	resourceRequest common.ComputeResources
 	resourceUsage   common.ComputeResources
@@ -167,7 +208,7 @@ func (m *ClusterContextMetrics) Collect(metrics chan<- prometheus.Metric) {
 		}
 
 		request := common.TotalPodResourceRequest(&pod.Spec)
-		usage := m.queueUtilisationService.GetPodUtilisation(pod)
+		usage := domain.WithoutNetworkThroughput(m.queueUtilisationService.GetPodUtilisation(pod))
 
 		queueMetric[phase].count++
 		queueMetric[phase].resourceRequest.Add(request)
@@ -0,0 +1,32 @@
+package pod_metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestStartupDuration(t *testing.T) {
+	submitted := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		domain.JobSubmittedTime: submitted.Format(time.RFC3339Nano),
+	}}}
+
+	duration, ok := startupDuration(pod, submitted.Add(90*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, duration)
+
+	duration, ok = startupDuration(pod, submitted.Add(-time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), duration)
+}
+
+func TestStartupDuration_WithoutSubmittedTime(t *testing.T) {
+	_, ok := startupDuration(&v1.Pod{}, time.Now())
+	assert.False(t, ok)
+}
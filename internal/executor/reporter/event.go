@@ -12,6 +12,7 @@ import (
 	"github.com/G-Research/armada/pkg/api"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func CreateEventForCurrentState(pod *v1.Pod, clusterId string) (api.Event, error) {
@@ -29,20 +30,22 @@ func CreateEventForCurrentState(pod *v1.Pod, clusterId string) (api.Event, error
 			PodNumber:    getPodNumber(pod),
 		}, nil
 	case v1.PodRunning:
+		now := time.Now()
 		return &api.JobRunningEvent{
-			JobId:        pod.Labels[domain.JobId],
-			JobSetId:     pod.Annotations[domain.JobSetId],
-			Queue:        pod.Labels[domain.Queue],
-			Created:      time.Now(),
-			ClusterId:    clusterId,
-			KubernetesId: string(pod.ObjectMeta.UID),
-			PodNumber:    getPodNumber(pod),
-			NodeName:     pod.Spec.NodeName,
+			JobId:             pod.Labels[domain.JobId],
+			JobSetId:          pod.Annotations[domain.JobSetId],
+			Queue:             pod.Labels[domain.Queue],
+			Created:           now,
+			ClusterId:         clusterId,
+			KubernetesId:      string(pod.ObjectMeta.UID),
+			PodNumber:         getPodNumber(pod),
+			NodeName:          pod.Spec.NodeName,
+			SchedulingLatency: extractSchedulingLatency(pod, now),
 		}, nil
 	case v1.PodFailed:
 		return CreateJobFailedEvent(
 			pod,
-			util.ExtractPodFailedReason(pod),
+			extractFailedReasonWithAttempt(pod),
 			util.ExtractPodFailedCause(pod),
 			util.ExtractFailedPodContainerStatuses(pod),
 			util.ExtractPodExitCodes(pod),
@@ -63,6 +66,25 @@ func CreateEventForCurrentState(pod *v1.Pod, clusterId string) (api.Event, error
 	}
 }
 
+// Pods finishing quickly can succeed before the executor observes them running, without running event
+// the job would appear to succeed without ever running. Returns nil when the pod is annotated as reported running,
+// the reporter also tracks running events it queued which are not annotated yet.
+func CreateMissingRunningEvent(pod *v1.Pod, clusterId string) *api.JobRunningEvent {
+	if pod.Status.Phase != v1.PodSucceeded {
+		return nil
+	}
+	if _, reported := pod.Annotations[string(v1.PodRunning)]; reported {
+		return nil
+	}
+	runningPod := pod.DeepCopy()
+	runningPod.Status.Phase = v1.PodRunning
+	event, err := CreateEventForCurrentState(runningPod, clusterId)
+	if err != nil {
+		return nil
+	}
+	return event.(*api.JobRunningEvent)
+}
+
 func getPodNumber(pod *v1.Pod) int32 {
 	podNumberString, ok := pod.Labels[domain.PodNumber]
 	if !ok {
@@ -86,14 +108,27 @@ func CreateJobUnableToScheduleEvent(pod *v1.Pod, reason string, clusterId string
 	}
 }
 
-func CreateJobLeaseReturnedEvent(pod *v1.Pod, reason string, clusterId string) api.Event {
+func CreateJobLeaseReturnedEvent(pod *v1.Pod, reason string, requeueReason api.RequeueReason, clusterId string) api.Event {
 	return &api.JobLeaseReturnedEvent{
-		JobId:     pod.Labels[domain.JobId],
-		JobSetId:  pod.Annotations[domain.JobSetId],
-		Queue:     pod.Labels[domain.Queue],
-		Created:   time.Now(),
-		ClusterId: clusterId,
-		Reason:    reason,
+		JobId:         pod.Labels[domain.JobId],
+		JobSetId:      pod.Annotations[domain.JobSetId],
+		Queue:         pod.Labels[domain.Queue],
+		Created:       time.Now(),
+		ClusterId:     clusterId,
+		Reason:        reason,
+		RequeueReason: requeueReason,
+	}
+}
+
+func CreateJobProtectedEvent(pod *v1.Pod, reason string, requeueReason api.RequeueReason, clusterId string) api.Event {
+	return &api.JobProtectedEvent{
+		JobId:         pod.Labels[domain.JobId],
+		JobSetId:      pod.Annotations[domain.JobSetId],
+		Queue:         pod.Labels[domain.Queue],
+		Created:       time.Now(),
+		ClusterId:     clusterId,
+		Reason:        reason,
+		RequeueReason: requeueReason,
 	}
 }
 
@@ -101,6 +136,15 @@ func CreateSimpleJobFailedEvent(pod *v1.Pod, reason string, clusterId string) ap
 	return CreateJobFailedEvent(pod, reason, api.Cause_Error, []*api.ContainerStatus{}, map[string]int32{}, clusterId)
 }
 
+func extractFailedReasonWithAttempt(pod *v1.Pod) string {
+	reason := util.ExtractPodFailedReason(pod)
+	attempt, maxRetries := util.ExtractAttempt(pod)
+	if maxRetries <= 0 {
+		return reason
+	}
+	return fmt.Sprintf("%s (attempt %d of %d)", reason, attempt, maxRetries+1)
+}
+
 func CreateJobFailedEvent(pod *v1.Pod, reason string, cause api.Cause, containerStatuses []*api.ContainerStatus,
 	exitCodes map[string]int32, clusterId string) api.Event {
 	return &api.JobFailedEvent{
@@ -119,6 +163,27 @@ func CreateJobFailedEvent(pod *v1.Pod, reason string, cause api.Cause, container
 	}
 }
 
+// Started is when the kubelet accepted the pod, as the pod holds resources of the node from then on, including while pulling images
+func CreateJobRunDetails(pod *v1.Pod, pool string, instanceType string) *api.JobRunDetails {
+	details := &api.JobRunDetails{Pool: pool, InstanceType: instanceType}
+	if pod.Status.StartTime != nil {
+		started := pod.Status.StartTime.Time
+		details.Started = &started
+	}
+	var finished time.Time
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			if status.State.Terminated != nil && status.State.Terminated.FinishedAt.After(finished) {
+				finished = status.State.Terminated.FinishedAt.Time
+			}
+		}
+	}
+	if !finished.IsZero() {
+		details.Finished = &finished
+	}
+	return details
+}
+
 func CreateJobUtilisationEvent(pod *v1.Pod, maxResources common.ComputeResources, clusterId string) api.Event {
 	return &api.JobUtilisationEvent{
 		JobId:                 pod.Labels[domain.JobId],
@@ -132,3 +197,95 @@ func CreateJobUtilisationEvent(pod *v1.Pod, maxResources common.ComputeResources
 		NodeName:              pod.Spec.NodeName,
 	}
 }
+
+func CreateJobMemoryIncreasedEvent(pod *v1.Pod, attempt int, increases []util.MemoryIncrease, clusterId string) api.Event {
+	memoryLimits := map[string]resource.Quantity{}
+	for _, increase := range increases {
+		memoryLimits[increase.Container] = increase.NewMemory
+	}
+	return &api.JobMemoryIncreasedEvent{
+		JobId:        pod.Labels[domain.JobId],
+		JobSetId:     pod.Annotations[domain.JobSetId],
+		Queue:        pod.Labels[domain.Queue],
+		Created:      time.Now(),
+		ClusterId:    clusterId,
+		KubernetesId: string(pod.ObjectMeta.UID),
+		PodNumber:    getPodNumber(pod),
+		Attempt:      int32(attempt),
+		Reason:       "Killed for exceeding memory limit, memory increased: " + util.DescribeMemoryIncreases(increases),
+		MemoryLimits: memoryLimits,
+	}
+}
+
+func CreateJobGangPlacedEvent(job *api.Job, clusterId string) api.Event {
+	return &api.JobGangPlacedEvent{
+		JobId:     job.Id,
+		JobSetId:  job.JobSetId,
+		Queue:     job.Queue,
+		Created:   time.Now(),
+		ClusterId: clusterId,
+		GangId:    job.GangId,
+		GangSize:  job.GangSize,
+	}
+}
+
+func CreateJobGangRejectedEvent(job *api.Job, reason string, clusterId string) api.Event {
+	return &api.JobGangRejectedEvent{
+		JobId:     job.Id,
+		JobSetId:  job.JobSetId,
+		Queue:     job.Queue,
+		Created:   time.Now(),
+		ClusterId: clusterId,
+		GangId:    job.GangId,
+		GangSize:  job.GangSize,
+		Reason:    reason,
+	}
+}
+
+func CreateJobServiceCreatedEvent(pod *v1.Pod, service *v1.Service, clusterId string) api.Event {
+	ports := make([]int32, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		ports = append(ports, port.Port)
+	}
+	return &api.JobServiceCreatedEvent{
+		JobId:        pod.Labels[domain.JobId],
+		JobSetId:     pod.Annotations[domain.JobSetId],
+		Queue:        pod.Labels[domain.Queue],
+		Created:      time.Now(),
+		ClusterId:    clusterId,
+		KubernetesId: string(pod.ObjectMeta.UID),
+		PodNumber:    getPodNumber(pod),
+		ServiceName:  service.Name,
+		ClusterIp:    service.Spec.ClusterIP,
+		Ports:        ports,
+	}
+}
+
+// Returns nil for pods without the scheduling time annotations, for example created by an older executor
+func extractSchedulingLatency(pod *v1.Pod, runningTime time.Time) *api.JobSchedulingLatency {
+	submittedTime, err := time.Parse(time.RFC3339Nano, pod.Annotations[domain.JobSubmittedTime])
+	if err != nil {
+		return nil
+	}
+	leasedTime, err := time.Parse(time.RFC3339Nano, pod.Annotations[domain.JobLeasedTime])
+	if err != nil {
+		return nil
+	}
+	podCreatedTime := pod.CreationTimestamp.Time
+	if podCreatedTime.IsZero() {
+		return nil
+	}
+	return &api.JobSchedulingLatency{
+		Queued:      nonNegative(leasedTime.Sub(submittedTime)),
+		PodCreation: nonNegative(podCreatedTime.Sub(leasedTime)),
+		PodStartup:  nonNegative(runningTime.Sub(podCreatedTime)),
+	}
+}
+
+// Timestamps come from different clocks (server, executor and kubernetes), so small negative differences are possible
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -2,10 +2,13 @@ package reporter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -38,6 +41,44 @@ func TestCreateEventForCurrentState_WhenPodRunning(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestCreateEventForCurrentState_WhenPodRunning_ReportsSchedulingLatency(t *testing.T) {
+	podCreated := time.Now().Add(-time.Minute).Truncate(time.Second)
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(podCreated),
+			Annotations: map[string]string{
+				domain.JobSubmittedTime: podCreated.Add(-10 * time.Minute).Format(time.RFC3339Nano),
+				domain.JobLeasedTime:    podCreated.Add(-2 * time.Second).Format(time.RFC3339Nano),
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+		},
+	}
+
+	result, err := CreateEventForCurrentState(&pod, "cluster1")
+	assert.Nil(t, err)
+
+	event, ok := result.(*api.JobRunningEvent)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Minute-2*time.Second, event.SchedulingLatency.Queued)
+	assert.Equal(t, 2*time.Second, event.SchedulingLatency.PodCreation)
+	assert.Equal(t, event.Created.Sub(podCreated), event.SchedulingLatency.PodStartup)
+}
+
+func TestCreateEventForCurrentState_WhenPodRunningWithoutSchedulingTimes_ReportsNoLatency(t *testing.T) {
+	pod := v1.Pod{
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+		},
+	}
+
+	result, err := CreateEventForCurrentState(&pod, "cluster1")
+	assert.Nil(t, err)
+
+	assert.Nil(t, result.(*api.JobRunningEvent).SchedulingLatency)
+}
+
 func TestCreateEventForCurrentState_WhenPodFailed(t *testing.T) {
 	pod := v1.Pod{
 		Status: v1.PodStatus{
@@ -52,6 +93,26 @@ func TestCreateEventForCurrentState_WhenPodFailed(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestCreateEventForCurrentState_WhenPodFailed_ReportsAttempt(t *testing.T) {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{domain.JobMaxRetries: "2", domain.JobAttempt: "3"},
+		},
+		Status: v1.PodStatus{
+			Phase:   v1.PodFailed,
+			Reason:  "Evicted",
+			Message: "node is low on memory",
+		},
+	}
+
+	result, err := CreateEventForCurrentState(&pod, "cluster1")
+	assert.Nil(t, err)
+
+	failedEvent, ok := result.(*api.JobFailedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, failedEvent.Reason, "(attempt 3 of 3)")
+}
+
 func TestCreateEventForCurrentState_WhenPodSucceeded(t *testing.T) {
 	pod := v1.Pod{
 		Status: v1.PodStatus{
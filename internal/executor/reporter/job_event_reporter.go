@@ -1,20 +1,27 @@
 package reporter
 
 import (
+	"fmt"
+	"hash/fnv"
+	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"github.com/G-Research/armada/internal/common"
+	commonUtil "github.com/G-Research/armada/internal/common/util"
 	clusterContext "github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 const batchSize = 200
+const defaultFailedPodLogMaxBytes = 64 * 1024
 
 type EventReporter interface {
 	Report(event api.Event) error
@@ -34,17 +41,77 @@ type JobEventReporter struct {
 
 	clusterContext clusterContext.ClusterContext
 	stop           chan bool
+
+	reconciliationConcurrency int
+	reportedNodeLabels        []string
+	// node label holding the instance type of the node, empty disables resolving instance types
+	instanceTypeLabel string
+	// limits node lookups done to tell node memory pressure from other SIGKILLs, nil disables the lookups
+	nodeOomDetectionRateLimiter flowcontrol.RateLimiter
+	failedPodLogLines           int64
+	failedPodLogMaxBytes        int
+	// limits log captures of failed pods, nil disables the limit
+	failedPodLogRateLimiter flowcontrol.RateLimiter
+	// slots of log captures running at the same time, nil does not limit the concurrency
+	failedPodLogFetches chan struct{}
+	// UIDs of pods a running event was queued for, the Running annotation is only added once the event was sent
+	runningReported sync.Map
 }
 
-func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClient api.EventClient) (*JobEventReporter, chan bool) {
+func NewJobEventReporter(
+	clusterContext clusterContext.ClusterContext,
+	eventClient api.EventClient,
+	reconciliationConcurrency int,
+	reportedNodeLabels []string,
+	instanceTypeLabel string,
+	nodeOomDetectionQPS float32,
+	nodeOomDetectionBurst int,
+	failedPodLogLines int64,
+	failedPodLogMaxBytes int,
+	failedPodLogQPS float32,
+	failedPodLogBurst int,
+	failedPodLogConcurrency int) (*JobEventReporter, chan bool) {
+
+	if reconciliationConcurrency < 1 {
+		reconciliationConcurrency = 1
+	}
+	var nodeOomDetectionRateLimiter flowcontrol.RateLimiter
+	if nodeOomDetectionQPS > 0 {
+		if nodeOomDetectionBurst < 1 {
+			nodeOomDetectionBurst = 1
+		}
+		nodeOomDetectionRateLimiter = flowcontrol.NewTokenBucketRateLimiter(nodeOomDetectionQPS, nodeOomDetectionBurst)
+	}
+	if failedPodLogMaxBytes <= 0 {
+		failedPodLogMaxBytes = defaultFailedPodLogMaxBytes
+	}
+	var failedPodLogRateLimiter flowcontrol.RateLimiter
+	if failedPodLogQPS > 0 {
+		if failedPodLogBurst < 1 {
+			failedPodLogBurst = 1
+		}
+		failedPodLogRateLimiter = flowcontrol.NewTokenBucketRateLimiter(failedPodLogQPS, failedPodLogBurst)
+	}
+	var failedPodLogFetches chan struct{}
+	if failedPodLogConcurrency > 0 {
+		failedPodLogFetches = make(chan struct{}, failedPodLogConcurrency)
+	}
 
 	stop := make(chan bool)
 	reporter := &JobEventReporter{
-		eventClient:      eventClient,
-		clusterContext:   clusterContext,
-		eventBuffer:      make(chan *queuedEvent, 1000000),
-		eventQueued:      map[string]uint8{},
-		eventQueuedMutex: sync.Mutex{}}
+		eventClient:                 eventClient,
+		clusterContext:              clusterContext,
+		eventBuffer:                 make(chan *queuedEvent, 1000000),
+		eventQueued:                 map[string]uint8{},
+		eventQueuedMutex:            sync.Mutex{},
+		reconciliationConcurrency:   reconciliationConcurrency,
+		reportedNodeLabels:          reportedNodeLabels,
+		instanceTypeLabel:           instanceTypeLabel,
+		nodeOomDetectionRateLimiter: nodeOomDetectionRateLimiter,
+		failedPodLogLines:           failedPodLogLines,
+		failedPodLogMaxBytes:        failedPodLogMaxBytes,
+		failedPodLogRateLimiter:     failedPodLogRateLimiter,
+		failedPodLogFetches:         failedPodLogFetches}
 
 	clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -53,7 +120,10 @@ func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClie
 				log.Errorf("Failed to process pod event due to it being an unexpected type. Failed to process %+v", obj)
 				return
 			}
-			go reporter.reportCurrentStatus(pod)
+			go func() {
+				reporter.annotateInstanceType(pod)
+				reporter.reportCurrentStatus(pod)
+			}()
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
 			oldPod, ok := oldObj.(*v1.Pod)
@@ -68,6 +138,11 @@ func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClie
 			}
 			go reporter.reportStatusUpdate(oldPod, newPod)
 		},
+		DeleteFunc: func(obj interface{}) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				reporter.runningReported.Delete(pod.UID)
+			}
+		},
 	})
 
 	go reporter.processEventQueue(stop)
@@ -80,7 +155,11 @@ func (eventReporter *JobEventReporter) Report(event api.Event) error {
 }
 
 func (eventReporter *JobEventReporter) reportStatusUpdate(old *v1.Pod, new *v1.Pod) {
-	if old.Status.Phase == new.Status.Phase {
+	if old.Spec.NodeName == "" && new.Spec.NodeName != "" {
+		eventReporter.annotateInstanceType(new)
+	}
+	becameReady := util.IsWaitingForReadiness(old) && !util.IsWaitingForReadiness(new)
+	if old.Status.Phase == new.Status.Phase && !becameReady {
 		return
 	}
 	eventReporter.reportCurrentStatus(new)
@@ -90,12 +169,55 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 	if !util.IsManagedPod(pod) {
 		return
 	}
+	if pod.DeletionTimestamp != nil && util.HavePrimaryContainersFinished(pod) {
+		// completion of pods with sidecar containers is reported when they are deleted, after the sidecars are killed
+		return
+	}
+	if util.IsWaitingForReadiness(pod) {
+		return
+	}
+	if util.ShouldBeRetried(pod) {
+		// the pod is recreated by the stuck pod detector, the job only fails once its retries are exhausted
+		return
+	}
+	if util.IsDrained(pod) {
+		// the job is returned to the server once its pods are gone, to run again on another node
+		return
+	}
 
 	event, err := CreateEventForCurrentState(pod, eventReporter.clusterContext.GetClusterId())
 	if err != nil {
 		log.Errorf("Failed to report event because %s", err)
 		return
 	}
+	if runningEvent, ok := event.(*api.JobRunningEvent); ok {
+		runningEvent.NodeLabels = eventReporter.getReportedNodeLabels(pod.Spec.NodeName)
+	}
+	if failedEvent, ok := event.(*api.JobFailedEvent); ok {
+		eventReporter.detectNodeOom(pod, failedEvent)
+		eventReporter.attachContainerLogs(pod, failedEvent)
+		failedEvent.RunDetails = CreateJobRunDetails(pod, eventReporter.clusterContext.GetClusterPool(), eventReporter.getInstanceType(pod))
+	}
+	if succeededEvent, ok := event.(*api.JobSucceededEvent); ok {
+		succeededEvent.RunDetails = CreateJobRunDetails(pod, eventReporter.clusterContext.GetClusterPool(), eventReporter.getInstanceType(pod))
+	}
+
+	reportedPhases := []v1.PodPhase{pod.Status.Phase}
+	if pod.Status.Phase == v1.PodRunning {
+		eventReporter.runningReported.Store(pod.UID, true)
+	} else if _, runningReported := eventReporter.runningReported.Load(pod.UID); !runningReported {
+		if runningEvent := CreateMissingRunningEvent(pod, eventReporter.clusterContext.GetClusterId()); runningEvent != nil {
+			runningEvent.NodeLabels = eventReporter.getReportedNodeLabels(pod.Spec.NodeName)
+			// queued first so it is sent before the succeeded event
+			eventReporter.runningReported.Store(pod.UID, true)
+			eventReporter.QueueEvent(runningEvent, func(err error) {
+				if err != nil {
+					log.Errorf("Failed to report missing running event of pod %s because %s", pod.Name, err)
+				}
+			})
+			reportedPhases = append(reportedPhases, v1.PodRunning)
+		}
+	}
 
 	eventReporter.QueueEvent(event, func(err error) {
 		if err != nil {
@@ -104,7 +226,7 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 		}
 
 		if util.IsReportingPhaseRequired(pod.Status.Phase) {
-			err = eventReporter.addAnnotationToMarkStateReported(pod)
+			err = eventReporter.addAnnotationToMarkStateReported(pod, reportedPhases...)
 			if err != nil {
 				log.Errorf("Failed to add state annotation %s to pod %s because %s", string(pod.Status.Phase), pod.Name, err)
 				return
@@ -113,6 +235,150 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 	})
 }
 
+func (eventReporter *JobEventReporter) getReportedNodeLabels(nodeName string) map[string]string {
+	if nodeName == "" || len(eventReporter.reportedNodeLabels) == 0 {
+		return nil
+	}
+	node, err := eventReporter.clusterContext.GetNode(nodeName)
+	if err != nil {
+		log.Warnf("Failed to get labels of node %s because %s", nodeName, err)
+		return nil
+	}
+	labels := map[string]string{}
+	for _, label := range eventReporter.reportedNodeLabels {
+		if value, ok := node.Labels[label]; ok {
+			labels[label] = value
+		}
+	}
+	return labels
+}
+
+// The instance type is kept on the pod once it is scheduled, so it is still known when the pod finishes after its node is gone
+func (eventReporter *JobEventReporter) annotateInstanceType(pod *v1.Pod) {
+	if eventReporter.instanceTypeLabel == "" || pod.Spec.NodeName == "" || !util.IsManagedPod(pod) {
+		return
+	}
+	if _, annotated := pod.Annotations[domain.NodeInstanceType]; annotated {
+		return
+	}
+	instanceType := eventReporter.getNodeInstanceType(pod.Spec.NodeName)
+	if instanceType == "" {
+		return
+	}
+	err := eventReporter.clusterContext.AddAnnotation(pod, map[string]string{domain.NodeInstanceType: instanceType})
+	if err != nil {
+		log.Errorf("Failed to add instance type annotation to pod %s because %s", pod.Name, err)
+	}
+}
+
+func (eventReporter *JobEventReporter) getInstanceType(pod *v1.Pod) string {
+	if instanceType, annotated := pod.Annotations[domain.NodeInstanceType]; annotated {
+		return instanceType
+	}
+	if eventReporter.instanceTypeLabel == "" || pod.Spec.NodeName == "" {
+		return ""
+	}
+	return eventReporter.getNodeInstanceType(pod.Spec.NodeName)
+}
+
+func (eventReporter *JobEventReporter) getNodeInstanceType(nodeName string) string {
+	node, err := eventReporter.clusterContext.GetNode(nodeName)
+	if err != nil {
+		log.Warnf("Failed to get instance type of node %s because %s", nodeName, err)
+		return ""
+	}
+	return node.Labels[eventReporter.instanceTypeLabel]
+}
+
+// Containers killed by the kernel when the whole node runs out of memory look like any other SIGKILL,
+// the node conditions and kubelet events are checked to tell them apart
+func (eventReporter *JobEventReporter) detectNodeOom(pod *v1.Pod, failedEvent *api.JobFailedEvent) {
+	if eventReporter.nodeOomDetectionRateLimiter == nil || pod.Spec.NodeName == "" {
+		return
+	}
+	containers := util.ExtractPossiblyNodeOomKilledContainers(pod)
+	if len(containers) == 0 {
+		return
+	}
+	if !eventReporter.nodeOomDetectionRateLimiter.TryAccept() {
+		log.Warnf("Skipping node memory pressure detection for pod %s, rate limit reached", pod.Name)
+		return
+	}
+
+	node, err := eventReporter.clusterContext.GetNode(pod.Spec.NodeName)
+	if err != nil {
+		node = nil
+	}
+	nodeEvents, err := eventReporter.clusterContext.GetNodeEvents(pod.Spec.NodeName)
+	if err != nil {
+		log.Warnf("Failed to get events of node %s because %s", pod.Spec.NodeName, err)
+	}
+	underPressure, message := util.DiagnoseNodeMemoryPressure(pod, node, nodeEvents)
+	if !underPressure {
+		return
+	}
+
+	killed := commonUtil.StringListToSet(containers)
+	for _, status := range failedEvent.ContainerStatuses {
+		if killed[status.Name] {
+			status.Cause = api.Cause_NodeMemoryPressure
+		}
+	}
+	failedEvent.Cause = api.Cause_NodeMemoryPressure
+	if failedEvent.Reason != "" && !strings.HasSuffix(failedEvent.Reason, "\n") {
+		failedEvent.Reason += "\n"
+	}
+	failedEvent.Reason += fmt.Sprintf("Containers %s were likely killed because the node ran out of memory, not because of their memory limit (%s)\n",
+		strings.Join(containers, ", "), message)
+}
+
+// Logs are captured when the failure is reported, as they are lost once the pod is deleted after FailedPodExpiry.
+// Kubelet of a removed node can not serve logs, so they are only fetched while the node exists.
+func (eventReporter *JobEventReporter) attachContainerLogs(pod *v1.Pod, failedEvent *api.JobFailedEvent) {
+	if eventReporter.failedPodLogLines <= 0 || pod.Spec.NodeName == "" {
+		return
+	}
+	if eventReporter.failedPodLogRateLimiter != nil && !eventReporter.failedPodLogRateLimiter.TryAccept() {
+		log.Warnf("Not capturing logs of failed pod %s, rate limit reached", pod.Name)
+		return
+	}
+	if eventReporter.failedPodLogFetches != nil {
+		eventReporter.failedPodLogFetches <- struct{}{}
+		defer func() { <-eventReporter.failedPodLogFetches }()
+	}
+	if _, err := eventReporter.clusterContext.GetNode(pod.Spec.NodeName); err != nil {
+		log.Warnf("Not capturing logs of failed pod %s, node %s is not available: %s", pod.Name, pod.Spec.NodeName, err)
+		return
+	}
+
+	remainingBytes := eventReporter.failedPodLogMaxBytes
+	for _, status := range failedEvent.ContainerStatuses {
+		if remainingBytes <= 0 {
+			return
+		}
+		logs, err := eventReporter.clusterContext.GetPodLogs(pod, status.Name, eventReporter.failedPodLogLines)
+		if err != nil {
+			log.Warnf("Failed to capture logs of container %s of pod %s because %s", status.Name, pod.Name, err)
+			continue
+		}
+		logs = keepLastBytes(logs, remainingBytes)
+		remainingBytes -= len(logs)
+		status.LogTail = strings.ToValidUTF8(logs, "\uFFFD")
+	}
+}
+
+// keepLastBytes drops the oldest lines, a line cut by the limit is dropped as a whole
+func keepLastBytes(logs string, maxBytes int) string {
+	if len(logs) <= maxBytes {
+		return logs
+	}
+	logs = logs[len(logs)-maxBytes:]
+	if newLine := strings.Index(logs, "\n"); newLine >= 0 {
+		return logs[newLine+1:]
+	}
+	return ""
+}
+
 func (eventReporter *JobEventReporter) QueueEvent(event api.Event, callback func(error)) {
 	eventReporter.eventQueuedMutex.Lock()
 	defer eventReporter.eventQueuedMutex.Unlock()
@@ -194,10 +460,11 @@ func (eventReporter *JobEventReporter) sendEvent(event api.Event) error {
 	return err
 }
 
-func (eventReporter *JobEventReporter) addAnnotationToMarkStateReported(pod *v1.Pod) error {
+func (eventReporter *JobEventReporter) addAnnotationToMarkStateReported(pod *v1.Pod, phases ...v1.PodPhase) error {
 	annotations := make(map[string]string)
-	annotationName := string(pod.Status.Phase)
-	annotations[annotationName] = time.Now().String()
+	for _, phase := range phases {
+		annotations[string(phase)] = time.Now().String()
+	}
 
 	return eventReporter.clusterContext.AddAnnotation(pod, annotations)
 }
@@ -210,11 +477,40 @@ func (eventReporter *JobEventReporter) ReportMissingJobEvents() {
 	}
 	podsWithCurrentPhaseNotReported := filterPodsWithCurrentStateNotReported(allBatchPods)
 
-	for _, pod := range podsWithCurrentPhaseNotReported {
-		if util.IsReportingPhaseRequired(pod.Status.Phase) && !eventReporter.hasPendingEvents(pod) {
-			eventReporter.reportCurrentStatus(pod)
+	// pods of the same job always end up in the same partition, so events of one job are queued in order
+	partitions := partitionPodsByJobId(podsWithCurrentPhaseNotReported, eventReporter.reconciliationConcurrency)
+
+	wg := &sync.WaitGroup{}
+	for _, partition := range partitions {
+		wg.Add(1)
+		go func(pods []*v1.Pod) {
+			defer wg.Done()
+			for _, pod := range pods {
+				if util.IsReportingPhaseRequired(pod.Status.Phase) && !eventReporter.hasPendingEvents(pod) {
+					eventReporter.reportCurrentStatus(pod)
+				}
+			}
+		}(partition)
+	}
+	wg.Wait()
+}
+
+func partitionPodsByJobId(pods []*v1.Pod, partitionCount int) [][]*v1.Pod {
+	partitions := make([][]*v1.Pod, partitionCount)
+	for _, pod := range pods {
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(util.ExtractJobId(pod)))
+		index := hash.Sum32() % uint32(partitionCount)
+		partitions[index] = append(partitions[index], pod)
+	}
+
+	result := make([][]*v1.Pod, 0, partitionCount)
+	for _, partition := range partitions {
+		if len(partition) > 0 {
+			result = append(result, partition)
 		}
 	}
+	return result
 }
 
 func (eventReporter *JobEventReporter) hasPendingEvents(pod *v1.Pod) bool {
@@ -241,7 +537,11 @@ func HasCurrentStateBeenReported(pod *v1.Pod) bool {
 }
 
 func HasPodBeenInStateForLongerThanGivenDuration(pod *v1.Pod, duration time.Duration) bool {
-	deadline := time.Now().Add(-duration)
+	return HasPodBeenInStateForLongerThanGivenDurationAt(pod, duration, time.Now())
+}
+
+func HasPodBeenInStateForLongerThanGivenDurationAt(pod *v1.Pod, duration time.Duration, now time.Time) bool {
+	deadline := now.Add(-duration)
 	lastStatusChange, err := util.LastStatusChange(pod)
 
 	if err != nil {
@@ -1,12 +1,19 @@
 package reporter
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 func TestHasPodBeenInStateForLongerThanGivenDuration_ReturnsTrue(t *testing.T) {
@@ -89,3 +96,454 @@ func TestHasCurrentStateBeenReported_FalseWhenNoAnnotationsExist(t *testing.T) {
 	result := HasCurrentStateBeenReported(&pod)
 	assert.False(t, result)
 }
+
+func TestPartitionPodsByJobId_KeepsPodsOfJobTogether(t *testing.T) {
+	pods := []*v1.Pod{}
+	for i := 0; i < 100; i++ {
+		pods = append(pods, makeUnreportedRunningPod(fmt.Sprintf("job-%d", i%10)))
+	}
+
+	partitions := partitionPodsByJobId(pods, 4)
+
+	assert.True(t, len(partitions) <= 4)
+	partitionOfJob := map[string]int{}
+	podCount := 0
+	for i, partition := range partitions {
+		for _, pod := range partition {
+			jobId := pod.Labels[domain.JobId]
+			if existing, ok := partitionOfJob[jobId]; ok {
+				assert.Equal(t, existing, i)
+			}
+			partitionOfJob[jobId] = i
+			podCount++
+		}
+	}
+	assert.Equal(t, len(pods), podCount)
+}
+
+func TestReportMissingJobEvents_KeepsUpWithThousandsOfPods(t *testing.T) {
+	pods := []*v1.Pod{}
+	for i := 0; i < 5000; i++ {
+		pods = append(pods, makeUnreportedRunningPod(fmt.Sprintf("job-%d", i)))
+	}
+	eventReporter := &JobEventReporter{
+		clusterContext:            &podListClusterContext{pods: pods},
+		eventBuffer:               make(chan *queuedEvent, len(pods)),
+		eventQueued:               map[string]uint8{},
+		reconciliationConcurrency: 10,
+	}
+
+	start := time.Now()
+	eventReporter.ReportMissingJobEvents()
+
+	assert.True(t, time.Since(start) < 15*time.Second)
+	assert.Equal(t, len(pods), len(eventReporter.eventBuffer))
+	assert.Equal(t, len(pods), len(eventReporter.eventQueued))
+}
+
+func TestGetReportedNodeLabels_OnlyIncludesConfiguredLabels(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"zone": "a", "instance-type": "large", "other": "value"},
+		},
+	}
+	eventReporter := &JobEventReporter{
+		clusterContext:     &podListClusterContext{nodes: []*v1.Node{node}},
+		reportedNodeLabels: []string{"zone", "instance-type", "missing"},
+	}
+
+	assert.Equal(t, map[string]string{"zone": "a", "instance-type": "large"}, eventReporter.getReportedNodeLabels("node-1"))
+	assert.Nil(t, eventReporter.getReportedNodeLabels("unknown-node"))
+	assert.Nil(t, eventReporter.getReportedNodeLabels(""))
+}
+
+func TestReportStatusUpdate_ReportsRunningOnceReadyWhenOptedIn(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{},
+		eventBuffer:    make(chan *queuedEvent, 10),
+		eventQueued:    map[string]uint8{},
+	}
+	pending := makeUnreportedRunningPod("job-1")
+	pending.Status.Phase = v1.PodPending
+	pending.Annotations = map[string]string{domain.RunningWhenReady: "true"}
+	pending.Spec.Containers = []v1.Container{{Name: "service", ReadinessProbe: &v1.Probe{}}}
+
+	running := pending.DeepCopy()
+	running.Status.Phase = v1.PodRunning
+	running.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}}
+	eventReporter.reportStatusUpdate(pending, running)
+	assert.Equal(t, 0, len(eventReporter.eventBuffer))
+
+	ready := running.DeepCopy()
+	ready.Status.Conditions[0].Status = v1.ConditionTrue
+	eventReporter.reportStatusUpdate(running, ready)
+	assert.Equal(t, 1, len(eventReporter.eventBuffer))
+	_, isRunningEvent := (<-eventReporter.eventBuffer).Event.(*api.JobRunningEvent)
+	assert.True(t, isRunningEvent)
+}
+
+func TestReportCurrentStatus_ClassifiesNodeMemoryPressure(t *testing.T) {
+	killedAt := time.Now().Add(-time.Minute)
+	nodeEvent := &v1.Event{
+		InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "node-1"},
+		Reason:         "SystemOOM",
+		Message:        "System OOM encountered, victim process: python",
+		LastTimestamp:  metav1.NewTime(killedAt),
+	}
+	eventReporter := &JobEventReporter{
+		clusterContext:              &podListClusterContext{nodeEvents: []*v1.Event{nodeEvent}},
+		eventBuffer:                 make(chan *queuedEvent, 10),
+		eventQueued:                 map[string]uint8{},
+		nodeOomDetectionRateLimiter: flowcontrol.NewFakeAlwaysRateLimiter(),
+	}
+
+	pod := makeKilledPod("job-1", killedAt)
+	eventReporter.reportCurrentStatus(pod)
+
+	failedEvent := (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent)
+	assert.Equal(t, api.Cause_NodeMemoryPressure, failedEvent.Cause)
+	assert.Equal(t, api.Cause_NodeMemoryPressure, failedEvent.ContainerStatuses[0].Cause)
+	assert.Contains(t, failedEvent.Reason, "System OOM encountered")
+}
+
+func TestReportCurrentStatus_KeepsCauseWithoutNodeMemoryPressure(t *testing.T) {
+	killedAt := time.Now().Add(-time.Minute)
+	oldNodeEvent := &v1.Event{
+		InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "node-1"},
+		Reason:         "SystemOOM",
+		LastTimestamp:  metav1.NewTime(killedAt.Add(-time.Hour)),
+	}
+	eventReporter := &JobEventReporter{
+		clusterContext:              &podListClusterContext{nodeEvents: []*v1.Event{oldNodeEvent}},
+		eventBuffer:                 make(chan *queuedEvent, 10),
+		eventQueued:                 map[string]uint8{},
+		nodeOomDetectionRateLimiter: flowcontrol.NewFakeAlwaysRateLimiter(),
+	}
+
+	eventReporter.reportCurrentStatus(makeKilledPod("job-1", killedAt))
+
+	failedEvent := (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent)
+	assert.Equal(t, api.Cause_Error, failedEvent.Cause)
+	assert.Equal(t, api.Cause_Error, failedEvent.ContainerStatuses[0].Cause)
+}
+
+func TestReportCurrentStatus_SkipsNodeOomDetectionWhenRateLimited(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status:     v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue}}},
+	}
+	eventReporter := &JobEventReporter{
+		clusterContext:              &podListClusterContext{nodes: []*v1.Node{node}},
+		eventBuffer:                 make(chan *queuedEvent, 10),
+		eventQueued:                 map[string]uint8{},
+		nodeOomDetectionRateLimiter: flowcontrol.NewTokenBucketRateLimiter(0.001, 1),
+	}
+
+	eventReporter.reportCurrentStatus(makeKilledPod("job-1", time.Now()))
+	eventReporter.reportCurrentStatus(makeKilledPod("job-2", time.Now()))
+
+	assert.Equal(t, api.Cause_NodeMemoryPressure, (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent).Cause)
+	assert.Equal(t, api.Cause_Error, (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent).Cause)
+}
+
+func TestReportCurrentStatus_ReportsRunningBeforeSucceededWhenRunningWasMissed(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{},
+		eventBuffer:    make(chan *queuedEvent, 10),
+		eventQueued:    map[string]uint8{},
+	}
+	pending := makeUnreportedRunningPod("job-1")
+	pending.Status.Phase = v1.PodPending
+	succeeded := pending.DeepCopy()
+	succeeded.Status.Phase = v1.PodSucceeded
+
+	eventReporter.reportStatusUpdate(pending, succeeded)
+
+	assert.Equal(t, 2, len(eventReporter.eventBuffer))
+	runningEvent, isRunningEvent := (<-eventReporter.eventBuffer).Event.(*api.JobRunningEvent)
+	assert.True(t, isRunningEvent)
+	assert.Equal(t, "job-1", runningEvent.JobId)
+	_, isSucceededEvent := (<-eventReporter.eventBuffer).Event.(*api.JobSucceededEvent)
+	assert.True(t, isSucceededEvent)
+
+	// running was reported already
+	succeeded.Annotations = map[string]string{string(v1.PodRunning): time.Now().String()}
+	eventReporter.reportCurrentStatus(succeeded)
+	assert.Equal(t, 1, len(eventReporter.eventBuffer))
+	_, isSucceededEvent = (<-eventReporter.eventBuffer).Event.(*api.JobSucceededEvent)
+	assert.True(t, isSucceededEvent)
+}
+
+func TestReportCurrentStatus_DoesNotRepeatRunningEventNotAnnotatedYet(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{},
+		eventBuffer:    make(chan *queuedEvent, 10),
+		eventQueued:    map[string]uint8{},
+	}
+	running := makeUnreportedRunningPod("job-1")
+	running.UID = "pod-1"
+	succeeded := running.DeepCopy()
+	succeeded.Status.Phase = v1.PodSucceeded
+
+	eventReporter.reportCurrentStatus(running)
+	eventReporter.reportStatusUpdate(running, succeeded)
+
+	assert.Equal(t, 2, len(eventReporter.eventBuffer))
+	_, isRunningEvent := (<-eventReporter.eventBuffer).Event.(*api.JobRunningEvent)
+	assert.True(t, isRunningEvent)
+	_, isSucceededEvent := (<-eventReporter.eventBuffer).Event.(*api.JobSucceededEvent)
+	assert.True(t, isSucceededEvent)
+}
+
+func TestReportCurrentStatus_AttachesLogsOfFailedContainers(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{
+			nodes:   []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}},
+			podLogs: map[string]string{"main": "line 1\nline 2\nline 3\n"},
+		},
+		eventBuffer:          make(chan *queuedEvent, 10),
+		eventQueued:          map[string]uint8{},
+		failedPodLogLines:    100,
+		failedPodLogMaxBytes: 15,
+	}
+
+	eventReporter.reportCurrentStatus(makeKilledPod("job-1", time.Now()))
+
+	failedEvent := (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent)
+	assert.Equal(t, "line 2\nline 3\n", failedEvent.ContainerStatuses[0].LogTail)
+}
+
+func TestReportCurrentStatus_ReportsFailureWithoutLogsWhenLogCaptureIsRateLimited(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{
+			nodes:   []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}},
+			podLogs: map[string]string{"main": "line 1\n"},
+		},
+		eventBuffer:             make(chan *queuedEvent, 10),
+		eventQueued:             map[string]uint8{},
+		failedPodLogLines:       100,
+		failedPodLogMaxBytes:    100,
+		failedPodLogRateLimiter: flowcontrol.NewTokenBucketRateLimiter(0.001, 1),
+		failedPodLogFetches:     make(chan struct{}, 1),
+	}
+
+	eventReporter.reportCurrentStatus(makeKilledPod("job-1", time.Now()))
+	eventReporter.reportCurrentStatus(makeKilledPod("job-2", time.Now()))
+
+	assert.Equal(t, "line 1\n", (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent).ContainerStatuses[0].LogTail)
+	assert.Empty(t, (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent).ContainerStatuses[0].LogTail)
+	assert.Empty(t, eventReporter.failedPodLogFetches)
+}
+
+func TestReportCurrentStatus_ReportsFailureWithoutLogsWhenNodeIsGone(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext:    &podListClusterContext{podLogs: map[string]string{"main": "line 1\n"}},
+		eventBuffer:       make(chan *queuedEvent, 10),
+		eventQueued:       map[string]uint8{},
+		failedPodLogLines: 100,
+	}
+
+	eventReporter.reportCurrentStatus(makeKilledPod("job-1", time.Now()))
+
+	failedEvent := (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent)
+	assert.Empty(t, failedEvent.ContainerStatuses[0].LogTail)
+}
+
+func TestReportCurrentStatus_SkipsPodsOfDrainedJobs(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext: &podListClusterContext{},
+		eventBuffer:    make(chan *queuedEvent, 10),
+		eventQueued:    map[string]uint8{},
+	}
+	pod := makeKilledPod("job-1", time.Now())
+	pod.Annotations = map[string]string{domain.JobDrained: time.Now().String()}
+
+	eventReporter.reportCurrentStatus(pod)
+
+	assert.Equal(t, 0, len(eventReporter.eventBuffer))
+}
+
+func TestReportStatusUpdate_AnnotatesInstanceTypeOnceScheduled(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"instance-type": "m5.large"}}}
+	clusterContext := &podListClusterContext{nodes: []*v1.Node{node}, addedAnnotations: map[string]map[string]string{}}
+	eventReporter := &JobEventReporter{
+		clusterContext:    clusterContext,
+		eventBuffer:       make(chan *queuedEvent, 10),
+		eventQueued:       map[string]uint8{},
+		instanceTypeLabel: "instance-type",
+	}
+	unscheduled := makeUnreportedRunningPod("job-1")
+	unscheduled.Status.Phase = v1.PodPending
+	scheduled := unscheduled.DeepCopy()
+	scheduled.Spec.NodeName = "node-1"
+
+	eventReporter.reportStatusUpdate(unscheduled, scheduled)
+
+	assert.Equal(t, map[string]string{domain.NodeInstanceType: "m5.large"}, clusterContext.addedAnnotations[scheduled.Name])
+	assert.Equal(t, 0, len(eventReporter.eventBuffer))
+}
+
+func TestReportCurrentStatus_AddsRunDetailsToFinishedEvents(t *testing.T) {
+	eventReporter := &JobEventReporter{
+		clusterContext:    &podListClusterContext{},
+		eventBuffer:       make(chan *queuedEvent, 10),
+		eventQueued:       map[string]uint8{},
+		instanceTypeLabel: "instance-type",
+	}
+	startedAt := time.Now().Add(-time.Hour)
+	finishedAt := time.Now().Add(-time.Minute)
+	pod := makeKilledPod("job-1", finishedAt)
+	pod.Status.StartTime = &metav1.Time{Time: startedAt}
+	// the node is gone, the instance type annotated when the pod was scheduled is used
+	pod.Annotations = map[string]string{domain.NodeInstanceType: "m5.large"}
+
+	eventReporter.reportCurrentStatus(pod)
+
+	failedEvent := (<-eventReporter.eventBuffer).Event.(*api.JobFailedEvent)
+	assert.Equal(t, "pool", failedEvent.RunDetails.Pool)
+	assert.Equal(t, "m5.large", failedEvent.RunDetails.InstanceType)
+	assert.True(t, startedAt.Equal(*failedEvent.RunDetails.Started))
+	assert.True(t, finishedAt.Truncate(time.Second).Equal(failedEvent.RunDetails.Finished.Truncate(time.Second)))
+
+	succeeded := makeUnreportedRunningPod("job-2")
+	succeeded.Status.Phase = v1.PodSucceeded
+	succeeded.Annotations = map[string]string{string(v1.PodRunning): time.Now().String()}
+	eventReporter.reportCurrentStatus(succeeded)
+
+	succeededEvent := (<-eventReporter.eventBuffer).Event.(*api.JobSucceededEvent)
+	assert.Equal(t, "pool", succeededEvent.RunDetails.Pool)
+	assert.Empty(t, succeededEvent.RunDetails.InstanceType)
+	assert.Nil(t, succeededEvent.RunDetails.Started)
+}
+
+func TestKeepLastBytes(t *testing.T) {
+	assert.Equal(t, "a\nb\n", keepLastBytes("a\nb\n", 10))
+	assert.Equal(t, "b\n", keepLastBytes("aaa\nb\n", 4))
+	assert.Equal(t, "", keepLastBytes("aaaa", 2))
+}
+
+func makeKilledPod(jobId string, killedAt time.Time) *v1.Pod {
+	pod := makeUnreportedRunningPod(jobId)
+	pod.Spec.NodeName = "node-1"
+	pod.Status.Phase = v1.PodFailed
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name: "main",
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+			ExitCode:   137,
+			Reason:     "Error",
+			FinishedAt: metav1.NewTime(killedAt),
+		}},
+	}}
+	return pod
+}
+
+func makeUnreportedRunningPod(jobId string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "armada-" + jobId,
+			Labels: map[string]string{domain.JobId: jobId, domain.Queue: "queue"},
+		},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute))}},
+		},
+	}
+}
+
+type podListClusterContext struct {
+	pods       []*v1.Pod
+	nodes      []*v1.Node
+	nodeEvents []*v1.Event
+	podLogs    map[string]string // by container name
+	// annotations added by pod name
+	addedAnnotations map[string]map[string]string
+}
+
+func (c *podListClusterContext) AddPodEventHandler(handler cache.ResourceEventHandlerFuncs) {}
+
+func (c *podListClusterContext) GetBatchPods() ([]*v1.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *podListClusterContext) GetAllPods() ([]*v1.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *podListClusterContext) GetActiveBatchPods() ([]*v1.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *podListClusterContext) GetNodes() ([]*v1.Node, error) {
+	return c.nodes, nil
+}
+
+func (c *podListClusterContext) GetNode(nodeName string) (*v1.Node, error) {
+	for _, node := range c.nodes {
+		if node.Name == nodeName {
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("node %s not found", nodeName)
+}
+
+func (c *podListClusterContext) GetNodeStatsSummary(*v1.Node) (*v1alpha1.Summary, error) {
+	return &v1alpha1.Summary{}, nil
+}
+
+func (c *podListClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
+	return []*v1.Event{}, nil
+}
+
+func (c *podListClusterContext) GetNodeEvents(nodeName string) ([]*v1.Event, error) {
+	return c.nodeEvents, nil
+}
+
+func (c *podListClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	logs, ok := c.podLogs[containerName]
+	if !ok {
+		return "", fmt.Errorf("container %s not found", containerName)
+	}
+	return logs, nil
+}
+
+func (c *podListClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error) {
+	return pod, nil
+}
+
+func (c *podListClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	return service, nil
+}
+
+func (c *podListClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	return claim, nil
+}
+
+func (c *podListClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
+	if c.addedAnnotations != nil {
+		c.addedAnnotations[pod.Name] = annotations
+	}
+	return nil
+}
+
+func (c *podListClusterContext) AddAnnotationToPods(pods []*v1.Pod, annotations map[string]string) error {
+	return nil
+}
+
+func (c *podListClusterContext) DeletePods(pods []*v1.Pod) {}
+
+func (c *podListClusterContext) DeletePodsWithGracePeriod(pods []*v1.Pod, gracePeriodSeconds int64) {}
+
+func (c *podListClusterContext) GetClusterId() string {
+	return "cluster"
+}
+
+func (c *podListClusterContext) GetServerVersion() (string, error) {
+	return "v1.20.0", nil
+}
+
+func (c *podListClusterContext) GetClusterPool() string {
+	return "pool"
+}
+
+func (c *podListClusterContext) Stop() {}
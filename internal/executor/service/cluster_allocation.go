@@ -2,15 +2,20 @@ package service
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/reporter"
@@ -21,23 +26,47 @@ import (
 const admissionWebhookValidationFailureMessage string = "admission webhook"
 
 type ClusterAllocationService struct {
-	leaseService       LeaseService
-	eventReporter      reporter.EventReporter
-	utilisationService UtilisationService
-	clusterContext     context.ClusterContext
+	leaseService                LeaseService
+	eventReporter               reporter.EventReporter
+	utilisationService          UtilisationService
+	clusterContext              context.ClusterContext
+	maxInFlightLeases           int
+	maxConcurrentPodSubmissions int
+	priorityClassBands          []configuration.PriorityClassBand
+	oomRetry                    configuration.OomRetryConfiguration
+	podNamer                    *PodNamer
+	preemptibleNodes            configuration.PreemptibleNodeConfiguration
 }
 
 func NewClusterAllocationService(
 	clusterContext context.ClusterContext,
 	eventReporter reporter.EventReporter,
 	leaseService LeaseService,
-	utilisationService UtilisationService) *ClusterAllocationService {
+	utilisationService UtilisationService,
+	maxInFlightLeases int,
+	maxConcurrentPodSubmissions int,
+	priorityClassBands []configuration.PriorityClassBand,
+	oomRetry configuration.OomRetryConfiguration,
+	podNamer *PodNamer,
+	preemptibleNodes configuration.PreemptibleNodeConfiguration) *ClusterAllocationService {
+
+	sortedBands := make([]configuration.PriorityClassBand, len(priorityClassBands))
+	copy(sortedBands, priorityClassBands)
+	sort.Slice(sortedBands, func(i, j int) bool {
+		return sortedBands[i].MaximumPriority < sortedBands[j].MaximumPriority
+	})
 
 	return &ClusterAllocationService{
-		leaseService:       leaseService,
-		eventReporter:      eventReporter,
-		utilisationService: utilisationService,
-		clusterContext:     clusterContext}
+		leaseService:                leaseService,
+		eventReporter:               eventReporter,
+		utilisationService:          utilisationService,
+		clusterContext:              clusterContext,
+		maxInFlightLeases:           maxInFlightLeases,
+		maxConcurrentPodSubmissions: maxConcurrentPodSubmissions,
+		priorityClassBands:          sortedBands,
+		oomRetry:                    oomRetry,
+		podNamer:                    podNamer,
+		preemptibleNodes:            preemptibleNodes}
 }
 
 func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity() {
@@ -53,8 +82,15 @@ func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity(
 		log.Errorf("Failed to allocate spare cluster capacity because %s", err)
 		return
 	}
+
+	maxJobsToLease, canLease := allocationService.remainingInFlightLeases(leasedJobs)
+	if !canLease {
+		log.Infof("Not requesting new jobs as there are already %d leased jobs waiting to be submitted", len(util.FilterPods(leasedJobs, isTransient)))
+		return
+	}
+
 	leasedJobs = util.FilterPods(leasedJobs, shouldBeRenewed)
-	newJobs, err := allocationService.leaseService.RequestJobLeases(capacityReport.AvailableCapacity, capacityReport.Nodes, getAllocationByQueue(leasedJobs))
+	newJobs, err := allocationService.leaseService.RequestJobLeases(capacityReport.AvailableCapacity, capacityReport.Nodes, getAllocationByQueue(leasedJobs), maxJobsToLease)
 
 	cpu := (*capacityReport.AvailableCapacity)["cpu"]
 	memory := (*capacityReport.AvailableCapacity)["memory"]
@@ -68,36 +104,68 @@ func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity(
 	}
 }
 
+// remainingInFlightLeases returns how many more jobs can be leased before reaching the configured limit of transient pods,
+// 0 means there is no limit
+func (allocationService *ClusterAllocationService) remainingInFlightLeases(pods []*v1.Pod) (uint32, bool) {
+	if allocationService.maxInFlightLeases <= 0 {
+		return 0, true
+	}
+	transientPods := util.FilterPods(pods, isTransient)
+	remaining := allocationService.maxInFlightLeases - len(transientPods)
+	if remaining <= 0 {
+		return 0, false
+	}
+	return uint32(remaining), true
+}
+
+func isTransient(pod *v1.Pod) bool {
+	return pod.Status.Phase == ""
+}
+
+// Jobs are submitted concurrently, pods of one job and all jobs of one gang are submitted in order by the same worker.
+// Results are reported after all submissions finished, in the order of the leased jobs with gang members kept together.
 func (allocationService *ClusterAllocationService) submitJobs(jobsToSubmit []*api.Job) {
 	toBeFailedJobs := make([]*failedSubmissionDetails, 0, 10)
 
-	for _, job := range jobsToSubmit {
-		jobPods := []*v1.Pod{}
-		for i, _ := range job.GetAllPodSpecs() {
-			pod := createPod(job, i)
-			_, err := allocationService.clusterContext.SubmitPod(pod, job.Owner)
-			jobPods = append(jobPods, pod)
-
-			if err != nil {
-				log.Errorf("Failed to submit job %s because %s", job.Id, err)
-
-				status, ok := err.(errors.APIStatus)
-				if ok && (isNotRecoverable(status.Status())) {
-					errDetails := &failedSubmissionDetails{
-						job:   job,
-						pod:   pod,
-						error: status,
-					}
-					toBeFailedJobs = append(toBeFailedJobs, errDetails)
-				} else {
-					allocationService.returnLease(pod, fmt.Sprintf("Failed to submit pod because %s", err))
-				}
-				// remove just created pods
-				allocationService.clusterContext.DeletePods(jobPods)
-				break
+	leasedTime := time.Now()
+	gangs := groupGangs(jobsToSubmit)
+	gangSubmissions := make([][]*jobSubmission, len(gangs))
+	concurrency := allocationService.maxConcurrentPodSubmissions
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	limit := make(chan bool, concurrency)
+	wg := sync.WaitGroup{}
+	for i, members := range gangs {
+		wg.Add(1)
+		limit <- true
+		go func(i int, members []*api.Job) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			gangSubmissions[i] = allocationService.submitGang(members, leasedTime)
+		}(i, members)
+	}
+	wg.Wait()
+
+	failedSubmissions := 0
+	for _, submissions := range gangSubmissions {
+		for _, submission := range submissions {
+			if submission.err != nil {
+				failedSubmissions++
+				toBeFailedJobs = allocationService.handleSubmissionError(submission.job, submission.failedPod, submission.err, submission.object, toBeFailedJobs)
+				continue
 			}
+			if submission.service != nil {
+				allocationService.reportServiceCreated(submission.pods[0], submission.service)
+			}
+		}
+		if submissions[0].job.GangId != "" {
+			allocationService.reportGangSubmission(submissions)
 		}
 	}
+	if failedSubmissions > 0 {
+		log.Errorf("Failed to submit %d of %d jobs", failedSubmissions, len(jobsToSubmit))
+	}
 
 	err := allocationService.failJobs(toBeFailedJobs)
 	if err != nil {
@@ -105,6 +173,185 @@ func (allocationService *ClusterAllocationService) submitJobs(jobsToSubmit []*ap
 	}
 }
 
+// groupGangs keeps jobs without gang on their own, members of a gang are grouped at the position of the first of them
+func groupGangs(jobs []*api.Job) [][]*api.Job {
+	groups := [][]*api.Job{}
+	gangIndex := map[string]int{}
+	for _, job := range jobs {
+		if job.GangId == "" {
+			groups = append(groups, []*api.Job{job})
+			continue
+		}
+		key := job.JobSetId + "/" + job.GangId
+		i, exists := gangIndex[key]
+		if !exists {
+			i = len(groups)
+			gangIndex[key] = i
+			groups = append(groups, []*api.Job{})
+		}
+		groups[i] = append(groups[i], job)
+	}
+	return groups
+}
+
+// Gang members are created all or none, when any of them fails pods of the already created members are removed
+// and all members share the failure. Services and volume claims of removed pods are deleted together with the pods.
+func (allocationService *ClusterAllocationService) submitGang(members []*api.Job, leasedTime time.Time) []*jobSubmission {
+	if members[0].GangId == "" {
+		return []*jobSubmission{allocationService.submitJob(members[0], leasedTime)}
+	}
+
+	var failure *jobSubmission
+	if len(members) != int(members[0].GangSize) {
+		err := fmt.Errorf("lease contains %d of %d members of gang %s", len(members), members[0].GangSize, members[0].GangId)
+		failure = (&jobSubmission{job: members[0]}).failed(createPod(members[0], 0, allocationService.podNamer), "gang", err)
+	} else {
+		submitted := make([]*jobSubmission, 0, len(members))
+		for _, job := range members {
+			submission := allocationService.submitJob(job, leasedTime)
+			if submission.err != nil {
+				failure = submission
+				break
+			}
+			submitted = append(submitted, submission)
+		}
+		if failure == nil {
+			return submitted
+		}
+		log.Errorf("Failed to submit gang %s because job %s failed, removing pods of %d already submitted members",
+			members[0].GangId, failure.job.Id, len(submitted))
+		for _, submission := range submitted {
+			allocationService.clusterContext.DeletePods(submission.pods)
+		}
+	}
+
+	submissions := make([]*jobSubmission, 0, len(members))
+	for _, job := range members {
+		if job == failure.job {
+			submissions = append(submissions, failure)
+			continue
+		}
+		object := fmt.Sprintf("%s of gang member %s", failure.object, failure.job.Id)
+		submissions = append(submissions, (&jobSubmission{job: job}).failed(createPod(job, 0, allocationService.podNamer), object, failure.err))
+	}
+	return submissions
+}
+
+func (allocationService *ClusterAllocationService) reportGangSubmission(submissions []*jobSubmission) {
+	var rejection error
+	for _, submission := range submissions {
+		if submission.err != nil {
+			rejection = submission.err
+			break
+		}
+	}
+
+	clusterId := allocationService.clusterContext.GetClusterId()
+	for _, submission := range submissions {
+		var event api.Event
+		if rejection == nil {
+			event = reporter.CreateJobGangPlacedEvent(submission.job, clusterId)
+		} else {
+			event = reporter.CreateJobGangRejectedEvent(submission.job, rejection.Error(), clusterId)
+		}
+		err := allocationService.eventReporter.Report(event)
+		if err != nil {
+			log.Errorf("Failed to report event %+v because %s", event, err)
+		}
+	}
+}
+
+type jobSubmission struct {
+	job       *api.Job
+	pods      []*v1.Pod
+	service   *v1.Service
+	failedPod *v1.Pod
+	object    string
+	err       error
+}
+
+// Submits the pods of the job and its service, returning the outcome instead of reporting it as it runs on a submission worker.
+// On failure already created pods are removed, so jobs never run without all their pods, the claimed volume or the service exposing their ports.
+func (allocationService *ClusterAllocationService) submitJob(job *api.Job, leasedTime time.Time) *jobSubmission {
+	submission := &jobSubmission{job: job}
+	for i, _ := range job.GetAllPodSpecs() {
+		pod := createPod(job, i, allocationService.podNamer)
+		setPriorityClass(pod, job.Priority, allocationService.priorityClassBands)
+		setSchedulingTimes(pod, job.Created, leasedTime)
+		setOomRetryPolicy(pod, allocationService.oomRetry)
+		setPreemptibleNodePolicy(pod, job.ToleratesPreemptibleNodes, allocationService.preemptibleNodes)
+		err := addVolume(pod, job.VolumeClaimTemplate)
+		if err != nil {
+			log.Errorf("Failed to add volume claim to pod of job %s because %s", job.Id, err)
+			allocationService.clusterContext.DeletePods(submission.pods)
+			return submission.failed(pod, "volume claim", err)
+		}
+		submittedPod, err := allocationService.clusterContext.SubmitPod(pod, job.Owner)
+		if submittedPod != nil {
+			// admission plugins can change the pod, for example its namespace
+			pod = submittedPod
+		}
+		submission.pods = append(submission.pods, pod)
+
+		if err != nil {
+			log.Errorf("Failed to submit job %s because %s", job.Id, err)
+			// remove just created pods
+			allocationService.clusterContext.DeletePods(submission.pods)
+			return submission.failed(pod, "pod", err)
+		}
+
+		_, err = submitVolumeClaim(allocationService.clusterContext, pod)
+		if err != nil {
+			log.Errorf("Failed to create volume claim of job %s because %s", job.Id, err)
+			allocationService.clusterContext.DeletePods(submission.pods)
+			return submission.failed(pod, "volume claim", err)
+		}
+	}
+
+	if len(job.ServicePorts) > 0 {
+		service := createService(job, submission.pods[0])
+		submittedService, err := allocationService.clusterContext.SubmitService(service, job.Owner)
+		if err != nil {
+			log.Errorf("Failed to create service of job %s because %s", job.Id, err)
+			allocationService.clusterContext.DeletePods(submission.pods)
+			return submission.failed(submission.pods[0], "service", err)
+		}
+		submission.service = submittedService
+	}
+	return submission
+}
+
+func (submission *jobSubmission) failed(pod *v1.Pod, object string, err error) *jobSubmission {
+	submission.failedPod = pod
+	submission.object = object
+	submission.err = err
+	return submission
+}
+
+func (allocationService *ClusterAllocationService) reportServiceCreated(pod *v1.Pod, service *v1.Service) {
+	event := reporter.CreateJobServiceCreatedEvent(pod, service, allocationService.clusterContext.GetClusterId())
+	err := allocationService.eventReporter.Report(event)
+	if err != nil {
+		log.Errorf("Failed to report event %+v because %s", event, err)
+	}
+}
+
+func (allocationService *ClusterAllocationService) handleSubmissionError(
+	job *api.Job, pod *v1.Pod, err error, object string, toBeFailedJobs []*failedSubmissionDetails) []*failedSubmissionDetails {
+
+	status, ok := err.(errors.APIStatus)
+	if ok && (isNotRecoverable(status.Status())) {
+		errDetails := &failedSubmissionDetails{
+			job:   job,
+			pod:   pod,
+			error: status,
+		}
+		return append(toBeFailedJobs, errDetails)
+	}
+	allocationService.returnLease(pod, fmt.Sprintf("Failed to submit %s because %s", object, err))
+	return toBeFailedJobs
+}
+
 func isNotRecoverable(status metav1.Status) bool {
 	if status.Reason == metav1.StatusReasonInvalid ||
 		status.Reason == metav1.StatusReasonForbidden {
@@ -136,12 +383,12 @@ func (allocationService *ClusterAllocationService) failJobs(failedSubmissions []
 }
 
 func (allocationService *ClusterAllocationService) returnLease(pod *v1.Pod, reason string) {
-	err := allocationService.leaseService.ReturnLease(pod)
+	err := allocationService.leaseService.ReturnLease(pod, api.RequeueReason_PodCreationFailed)
 
 	if err != nil {
 		log.Errorf("Failed to return lease for job %s because %s", util.ExtractJobId(pod), err)
 	} else {
-		leaseReturnedEvent := reporter.CreateJobLeaseReturnedEvent(pod, reason, allocationService.clusterContext.GetClusterId())
+		leaseReturnedEvent := reporter.CreateJobLeaseReturnedEvent(pod, reason, api.RequeueReason_PodCreationFailed, allocationService.clusterContext.GetClusterId())
 
 		err = allocationService.eventReporter.Report(leaseReturnedEvent)
 		if err != nil {
@@ -150,7 +397,7 @@ func (allocationService *ClusterAllocationService) returnLease(pod *v1.Pod, reas
 	}
 }
 
-func createPod(job *api.Job, i int) *v1.Pod {
+func createPod(job *api.Job, i int, podNamer *PodNamer) *v1.Pod {
 
 	allPodSpecs := job.GetAllPodSpecs()
 	podSpec := allPodSpecs[i]
@@ -165,11 +412,20 @@ func createPod(job *api.Job, i int) *v1.Pod {
 		domain.JobSetId: job.JobSetId,
 	})
 
+	if len(job.ServicePorts) > 0 {
+		annotation[domain.JobServiceName] = serviceName(job)
+	}
+	if job.MaxRetries > 0 {
+		annotation[domain.JobMaxRetries] = strconv.Itoa(int(job.MaxRetries))
+		annotation[domain.JobAttempt] = "1"
+		annotation[domain.JobOwner] = job.Owner
+	}
+
 	setRestartPolicyNever(podSpec)
 
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        common.PodNamePrefix + job.Id + "-" + strconv.Itoa(i),
+			Name:        podNamer.PodName(job, i),
 			Labels:      labels,
 			Annotations: annotation,
 			Namespace:   job.Namespace,
@@ -180,6 +436,79 @@ func createPod(job *api.Job, i int) *v1.Pod {
 	return pod
 }
 
+// Service is in the namespace of the pod returned by submission, as admission plugins can change it
+func createService(job *api.Job, pod *v1.Pod) *v1.Service {
+	ports := make([]v1.ServicePort, 0, len(job.ServicePorts))
+	for _, port := range job.ServicePorts {
+		ports = append(ports, *port)
+	}
+
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceName(job),
+			Labels: map[string]string{
+				domain.JobId: job.Id,
+				domain.Queue: job.Queue,
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: job.JobSetId,
+			},
+			Namespace: pod.Namespace,
+		},
+		Spec: v1.ServiceSpec{
+			Type:     v1.ServiceTypeClusterIP,
+			Selector: map[string]string{domain.JobId: job.Id},
+			Ports:    ports,
+		},
+	}
+	if pod.UID != "" {
+		service.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: pod.Name, UID: pod.UID}}
+	}
+	return service
+}
+
+func serviceName(job *api.Job) string {
+	return common.PodNamePrefix + job.Id
+}
+
+func setSchedulingTimes(pod *v1.Pod, submittedTime time.Time, leasedTime time.Time) {
+	if !submittedTime.IsZero() {
+		pod.Annotations[domain.JobSubmittedTime] = submittedTime.Format(time.RFC3339Nano)
+	}
+	pod.Annotations[domain.JobLeasedTime] = leasedTime.Format(time.RFC3339Nano)
+}
+
+// Priority class explicitly requested in the pod spec takes precedence over the banding
+func setPriorityClass(pod *v1.Pod, priority float64, sortedBands []configuration.PriorityClassBand) {
+	if pod.Spec.PriorityClassName != "" || pod.Spec.Priority != nil {
+		return
+	}
+	for _, band := range sortedBands {
+		if priority <= band.MaximumPriority {
+			pod.Spec.PriorityClassName = band.PriorityClassName
+			return
+		}
+	}
+}
+
+// Pods of jobs with retries get the oom retry policy of the executor unless the job has its own factor,
+// the max memory of the executor also caps the max memory of jobs with their own policy
+func setOomRetryPolicy(pod *v1.Pod, oomRetry configuration.OomRetryConfiguration) {
+	if _, hasRetries := pod.Annotations[domain.JobMaxRetries]; !hasRetries {
+		return
+	}
+	if _, exists := pod.Annotations[domain.OomRetryMemoryFactor]; !exists && oomRetry.MemoryFactor > 1 {
+		pod.Annotations[domain.OomRetryMemoryFactor] = strconv.FormatFloat(oomRetry.MemoryFactor, 'f', -1, 64)
+	}
+	if oomRetry.MaxMemory.IsZero() {
+		return
+	}
+	maxMemory, err := resource.ParseQuantity(pod.Annotations[domain.OomRetryMaxMemory])
+	if err != nil || maxMemory.Cmp(oomRetry.MaxMemory) > 0 {
+		pod.Annotations[domain.OomRetryMaxMemory] = oomRetry.MaxMemory.String()
+	}
+}
+
 func setRestartPolicyNever(podSpec *v1.PodSpec) {
 	podSpec.RestartPolicy = v1.RestartPolicyNever
 }
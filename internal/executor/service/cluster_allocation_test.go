@@ -1,15 +1,21 @@
 package service
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestCreateLabels_CreatesExpectedLabels(t *testing.T) {
@@ -31,7 +37,7 @@ func TestCreateLabels_CreatesExpectedLabels(t *testing.T) {
 		domain.JobSetId: job.JobSetId,
 	}
 
-	result := createPod(&job, 0)
+	result := createPod(&job, 0, nil)
 
 	assert.Equal(t, result.Labels, expectedLabels)
 	assert.Equal(t, result.Annotations, expectedAnotations)
@@ -78,10 +84,124 @@ func TestCreatePod_CreatesExpectedPod(t *testing.T) {
 		Spec: *podSpec,
 	}
 
-	result := createPod(&job, 0)
+	result := createPod(&job, 0, nil)
 	assert.Equal(t, result, &expectedOutput)
 }
 
+func TestSetPriorityClass_UsesLowestMatchingBand(t *testing.T) {
+	bands := []configuration.PriorityClassBand{
+		{MaximumPriority: 10, PriorityClassName: "armada-high"},
+		{MaximumPriority: 100, PriorityClassName: "armada-default"},
+	}
+
+	pod := &v1.Pod{}
+	setPriorityClass(pod, 5, bands)
+	assert.Equal(t, "armada-high", pod.Spec.PriorityClassName)
+
+	pod = &v1.Pod{}
+	setPriorityClass(pod, 10, bands)
+	assert.Equal(t, "armada-high", pod.Spec.PriorityClassName)
+
+	pod = &v1.Pod{}
+	setPriorityClass(pod, 50, bands)
+	assert.Equal(t, "armada-default", pod.Spec.PriorityClassName)
+}
+
+func TestSetPriorityClass_LeavesUnsetAboveAllBands(t *testing.T) {
+	pod := &v1.Pod{}
+	setPriorityClass(pod, 1000, []configuration.PriorityClassBand{{MaximumPriority: 100, PriorityClassName: "armada-default"}})
+	assert.Equal(t, "", pod.Spec.PriorityClassName)
+}
+
+func TestSetPriorityClass_UsesFirstBandBelowAllBands(t *testing.T) {
+	bands := []configuration.PriorityClassBand{
+		{MaximumPriority: 10, PriorityClassName: "armada-high"},
+		{MaximumPriority: 100, PriorityClassName: "armada-default"},
+	}
+
+	pod := &v1.Pod{}
+	setPriorityClass(pod, 0, bands)
+	assert.Equal(t, "armada-high", pod.Spec.PriorityClassName)
+
+	pod = &v1.Pod{}
+	setPriorityClass(pod, -5, bands)
+	assert.Equal(t, "armada-high", pod.Spec.PriorityClassName)
+}
+
+func TestSetPriorityClass_LeavesUnsetWithoutBands(t *testing.T) {
+	pod := &v1.Pod{}
+	setPriorityClass(pod, 1, nil)
+	assert.Equal(t, "", pod.Spec.PriorityClassName)
+}
+
+func TestSetPriorityClass_KeepsExplicitPriorityClass(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{PriorityClassName: "user-class"}}
+	setPriorityClass(pod, 1, []configuration.PriorityClassBand{{MaximumPriority: 100, PriorityClassName: "armada-default"}})
+	assert.Equal(t, "user-class", pod.Spec.PriorityClassName)
+}
+
+func TestNewClusterAllocationService_SortsPriorityClassBands(t *testing.T) {
+	allocationService := NewClusterAllocationService(nil, nil, nil, nil, 0, 0, []configuration.PriorityClassBand{
+		{MaximumPriority: 100, PriorityClassName: "armada-default"},
+		{MaximumPriority: 10, PriorityClassName: "armada-high"},
+	}, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+
+	pod := &v1.Pod{}
+	setPriorityClass(pod, 5, allocationService.priorityClassBands)
+	assert.Equal(t, "armada-high", pod.Spec.PriorityClassName)
+}
+
+func TestRemainingInFlightLeases_NoLimitConfigured(t *testing.T) {
+	allocationService := &ClusterAllocationService{}
+
+	remaining, canLease := allocationService.remainingInFlightLeases([]*v1.Pod{{}, {}})
+	assert.True(t, canLease)
+	assert.Equal(t, uint32(0), remaining)
+}
+
+func TestRemainingInFlightLeases_SubtractsTransientPods(t *testing.T) {
+	allocationService := &ClusterAllocationService{maxInFlightLeases: 3}
+	pods := []*v1.Pod{{}, {Status: v1.PodStatus{Phase: v1.PodRunning}}}
+
+	remaining, canLease := allocationService.remainingInFlightLeases(pods)
+	assert.True(t, canLease)
+	assert.Equal(t, uint32(2), remaining)
+}
+
+func TestRemainingInFlightLeases_LimitReached(t *testing.T) {
+	allocationService := &ClusterAllocationService{maxInFlightLeases: 2}
+
+	_, canLease := allocationService.remainingInFlightLeases([]*v1.Pod{{}, {}})
+	assert.False(t, canLease)
+}
+
+func TestSetOomRetryPolicy_AppliesConfiguredPolicyToJobsWithRetries(t *testing.T) {
+	oomRetry := configuration.OomRetryConfiguration{MemoryFactor: 1.5, MaxMemory: resource.MustParse("8Gi")}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.JobMaxRetries: "2", domain.OomRetryMaxMemory: "16Gi"}}}
+
+	setOomRetryPolicy(pod, oomRetry)
+	assert.Equal(t, "1.5", pod.Annotations[domain.OomRetryMemoryFactor])
+	assert.Equal(t, "8Gi", pod.Annotations[domain.OomRetryMaxMemory])
+}
+
+func TestSetOomRetryPolicy_KeepsJobPolicyWithinLimits(t *testing.T) {
+	oomRetry := configuration.OomRetryConfiguration{MemoryFactor: 1.5, MaxMemory: resource.MustParse("8Gi")}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		domain.JobMaxRetries: "2", domain.OomRetryMemoryFactor: "2", domain.OomRetryMaxMemory: "4Gi"}}}
+
+	setOomRetryPolicy(pod, oomRetry)
+	assert.Equal(t, "2", pod.Annotations[domain.OomRetryMemoryFactor])
+	assert.Equal(t, "4Gi", pod.Annotations[domain.OomRetryMaxMemory])
+}
+
+func TestSetOomRetryPolicy_IgnoresJobsWithoutRetries(t *testing.T) {
+	oomRetry := configuration.OomRetryConfiguration{MemoryFactor: 1.5, MaxMemory: resource.MustParse("8Gi")}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+
+	setOomRetryPolicy(pod, oomRetry)
+	assert.Empty(t, pod.Annotations)
+}
+
 func makePodSpec() *v1.PodSpec {
 	containers := make([]v1.Container, 1)
 	containers[0] = v1.Container{
@@ -96,3 +216,168 @@ func makePodSpec() *v1.PodSpec {
 
 	return &spec
 }
+
+func TestSubmitJobs_CreatesServiceForJobWithServicePorts(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	eventReporter := &FakeEventReporter{}
+	allocationService := NewClusterAllocationService(clusterContext, eventReporter, NewMockLeaseService(), nil, 0, 0, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	job := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", Namespace: "namespace", PodSpec: makePodSpec(),
+		ServicePorts: []*v1.ServicePort{{Name: "jupyter", Port: 8888}}}
+
+	allocationService.submitJobs([]*api.Job{job})
+
+	service := clusterContext.services["armada-job1"]
+	assert.NotNil(t, service)
+	assert.Equal(t, "namespace", service.Namespace)
+	assert.Equal(t, map[string]string{domain.JobId: "job1"}, service.Spec.Selector)
+	assert.Equal(t, []v1.ServicePort{{Name: "jupyter", Port: 8888}}, service.Spec.Ports)
+	assert.Equal(t, "armada-job1", clusterContext.pods["job1"].Annotations[domain.JobServiceName])
+
+	assert.Len(t, eventReporter.receivedEvents, 1)
+	serviceCreated, ok := eventReporter.receivedEvents[0].(*api.JobServiceCreatedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "10.0.0.1", serviceCreated.ClusterIp)
+	assert.Equal(t, []int32{8888}, serviceCreated.Ports)
+}
+
+func TestSubmitJobs_RemovesPodsAndReturnsLease_WhenServiceCreationFails(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	clusterContext.serviceError = fmt.Errorf("api server unavailable")
+	leaseService := NewMockLeaseService()
+	allocationService := NewClusterAllocationService(clusterContext, &FakeEventReporter{}, leaseService, nil, 0, 0, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	job := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", PodSpec: makePodSpec(),
+		ServicePorts: []*v1.ServicePort{{Port: 8888}}}
+
+	allocationService.submitJobs([]*api.Job{job})
+
+	assert.Empty(t, clusterContext.pods)
+	assert.Equal(t, 1, leaseService.returnLeaseCalls)
+	assert.Equal(t, api.RequeueReason_PodCreationFailed, leaseService.returnLeaseReason)
+}
+
+func TestSubmitJobs_CreatesVolumeClaimOfPodFromJobTemplate(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	allocationService := NewClusterAllocationService(clusterContext, &FakeEventReporter{}, NewMockLeaseService(), nil, 0, 0, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	job := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", Namespace: "namespace", PodSpec: makePodSpec(), VolumeClaimTemplate: testVolumeClaimTemplate}
+
+	allocationService.submitJobs([]*api.Job{job})
+
+	pod := clusterContext.pods["job1"]
+	assert.Equal(t, "armada-job1-0-scratch", pod.Annotations[domain.JobVolumeClaimName])
+	assert.Equal(t, "armada-job1-0-scratch", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, []v1.VolumeMount{{Name: "scratch", MountPath: "/scratch"}}, pod.Spec.Containers[0].VolumeMounts)
+	assert.Empty(t, job.PodSpec.Volumes)
+
+	claim := clusterContext.volumeClaims["armada-job1-0-scratch"]
+	assert.NotNil(t, claim)
+	assert.Equal(t, "namespace", claim.Namespace)
+	assert.Equal(t, "fast", *claim.Spec.StorageClassName)
+	assert.Equal(t, resource.MustParse("10Gi"), claim.Spec.Resources.Requests[v1.ResourceStorage])
+	assert.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, claim.Spec.AccessModes)
+}
+
+func TestSubmitJobs_RemovesPodsAndReturnsLease_WhenVolumeClaimCreationFails(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	clusterContext.claimError = fmt.Errorf("api server unavailable")
+	leaseService := NewMockLeaseService()
+	allocationService := NewClusterAllocationService(clusterContext, &FakeEventReporter{}, leaseService, nil, 0, 0, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	job := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", PodSpec: makePodSpec(), VolumeClaimTemplate: testVolumeClaimTemplate}
+
+	allocationService.submitJobs([]*api.Job{job})
+
+	assert.Empty(t, clusterContext.pods)
+	assert.Equal(t, 1, leaseService.returnLeaseCalls)
+	assert.Equal(t, api.RequeueReason_PodCreationFailed, leaseService.returnLeaseReason)
+}
+
+func TestSubmitJobs_ConcurrentlySubmittedJobsAreReportedInOrder(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	invalid := errors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "pod", field.ErrorList{})
+	clusterContext.podErrors = map[string]error{"job2": invalid, "job3": fmt.Errorf("api server unavailable"), "job4": invalid}
+	eventReporter := &FakeEventReporter{}
+	leaseService := NewMockLeaseService()
+	allocationService := NewClusterAllocationService(clusterContext, eventReporter, leaseService, nil, 0, 3, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	jobs := []*api.Job{}
+	for i := 1; i <= 5; i++ {
+		jobs = append(jobs, &api.Job{Id: fmt.Sprintf("job%d", i), JobSetId: "set1", Queue: "queue1", PodSpec: makePodSpec()})
+	}
+
+	allocationService.submitJobs(jobs)
+
+	assert.Len(t, clusterContext.pods, 2)
+	assert.Contains(t, clusterContext.pods, "job1")
+	assert.Contains(t, clusterContext.pods, "job5")
+	assert.Equal(t, 1, leaseService.returnLeaseCalls)
+	assert.Equal(t, "job3", leaseService.returnLeaseArg.Labels[domain.JobId])
+
+	jobIds := []string{}
+	for _, event := range eventReporter.receivedEvents {
+		jobIds = append(jobIds, event.GetJobId())
+	}
+	assert.Equal(t, []string{"job3", "job2", "job4"}, jobIds)
+}
+
+func TestSubmitJobs_SubmitsWholeGang(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	eventReporter := &FakeEventReporter{}
+	allocationService := NewClusterAllocationService(clusterContext, eventReporter, NewMockLeaseService(), nil, 0, 3, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+
+	allocationService.submitJobs(makeGang("gang1", 2))
+
+	assert.Len(t, clusterContext.pods, 2)
+	assert.Len(t, eventReporter.receivedEvents, 2)
+	for _, event := range eventReporter.receivedEvents {
+		placed, ok := event.(*api.JobGangPlacedEvent)
+		assert.True(t, ok)
+		assert.Equal(t, "gang1", placed.GangId)
+		assert.Equal(t, uint32(2), placed.GangSize)
+	}
+}
+
+func TestSubmitJobs_RemovesPodsOfAllGangMembers_WhenMemberFails(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	clusterContext.podErrors = map[string]error{"gang1-2": fmt.Errorf("api server unavailable")}
+	eventReporter := &FakeEventReporter{}
+	leaseService := NewMockLeaseService()
+	allocationService := NewClusterAllocationService(clusterContext, eventReporter, leaseService, nil, 0, 3, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+	single := &api.Job{Id: "single", JobSetId: "set1", Queue: "queue1", PodSpec: makePodSpec()}
+
+	allocationService.submitJobs(append(makeGang("gang1", 3), single))
+
+	assert.Len(t, clusterContext.pods, 1)
+	assert.Contains(t, clusterContext.pods, "single")
+	assert.Equal(t, 3, leaseService.returnLeaseCalls)
+
+	rejected := []string{}
+	for _, event := range eventReporter.receivedEvents {
+		if rejectedEvent, ok := event.(*api.JobGangRejectedEvent); ok {
+			rejected = append(rejected, rejectedEvent.JobId)
+			assert.Equal(t, "api server unavailable", rejectedEvent.Reason)
+		}
+	}
+	assert.Equal(t, []string{"gang1-1", "gang1-2", "gang1-3"}, rejected)
+}
+
+func TestSubmitJobs_RejectsIncompleteGang(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	eventReporter := &FakeEventReporter{}
+	leaseService := NewMockLeaseService()
+	allocationService := NewClusterAllocationService(clusterContext, eventReporter, leaseService, nil, 0, 3, nil, configuration.OomRetryConfiguration{}, nil, configuration.PreemptibleNodeConfiguration{})
+
+	allocationService.submitJobs(makeGang("gang1", 2)[:1])
+
+	assert.Empty(t, clusterContext.pods)
+	assert.Equal(t, 1, leaseService.returnLeaseCalls)
+	assert.Len(t, eventReporter.receivedEvents, 2)
+	_, ok := eventReporter.receivedEvents[1].(*api.JobGangRejectedEvent)
+	assert.True(t, ok)
+}
+
+func makeGang(gangId string, size int) []*api.Job {
+	members := []*api.Job{}
+	for i := 1; i <= size; i++ {
+		members = append(members, &api.Job{Id: fmt.Sprintf("%s-%d", gangId, i), JobSetId: "set1", Queue: "queue1", PodSpec: makePodSpec(),
+			GangId: gangId, GangSize: uint32(size)})
+	}
+	return members
+}
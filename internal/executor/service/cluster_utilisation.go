@@ -1,15 +1,19 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
-	"github.com/G-Research/armada/internal/executor/context"
+	context2 "github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
 	. "github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
@@ -19,22 +23,34 @@ type UtilisationService interface {
 	GetAvailableClusterCapacity() (*ClusterAvailableCapacityReport, error)
 	GetTotalAllocatableClusterCapacity() (*common.ComputeResources, error)
 	GetAllAvailableProcessingNodes() ([]*v1.Node, error)
+	GetNodeTypes() ([]*api.NodeType, error)
 }
 
 type ClusterUtilisationService struct {
-	clusterContext          context.ClusterContext
+	clusterContext          context2.ClusterContext
 	queueUtilisationService PodUtilisationService
 	usageClient             api.UsageClient
 	trackedNodeLabels       []string
 	toleratedTaints         map[string]bool
+	oversubscriptionFactor  map[string]float64
+	apiBackoff              *ApiBackoff
+	// nodes with one of these taints or annotations are about to be removed
+	nodeDrainTaints      map[string]bool
+	nodeDrainAnnotations []string
+	// unix nanoseconds of the last successful usage report, 0 before the first one
+	lastReportTime int64
 }
 
 func NewClusterUtilisationService(
-	clusterContext context.ClusterContext,
+	clusterContext context2.ClusterContext,
 	queueUtilisationService PodUtilisationService,
 	usageClient api.UsageClient,
 	trackedNodeLabels []string,
-	toleratedTaints []string) *ClusterUtilisationService {
+	toleratedTaints []string,
+	oversubscriptionFactor map[string]float64,
+	apiBackoff *ApiBackoff,
+	nodeDrainTaints []string,
+	nodeDrainAnnotations []string) *ClusterUtilisationService {
 
 	return &ClusterUtilisationService{
 		clusterContext:          clusterContext,
@@ -42,17 +58,34 @@ func NewClusterUtilisationService(
 		usageClient:             usageClient,
 		trackedNodeLabels:       trackedNodeLabels,
 		toleratedTaints:         util.StringListToSet(toleratedTaints),
+		oversubscriptionFactor:  clampOversubscriptionFactor(oversubscriptionFactor),
+		apiBackoff:              apiBackoff,
+		nodeDrainTaints:         util.StringListToSet(nodeDrainTaints),
+		nodeDrainAnnotations:    nodeDrainAnnotations,
 	}
 }
 
-func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisation() {
+// Factors below 1 would hide allocatable resources from the server, so they are raised to 1
+func clampOversubscriptionFactor(oversubscriptionFactor map[string]float64) map[string]float64 {
+	clamped := make(map[string]float64, len(oversubscriptionFactor))
+	for resourceName, factor := range oversubscriptionFactor {
+		if factor < 1 {
+			log.Warnf("Oversubscription factor %f of %s is lower than 1, using 1 instead", factor, resourceName)
+			factor = 1
+		}
+		clamped[resourceName] = factor
+	}
+	return clamped
+}
+
+func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisation(ctx context.Context) {
 	allAvailableProcessingNodes, err := clusterUtilisationService.GetAllAvailableProcessingNodes()
 	if err != nil {
 		log.Errorf("Failed to get required information to report cluster usage because %s", err)
 		return
 	}
 
-	totalNodeResource := common.CalculateTotalResource(allAvailableProcessingNodes)
+	totalNodeResource := clusterUtilisationService.calculateTotalAllocatable(allAvailableProcessingNodes)
 
 	allActiveManagedPods, err := clusterUtilisationService.getAllRunningManagedPods()
 	if err != nil {
@@ -77,12 +110,27 @@ func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisa
 		ClusterAvailableCapacity: *allocatableClusterCapacity,
 	}
 
-	err = clusterUtilisationService.reportUsage(&clusterUsage)
+	err = clusterUtilisationService.reportUsage(ctx, &clusterUsage)
 
 	if err != nil {
 		log.Errorf("Failed to report cluster usage because %s", err)
 		return
 	}
+	atomic.StoreInt64(&clusterUtilisationService.lastReportTime, clusterUsage.ReportTime.UnixNano())
+}
+
+// CheckUtilisationReported fails until usage is reported successfully, and when the last successful report is older
+// than maxAge, 0 maxAge only requires one successful report
+func (clusterUtilisationService *ClusterUtilisationService) CheckUtilisationReported(maxAge time.Duration) error {
+	lastReportTime := atomic.LoadInt64(&clusterUtilisationService.lastReportTime)
+	if lastReportTime == 0 {
+		return fmt.Errorf("no cluster usage reported yet")
+	}
+	reportTime := time.Unix(0, lastReportTime)
+	if maxAge > 0 && time.Since(reportTime) > maxAge {
+		return fmt.Errorf("last cluster usage report succeeded at %s, more than %s ago", reportTime.Format(time.RFC3339), maxAge)
+	}
+	return nil
 }
 
 type ClusterAvailableCapacityReport struct {
@@ -104,7 +152,7 @@ func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterC
 	allPodsRequiringResource := getAllPodsRequiringResourceOnProcessingNodes(allPods, processingNodes)
 	allNonCompletePodsRequiringResource := FilterNonCompletedPods(allPodsRequiringResource)
 
-	totalNodeResource := common.CalculateTotalResource(processingNodes)
+	totalNodeResource := clusterUtilisationService.calculateTotalAllocatable(processingNodes)
 	totalPodResource := common.CalculateTotalResourceRequest(allNonCompletePodsRequiringResource)
 
 	availableResource := totalNodeResource.DeepCopy()
@@ -113,7 +161,7 @@ func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterC
 	nodesUsage := getAllocatedResourceByNodeName(allNonCompletePodsRequiringResource)
 	nodes := []api.NodeInfo{}
 	for _, n := range processingNodes {
-		allocatable := common.FromResourceList(n.Status.Allocatable)
+		allocatable := clusterUtilisationService.getAllocatable(n)
 		available := allocatable.DeepCopy()
 		available.Sub(nodesUsage[n.Name])
 
@@ -158,7 +206,7 @@ func (clusterUtilisationService *ClusterUtilisationService) GetTotalAllocatableC
 		return new(common.ComputeResources), fmt.Errorf("Failed getting total allocatable cluster capacity due to: %s", err)
 	}
 
-	totalNodeResource := common.CalculateTotalResource(allAvailableProcessingNodes)
+	totalNodeResource := clusterUtilisationService.calculateTotalAllocatable(allAvailableProcessingNodes)
 	resourceOfUnmanagedPodsOnProcessingNodes := getResourceRequiredByUnmanagedPodsOnNodes(allPods, allAvailableProcessingNodes)
 	allocatableClusterCapacity := totalNodeResource.DeepCopy()
 	allocatableClusterCapacity.Sub(resourceOfUnmanagedPodsOnProcessingNodes)
@@ -166,6 +214,25 @@ func (clusterUtilisationService *ClusterUtilisationService) GetTotalAllocatableC
 	return &allocatableClusterCapacity, nil
 }
 
+// Allocatable resources of the node multiplied by the oversubscription factor, resources without a factor are reported as they are
+func (clusterUtilisationService *ClusterUtilisationService) getAllocatable(node *v1.Node) common.ComputeResources {
+	allocatable := common.FromResourceList(node.Status.Allocatable)
+	for resourceName, factor := range clusterUtilisationService.oversubscriptionFactor {
+		if quantity, exists := allocatable[resourceName]; exists && factor > 1 {
+			allocatable[resourceName] = *resource.NewMilliQuantity(int64(float64(quantity.MilliValue())*factor), quantity.Format)
+		}
+	}
+	return allocatable
+}
+
+func (clusterUtilisationService *ClusterUtilisationService) calculateTotalAllocatable(nodes []*v1.Node) common.ComputeResources {
+	total := common.ComputeResources{}
+	for _, node := range nodes {
+		total.Add(clusterUtilisationService.getAllocatable(node))
+	}
+	return total
+}
+
 func getResourceRequiredByUnmanagedPodsOnNodes(allPods []*v1.Pod, nodes []*v1.Node) common.ComputeResources {
 	unmanagedPodsOnNodes := getUnmanagedPodsByNode(allPods, nodes)
 
@@ -195,12 +262,42 @@ func (clusterUtilisationService *ClusterUtilisationService) GetAllAvailableProce
 	return clusterUtilisationService.filterAvailableProcessingNodes(allNodes), nil
 }
 
-func (clusterUtilisationService *ClusterUtilisationService) reportUsage(clusterUsage *api.ClusterUsageReport) error {
-	ctx, cancel := common.ContextWithDefaultTimeout()
-	defer cancel()
-	_, err := clusterUtilisationService.usageClient.ReportUsage(ctx, clusterUsage)
+func (clusterUtilisationService *ClusterUtilisationService) GetDrainingNodes() ([]*v1.Node, error) {
+	allNodes, err := clusterUtilisationService.clusterContext.GetNodes()
+	if err != nil {
+		return []*v1.Node{}, err
+	}
+
+	drainingNodes := []*v1.Node{}
+	for _, node := range allNodes {
+		if clusterUtilisationService.isDraining(node) {
+			drainingNodes = append(drainingNodes, node)
+		}
+	}
+	return drainingNodes, nil
+}
+
+func (clusterUtilisationService *ClusterUtilisationService) isDraining(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if clusterUtilisationService.nodeDrainTaints[taint.Key] {
+			return true
+		}
+	}
+	for _, annotation := range clusterUtilisationService.nodeDrainAnnotations {
+		if _, exists := node.Annotations[annotation]; exists {
+			return true
+		}
+	}
+	return false
+}
 
-	return err
+func (clusterUtilisationService *ClusterUtilisationService) reportUsage(ctx context.Context, clusterUsage *api.ClusterUsageReport) error {
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultTimeout)
+	defer cancel()
+	return clusterUtilisationService.apiBackoff.Call("usage report", func() error {
+		_, err := clusterUtilisationService.usageClient.ReportUsage(ctx, clusterUsage)
+		return err
+	})
 }
 
 func (clusterUtilisationService *ClusterUtilisationService) filterAvailableProcessingNodes(nodes []*v1.Node) []*v1.Node {
@@ -216,7 +313,7 @@ func (clusterUtilisationService *ClusterUtilisationService) filterAvailableProce
 }
 
 func (clusterUtilisationService *ClusterUtilisationService) isAvailableProcessingNode(node *v1.Node) bool {
-	if node.Spec.Unschedulable {
+	if node.Spec.Unschedulable || isNotReady(node) || clusterUtilisationService.isDraining(node) {
 		return false
 	}
 
@@ -230,6 +327,58 @@ func (clusterUtilisationService *ClusterUtilisationService) isAvailableProcessin
 	return true
 }
 
+// Nodes without the Ready condition are expected to be reported as not ready by the node controller soon
+func isNotReady(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status != v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// Node types of the available processing nodes, as reported to the server to match jobs to the cluster
+func (clusterUtilisationService *ClusterUtilisationService) GetNodeTypes() ([]*api.NodeType, error) {
+	nodes, err := clusterUtilisationService.GetAllAvailableProcessingNodes()
+	if err != nil {
+		return nil, err
+	}
+	return clusterUtilisationService.groupNodeTypes(nodes), nil
+}
+
+func (clusterUtilisationService *ClusterUtilisationService) groupNodeTypes(nodes []*v1.Node) []*api.NodeType {
+	nodeTypesByDescription := map[string]*api.NodeType{}
+	for _, node := range nodes {
+		nodeType := &api.NodeType{
+			Taints:               sortedTaints(node.Spec.Taints),
+			Labels:               clusterUtilisationService.filterTrackedLabels(node.Labels),
+			AllocatableResources: clusterUtilisationService.getAllocatable(node),
+		}
+		nodeTypesByDescription[common.NodeTypeDescription(nodeType.Labels, nodeType.Taints, nodeType.AllocatableResources)] = nodeType
+	}
+
+	descriptions := make([]string, 0, len(nodeTypesByDescription))
+	for description := range nodeTypesByDescription {
+		descriptions = append(descriptions, description)
+	}
+	sort.Strings(descriptions)
+
+	result := make([]*api.NodeType, 0, len(descriptions))
+	for _, description := range descriptions {
+		result = append(result, nodeTypesByDescription[description])
+	}
+	return result
+}
+
+func sortedTaints(taints []v1.Taint) []v1.Taint {
+	result := make([]v1.Taint, len(taints))
+	copy(result, taints)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key || result[i].Key == result[j].Key && result[i].Effect < result[j].Effect
+	})
+	return result
+}
+
 func getAllPodsRequiringResourceOnProcessingNodes(allPods []*v1.Pod, processingNodes []*v1.Node) []*v1.Pod {
 	podsUsingResourceOnProcessingNodes := make([]*v1.Pod, 0, len(allPods))
 
@@ -312,7 +461,7 @@ func (clusterUtilisationService *ClusterUtilisationService) getUsageByQueue(pods
 			continue
 		}
 
-		podUsage := clusterUtilisationService.queueUtilisationService.GetPodUtilisation(pod)
+		podUsage := domain.WithoutNetworkThroughput(clusterUtilisationService.queueUtilisationService.GetPodUtilisation(pod))
 
 		if _, ok := utilisationByQueue[queue]; ok {
 			utilisationByQueue[queue].Add(podUsage)
@@ -2,6 +2,7 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -19,7 +20,7 @@ var testAppConfig = configuration.ApplicationConfiguration{ClusterId: "test", Po
 
 func TestFilterAvailableProcessingNodes_ShouldReturnAvailableProcessingNodes(t *testing.T) {
 	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
-	service := NewClusterUtilisationService(context, nil, nil, nil, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	node := v1.Node{
 		Spec: v1.NodeSpec{
@@ -36,7 +37,7 @@ func TestFilterAvailableProcessingNodes_ShouldReturnAvailableProcessingNodes(t *
 
 func TestFilterAvailableProcessingNodes_ShouldFilterUnschedulableNodes(t *testing.T) {
 	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
-	service := NewClusterUtilisationService(context, nil, nil, nil, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	node := v1.Node{
 		Spec: v1.NodeSpec{
@@ -53,7 +54,7 @@ func TestFilterAvailableProcessingNodes_ShouldFilterUnschedulableNodes(t *testin
 
 func TestFilterAvailableProcessingNodes_ShouldFilterNodesWithNoScheduleTaint(t *testing.T) {
 	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
-	service := NewClusterUtilisationService(context, nil, nil, nil, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	taint := v1.Taint{
 		Effect: v1.TaintEffectNoSchedule,
@@ -71,6 +72,129 @@ func TestFilterAvailableProcessingNodes_ShouldFilterNodesWithNoScheduleTaint(t *
 	assert.Equal(t, len(result), 0)
 }
 
+func TestFilterAvailableProcessingNodes_ShouldFilterNotReadyNodes(t *testing.T) {
+	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	readyNode := v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}}}
+	notReadyNode := v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}}}
+	unknownNode := v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionUnknown}}}}
+
+	result := service.filterAvailableProcessingNodes([]*v1.Node{&readyNode, &notReadyNode, &unknownNode})
+
+	assert.Equal(t, []*v1.Node{&readyNode}, result)
+}
+
+func TestFilterAvailableProcessingNodes_ShouldFilterDrainingNodes(t *testing.T) {
+	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, []string{"ToBeDeletedByClusterAutoscaler"}, nil, nil,
+		[]string{"ToBeDeletedByClusterAutoscaler"}, []string{"example.com/drain"})
+
+	node := v1.Node{}
+	taintedNode := v1.Node{Spec: v1.NodeSpec{Taints: []v1.Taint{{Key: "ToBeDeletedByClusterAutoscaler", Effect: v1.TaintEffectNoSchedule}}}}
+	annotatedNode := v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"example.com/drain": ""}}}
+
+	result := service.filterAvailableProcessingNodes([]*v1.Node{&node, &taintedNode, &annotatedNode})
+
+	assert.Equal(t, []*v1.Node{&node}, result)
+	assert.False(t, service.isDraining(&node))
+	assert.True(t, service.isDraining(&taintedNode))
+	assert.True(t, service.isDraining(&annotatedNode))
+}
+
+func TestCheckUtilisationReported(t *testing.T) {
+	service := NewClusterUtilisationService(fakeContext.NewFakeClusterContext(testAppConfig, nil), nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.EqualError(t, service.CheckUtilisationReported(time.Minute), "no cluster usage reported yet")
+
+	service.lastReportTime = time.Now().Add(-2 * time.Minute).UnixNano()
+	assert.Error(t, service.CheckUtilisationReported(time.Minute))
+	assert.NoError(t, service.CheckUtilisationReported(0))
+
+	service.lastReportTime = time.Now().UnixNano()
+	assert.NoError(t, service.CheckUtilisationReported(time.Minute))
+}
+
+func TestGroupNodeTypes_CollapsesNodesWithSameTopology(t *testing.T) {
+	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
+	service := NewClusterUtilisationService(context, nil, nil, []string{"zone"}, []string{"gpu"}, nil, nil, nil, nil)
+
+	gpuTaint := v1.Taint{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}
+	makeNode := func(zone string, allocatable v1.ResourceList, taints ...v1.Taint) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"zone": zone, "hostname": util2.NewULID()}},
+			Spec:       v1.NodeSpec{Taints: taints},
+			Status:     v1.NodeStatus{Allocatable: allocatable},
+		}
+	}
+	nodes := []*v1.Node{
+		makeNode("a", makeResourceList(2, 8)),
+		makeNode("a", makeResourceList(2, 8)),
+		makeNode("b", makeResourceList(2, 8)),
+		makeNode("a", makeResourceList(4, 8)),
+		makeNode("a", makeResourceList(2, 8), gpuTaint),
+		makeNode("a", makeResourceList(2, 8), gpuTaint),
+	}
+
+	nodeTypes := service.groupNodeTypes(nodes)
+
+	assert.Len(t, nodeTypes, 4)
+	taintedTypes := 0
+	for _, nodeType := range nodeTypes {
+		assert.Equal(t, []string{"zone"}, keys(nodeType.Labels))
+		if len(nodeType.Taints) > 0 {
+			taintedTypes++
+			assert.Equal(t, []v1.Taint{gpuTaint}, nodeType.Taints)
+		}
+	}
+	assert.Equal(t, 1, taintedTypes)
+}
+
+func TestGetAvailableClusterCapacity_ReportsOversubscribedAllocatable(t *testing.T) {
+	context := fakeContext.NewFakeClusterContext(testAppConfig, []*fakeContext.NodeSpec{
+		{Name: "worker", Count: 2, Allocatable: makeResourceList(4, 16)},
+	})
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, map[string]float64{"cpu": 1.5, "memory": 1}, nil, nil, nil)
+
+	report, e := service.GetAvailableClusterCapacity()
+	assert.NoError(t, e)
+
+	expectedNode := common.ComputeResources{"cpu": resource.MustParse("6"), "memory": resource.MustParse("16Gi")}
+	assert.Len(t, report.Nodes, 2)
+	for _, node := range report.Nodes {
+		assert.True(t, expectedNode.Equal(node.AllocatableResources), "unexpected allocatable %s", node.AllocatableResources)
+		assert.True(t, expectedNode.Equal(node.AvailableResources), "unexpected available %s", node.AvailableResources)
+	}
+	expectedTotal := common.ComputeResources{"cpu": resource.MustParse("12"), "memory": resource.MustParse("32Gi")}
+	assert.True(t, expectedTotal.Equal(*report.AvailableCapacity), "unexpected capacity %s", report.AvailableCapacity)
+
+	nodeTypes := service.groupNodeTypes(mustGetNodes(t, service))
+	assert.Len(t, nodeTypes, 1)
+	assert.True(t, expectedNode.Equal(nodeTypes[0].AllocatableResources))
+}
+
+func TestGetAllocatable_ClampsOversubscriptionFactorBelowOne(t *testing.T) {
+	context := fakeContext.NewFakeClusterContext(testAppConfig, nil)
+	service := NewClusterUtilisationService(context, nil, nil, nil, nil, map[string]float64{"cpu": 0.5, "nvidia.com/gpu": 2}, nil, nil, nil)
+
+	node := &v1.Node{Status: v1.NodeStatus{Allocatable: makeResourceList(4, 16)}}
+
+	assert.True(t, common.FromResourceList(makeResourceList(4, 16)).Equal(service.getAllocatable(node)))
+}
+
+func mustGetNodes(t *testing.T, service *ClusterUtilisationService) []*v1.Node {
+	nodes, e := service.GetAllAvailableProcessingNodes()
+	assert.NoError(t, e)
+	return nodes
+}
+
+func keys(labels map[string]string) []string {
+	result := []string{}
+	for key := range labels {
+		result = append(result, key)
+	}
+	return result
+}
+
 func TestGetAllPodsUsingResourceOnProcessingNodes_ShouldExcludePodsNotOnGivenNodes(t *testing.T) {
 	presentNodeName := "Node1"
 	podOnNode := v1.Pod{
@@ -220,6 +344,18 @@ func TestGetUsageByQueue_AggregatesPodResourcesInAQueue(t *testing.T) {
 	assert.Equal(t, result, expectedResult)
 }
 
+func TestGetUsageByQueue_AccountsForInitContainers(t *testing.T) {
+	pod := makePodWithResource("queue1", makeResourceList(2, 10))
+	pod.Spec.InitContainers = []v1.Container{{
+		Resources: v1.ResourceRequirements{Requests: makeResourceList(1, 50), Limits: makeResourceList(1, 50)},
+	}}
+
+	expectedResult := map[string]common.ComputeResources{"queue1": common.FromResourceList(makeResourceList(2, 50))}
+
+	result := getAllocationByQueue([]*v1.Pod{&pod})
+	assert.Equal(t, expectedResult, result)
+}
+
 func TestGetUsageByQueue_HandlesEmptyList(t *testing.T) {
 	var pods []*v1.Pod
 
@@ -229,6 +365,15 @@ func TestGetUsageByQueue_HandlesEmptyList(t *testing.T) {
 	assert.Equal(t, len(result), 0)
 }
 
+func TestGetUsageByQueue_LeavesOutNetworkThroughput(t *testing.T) {
+	pod := makePodWithResource("queue1", makeResourceList(2, 50))
+	service := &ClusterUtilisationService{queueUtilisationService: &fakeNetworkPodUtilisation{}}
+
+	result := service.getUsageByQueue([]*v1.Pod{&pod})
+
+	assert.Equal(t, map[string]common.ComputeResources{"queue1": {"cpu": resource.MustParse("1")}}, result)
+}
+
 func TestGetAllocatedResourceByNodeName(t *testing.T) {
 	podResource := makeResourceList(2, 50)
 	pod1 := makePodWithResource("queue1", podResource)
@@ -246,6 +391,16 @@ func TestGetAllocatedResourceByNodeName(t *testing.T) {
 	}, allocatedResource)
 }
 
+type fakeNetworkPodUtilisation struct{}
+
+func (f *fakeNetworkPodUtilisation) GetPodUtilisation(pod *v1.Pod) common.ComputeResources {
+	return common.ComputeResources{
+		"cpu":                      resource.MustParse("1"),
+		domain.NetworkReceiveRate:  resource.MustParse("100"),
+		domain.NetworkTransmitRate: resource.MustParse("200"),
+	}
+}
+
 func hasKey(value map[string]common.ComputeResources, key string) bool {
 	_, ok := value[key]
 	return ok
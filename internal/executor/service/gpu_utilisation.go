@@ -0,0 +1,184 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+const (
+	dcgmGpuUtilisation = "DCGM_FI_DEV_GPU_UTIL" // percent
+	dcgmMemoryUsed     = "DCGM_FI_DEV_FB_USED"  // MiB
+	gpuResource        = "nvidia.com/gpu"
+)
+
+// Returns GPU utilisation of pods running on the nodes by pod key
+type GpuUtilisationCollector interface {
+	CollectGpuUtilisation(nodes []*v1.Node) map[string]common.ComputeResources
+}
+
+// Scrapes the DCGM exporter of every node with GPUs, on the node internal address
+type DcgmGpuUtilisationCollector struct {
+	port       uint16
+	path       string
+	httpClient *http.Client
+	// nodes whose exporter failed on the last scrape, so failures are logged once and not on every refresh
+	failingNodes map[string]bool
+	mutex        sync.Mutex
+}
+
+func NewDcgmGpuUtilisationCollector(config configuration.GpuUtilisationConfiguration) *DcgmGpuUtilisationCollector {
+	path := config.DcgmExporterPath
+	if path == "" {
+		path = "/metrics"
+	}
+	return &DcgmGpuUtilisationCollector{
+		port:         config.DcgmExporterPort,
+		path:         path,
+		httpClient:   &http.Client{Timeout: config.Timeout},
+		failingNodes: map[string]bool{},
+	}
+}
+
+func (c *DcgmGpuUtilisationCollector) CollectGpuUtilisation(nodes []*v1.Node) map[string]common.ComputeResources {
+	results := make(chan map[string]common.ComputeResources, len(nodes))
+	wg := sync.WaitGroup{}
+	for _, n := range nodes {
+		if !hasGpus(n) {
+			continue
+		}
+		wg.Add(1)
+		go func(node *v1.Node) {
+			defer wg.Done()
+			utilisation, err := c.scrapeNode(node)
+			c.recordScrapeResult(node.Name, err)
+			if err == nil {
+				results <- utilisation
+			}
+		}(n)
+	}
+	wg.Wait()
+	close(results)
+
+	podUtilisation := map[string]common.ComputeResources{}
+	for utilisation := range results {
+		for key, resources := range utilisation {
+			podUtilisation[key] = resources
+		}
+	}
+	return podUtilisation
+}
+
+func (c *DcgmGpuUtilisationCollector) recordScrapeResult(nodeName string, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err != nil && !c.failingNodes[nodeName] {
+		log.Warnf("Failed to get GPU metrics of node %s, GPU utilisation of its pods is not reported: %s", nodeName, err)
+		c.failingNodes[nodeName] = true
+	}
+	if err == nil && c.failingNodes[nodeName] {
+		log.Infof("Got GPU metrics of node %s again", nodeName)
+		delete(c.failingNodes, nodeName)
+	}
+}
+
+func (c *DcgmGpuUtilisationCollector) scrapeNode(node *v1.Node) (map[string]common.ComputeResources, error) {
+	address, ok := nodeInternalAddress(node)
+	if !ok {
+		return nil, fmt.Errorf("node has no internal address")
+	}
+	response, err := c.httpClient.Get("http://" + net.JoinHostPort(address, strconv.Itoa(int(c.port))) + c.path)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exporter responded with status %s", response.Status)
+	}
+	return parseDcgmMetrics(response.Body)
+}
+
+// GPU utilisation is summed over the GPUs of a pod in the same unit as kubelet accelerator duty cycles,
+// 1 being one fully used GPU. Used GPU memory is in bytes.
+func parseDcgmMetrics(reader io.Reader) (map[string]common.ComputeResources, error) {
+	parser := expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	utilisation := map[string]float64{}
+	for _, metric := range families[dcgmGpuUtilisation].GetMetric() {
+		if key, ok := dcgmPodKey(metric); ok {
+			utilisation[key] += metricValue(metric)
+		}
+	}
+	memoryUsed := map[string]float64{}
+	for _, metric := range families[dcgmMemoryUsed].GetMetric() {
+		if key, ok := dcgmPodKey(metric); ok {
+			memoryUsed[key] += metricValue(metric)
+		}
+	}
+
+	result := map[string]common.ComputeResources{}
+	for key, value := range utilisation {
+		result[key] = common.ComputeResources{domain.AcceleratorDutyCycle: *resource.NewScaledQuantity(int64(value), -2)}
+	}
+	for key, value := range memoryUsed {
+		if _, exists := result[key]; !exists {
+			result[key] = common.ComputeResources{}
+		}
+		result[key][domain.AcceleratorMemory] = *resource.NewQuantity(int64(value*1024*1024), resource.BinarySI)
+	}
+	return result, nil
+}
+
+// GPUs not assigned to a pod have no pod label. Older exporter versions use pod_name and pod_namespace labels.
+func dcgmPodKey(metric *dto.Metric) (string, bool) {
+	labels := map[string]string{}
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	name, namespace := labels["pod"], labels["namespace"]
+	if name == "" {
+		name, namespace = labels["pod_name"], labels["pod_namespace"]
+	}
+	if name == "" {
+		return "", false
+	}
+	return podKey(namespace, name), true
+}
+
+func metricValue(metric *dto.Metric) float64 {
+	if metric.Gauge != nil {
+		return metric.Gauge.GetValue()
+	}
+	return metric.GetUntyped().GetValue()
+}
+
+func hasGpus(node *v1.Node) bool {
+	gpus, exists := node.Status.Allocatable[gpuResource]
+	return exists && !gpus.IsZero()
+}
+
+func nodeInternalAddress(node *v1.Node) (string, bool) {
+	for _, address := range node.Status.Addresses {
+		if address.Type == v1.NodeInternalIP {
+			return address.Address, true
+		}
+	}
+	return "", false
+}
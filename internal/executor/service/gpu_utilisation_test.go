@@ -0,0 +1,92 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+const dcgmMetrics = `# HELP DCGM_FI_DEV_GPU_UTIL GPU utilization (in %).
+# TYPE DCGM_FI_DEV_GPU_UTIL gauge
+DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-1",device="nvidia0",container="main",namespace="namespace",pod="pod"} 50
+DCGM_FI_DEV_GPU_UTIL{gpu="1",UUID="GPU-2",device="nvidia1",container="main",namespace="namespace",pod="pod"} 100
+DCGM_FI_DEV_GPU_UTIL{gpu="2",UUID="GPU-3",device="nvidia2",pod_namespace="other",pod_name="old-exporter-pod"} 25
+DCGM_FI_DEV_GPU_UTIL{gpu="3",UUID="GPU-4",device="nvidia3"} 0
+# HELP DCGM_FI_DEV_FB_USED Framebuffer memory used (in MiB).
+# TYPE DCGM_FI_DEV_FB_USED gauge
+DCGM_FI_DEV_FB_USED{gpu="0",UUID="GPU-1",device="nvidia0",container="main",namespace="namespace",pod="pod"} 1024
+DCGM_FI_DEV_FB_USED{gpu="1",UUID="GPU-2",device="nvidia1",container="main",namespace="namespace",pod="pod"} 512
+`
+
+func TestParseDcgmMetrics_SumsMetricsOfGpusOfEachPod(t *testing.T) {
+	utilisation, err := parseDcgmMetrics(strings.NewReader(dcgmMetrics))
+	assert.NoError(t, err)
+
+	assert.Len(t, utilisation, 2)
+	dutyCycle := utilisation["namespace/pod"][domain.AcceleratorDutyCycle]
+	memory := utilisation["namespace/pod"][domain.AcceleratorMemory]
+	assert.Equal(t, "1500m", dutyCycle.String())
+	assert.Equal(t, "1536Mi", memory.String())
+	assert.Contains(t, utilisation, "other/old-exporter-pod")
+}
+
+func TestCollectGpuUtilisation_SkipsUnreachableExporters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics", r.URL.Path)
+		fmt.Fprint(w, dcgmMetrics)
+	}))
+	defer server.Close()
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	exporterPort, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+
+	collector := NewDcgmGpuUtilisationCollector(configuration.GpuUtilisationConfiguration{DcgmExporterPort: uint16(exporterPort), Timeout: time.Second})
+	nodes := []*v1.Node{makeGpuNode("gpu-node", host, "8"), makeGpuNode("cpu-node", host, "0"), makeGpuNode("unreachable-node", "", "8")}
+
+	utilisation := collector.CollectGpuUtilisation(nodes)
+	assert.Len(t, utilisation, 2)
+	assert.Contains(t, utilisation, "namespace/pod")
+	assert.True(t, collector.failingNodes["unreachable-node"])
+	assert.False(t, collector.failingNodes["gpu-node"])
+}
+
+func TestAddGpuUtilisation_MergesIntoPodUtilisation(t *testing.T) {
+	service := NewMetricsServerQueueUtilisationService(nil, nil)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}}
+	service.updatePodStats(makePodStats(time.Now()))
+
+	gpuUtilisation, err := parseDcgmMetrics(strings.NewReader(dcgmMetrics))
+	assert.NoError(t, err)
+	service.addGpuUtilisation(gpuUtilisation, map[string]bool{"namespace/pod": true})
+
+	utilisation := service.GetPodUtilisation(pod)
+	assert.Contains(t, utilisation, "cpu")
+	assert.Contains(t, utilisation, domain.AcceleratorDutyCycle)
+	assert.Contains(t, utilisation, domain.AcceleratorMemory)
+	assert.Len(t, service.podUtilisationData, 1)
+}
+
+func makeGpuNode(name string, address string, gpus string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     v1.NodeStatus{Allocatable: v1.ResourceList{gpuResource: resource.MustParse(gpus)}},
+	}
+	if address != "" {
+		node.Status.Addresses = []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: address}}
+	}
+	return node
+}
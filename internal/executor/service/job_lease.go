@@ -2,28 +2,59 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/G-Research/armada/internal/common"
 	commonUtil "github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/configuration"
 	context2 "github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/job_context"
+	"github.com/G-Research/armada/internal/executor/metrics"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 const maxPodRequestSize = 10000
+const maxConcurrentLeaseReturns = 10
 const jobDoneAnnotation = "reported_done"
 
+// reasons of lease renewal failures
+const (
+	renewalSkippedServerUnavailable = "server_backoff" // not attempted while backing off from an unavailable server
+	renewalServerUnavailable        = "server_unavailable"
+	renewalError                    = "error"
+	renewalRejected                 = "rejected" // the server did not renew the lease, the job is no longer leased to the cluster
+)
+
+var leaseRenewalFailuresCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: metrics.ArmadaExecutorMetricsPrefix + "job_lease_renewal_failures_total",
+		Help: "Number of job leases which failed to be renewed, by reason",
+	},
+	[]string{"reason"},
+)
+
+var staleLeasesGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: metrics.ArmadaExecutorMetricsPrefix + "job_leases_not_renewed",
+		Help: "Number of leased jobs whose lease was last renewed longer ago than the lease renewal interval",
+	},
+)
+
 type LeaseService interface {
-	ReturnLease(pod *v1.Pod) error
-	RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error)
+	ReturnLease(pod *v1.Pod, reason api.RequeueReason) error
+	RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources, maxJobsToLease uint32) ([]*api.Job, error)
 	ReportDone(jobIds []string) error
 }
 
@@ -34,6 +65,38 @@ type JobLeaseService struct {
 	minimumPodAge   time.Duration
 	failedPodExpiry time.Duration
 	minimumJobSize  common.ComputeResources
+	// reported to the server with lease requests, so jobs are matched to nodes the way their pods are created
+	preemptibleNodes *api.PreemptibleNodes
+
+	renewalMaxRetries   int
+	renewalRetryBackoff time.Duration
+	renewalInterval     time.Duration
+	// leases of jobs are not returned on shutdown or drain before their pods are this old
+	minimumLeaseHoldTime time.Duration
+
+	// time of the last successful renewal by job id, or when the job was first seen by the renewal
+	lastRenewal      map[string]time.Time
+	lastRenewalMutex sync.Mutex
+
+	// skew is measured on job lease requests, pod age uses the clock
+	clock *util.ServerClock
+
+	apiBackoff *util.ApiBackoff
+	// shorter than apiBackoff so leases are renewed before they expire, shared with the utilisation reporting
+	renewalBackoff *util.ApiBackoff
+
+	// jobs whose pods were deleted because their node is drained by job id, their leases are returned once the pods are gone
+	drainedJobs      map[string]*drainedJob
+	drainedJobsMutex sync.Mutex
+
+	// requeues the protected job annotation prevented which were reported, by job id
+	reportedProtections      map[string]map[api.RequeueReason]bool
+	reportedProtectionsMutex sync.Mutex
+}
+
+type drainedJob struct {
+	pod      *v1.Pod
+	nodeName string
 }
 
 func NewJobLeaseService(
@@ -42,18 +105,44 @@ func NewJobLeaseService(
 	queueClient api.AggregatedQueueClient,
 	minimumPodAge time.Duration,
 	failedPodExpiry time.Duration,
-	minimumJobSize common.ComputeResources) *JobLeaseService {
+	minimumJobSize common.ComputeResources,
+	preemptibleNodes configuration.PreemptibleNodeConfiguration,
+	renewalMaxRetries int,
+	renewalRetryBackoff time.Duration,
+	renewalInterval time.Duration,
+	minimumLeaseHoldTime time.Duration,
+	clock *util.ServerClock,
+	apiBackoff *util.ApiBackoff,
+	renewalBackoff *util.ApiBackoff) *JobLeaseService {
 
 	return &JobLeaseService{
-		clusterContext:  clusterContext,
-		jobContext:      jobContext,
-		queueClient:     queueClient,
-		minimumPodAge:   minimumPodAge,
-		failedPodExpiry: failedPodExpiry,
-		minimumJobSize:  minimumJobSize}
+		clusterContext:       clusterContext,
+		jobContext:           jobContext,
+		queueClient:          queueClient,
+		minimumPodAge:        minimumPodAge,
+		failedPodExpiry:      failedPodExpiry,
+		minimumJobSize:       minimumJobSize,
+		preemptibleNodes:     preemptibleNodesOfConfig(preemptibleNodes),
+		renewalMaxRetries:    renewalMaxRetries,
+		renewalRetryBackoff:  renewalRetryBackoff,
+		renewalInterval:      renewalInterval,
+		minimumLeaseHoldTime: minimumLeaseHoldTime,
+		lastRenewal:          map[string]time.Time{},
+		clock:                clock,
+		apiBackoff:           apiBackoff,
+		renewalBackoff:       renewalBackoff,
+		drainedJobs:          map[string]*drainedJob{},
+		reportedProtections:  map[string]map[api.RequeueReason]bool{}}
 }
 
-func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
+func preemptibleNodesOfConfig(config configuration.PreemptibleNodeConfiguration) *api.PreemptibleNodes {
+	if config.Label == "" && config.Taint == "" {
+		return nil
+	}
+	return &api.PreemptibleNodes{Label: config.Label, LabelValue: config.LabelValue, Taint: config.Taint}
+}
+
+func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources, maxJobsToLease uint32) ([]*api.Job, error) {
 	leasedQueueReports := make([]*api.QueueLeasedReport, 0, len(leasedResourceByQueue))
 	for queueName, leasedResource := range leasedResourceByQueue {
 		leasedQueueReport := &api.QueueLeasedReport{
@@ -68,6 +157,11 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *comm
 		Queues:     leasedQueueReports,
 	}
 
+	kubernetesVersion, err := jobLeaseService.clusterContext.GetServerVersion()
+	if err != nil {
+		log.Warnf("Failed to get kubernetes server version, jobs requiring minimum version will not be leased: %s", err)
+	}
+
 	leaseRequest := api.LeaseRequest{
 		ClusterId:           jobLeaseService.clusterContext.GetClusterId(),
 		Pool:                jobLeaseService.clusterContext.GetClusterPool(),
@@ -75,26 +169,292 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *comm
 		ClusterLeasedReport: clusterLeasedReport,
 		Nodes:               nodes,
 		MinimumJobSize:      jobLeaseService.minimumJobSize,
+		MaxJobsToLease:      maxJobsToLease,
+		KubernetesVersion:   kubernetesVersion,
+		PreemptibleNodes:    jobLeaseService.preemptibleNodes,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
-	response, err := jobLeaseService.queueClient.LeaseJobs(ctx, &leaseRequest, grpc_retry.WithMax(1))
+	requestSent := time.Now()
+	var response *api.JobLease
+	err = jobLeaseService.apiBackoff.Call("job lease request", func() error {
+		var err error
+		response, err = jobLeaseService.queueClient.LeaseJobs(ctx, &leaseRequest, grpc_retry.WithMax(1))
+		return err
+	})
 
 	if err != nil {
 		return make([]*api.Job, 0), err
 	}
+	jobLeaseService.clock.Observe(response.ServerTime, requestSent, time.Now())
 
 	return response.Job, nil
 }
 
-func (jobLeaseService *JobLeaseService) ReturnLease(pod *v1.Pod) error {
+func (jobLeaseService *JobLeaseService) ReturnLease(pod *v1.Pod, reason api.RequeueReason) error {
 	jobId := util.ExtractJobId(pod)
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
 	log.Infof("Returning lease for job %s", jobId)
-	_, err := jobLeaseService.queueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: jobLeaseService.clusterContext.GetClusterId(), JobId: jobId})
+	return jobLeaseService.apiBackoff.Call("lease return", func() error {
+		_, err := jobLeaseService.queueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: jobLeaseService.clusterContext.GetClusterId(), JobId: jobId, Reason: reason})
+		return err
+	})
+}
 
-	return err
+// ReturnLeases returns leases of jobs whose pods have not started running yet and deletes their pods, so the server can
+// lease the jobs again without waiting for the leases to expire. Running and protected jobs, and jobs within the minimum
+// lease hold time keep their leases.
+func (jobLeaseService *JobLeaseService) ReturnLeases(ctx context.Context, eventReporter reporter.EventReporter) {
+	jobs, err := jobLeaseService.jobContext.GetRunningJobs()
+	if err != nil {
+		log.Errorf("Failed to return job leases due to %s", err)
+		return
+	}
+	jobsToReturn := filterRunningJobs(jobs, func(job *job_context.RunningJob) bool {
+		return hasNotStarted(job) && !jobLeaseService.isWithinMinimumLeaseHoldTime(job)
+	})
+	for _, job := range filterRunningJobs(jobsToReturn, isProtected) {
+		jobLeaseService.reportProtectedJob(job, api.RequeueReason_ExecutorShutdown,
+			"Executor is shutting down, lease of protected job is kept and its pods are not deleted.", eventReporter)
+	}
+	jobsToReturn = filterRunningJobs(jobsToReturn, func(job *job_context.RunningJob) bool { return !isProtected(job) })
+
+	returned := make([]bool, len(jobsToReturn))
+	limit := make(chan bool, maxConcurrentLeaseReturns)
+	wg := sync.WaitGroup{}
+	for i, job := range jobsToReturn {
+		wg.Add(1)
+		limit <- true
+		go func(i int, job *job_context.RunningJob) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			returned[i] = jobLeaseService.returnLeaseOfNotStartedJob(ctx, job, eventReporter)
+		}(i, job)
+	}
+	wg.Wait()
+
+	returnedJobs := filterRunningJobsByIndex(jobsToReturn, returned)
+	jobLeaseService.clusterContext.DeletePods(extractPods(returnedJobs))
+	log.Infof("Returned leases of %d of %d jobs which did not start running", len(returnedJobs), len(jobsToReturn))
+}
+
+func (jobLeaseService *JobLeaseService) returnLeaseOfNotStartedJob(ctx context.Context, job *job_context.RunningJob, eventReporter reporter.EventReporter) bool {
+	clusterId := jobLeaseService.clusterContext.GetClusterId()
+	_, err := jobLeaseService.queueClient.ReturnLease(ctx,
+		&api.ReturnLeaseRequest{ClusterId: clusterId, JobId: job.JobId, Reason: api.RequeueReason_ExecutorShutdown})
+	if err != nil {
+		log.Errorf("Failed to return lease of job %s because %s", job.JobId, err)
+		return false
+	}
+
+	event := reporter.CreateJobLeaseReturnedEvent(job.Pods[0], "Executor is shutting down, Armada will return lease and retry.",
+		api.RequeueReason_ExecutorShutdown, clusterId)
+	if err := eventReporter.Report(event); err != nil {
+		// lease is already returned, the event is just for reporting
+		log.Errorf("Failure to report lease returned event %+v because %s", event, err)
+	}
+	return true
+}
+
+// ReturnLeasesOnDrainingNodes deletes the pods of unfinished jobs with pods on nodes about to be removed with their
+// termination grace period, and returns the leases of the jobs once their pods are gone, so the server leases
+// the jobs again before the nodes go away without them running twice. Protected jobs keep running until their nodes are gone,
+// jobs within the minimum lease hold time are drained once it has passed.
+func (jobLeaseService *JobLeaseService) ReturnLeasesOnDrainingNodes(drainingNodes []*v1.Node, eventReporter reporter.EventReporter) {
+	jobs, err := jobLeaseService.jobContext.GetRunningJobs()
+	if err != nil {
+		log.Errorf("Failed to return job leases on draining nodes due to %s", err)
+		return
+	}
+	jobLeaseService.returnLeasesOfDrainedJobs(jobs, eventReporter)
+
+	drainingNodeNames := map[string]bool{}
+	for _, node := range drainingNodes {
+		drainingNodeNames[node.Name] = true
+	}
+	jobLeaseService.forgetProtectionsOfFinishedJobs(jobs)
+	for _, job := range jobs {
+		nodeName, onDrainingNode := findDrainingNode(job, drainingNodeNames)
+		if hasBeenDrained(job) {
+			jobLeaseService.trackDrainedJob(job, nodeName)
+			continue
+		}
+		if !onDrainingNode || hasFinished(job) {
+			continue
+		}
+		if jobLeaseService.isWithinMinimumLeaseHoldTime(job) {
+			log.Debugf("Not draining job %s on draining node %s within minimum lease hold time", job.JobId, nodeName)
+			continue
+		}
+		if isProtected(job) {
+			message := fmt.Sprintf("Node %s is being drained, protected job keeps running until the node is gone.", nodeName)
+			jobLeaseService.reportProtectedJob(job, api.RequeueReason_NodeDrain, message, eventReporter)
+			continue
+		}
+
+		// termination of the pods is not reported, the job runs again elsewhere
+		err = jobLeaseService.clusterContext.AddAnnotationToPods(job.Pods, map[string]string{domain.JobDrained: time.Now().String()})
+		if err != nil {
+			log.Errorf("Failed to annotate pods of job %s on draining node %s as drained: %v", job.JobId, nodeName, err)
+			continue
+		}
+		jobLeaseService.trackDrainedJob(job, nodeName)
+		jobLeaseService.deletePodsWithGracePeriod(job.Pods)
+	}
+}
+
+// Drained jobs keep their leases while their pods terminate, pods deleted by a previous executor run are tracked again
+func (jobLeaseService *JobLeaseService) trackDrainedJob(job *job_context.RunningJob, nodeName string) {
+	jobLeaseService.drainedJobsMutex.Lock()
+	defer jobLeaseService.drainedJobsMutex.Unlock()
+	if _, tracked := jobLeaseService.drainedJobs[job.JobId]; !tracked {
+		jobLeaseService.drainedJobs[job.JobId] = &drainedJob{pod: job.Pods[0].DeepCopy(), nodeName: nodeName}
+	}
+}
+
+func (jobLeaseService *JobLeaseService) forgetDrainedJobs(jobIds []string) {
+	jobLeaseService.drainedJobsMutex.Lock()
+	defer jobLeaseService.drainedJobsMutex.Unlock()
+	for _, jobId := range jobIds {
+		delete(jobLeaseService.drainedJobs, jobId)
+	}
+}
+
+func (jobLeaseService *JobLeaseService) drainedJobsWithoutPods(jobs []*job_context.RunningJob) map[string]*drainedJob {
+	jobsWithPods := map[string]bool{}
+	for _, job := range jobs {
+		jobsWithPods[job.JobId] = true
+	}
+	jobLeaseService.drainedJobsMutex.Lock()
+	defer jobLeaseService.drainedJobsMutex.Unlock()
+	result := map[string]*drainedJob{}
+	for jobId, job := range jobLeaseService.drainedJobs {
+		if !jobsWithPods[jobId] {
+			result[jobId] = job
+		}
+	}
+	return result
+}
+
+func (jobLeaseService *JobLeaseService) returnLeasesOfDrainedJobs(jobs []*job_context.RunningJob, eventReporter reporter.EventReporter) {
+	for jobId, job := range jobLeaseService.drainedJobsWithoutPods(jobs) {
+		err := jobLeaseService.ReturnLease(job.pod, api.RequeueReason_NodeDrain)
+		if err != nil {
+			log.Errorf("Failed to return lease of job %s drained from node %s because %s", jobId, job.nodeName, err)
+			if !util.IsTransientError(err) {
+				// e.g. the job was cancelled meanwhile, the lease is not held anymore
+				jobLeaseService.forgetDrainedJobs([]string{jobId})
+			}
+			continue
+		}
+		jobLeaseService.forgetDrainedJobs([]string{jobId})
+
+		message := fmt.Sprintf("Node %s is being drained, pods were preempted and Armada will return lease and retry on another node.", job.nodeName)
+		event := reporter.CreateJobLeaseReturnedEvent(job.pod, message, api.RequeueReason_NodeDrain, jobLeaseService.clusterContext.GetClusterId())
+		if err := eventReporter.Report(event); err != nil {
+			// lease is already returned, the event is just for reporting
+			log.Errorf("Failure to report node drain event %+v because %s", event, err)
+		}
+	}
+}
+
+// Reported once per job and requeue reason, the drain check runs again for every node drain check interval
+func (jobLeaseService *JobLeaseService) reportProtectedJob(job *job_context.RunningJob, requeueReason api.RequeueReason, message string, eventReporter reporter.EventReporter) {
+	if !jobLeaseService.markProtectionReported(job.JobId, requeueReason) {
+		return
+	}
+	log.Infof("Not requeueing protected job %s (%s): %s", job.JobId, requeueReason, message)
+	event := reporter.CreateJobProtectedEvent(job.Pods[0], message, requeueReason, jobLeaseService.clusterContext.GetClusterId())
+	if err := eventReporter.Report(event); err != nil {
+		log.Errorf("Failure to report protected job event %+v because %s", event, err)
+	}
+}
+
+func (jobLeaseService *JobLeaseService) markProtectionReported(jobId string, requeueReason api.RequeueReason) bool {
+	jobLeaseService.reportedProtectionsMutex.Lock()
+	defer jobLeaseService.reportedProtectionsMutex.Unlock()
+	reported, ok := jobLeaseService.reportedProtections[jobId]
+	if !ok {
+		reported = map[api.RequeueReason]bool{}
+		jobLeaseService.reportedProtections[jobId] = reported
+	}
+	if reported[requeueReason] {
+		return false
+	}
+	reported[requeueReason] = true
+	return true
+}
+
+func (jobLeaseService *JobLeaseService) forgetProtectionsOfFinishedJobs(jobs []*job_context.RunningJob) {
+	runningJobIds := map[string]bool{}
+	for _, job := range jobs {
+		runningJobIds[job.JobId] = true
+	}
+	jobLeaseService.reportedProtectionsMutex.Lock()
+	defer jobLeaseService.reportedProtectionsMutex.Unlock()
+	for jobId := range jobLeaseService.reportedProtections {
+		if !runningJobIds[jobId] {
+			delete(jobLeaseService.reportedProtections, jobId)
+		}
+	}
+}
+
+func (jobLeaseService *JobLeaseService) deletePodsWithGracePeriod(pods []*v1.Pod) {
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil {
+			jobLeaseService.clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, terminationGracePeriodSeconds(pod))
+		}
+	}
+}
+
+func findDrainingNode(job *job_context.RunningJob, drainingNodeNames map[string]bool) (nodeName string, found bool) {
+	for _, pod := range job.Pods {
+		if drainingNodeNames[pod.Spec.NodeName] {
+			return pod.Spec.NodeName, true
+		}
+	}
+	return "", false
+}
+
+// Finished jobs are reported as they are, even when their node is drained
+func hasFinished(job *job_context.RunningJob) bool {
+	for _, pod := range job.Pods {
+		if util.IsInTerminalState(pod) || isReportedDone(pod) {
+			return true
+		}
+	}
+	return false
+}
+
+func isProtected(job *job_context.RunningJob) bool {
+	return len(job.Pods) > 0 && util.IsProtectedJob(job.Pods[0])
+}
+
+func (jobLeaseService *JobLeaseService) isWithinMinimumLeaseHoldTime(job *job_context.RunningJob) bool {
+	return isWithinMinimumLeaseHoldTime(job.Pods, jobLeaseService.minimumLeaseHoldTime, jobLeaseService.clock.Now())
+}
+
+// Hold time starts when the first pod of the job was created
+func isWithinMinimumLeaseHoldTime(pods []*v1.Pod, hold time.Duration, now time.Time) bool {
+	if hold <= 0 {
+		return false
+	}
+	for _, pod := range pods {
+		if !pod.CreationTimestamp.Add(hold).After(now) {
+			return false
+		}
+	}
+	return len(pods) > 0
+}
+
+func hasBeenDrained(job *job_context.RunningJob) bool {
+	for _, pod := range job.Pods {
+		if util.IsDrained(pod) {
+			return true
+		}
+	}
+	return false
 }
 
 func (jobLeaseService *JobLeaseService) ManageJobLeases() {
@@ -104,11 +464,17 @@ func (jobLeaseService *JobLeaseService) ManageJobLeases() {
 		return
 	}
 
-	jobsToRenew := filterRunningJobs(jobs, jobShouldBeRenewed)
+	// drained jobs keep their leases until their pods are gone, their deletion is retried until then
+	drainedJobs := filterRunningJobs(jobs, hasBeenDrained)
+	jobLeaseService.deletePodsWithGracePeriod(extractPods(drainedJobs))
+
+	jobsToRenew := append(filterRunningJobs(jobs, func(job *job_context.RunningJob) bool { return !hasBeenDrained(job) && jobShouldBeRenewed(job) }), drainedJobs...)
 	chunkedJobs := chunkJobs(jobsToRenew, maxPodRequestSize)
 	for _, chunk := range chunkedJobs {
 		jobLeaseService.renewJobLeases(chunk)
 	}
+	jobLeaseService.reportStaleLeases(jobsToRenew, time.Now())
+	jobs = filterRunningJobs(jobs, func(job *job_context.RunningJob) bool { return !hasBeenDrained(job) })
 
 	jobsForReporting := filterRunningJobs(jobs, shouldBeReportedDone)
 	chunkedJobsToReportDone := chunkJobs(jobsForReporting, maxPodRequestSize)
@@ -142,8 +508,10 @@ func (jobLeaseService *JobLeaseService) ReportDone(jobIds []string) error {
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
 	log.Infof("Reporting done for jobs %s", strings.Join(jobIds, ","))
-	_, err := jobLeaseService.queueClient.ReportDone(ctx, &api.IdList{Ids: jobIds})
-	return err
+	return jobLeaseService.apiBackoff.Call("done report", func() error {
+		_, err := jobLeaseService.queueClient.ReportDone(ctx, &api.IdList{Ids: jobIds})
+		return err
+	})
 }
 
 func extractJobIds(jobs []*job_context.RunningJob) []string {
@@ -169,34 +537,99 @@ func (jobLeaseService *JobLeaseService) renewJobLeases(jobs []*job_context.Runni
 	jobIds := extractJobIds(jobs)
 	log.Infof("Renewing lease for %s", strings.Join(jobIds, ","))
 
-	ctx, cancel := common.ContextWithDefaultTimeout()
-	defer cancel()
-	renewedJobIds, err := jobLeaseService.queueClient.RenewLease(ctx,
-		&api.RenewLeaseRequest{
+	var renewedJobIds *api.IdList
+	err := jobLeaseService.renewalBackoff.Call("job lease renewal", func() error {
+		var err error
+		renewedJobIds, err = jobLeaseService.renewLeaseWithRetry(&api.RenewLeaseRequest{
 			ClusterId: jobLeaseService.clusterContext.GetClusterId(),
 			Ids:       jobIds})
+		return err
+	})
 	if err != nil {
 		log.Errorf("Failed to renew lease for jobs because %s", err)
+		leaseRenewalFailuresCounter.WithLabelValues(renewalFailureReason(err)).Add(float64(len(jobIds)))
 		return
 	}
 
 	failedIds := commonUtil.SubtractStringList(jobIds, renewedJobIds.Ids)
+	jobLeaseService.recordRenewal(commonUtil.SubtractStringList(jobIds, failedIds), time.Now())
 	failedPods := filterPodsByJobId(extractPods(jobs), failedIds)
+	jobLeaseService.forgetDrainedJobs(failedIds)
 	if len(failedIds) > 0 {
 		log.Warnf("Server has prevented renewing of job lease for jobs %s", strings.Join(failedIds, ","))
-		jobLeaseService.clusterContext.DeletePods(failedPods)
+		leaseRenewalFailuresCounter.WithLabelValues(renewalRejected).Add(float64(len(failedIds)))
+		// jobs are no longer leased to this cluster, but their containers get time to clean up.
+		// Protected jobs are deleted as well, the server keeps their leases while this cluster reports usage,
+		// so they were cancelled or their lease expired after protectedJobExpireAfter.
+		for _, pod := range failedPods {
+			jobLeaseService.clusterContext.DeletePodsWithGracePeriod([]*v1.Pod{pod}, terminationGracePeriodSeconds(pod))
+		}
 	}
 }
 
-func (jobLeaseService *JobLeaseService) markAsDone(pods []*v1.Pod) {
-	for _, pod := range pods {
-		err := jobLeaseService.clusterContext.AddAnnotation(pod, map[string]string{
-			jobDoneAnnotation: time.Now().String(),
-		})
-		if err != nil {
-			log.Warnf("Failed to annotate pod as done: %v", err)
+func renewalFailureReason(err error) string {
+	if _, skipped := err.(*util.ApiUnavailableError); skipped {
+		return renewalSkippedServerUnavailable
+	}
+	if util.IsTransientError(err) {
+		return renewalServerUnavailable
+	}
+	return renewalError
+}
+
+func (jobLeaseService *JobLeaseService) recordRenewal(jobIds []string, renewed time.Time) {
+	jobLeaseService.lastRenewalMutex.Lock()
+	defer jobLeaseService.lastRenewalMutex.Unlock()
+	for _, jobId := range jobIds {
+		jobLeaseService.lastRenewal[jobId] = renewed
+	}
+}
+
+// Jobs not renewed yet count from when the renewal first saw them, so leases are not reported stale after an executor restart.
+// Jobs which are no longer renewed are forgotten.
+func (jobLeaseService *JobLeaseService) reportStaleLeases(jobs []*job_context.RunningJob, now time.Time) {
+	jobLeaseService.lastRenewalMutex.Lock()
+	defer jobLeaseService.lastRenewalMutex.Unlock()
+	lastRenewal := make(map[string]time.Time, len(jobs))
+	stale := 0
+	for _, job := range jobs {
+		renewed, exists := jobLeaseService.lastRenewal[job.JobId]
+		if !exists {
+			renewed = now
+		}
+		lastRenewal[job.JobId] = renewed
+		if now.Sub(renewed) > jobLeaseService.renewalInterval {
+			stale++
 		}
 	}
+	jobLeaseService.lastRenewal = lastRenewal
+	staleLeasesGauge.Set(float64(stale))
+}
+
+// Transient failures are retried within the renewal cycle, so a short network blip does not forfeit the leases.
+// The total retry time should stay well below the server lease expiry.
+func (jobLeaseService *JobLeaseService) renewLeaseWithRetry(request *api.RenewLeaseRequest) (*api.IdList, error) {
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		renewedJobIds, err := jobLeaseService.queueClient.RenewLease(ctx, request, grpc_retry.WithMax(1))
+		cancel()
+
+		if err == nil || attempt > jobLeaseService.renewalMaxRetries || !util.IsTransientError(err) {
+			return renewedJobIds, err
+		}
+		log.Warnf("Failed to renew lease for jobs (attempt %d of %d), retrying in %s: %s",
+			attempt, jobLeaseService.renewalMaxRetries+1, jobLeaseService.renewalRetryBackoff, err)
+		time.Sleep(jobLeaseService.renewalRetryBackoff)
+	}
+}
+
+func (jobLeaseService *JobLeaseService) markAsDone(pods []*v1.Pod) {
+	err := jobLeaseService.clusterContext.AddAnnotationToPods(pods, map[string]string{
+		jobDoneAnnotation: time.Now().String(),
+	})
+	if err != nil {
+		log.Warnf("Failed to annotate pods as done: %v", err)
+	}
 }
 
 func shouldBeRenewed(pod *v1.Pod) bool {
@@ -212,13 +645,36 @@ func jobShouldBeRenewed(job *job_context.RunningJob) bool {
 	return false
 }
 
+func filterRunningJobsByIndex(jobs []*job_context.RunningJob, include []bool) []*job_context.RunningJob {
+	result := []*job_context.RunningJob{}
+	for i, job := range jobs {
+		if include[i] {
+			result = append(result, job)
+		}
+	}
+	return result
+}
+
+func hasNotStarted(job *job_context.RunningJob) bool {
+	for _, pod := range job.Pods {
+		// pods just submitted are not in the informer cache yet and have no phase
+		if isReportedDone(pod) || util.IsDrained(pod) || pod.Status.Phase != v1.PodPending && pod.Status.Phase != "" {
+			return false
+		}
+	}
+	return len(job.Pods) > 0
+}
+
+// Jobs with a pod waiting to be recreated keep their lease, their pods are recreated by the stuck pod detector
 func shouldBeReportedDone(job *job_context.RunningJob) bool {
+	terminated := false
 	for _, pod := range job.Pods {
-		if util.IsInTerminalState(pod) && !isReportedDone(pod) {
-			return true
+		if util.ShouldBeRetried(pod) {
+			return false
 		}
+		terminated = terminated || util.IsInTerminalState(pod) && !isReportedDone(pod)
 	}
-	return false
+	return terminated
 }
 
 func (jobLeaseService *JobLeaseService) canBeRemoved(pod *v1.Pod) bool {
@@ -228,20 +684,28 @@ func (jobLeaseService *JobLeaseService) canBeRemoved(pod *v1.Pod) bool {
 		return false
 	}
 
+	now := jobLeaseService.clock.Now()
 	lastContainerStart := util.FindLastContainerStartTime(pod)
-	if lastContainerStart.Add(jobLeaseService.minimumPodAge).After(time.Now()) {
+	if lastContainerStart.Add(jobLeaseService.minimumPodAge).After(now) {
 		return false
 	}
 
 	if pod.Status.Phase == v1.PodFailed {
 		lastChange, err := util.LastStatusChange(pod)
-		if err == nil && lastChange.Add(jobLeaseService.failedPodExpiry).After(time.Now()) {
+		if err == nil && lastChange.Add(jobLeaseService.failedPodExpiry).After(now) {
 			return false
 		}
 	}
 	return true
 }
 
+func terminationGracePeriodSeconds(pod *v1.Pod) int64 {
+	if pod.Spec.TerminationGracePeriodSeconds == nil {
+		return v1.DefaultTerminationGracePeriodSeconds
+	}
+	return *pod.Spec.TerminationGracePeriodSeconds
+}
+
 func isReportedDone(pod *v1.Pod) bool {
 	_, exists := pod.Annotations[jobDoneAnnotation]
 	return exists
@@ -2,19 +2,25 @@ package service
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/domain"
 	context2 "github.com/G-Research/armada/internal/executor/fake/context"
 	"github.com/G-Research/armada/internal/executor/job_context"
+	"github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -57,12 +63,388 @@ func TestCanBeRemovedMinumumPodTime(t *testing.T) {
 	}
 }
 
+func TestCanBeRemoved_UsesServerTimeWhenExecutorClockIsBehind(t *testing.T) {
+	s := createLeaseService(10*time.Minute, 10*time.Minute)
+	s.queueClient = &serverTimeQueueClientMock{serverTime: time.Now().Add(time.Hour)}
+	pod := makeFinishedPodWithTimestamp(v1.PodSucceeded, time.Now().Add(-7*time.Minute))
+	assert.False(t, s.canBeRemoved(pod))
+
+	s.clock = util.NewServerClock(time.Minute, false)
+	_, err := s.RequestJobLeases(&common.ComputeResources{}, []api.NodeInfo{}, map[string]common.ComputeResources{}, 1)
+	assert.NoError(t, err)
+	assert.False(t, s.canBeRemoved(pod))
+
+	s.clock = util.NewServerClock(time.Minute, true)
+	_, err = s.RequestJobLeases(&common.ComputeResources{}, []api.NodeInfo{}, map[string]common.ComputeResources{}, 1)
+	assert.NoError(t, err)
+	assert.True(t, s.canBeRemoved(pod))
+}
+
+func TestShouldBeReportedDone_IsFalseWhilePodIsRetried(t *testing.T) {
+	failedPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.JobMaxRetries: "1", domain.JobAttempt: "1"}},
+		Status:     v1.PodStatus{Phase: v1.PodFailed, Reason: "NodeLost"},
+	}
+	assert.False(t, shouldBeReportedDone(&job_context.RunningJob{JobId: "job1", Pods: []*v1.Pod{failedPod}}))
+
+	failedPod.Annotations[domain.JobAttempt] = "2"
+	assert.True(t, shouldBeReportedDone(&job_context.RunningJob{JobId: "job1", Pods: []*v1.Pod{failedPod}}))
+}
+
+func TestRenewJobLeases_DeletesPodsOfExpiredLeasesWithTheirGracePeriod(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	gracePeriod := int64(120)
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	pod.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	addPod(t, clusterContext, pod)
+
+	s.renewJobLeases([]*job_context.RunningJob{{JobId: "job-id-1", Pods: []*v1.Pod{pod}}})
+
+	assert.Empty(t, clusterContext.pods)
+	assert.Equal(t, []int64{120}, clusterContext.deletionGracePeriods)
+}
+
+func TestRenewJobLeases_DeletesPodsWithoutGracePeriodWithTheKubernetesDefault(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	pod.Spec.TerminationGracePeriodSeconds = nil
+	addPod(t, clusterContext, pod)
+
+	s.renewJobLeases([]*job_context.RunningJob{{JobId: "job-id-1", Pods: []*v1.Pod{pod}}})
+
+	assert.Empty(t, clusterContext.pods)
+	assert.Equal(t, []int64{v1.DefaultTerminationGracePeriodSeconds}, clusterContext.deletionGracePeriods)
+}
+
+func TestRenewJobLeases_CountsFailuresByReason(t *testing.T) {
+	s := createLeaseService(time.Second, time.Second)
+	jobs := []*job_context.RunningJob{
+		{JobId: "job-id-1", Pods: []*v1.Pod{makeTestPod(v1.PodStatus{Phase: v1.PodRunning})}},
+		{JobId: "job-id-2", Pods: []*v1.Pod{makeTestPod(v1.PodStatus{Phase: v1.PodRunning})}},
+	}
+
+	unavailable := testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalServerUnavailable))
+	s.queueClient = &failingRenewLeaseClientMock{failures: 1, err: status.Error(codes.Unavailable, "unavailable")}
+	s.renewJobLeases(jobs)
+	assert.Equal(t, unavailable+2, testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalServerUnavailable)))
+
+	denied := testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalError))
+	s.queueClient = &failingRenewLeaseClientMock{failures: 1, err: status.Error(codes.PermissionDenied, "denied")}
+	s.renewJobLeases(jobs)
+	assert.Equal(t, denied+2, testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalError)))
+
+	rejected := testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalRejected))
+	s.queueClient = &queueClientMock{}
+	s.renewJobLeases(jobs[:1])
+	assert.Equal(t, rejected+1, testutil.ToFloat64(leaseRenewalFailuresCounter.WithLabelValues(renewalRejected)))
+
+	assert.Equal(t, renewalSkippedServerUnavailable, renewalFailureReason(&util.ApiUnavailableError{Operation: "job lease renewal"}))
+}
+
+func TestReportStaleLeases_CountsJobsNotRenewedWithinInterval(t *testing.T) {
+	s := createLeaseService(time.Second, time.Second)
+	s.renewalInterval = time.Minute
+	now := time.Now()
+	s.recordRenewal([]string{"stale", "gone"}, now.Add(-2*time.Minute))
+	s.recordRenewal([]string{"renewed"}, now.Add(-time.Second))
+
+	s.reportStaleLeases([]*job_context.RunningJob{{JobId: "stale"}, {JobId: "renewed"}, {JobId: "new"}}, now)
+	assert.Equal(t, float64(1), testutil.ToFloat64(staleLeasesGauge))
+
+	// jobs not renewed yet count from when they were first seen
+	s.reportStaleLeases([]*job_context.RunningJob{{JobId: "stale"}, {JobId: "renewed"}, {JobId: "new"}}, now.Add(2*time.Minute))
+	assert.Equal(t, float64(3), testutil.ToFloat64(staleLeasesGauge))
+	assert.NotContains(t, s.lastRenewal, "gone")
+
+	s.recordRenewal([]string{"stale", "renewed", "new"}, now.Add(2*time.Minute))
+	s.reportStaleLeases([]*job_context.RunningJob{{JobId: "stale"}, {JobId: "renewed"}, {JobId: "new"}}, now.Add(2*time.Minute))
+	assert.Equal(t, float64(0), testutil.ToFloat64(staleLeasesGauge))
+}
+
+func TestReturnLeases_ReturnsOnlyJobsWhichDidNotStart(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	queueClient := &returnLeaseClientMock{}
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = queueClient
+
+	pendingPod := makeTestPod(v1.PodStatus{Phase: v1.PodPending})
+	pendingPod.Labels[domain.JobId] = "pending-job"
+	runningPod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	runningPod.Labels[domain.JobId] = "running-job"
+	protectedPod := makeTestPod(v1.PodStatus{Phase: v1.PodPending})
+	protectedPod.Labels[domain.JobId] = "protected-job"
+	protectedPod.Annotations[common.ProtectedJobAnnotation] = "true"
+	addPod(t, clusterContext, pendingPod)
+	addPod(t, clusterContext, runningPod)
+	addPod(t, clusterContext, protectedPod)
+
+	s.ReturnLeases(context.Background(), eventReporter)
+
+	assert.Equal(t, []*api.ReturnLeaseRequest{
+		{ClusterId: "cluster-id-1", JobId: "pending-job", Reason: api.RequeueReason_ExecutorShutdown},
+	}, queueClient.requests)
+	assert.Len(t, eventReporter.receivedEvents, 2)
+	protectedEvent, ok := eventReporter.receivedEvents[0].(*api.JobProtectedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "protected-job", protectedEvent.JobId)
+	assert.Equal(t, api.RequeueReason_ExecutorShutdown, protectedEvent.RequeueReason)
+	assert.Equal(t, "pending-job", eventReporter.receivedEvents[1].GetJobId())
+	assert.Len(t, clusterContext.pods, 2)
+	assert.Contains(t, clusterContext.pods, "running-job")
+	assert.Contains(t, clusterContext.pods, "protected-job")
+}
+
+func TestReturnLeases_KeepsPodsWhenReturnFails(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = &returnLeaseClientMock{err: status.Error(codes.Unavailable, "unavailable")}
+	addPod(t, clusterContext, makeTestPod(v1.PodStatus{Phase: v1.PodPending}))
+
+	s.ReturnLeases(context.Background(), eventReporter)
+
+	assert.Empty(t, eventReporter.receivedEvents)
+	assert.Len(t, clusterContext.pods, 1)
+}
+
+func TestReturnLeases_KeepsJobsWithinMinimumLeaseHoldTime(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	queueClient := &returnLeaseClientMock{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = queueClient
+	s.minimumLeaseHoldTime = time.Hour
+	addPod(t, clusterContext, makeTestPod(v1.PodStatus{Phase: v1.PodPending}))
+
+	s.ReturnLeases(context.Background(), &FakeEventReporter{})
+
+	assert.Empty(t, queueClient.requests)
+	assert.Len(t, clusterContext.pods, 1)
+}
+
+func TestReturnLeasesOnDrainingNodes_ReturnsLeasesOnceDrainedPodsAreGone(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	queueClient := &returnLeaseClientMock{}
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = queueClient
+	drainingNodes := []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "draining-node"}}}
+
+	gracePeriod := int64(60)
+	drainedPod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	drainedPod.Labels[domain.JobId] = "drained-job"
+	drainedPod.Spec = v1.PodSpec{NodeName: "draining-node", TerminationGracePeriodSeconds: &gracePeriod}
+	finishedPod := makeTestPod(v1.PodStatus{Phase: v1.PodSucceeded})
+	finishedPod.Labels[domain.JobId] = "finished-job"
+	finishedPod.Spec.NodeName = "draining-node"
+	otherPod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	otherPod.Labels[domain.JobId] = "other-job"
+	otherPod.Spec.NodeName = "other-node"
+	addPod(t, clusterContext, drainedPod)
+	addPod(t, clusterContext, finishedPod)
+	addPod(t, clusterContext, otherPod)
+
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+
+	assert.Empty(t, queueClient.requests)
+	assert.Empty(t, eventReporter.receivedEvents)
+	assert.Equal(t, []int64{60}, clusterContext.deletionGracePeriods)
+	assert.Len(t, clusterContext.pods, 2)
+	assert.NotContains(t, clusterContext.pods, "drained-job")
+
+	// pod still terminating
+	terminatingPod := drainedPod.DeepCopy()
+	terminatingPod.Annotations[domain.JobDrained] = time.Now().String()
+	terminatingPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	addPod(t, clusterContext, terminatingPod)
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Empty(t, queueClient.requests)
+	assert.Equal(t, []int64{60}, clusterContext.deletionGracePeriods)
+
+	clusterContext.DeletePods([]*v1.Pod{terminatingPod})
+	s.ReturnLeasesOnDrainingNodes([]*v1.Node{}, eventReporter)
+
+	assert.Equal(t, []*api.ReturnLeaseRequest{
+		{ClusterId: "cluster-id-1", JobId: "drained-job", Reason: api.RequeueReason_NodeDrain},
+	}, queueClient.requests)
+	assert.Len(t, eventReporter.receivedEvents, 1)
+	event, ok := eventReporter.receivedEvents[0].(*api.JobLeaseReturnedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, api.RequeueReason_NodeDrain, event.RequeueReason)
+	assert.Contains(t, event.Reason, "Node draining-node is being drained")
+
+	s.ReturnLeasesOnDrainingNodes([]*v1.Node{}, eventReporter)
+	assert.Len(t, queueClient.requests, 1)
+}
+
+func TestReturnLeasesOnDrainingNodes_RetriesFailedLeaseReturns(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = &returnLeaseClientMock{err: status.Error(codes.Unavailable, "unavailable")}
+	drainingNodes := []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "draining-node"}}}
+
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	pod.Spec.NodeName = "draining-node"
+	addPod(t, clusterContext, pod)
+
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Empty(t, eventReporter.receivedEvents)
+
+	queueClient := &returnLeaseClientMock{}
+	s.queueClient = queueClient
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+
+	assert.Len(t, queueClient.requests, 1)
+	assert.Len(t, eventReporter.receivedEvents, 1)
+}
+
+func TestReturnLeasesOnDrainingNodes_KeepsProtectedJobs(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	queueClient := &returnLeaseClientMock{}
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = queueClient
+
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	pod.Annotations[common.ProtectedJobAnnotation] = "true"
+	pod.Spec.NodeName = "draining-node"
+	addPod(t, clusterContext, pod)
+
+	drainingNodes := []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "draining-node"}}}
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+
+	assert.Empty(t, queueClient.requests)
+	assert.Len(t, clusterContext.pods, 1)
+	assert.Len(t, eventReporter.receivedEvents, 1)
+	event, ok := eventReporter.receivedEvents[0].(*api.JobProtectedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, pod.Labels[domain.JobId], event.JobId)
+	assert.Equal(t, api.RequeueReason_NodeDrain, event.RequeueReason)
+	assert.Contains(t, event.Reason, "Node draining-node is being drained")
+
+	// reported once while the job keeps running
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Len(t, eventReporter.receivedEvents, 1)
+}
+
+func TestReturnLeasesOnDrainingNodes_DrainsJobsOnceMinimumLeaseHoldTimeHasPassed(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	queueClient := &returnLeaseClientMock{}
+	eventReporter := &FakeEventReporter{}
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	s.queueClient = queueClient
+	s.minimumLeaseHoldTime = time.Hour
+	drainingNodes := []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "draining-node"}}}
+
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodRunning})
+	pod.Spec.NodeName = "draining-node"
+	addPod(t, clusterContext, pod)
+
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Len(t, clusterContext.pods, 1)
+
+	// pod is 10 minutes old
+	s.minimumLeaseHoldTime = 5 * time.Minute
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Empty(t, clusterContext.pods)
+
+	s.ReturnLeasesOnDrainingNodes(drainingNodes, eventReporter)
+	assert.Equal(t, []*api.ReturnLeaseRequest{
+		{ClusterId: "cluster-id-1", JobId: pod.Labels[domain.JobId], Reason: api.RequeueReason_NodeDrain},
+	}, queueClient.requests)
+}
+
+func TestManageJobLeases_DeletesPodsOfDrainedJobsAndRenewsTheirLeases(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	s := createLeaseService(time.Second, time.Second)
+	s.clusterContext = clusterContext
+	s.jobContext = job_context.NewClusterJobContext(clusterContext)
+	queueClient := &failingRenewLeaseClientMock{}
+	s.queueClient = queueClient
+
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodFailed})
+	pod.Annotations[domain.JobDrained] = time.Now().String()
+	addPod(t, clusterContext, pod)
+
+	s.ManageJobLeases()
+
+	assert.Equal(t, 1, queueClient.calls)
+	assert.Empty(t, clusterContext.pods)
+}
+
+func TestHasNotStarted(t *testing.T) {
+	pending := &v1.Pod{Status: v1.PodStatus{Phase: v1.PodPending}}
+	submitted := &v1.Pod{}
+	running := &v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}}
+
+	assert.True(t, hasNotStarted(&job_context.RunningJob{Pods: []*v1.Pod{pending, submitted}}))
+	assert.False(t, hasNotStarted(&job_context.RunningJob{Pods: []*v1.Pod{pending, running}}))
+	assert.False(t, hasNotStarted(&job_context.RunningJob{Pods: []*v1.Pod{makePodWithCurrentStateReported(v1.PodPending, true)}}))
+}
+
 func TestChunkPods(t *testing.T) {
 	j := &job_context.RunningJob{}
 	chunks := chunkJobs([]*job_context.RunningJob{j, j, j}, 2)
 	assert.Equal(t, [][]*job_context.RunningJob{{j, j}, {j}}, chunks)
 }
 
+func TestRenewLeaseWithRetry_RetriesTransientFailures(t *testing.T) {
+	client := &failingRenewLeaseClientMock{failures: 2, err: status.Error(codes.Unavailable, "unavailable")}
+	s := createLeaseService(time.Second, time.Second)
+	s.queueClient = client
+	s.renewalMaxRetries = 2
+
+	renewed, err := s.renewLeaseWithRetry(&api.RenewLeaseRequest{Ids: []string{"job1"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"job1"}, renewed.Ids)
+	assert.Equal(t, 3, client.calls)
+}
+
+func TestRenewLeaseWithRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	client := &failingRenewLeaseClientMock{failures: 5, err: status.Error(codes.Unavailable, "unavailable")}
+	s := createLeaseService(time.Second, time.Second)
+	s.queueClient = client
+	s.renewalMaxRetries = 2
+
+	_, err := s.renewLeaseWithRetry(&api.RenewLeaseRequest{Ids: []string{"job1"}})
+
+	assert.Error(t, err)
+	assert.Equal(t, 3, client.calls)
+}
+
+func TestRenewLeaseWithRetry_DoesNotRetryPermanentFailures(t *testing.T) {
+	client := &failingRenewLeaseClientMock{failures: 1, err: status.Error(codes.PermissionDenied, "denied")}
+	s := createLeaseService(time.Second, time.Second)
+	s.queueClient = client
+	s.renewalMaxRetries = 2
+
+	_, err := s.renewLeaseWithRetry(&api.RenewLeaseRequest{Ids: []string{"job1"}})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, client.calls)
+}
+
 func makeFinishedPodWithTimestamp(state v1.PodPhase, timestamp time.Time) *v1.Pod {
 	pod := makePodWithCurrentStateReported(state, true)
 	pod.CreationTimestamp.Time = timestamp
@@ -91,7 +473,7 @@ func makePodWithCurrentStateReported(state v1.PodPhase, reportedDone bool) *v1.P
 func createLeaseService(minimumPodAge, failedPodExpiry time.Duration) *JobLeaseService {
 	fakeClusterContext := context2.NewFakeClusterContext(configuration.ApplicationConfiguration{ClusterId: "test", Pool: "pool"}, nil)
 	jobContext := job_context.NewClusterJobContext(fakeClusterContext)
-	return NewJobLeaseService(fakeClusterContext, jobContext, &queueClientMock{}, minimumPodAge, failedPodExpiry, common.ComputeResources{})
+	return NewJobLeaseService(fakeClusterContext, jobContext, &queueClientMock{}, minimumPodAge, failedPodExpiry, common.ComputeResources{}, configuration.PreemptibleNodeConfiguration{}, 0, 0, 0, 0, nil, nil, nil)
 }
 
 type queueClientMock struct {
@@ -112,3 +494,44 @@ func (queueClientMock) ReturnLease(ctx context.Context, in *api.ReturnLeaseReque
 func (queueClientMock) ReportDone(ctx context.Context, in *api.IdList, opts ...grpc.CallOption) (*api.IdList, error) {
 	return &api.IdList{}, nil
 }
+
+type serverTimeQueueClientMock struct {
+	queueClientMock
+	serverTime time.Time
+}
+
+func (c *serverTimeQueueClientMock) LeaseJobs(ctx context.Context, in *api.LeaseRequest, opts ...grpc.CallOption) (*api.JobLease, error) {
+	return &api.JobLease{ServerTime: c.serverTime}, nil
+}
+
+type returnLeaseClientMock struct {
+	queueClientMock
+	err      error
+	mutex    sync.Mutex
+	requests []*api.ReturnLeaseRequest
+}
+
+func (c *returnLeaseClientMock) ReturnLease(ctx context.Context, in *api.ReturnLeaseRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &types.Empty{}, nil
+}
+
+type failingRenewLeaseClientMock struct {
+	queueClientMock
+	failures int
+	err      error
+	calls    int
+}
+
+func (c *failingRenewLeaseClientMock) RenewLease(ctx context.Context, in *api.RenewLeaseRequest, opts ...grpc.CallOption) (*api.IdList, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return nil, c.err
+	}
+	return &api.IdList{Ids: in.Ids}, nil
+}
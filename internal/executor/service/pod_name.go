@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	// the rest of the name is the job id and pod number, so names stay valid DNS-1123 labels usable as pod host names
+	maxPodNamePrefixLength = 30
+	jobSetTokenLength      = 8
+)
+
+var invalidPodNameCharacters = regexp.MustCompile("[^a-z0-9-]+")
+var podNamePlaceholder = regexp.MustCompile("{{[^}]*}}")
+
+// Builds pod names from a template with {{queue}}, {{jobSet}} and {{owner}} placeholders, e.g. "{{queue}}-{{jobSet}}".
+// The job id and pod number are always appended, so names are unique and the job id label stays the way to find pods of a job.
+type PodNamer struct {
+	template string
+}
+
+func NewPodNamer(template string) (*PodNamer, error) {
+	namer := &PodNamer{template: template}
+	if template == "" {
+		return namer, nil
+	}
+	sample := &api.Job{Id: "01f3ab6c8m1y0bw1xkvr4j2n5d", Queue: "queue", JobSetId: "job-set", Owner: "owner"}
+	if unknown := podNamePlaceholder.FindString(namer.render(sample)); unknown != "" {
+		return nil, fmt.Errorf("pod name template %q has unknown placeholder %s", template, unknown)
+	}
+	if namer.prefix(sample) == "" {
+		return nil, fmt.Errorf("pod name template %q produces empty names", template)
+	}
+	if errs := validation.IsDNS1123Label(namer.PodName(sample, 0)); len(errs) > 0 {
+		return nil, fmt.Errorf("pod name template %q produces invalid names: %s", template, strings.Join(errs, ", "))
+	}
+	return namer, nil
+}
+
+// Without template, and for jobs whose values leave nothing of the template, names are armada-<job id>-<pod number>
+func (n *PodNamer) PodName(job *api.Job, podNumber int) string {
+	suffix := job.Id + "-" + strconv.Itoa(podNumber)
+	if n == nil || n.template == "" {
+		return common.PodNamePrefix + suffix
+	}
+	prefix := n.prefix(job)
+	if prefix == "" {
+		return common.PodNamePrefix + suffix
+	}
+	return prefix + "-" + suffix
+}
+
+func (n *PodNamer) prefix(job *api.Job) string {
+	prefix := sanitizePodName(n.render(job))
+	if len(prefix) > maxPodNamePrefixLength {
+		prefix = strings.Trim(prefix[:maxPodNamePrefixLength], "-")
+	}
+	return prefix
+}
+
+func (n *PodNamer) render(job *api.Job) string {
+	jobSetToken := sanitizePodName(job.JobSetId)
+	if len(jobSetToken) > jobSetTokenLength {
+		jobSetToken = strings.Trim(jobSetToken[:jobSetTokenLength], "-")
+	}
+	return strings.NewReplacer(
+		"{{queue}}", job.Queue,
+		"{{jobSet}}", jobSetToken,
+		"{{owner}}", job.Owner,
+	).Replace(n.template)
+}
+
+func sanitizePodName(name string) string {
+	return strings.Trim(invalidPodNameCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPodNamer_UsesDefaultNameWithoutTemplate(t *testing.T) {
+	namer, err := NewPodNamer("")
+	assert.NoError(t, err)
+	job := &api.Job{Id: "01f3ab6c8m1y0bw1xkvr4j2n5d", Queue: "queue1"}
+
+	assert.Equal(t, "armada-01f3ab6c8m1y0bw1xkvr4j2n5d-1", namer.PodName(job, 1))
+	assert.Equal(t, "armada-01f3ab6c8m1y0bw1xkvr4j2n5d-1", (*PodNamer)(nil).PodName(job, 1))
+}
+
+func TestPodNamer_EmbedsQueueAndJobSetToken(t *testing.T) {
+	namer, err := NewPodNamer("{{queue}}-{{jobSet}}")
+	assert.NoError(t, err)
+	job := &api.Job{Id: "01f3ab6c8m1y0bw1xkvr4j2n5d", Queue: "Research_Team", JobSetId: "Nightly.Training-2021"}
+
+	assert.Equal(t, "research-team-nightly-01f3ab6c8m1y0bw1xkvr4j2n5d-0", namer.PodName(job, 0))
+}
+
+func TestPodNamer_TruncatesLongNamesToValidLabels(t *testing.T) {
+	namer, err := NewPodNamer("{{owner}}-{{queue}}")
+	assert.NoError(t, err)
+	job := &api.Job{Id: "01f3ab6c8m1y0bw1xkvr4j2n5d", Queue: strings.Repeat("q", 100), Owner: "someone@example.com"}
+
+	name := namer.PodName(job, 12)
+	assert.Equal(t, "someone-example-com-qqqqqqqqqq-01f3ab6c8m1y0bw1xkvr4j2n5d-12", name)
+	assert.Empty(t, validation.IsDNS1123Label(name))
+}
+
+func TestPodNamer_FallsBackToDefaultNameWhenNothingOfTemplateIsLeft(t *testing.T) {
+	namer, err := NewPodNamer("{{queue}}")
+	assert.NoError(t, err)
+	job := &api.Job{Id: "01f3ab6c8m1y0bw1xkvr4j2n5d", Queue: "___"}
+
+	assert.Equal(t, "armada-01f3ab6c8m1y0bw1xkvr4j2n5d-0", namer.PodName(job, 0))
+}
+
+func TestNewPodNamer_RejectsInvalidTemplates(t *testing.T) {
+	_, err := NewPodNamer("{{queue}}-{{cluster}}")
+	assert.Error(t, err)
+
+	_, err = NewPodNamer("---")
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -9,10 +10,8 @@ import (
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/G-Research/armada/internal/common"
-	commonUtil "github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
-	"github.com/G-Research/armada/internal/executor/util"
 )
 
 type PodUtilisationService interface {
@@ -22,14 +21,28 @@ type PodUtilisationService interface {
 type MetricsServerPodUtilisationService struct {
 	clusterContext     context.ClusterContext
 	podUtilisationData map[string]common.ComputeResources
-	dataAccessMutex    sync.Mutex
+	// kubelet reports cumulative network counters, throughput is computed from the previous sample of each pod
+	networkSamples  map[string]networkSample
+	dataAccessMutex sync.Mutex
+	// nil when GPU metrics are not collected
+	gpuUtilisationCollector GpuUtilisationCollector
 }
 
-func NewMetricsServerQueueUtilisationService(clusterContext context.ClusterContext) *MetricsServerPodUtilisationService {
+type networkSample struct {
+	time          time.Time
+	receiveBytes  uint64
+	transmitBytes uint64
+}
+
+func NewMetricsServerQueueUtilisationService(
+	clusterContext context.ClusterContext,
+	gpuUtilisationCollector GpuUtilisationCollector) *MetricsServerPodUtilisationService {
 	return &MetricsServerPodUtilisationService{
-		clusterContext:     clusterContext,
-		podUtilisationData: map[string]common.ComputeResources{},
-		dataAccessMutex:    sync.Mutex{},
+		clusterContext:          clusterContext,
+		podUtilisationData:      map[string]common.ComputeResources{},
+		networkSamples:          map[string]networkSample{},
+		dataAccessMutex:         sync.Mutex{},
+		gpuUtilisationCollector: gpuUtilisationCollector,
 	}
 }
 
@@ -49,29 +62,58 @@ func (q *MetricsServerPodUtilisationService) GetPodUtilisation(pod *v1.Pod) comm
 	}
 	q.dataAccessMutex.Lock()
 	defer q.dataAccessMutex.Unlock()
-	utilisation, present := q.podUtilisationData[pod.Name]
+	utilisation, present := q.podUtilisationData[podKey(pod.Namespace, pod.Name)]
 	if !present {
 		return common.ComputeResources{}
 	}
 	return utilisation.DeepCopy()
 }
 
-func (q *MetricsServerPodUtilisationService) updatePodUtilisation(name string, resources common.ComputeResources) {
+func (q *MetricsServerPodUtilisationService) updatePodUtilisation(key string, resources common.ComputeResources) {
+	q.dataAccessMutex.Lock()
+	defer q.dataAccessMutex.Unlock()
+	q.podUtilisationData[key] = resources
+}
+
+// GPU metrics of the exporter replace kubelet accelerator stats
+func (q *MetricsServerPodUtilisationService) addGpuUtilisation(gpuUtilisation map[string]common.ComputeResources, podKeys map[string]bool) {
 	q.dataAccessMutex.Lock()
 	defer q.dataAccessMutex.Unlock()
-	q.podUtilisationData[name] = resources
+	for key, resources := range gpuUtilisation {
+		if !podKeys[key] {
+			continue
+		}
+		utilisation, present := q.podUtilisationData[key]
+		if !present {
+			utilisation = common.ComputeResources{}
+			q.podUtilisationData[key] = utilisation
+		}
+		for name, quantity := range resources {
+			utilisation[name] = quantity
+		}
+	}
 }
 
-func (q *MetricsServerPodUtilisationService) removeFinishedPods(podNames map[string]bool) {
+func (q *MetricsServerPodUtilisationService) removeFinishedPods(podKeys map[string]bool) {
 	q.dataAccessMutex.Lock()
 	defer q.dataAccessMutex.Unlock()
-	for name := range q.podUtilisationData {
-		if !podNames[name] {
-			delete(q.podUtilisationData, name)
+	for key := range q.podUtilisationData {
+		if !podKeys[key] {
+			delete(q.podUtilisationData, key)
+		}
+	}
+	for key := range q.networkSamples {
+		if !podKeys[key] {
+			delete(q.networkSamples, key)
 		}
 	}
 }
 
+// Batch pods can be spread across namespaces, so pods are identified by namespace and name
+func podKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
 func (q *MetricsServerPodUtilisationService) RefreshUtilisationData() {
 	nodes, err := q.clusterContext.GetNodes()
 	if err != nil {
@@ -82,7 +124,20 @@ func (q *MetricsServerPodUtilisationService) RefreshUtilisationData() {
 	if err != nil {
 		log.Errorf("Failed to retrieve pods from context: %s", err)
 	}
-	podNames := commonUtil.StringListToSet(util.ExtractNames(pods))
+	podKeys := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		podKeys[podKey(pod.Namespace, pod.Name)] = true
+	}
+
+	// scraped alongside kubelet stats, a slow or unreachable GPU exporter only delays the refresh up to its timeout
+	gpuUtilisation := make(chan map[string]common.ComputeResources, 1)
+	if q.gpuUtilisationCollector != nil {
+		go func() {
+			gpuUtilisation <- q.gpuUtilisationCollector.CollectGpuUtilisation(nodes)
+		}()
+	} else {
+		gpuUtilisation <- map[string]common.ComputeResources{}
+	}
 
 	summaries := make(chan *v1alpha1.Summary, len(nodes))
 	wg := sync.WaitGroup{}
@@ -106,13 +161,14 @@ func (q *MetricsServerPodUtilisationService) RefreshUtilisationData() {
 
 	for s := range summaries {
 		for _, pod := range s.Pods {
-			if podNames[pod.PodRef.Name] {
+			if podKeys[podKey(pod.PodRef.Namespace, pod.PodRef.Name)] {
 				q.updatePodStats(&pod)
 			}
 		}
 	}
+	q.addGpuUtilisation(<-gpuUtilisation, podKeys)
 
-	q.removeFinishedPods(podNames)
+	q.removeFinishedPods(podKeys)
 }
 
 func (q *MetricsServerPodUtilisationService) updatePodStats(podStats *v1alpha1.PodStats) {
@@ -150,5 +206,49 @@ func (q *MetricsServerPodUtilisationService) updatePodStats(podStats *v1alpha1.P
 		resources[domain.AcceleratorMemory] = *resource.NewScaledQuantity(acceleratorUsedMemory, -2)
 	}
 
-	q.updatePodUtilisation(podStats.PodRef.Name, resources)
+	key := podKey(podStats.PodRef.Namespace, podStats.PodRef.Name)
+	q.addNetworkThroughput(key, podStats.Network, resources)
+	q.updatePodUtilisation(key, resources)
+}
+
+// Network stats are not reported by all container runtimes, throughput is left out when they are missing
+func (q *MetricsServerPodUtilisationService) addNetworkThroughput(key string, networkStats *v1alpha1.NetworkStats, resources common.ComputeResources) {
+	q.dataAccessMutex.Lock()
+	defer q.dataAccessMutex.Unlock()
+
+	sample, ok := extractNetworkSample(networkStats)
+	if !ok {
+		delete(q.networkSamples, key)
+		return
+	}
+	previous, hasPrevious := q.networkSamples[key]
+	q.networkSamples[key] = sample
+
+	elapsed := sample.time.Sub(previous.time).Seconds()
+	// counters go back when the pod network is recreated
+	if !hasPrevious || elapsed <= 0 || sample.receiveBytes < previous.receiveBytes || sample.transmitBytes < previous.transmitBytes {
+		return
+	}
+	resources[domain.NetworkReceiveRate] = *resource.NewQuantity(int64(float64(sample.receiveBytes-previous.receiveBytes)/elapsed), resource.DecimalSI)
+	resources[domain.NetworkTransmitRate] = *resource.NewQuantity(int64(float64(sample.transmitBytes-previous.transmitBytes)/elapsed), resource.DecimalSI)
+}
+
+func extractNetworkSample(networkStats *v1alpha1.NetworkStats) (networkSample, bool) {
+	if networkStats == nil || networkStats.Time.IsZero() {
+		return networkSample{}, false
+	}
+	interfaces := networkStats.Interfaces
+	if len(interfaces) == 0 {
+		interfaces = []v1alpha1.InterfaceStats{networkStats.InterfaceStats}
+	}
+
+	sample := networkSample{time: networkStats.Time.Time}
+	for _, i := range interfaces {
+		if i.RxBytes == nil || i.TxBytes == nil {
+			return networkSample{}, false
+		}
+		sample.receiveBytes += *i.RxBytes
+		sample.transmitBytes += *i.TxBytes
+	}
+	return sample, true
 }
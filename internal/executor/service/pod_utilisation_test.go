@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestUpdatePodStats_ReportsNetworkThroughput(t *testing.T) {
+	service := NewMetricsServerQueueUtilisationService(nil, nil)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}}
+	start := time.Now()
+
+	service.updatePodStats(makePodStats(start, networkInterface(1000, 500)))
+	assert.NotContains(t, service.GetPodUtilisation(pod), domain.NetworkReceiveRate)
+
+	service.updatePodStats(makePodStats(start.Add(10*time.Second), networkInterface(3000, 1000), networkInterface(1000, 500)))
+	utilisation := service.GetPodUtilisation(pod)
+	receiveRate := utilisation[domain.NetworkReceiveRate]
+	transmitRate := utilisation[domain.NetworkTransmitRate]
+	assert.Equal(t, int64(300), receiveRate.Value())
+	assert.Equal(t, int64(100), transmitRate.Value())
+	assert.Contains(t, utilisation, "cpu")
+}
+
+func TestUpdatePodStats_OmitsNetworkThroughputWhenNotAvailable(t *testing.T) {
+	service := NewMetricsServerQueueUtilisationService(nil, nil)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}}
+	start := time.Now()
+
+	service.updatePodStats(makePodStats(start, networkInterface(1000, 500)))
+	withoutNetwork := makePodStats(start.Add(10 * time.Second))
+	withoutNetwork.Network = nil
+	service.updatePodStats(withoutNetwork)
+
+	utilisation := service.GetPodUtilisation(pod)
+	assert.NotContains(t, utilisation, domain.NetworkReceiveRate)
+	assert.Contains(t, utilisation, "cpu")
+
+	// counters reset, e.g. after the pod network was recreated
+	service.updatePodStats(makePodStats(start.Add(20*time.Second), networkInterface(5000, 5000)))
+	service.updatePodStats(makePodStats(start.Add(30*time.Second), networkInterface(10, 10)))
+	assert.NotContains(t, service.GetPodUtilisation(pod), domain.NetworkReceiveRate)
+}
+
+func makePodStats(time time.Time, interfaces ...v1alpha1.InterfaceStats) *v1alpha1.PodStats {
+	cpu := uint64(1000000000)
+	return &v1alpha1.PodStats{
+		PodRef:  v1alpha1.PodReference{Namespace: "namespace", Name: "pod"},
+		CPU:     &v1alpha1.CPUStats{UsageNanoCores: &cpu},
+		Network: &v1alpha1.NetworkStats{Time: metav1.NewTime(time), Interfaces: interfaces},
+	}
+}
+
+func networkInterface(receiveBytes uint64, transmitBytes uint64) v1alpha1.InterfaceStats {
+	return v1alpha1.InterfaceStats{RxBytes: &receiveBytes, TxBytes: &transmitBytes}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/configuration"
+)
+
+// Tolerations and affinity of the pod are copied before they are changed, as the pod spec is shared with the job
+func setPreemptibleNodePolicy(pod *v1.Pod, toleratesPreemptibleNodes bool, config configuration.PreemptibleNodeConfiguration) {
+	pod.Spec.Tolerations = common.PreemptibleNodeTolerations(pod.Spec.Tolerations, toleratesPreemptibleNodes, config.Taint)
+	if !toleratesPreemptibleNodes && config.Label != "" {
+		requireNodeAffinity(pod, avoidPreemptibleNodes(config))
+	}
+}
+
+func avoidPreemptibleNodes(config configuration.PreemptibleNodeConfiguration) v1.NodeSelectorRequirement {
+	if config.LabelValue == "" {
+		return v1.NodeSelectorRequirement{Key: config.Label, Operator: v1.NodeSelectorOpDoesNotExist}
+	}
+	return v1.NodeSelectorRequirement{Key: config.Label, Operator: v1.NodeSelectorOpNotIn, Values: []string{config.LabelValue}}
+}
+
+// Terms of required node affinity are alternatives, so the requirement is added to each of them
+func requireNodeAffinity(pod *v1.Pod, requirement v1.NodeSelectorRequirement) {
+	affinity := &v1.Affinity{}
+	if pod.Spec.Affinity != nil {
+		affinity = pod.Spec.Affinity.DeepCopy()
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &v1.NodeAffinity{}
+	}
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		required = &v1.NodeSelector{NodeSelectorTerms: []v1.NodeSelectorTerm{{}}}
+	}
+	for i := range required.NodeSelectorTerms {
+		term := &required.NodeSelectorTerms[i]
+		term.MatchExpressions = append(term.MatchExpressions, requirement)
+	}
+	affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = required
+	pod.Spec.Affinity = affinity
+}
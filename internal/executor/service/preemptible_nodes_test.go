@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var spotNodes = configuration.PreemptibleNodeConfiguration{Label: "cloud.google.com/gke-spot", LabelValue: "true", Taint: "cloud.google.com/gke-spot"}
+
+func TestSetPreemptibleNodePolicy_ToleratingJobToleratesTaint(t *testing.T) {
+	pod := createPod(&api.Job{Id: "job1", PodSpec: makePodSpec(), ToleratesPreemptibleNodes: true}, 0, nil)
+
+	setPreemptibleNodePolicy(pod, true, spotNodes)
+
+	assert.Equal(t, []v1.Toleration{{Key: "cloud.google.com/gke-spot", Operator: v1.TolerationOpExists}}, pod.Spec.Tolerations)
+	assert.Nil(t, pod.Spec.Affinity)
+}
+
+func TestSetPreemptibleNodePolicy_OtherJobsAvoidPreemptibleNodes(t *testing.T) {
+	podSpec := makePodSpec()
+	podSpec.Tolerations = []v1.Toleration{
+		{Key: "cloud.google.com/gke-spot", Operator: v1.TolerationOpExists},
+		{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists},
+	}
+	pod := createPod(&api.Job{Id: "job1", PodSpec: podSpec}, 0, nil)
+
+	setPreemptibleNodePolicy(pod, false, spotNodes)
+
+	assert.Equal(t, []v1.Toleration{{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists}}, pod.Spec.Tolerations)
+	assert.Equal(t, &v1.NodeSelector{NodeSelectorTerms: []v1.NodeSelectorTerm{{
+		MatchExpressions: []v1.NodeSelectorRequirement{
+			{Key: "cloud.google.com/gke-spot", Operator: v1.NodeSelectorOpNotIn, Values: []string{"true"}},
+		},
+	}}}, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	// the pod spec of the job is not changed
+	assert.Equal(t, 2, len(podSpec.Tolerations))
+}
+
+func TestSetPreemptibleNodePolicy_OtherJobsLoseTolerationsOfAllTaints(t *testing.T) {
+	podSpec := makePodSpec()
+	podSpec.Tolerations = []v1.Toleration{{Operator: v1.TolerationOpExists}, {Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists}}
+	pod := createPod(&api.Job{Id: "job1", PodSpec: podSpec}, 0, nil)
+
+	setPreemptibleNodePolicy(pod, false, spotNodes)
+
+	assert.Equal(t, []v1.Toleration{{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists}}, pod.Spec.Tolerations)
+}
+
+func TestSetPreemptibleNodePolicy_LabelWithoutValueAvoidsNodesWithAnyValue(t *testing.T) {
+	pod := createPod(&api.Job{Id: "job1", PodSpec: makePodSpec()}, 0, nil)
+
+	setPreemptibleNodePolicy(pod, false, configuration.PreemptibleNodeConfiguration{Label: "eks.amazonaws.com/spot"})
+
+	assert.Equal(t, []v1.NodeSelectorRequirement{{Key: "eks.amazonaws.com/spot", Operator: v1.NodeSelectorOpDoesNotExist}},
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions)
+}
+
+func TestSetPreemptibleNodePolicy_AddsRequirementToEveryNodeSelectorTerm(t *testing.T) {
+	zoneA := v1.NodeSelectorRequirement{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"a"}}
+	zoneB := v1.NodeSelectorRequirement{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"b"}}
+	podSpec := makePodSpec()
+	podSpec.Affinity = &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: []v1.NodeSelectorTerm{
+				{MatchExpressions: []v1.NodeSelectorRequirement{zoneA}},
+				{MatchExpressions: []v1.NodeSelectorRequirement{zoneB}},
+			}},
+		},
+		PodAntiAffinity: &v1.PodAntiAffinity{},
+	}
+	pod := createPod(&api.Job{Id: "job1", PodSpec: podSpec}, 0, nil)
+
+	setPreemptibleNodePolicy(pod, false, spotNodes)
+
+	avoidSpot := v1.NodeSelectorRequirement{Key: "cloud.google.com/gke-spot", Operator: v1.NodeSelectorOpNotIn, Values: []string{"true"}}
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Equal(t, []v1.NodeSelectorTerm{
+		{MatchExpressions: []v1.NodeSelectorRequirement{zoneA, avoidSpot}},
+		{MatchExpressions: []v1.NodeSelectorRequirement{zoneB, avoidSpot}},
+	}, terms)
+	assert.NotNil(t, pod.Spec.Affinity.PodAntiAffinity)
+	assert.Equal(t, 1, len(podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions))
+}
+
+func TestSetPreemptibleNodePolicy_WithoutConfigurationLeavesPodUnchanged(t *testing.T) {
+	pod := createPod(&api.Job{Id: "job1", PodSpec: makePodSpec()}, 0, nil)
+	expected := pod.DeepCopy()
+
+	setPreemptibleNodePolicy(pod, false, configuration.PreemptibleNodeConfiguration{})
+	assert.Equal(t, expected, pod)
+
+	setPreemptibleNodePolicy(pod, true, configuration.PreemptibleNodeConfiguration{})
+	assert.Equal(t, expected, pod)
+}
@@ -2,24 +2,41 @@ package service
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/job_context"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/util"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 type StuckPodDetector struct {
-	clusterContext  context.ClusterContext
-	jobContext      job_context.JobContext
-	eventReporter   reporter.EventReporter
-	stuckJobCache   map[string]*stuckJobRecord
-	jobLeaseService LeaseService
-	stuckPodExpiry  time.Duration
+	clusterContext     context.ClusterContext
+	jobContext         job_context.JobContext
+	eventReporter      reporter.EventReporter
+	stuckJobCache      map[string]*stuckJobRecord
+	jobLeaseService    LeaseService
+	utilisationService UtilisationService
+	stuckPodExpiry     time.Duration
+	imagePullTimeout   time.Duration
+
+	missingVolumeExpiry time.Duration
+	unknownPodExpiry    time.Duration
+	unknownPodSince     map[types.UID]time.Time
+	// release jobs with pods over their activeDeadlineSeconds as soon as their failure is reported
+	deleteDeadlineExceededPods bool
+	clock                      *util.ServerClock
+	// stuck pending pods and pods with missing volumes are kept at least this long after they were created
+	minimumLeaseHoldTime time.Duration
 }
 
 type stuckJobRecord struct {
@@ -27,6 +44,19 @@ type stuckJobRecord struct {
 	pod       *v1.Pod
 	message   string
 	retryable bool
+	// reported when the lease of a retryable job is returned
+	requeueReason api.RequeueReason
+
+	// primary containers of the job finished, only sidecar containers are still running
+	sidecarsOnly bool
+	// lease was already returned when the record was created, e.g. for pods on unreachable nodes
+	leaseReturned bool
+	// failure of the pod was already reported, only its peer pods need failed events
+	failureReported bool
+	// pod failed because of the infrastructure and the job has retries left, pods of the job are recreated
+	recreate bool
+	// pod was killed for exceeding its memory limit, it is recreated with more memory
+	memoryIncreases []util.MemoryIncrease
 }
 
 func NewPodProgressMonitorService(
@@ -34,20 +64,35 @@ func NewPodProgressMonitorService(
 	jobContext job_context.JobContext,
 	eventReporter reporter.EventReporter,
 	jobLeaseService LeaseService,
-	stuckPodExpiry time.Duration) *StuckPodDetector {
+	utilisationService UtilisationService,
+	stuckPodExpiry time.Duration,
+	imagePullTimeout time.Duration,
+	missingVolumeExpiry time.Duration,
+	unknownPodExpiry time.Duration,
+	deleteDeadlineExceededPods bool,
+	clock *util.ServerClock,
+	minimumLeaseHoldTime time.Duration) *StuckPodDetector {
 
 	return &StuckPodDetector{
-		clusterContext:  clusterContext,
-		jobContext:      jobContext,
-		eventReporter:   eventReporter,
-		stuckJobCache:   map[string]*stuckJobRecord{},
-		jobLeaseService: jobLeaseService,
-		stuckPodExpiry:  stuckPodExpiry,
+		clusterContext:      clusterContext,
+		jobContext:          jobContext,
+		eventReporter:       eventReporter,
+		stuckJobCache:       map[string]*stuckJobRecord{},
+		jobLeaseService:     jobLeaseService,
+		utilisationService:  utilisationService,
+		stuckPodExpiry:      stuckPodExpiry,
+		imagePullTimeout:    imagePullTimeout,
+		missingVolumeExpiry: missingVolumeExpiry,
+		unknownPodExpiry:    unknownPodExpiry,
+		unknownPodSince:     map[types.UID]time.Time{},
+
+		deleteDeadlineExceededPods: deleteDeadlineExceededPods,
+		clock:                      clock,
+		minimumLeaseHoldTime:       minimumLeaseHoldTime,
 	}
 }
 
-func (d *StuckPodDetector) determineStuckPodState(pod *v1.Pod) (err error, retryable bool, message string) {
-
+func (d *StuckPodDetector) diagnoseStuckPod(pod *v1.Pod) (retryable bool, message string) {
 	podEvents, err := d.clusterContext.GetPodEvents(pod)
 	if err != nil {
 		log.Errorf("Unable to get pod events: %v", err)
@@ -59,30 +104,159 @@ func (d *StuckPodDetector) determineStuckPodState(pod *v1.Pod) (err error, retry
 	} else {
 		message = fmt.Sprintf("Unable to schedule pod with unrecoverable problem, Armada will not retry.\n%s", message)
 	}
+	return retryable, message
+}
+
+func (d *StuckPodDetector) reportStuckPod(pod *v1.Pod, message string) error {
 	event := reporter.CreateJobUnableToScheduleEvent(pod, message, d.clusterContext.GetClusterId())
-	err = d.eventReporter.Report(event)
+	err := d.eventReporter.Report(event)
 	if err != nil {
 		log.Errorf("Failure to report stuck pod event %+v because %s", event, err)
 	}
-	return err, retryable, message
+	return err
+}
+
+func (d *StuckPodDetector) lastWarningEventMessage(pod *v1.Pod) string {
+	podEvents, err := d.clusterContext.GetPodEvents(pod)
+	if err != nil {
+		log.Errorf("Unable to get pod events: %v", err)
+		return ""
+	}
+	lastWarning := util.LastWarningEvent(podEvents)
+	if lastWarning == nil {
+		return ""
+	}
+	return fmt.Sprintf("\nLast warning event %v: %v", lastWarning.Reason, lastWarning.Message)
+}
+
+func (d *StuckPodDetector) reportMissingVolume(pod *v1.Pod, reason string) (err error, message string) {
+	message = fmt.Sprintf("Volume not available, Armada will return lease and retry.\n%s", reason)
+	event := reporter.CreateJobUnableToScheduleEvent(pod, message, d.clusterContext.GetClusterId())
+	err = d.eventReporter.Report(event)
+	if err != nil {
+		log.Errorf("Failure to report missing volume event %+v because %s", event, err)
+	}
+	return err, message
+}
+
+func (d *StuckPodDetector) missingVolumeReason(pod *v1.Pod) (reason string, isMissing bool) {
+	if d.missingVolumeExpiry <= 0 || pod.Status.Phase != v1.PodPending || d.isWithinMinimumLeaseHoldTime(pod) {
+		return "", false
+	}
+	reason, isMissing = util.ExtractMissingVolumeReason(pod)
+	return reason, isMissing && reporter.HasPodBeenInStateForLongerThanGivenDurationAt(pod, d.missingVolumeExpiry, d.clock.Now())
+}
+
+// Pods pulling big images can legitimately stay pending for long, unschedulable pods are given up on sooner
+func (d *StuckPodDetector) pendingPodExpiry(pod *v1.Pod) time.Duration {
+	if d.imagePullTimeout <= 0 {
+		return d.stuckPodExpiry
+	}
+	podEvents, err := d.clusterContext.GetPodEvents(pod)
+	if err != nil {
+		log.Errorf("Unable to get pod events: %v", err)
+	}
+	if util.IsPullingImages(pod, podEvents) {
+		return d.imagePullTimeout
+	}
+	return d.stuckPodExpiry
+}
+
+// Only delays giving up on pods which may still start, failed pods and pods on unreachable nodes are handled regardless
+func (d *StuckPodDetector) isWithinMinimumLeaseHoldTime(pod *v1.Pod) bool {
+	return isWithinMinimumLeaseHoldTime([]*v1.Pod{pod}, d.minimumLeaseHoldTime, d.clock.Now())
+}
+
+func (d *StuckPodDetector) returnLeaseOfUnreachablePod(pod *v1.Pod) error {
+	err := d.jobLeaseService.ReturnLease(pod, api.RequeueReason_NodeUnreachable)
+	if err != nil {
+		log.Errorf("Failed to return lease for pod %s on unreachable node because %s", pod.Name, err)
+		return err
+	}
+
+	message := fmt.Sprintf("Node %s unreachable, pod phase has been %s for longer than %s, Armada will return lease and retry.",
+		pod.Spec.NodeName, v1.PodUnknown, d.unknownPodExpiry)
+	event := reporter.CreateJobLeaseReturnedEvent(pod, message, api.RequeueReason_NodeUnreachable, d.clusterContext.GetClusterId())
+	err = d.eventReporter.Report(event)
+	if err != nil {
+		// lease is already returned, the event is just for reporting
+		log.Errorf("Failure to report node unreachable event %+v because %s", event, err)
+	}
+	return nil
+}
+
+// Pods on unreachable nodes have phase Unknown, which can also happen briefly when a node misses a heartbeat.
+// The pod is only considered lost if it stays Unknown for longer than unknownPodExpiry, observed by this executor.
+func (d *StuckPodDetector) isLostOnUnreachableNode(pod *v1.Pod) bool {
+	if d.unknownPodExpiry <= 0 || pod.Status.Phase != v1.PodUnknown {
+		return false
+	}
+	return d.unknownPodSince[pod.UID].Add(d.unknownPodExpiry).Before(time.Now())
+}
+
+// The failed event of the pod is reported by the event reporter, the pod is only released once it was sent
+func (d *StuckPodDetector) isReportedDeadlineExceeded(pod *v1.Pod) bool {
+	return d.deleteDeadlineExceededPods &&
+		pod.Status.Phase == v1.PodFailed &&
+		util.IsDeadlineExceeded(pod) &&
+		reporter.HasCurrentStateBeenReported(pod)
+}
+
+func (d *StuckPodDetector) trackUnknownPods(jobs []*job_context.RunningJob) {
+	now := time.Now()
+	unknownPodSince := map[types.UID]time.Time{}
+	for _, job := range jobs {
+		for _, pod := range job.Pods {
+			if pod.Status.Phase != v1.PodUnknown {
+				continue
+			}
+			since, tracked := d.unknownPodSince[pod.UID]
+			if !tracked {
+				since = now
+			}
+			unknownPodSince[pod.UID] = since
+		}
+	}
+	// pods which recovered from Unknown phase are dropped, so the grace period starts again on the next occurrence
+	d.unknownPodSince = unknownPodSince
 }
 
 func (d *StuckPodDetector) onStuckPodDeleted(record *stuckJobRecord) (resolved bool) {
 	// this method is executed after stuck pod was deleted from the cluster
-	if record.retryable {
-		err := d.jobLeaseService.ReturnLease(record.pod)
-		if err != nil {
-			log.Errorf("Failed to return lease for job %s because %s", record.job.JobId, err)
-			return false
+	if record.leaseReturned {
+		return true
+
+	} else if record.sidecarsOnly {
+		for _, pod := range record.job.Pods {
+			if !util.HasOnlySidecarContainersRunning(pod) {
+				continue
+			}
+			event, err := reporter.CreateEventForCurrentState(util.PodWithoutSidecarContainers(pod), d.clusterContext.GetClusterId())
+			if err == nil {
+				err = d.eventReporter.Report(event)
+			}
+			if err != nil {
+				log.Errorf("Failed to report completion of job %s with sidecar containers because %s", record.job.JobId, err)
+				return false
+			}
 		}
 
-		leaseReturnedEvent := reporter.CreateJobLeaseReturnedEvent(record.pod, record.message, d.clusterContext.GetClusterId())
+	} else if record.recreate {
+		return d.recreatePods(record)
 
-		err = d.eventReporter.Report(leaseReturnedEvent)
-		if err != nil {
-			log.Errorf("Failed to report lease returned for job %s because %s", record.job.JobId, err)
-			// We should fall through to true here, as we have already returned the lease and the event is just for reporting
-			// If we fail, we'll try again which could be complicated if the same executor leases is again between retries
+	} else if record.retryable {
+		return d.returnLeaseOfStuckJob(record)
+
+	} else if record.failureReported {
+		for _, pod := range record.job.Pods {
+			if pod.UID == record.pod.UID || util.IsInTerminalState(pod) {
+				continue
+			}
+			message := fmt.Sprintf("Peer pod %d failed: %s", util.ExtractPodNumber(record.pod), record.message)
+			err := d.eventReporter.Report(reporter.CreateSimpleJobFailedEvent(pod, message, d.clusterContext.GetClusterId()))
+			if err != nil {
+				return false
+			}
 		}
 
 	} else {
@@ -105,39 +279,232 @@ func (d *StuckPodDetector) onStuckPodDeleted(record *stuckJobRecord) (resolved b
 	return true
 }
 
+func (d *StuckPodDetector) returnLeaseOfStuckJob(record *stuckJobRecord) (resolved bool) {
+	err := d.jobLeaseService.ReturnLease(record.pod, record.requeueReason)
+	if err != nil {
+		log.Errorf("Failed to return lease for job %s because %s", record.job.JobId, err)
+		return false
+	}
+
+	leaseReturnedEvent := reporter.CreateJobLeaseReturnedEvent(record.pod, record.message, record.requeueReason, d.clusterContext.GetClusterId())
+
+	err = d.eventReporter.Report(leaseReturnedEvent)
+	if err != nil {
+		log.Errorf("Failed to report lease returned for job %s because %s", record.job.JobId, err)
+		// We should fall through to true here, as we have already returned the lease and the event is just for reporting
+		// If we fail, we'll try again which could be complicated if the same executor leases is again between retries
+	}
+	return true
+}
+
+// Recreated pods have the same names, this is only called once all pods of the job were deleted.
+// The job keeps its lease, when the pods can not be recreated the lease is returned instead.
+func (d *StuckPodDetector) recreatePods(record *stuckJobRecord) (resolved bool) {
+	attempt, maxRetries := util.ExtractAttempt(record.pod)
+	recreatedPods := []*v1.Pod{}
+	var podWithMoreMemory *v1.Pod
+	for _, pod := range record.job.Pods {
+		retryPod := createRetryPod(pod, attempt+1)
+		increaseMemory := pod.UID == record.pod.UID && len(record.memoryIncreases) > 0
+		if increaseMemory {
+			util.ApplyMemoryIncreases(&retryPod.Spec, record.memoryIncreases)
+			retryPod.Annotations[domain.JobMemoryIncrease] = util.DescribeMemoryIncreases(record.memoryIncreases)
+		}
+		recreatedPod, err := d.submitRetryPod(retryPod, pod.Annotations[domain.JobOwner])
+		if recreatedPod != nil {
+			recreatedPods = append(recreatedPods, recreatedPod)
+		}
+		if err != nil {
+			log.Errorf("Failed to recreate pods of job %s because %s", record.job.JobId, err)
+			d.clusterContext.DeletePods(recreatedPods)
+			record.requeueReason = api.RequeueReason_PodCreationFailed
+			record.message = fmt.Sprintf("Failed to recreate pods for attempt %d because %s", attempt+1, err)
+			return d.returnLeaseOfStuckJob(record)
+		}
+		if increaseMemory {
+			podWithMoreMemory = recreatedPod
+		}
+	}
+	log.Infof("Recreated pods of job %s for attempt %d of %d", record.job.JobId, attempt+1, maxRetries+1)
+
+	if podWithMoreMemory != nil {
+		event := reporter.CreateJobMemoryIncreasedEvent(podWithMoreMemory, attempt+1, record.memoryIncreases, d.clusterContext.GetClusterId())
+		err := d.eventReporter.Report(event)
+		if err != nil {
+			// pods are already recreated, the event is just for reporting
+			log.Errorf("Failed to report memory increase of job %s because %s", record.job.JobId, err)
+		}
+	}
+	return true
+}
+
+// Pods killed for exceeding their memory limit are only recreated with more memory when they still fit on a node type
+// of the cluster, the check the server does for submitted jobs. Otherwise the job fails. Returns nil when the node types
+// are not available, the pod is checked again on the next run.
+func (d *StuckPodDetector) createRetryRecord(job *job_context.RunningJob, pod *v1.Pod) *stuckJobRecord {
+	record := &stuckJobRecord{
+		job:       job,
+		pod:       pod.DeepCopy(),
+		message:   util.ExtractPodFailedReason(pod),
+		retryable: true,
+		recreate:  true}
+	if util.IsInfrastructureFailure(pod) {
+		return record
+	}
+
+	increases := util.ExtractOomMemoryIncreases(pod)
+	nodeTypes, err := d.utilisationService.GetNodeTypes()
+	if err != nil {
+		log.Errorf("Failed to check pod %s fits on the cluster with more memory because %s", pod.Name, err)
+		return nil
+	}
+	spec := pod.Spec.DeepCopy()
+	util.ApplyMemoryIncreases(spec, increases)
+	if !common.PodFitsAnyNodeType(spec, nodeTypes) {
+		record.retryable = false
+		record.recreate = false
+		record.message = fmt.Sprintf("%s\nNot retried with more memory (%s), the pod would not fit on any node of the cluster.",
+			record.message, util.DescribeMemoryIncreases(increases))
+		return record
+	}
+	record.memoryIncreases = increases
+	return record
+}
+
+// Annotations marking the state of the failed pod as reported, the recreated pod reports its own state again
+var reportedStateAnnotations = map[string]bool{
+	string(v1.PodPending):   true,
+	string(v1.PodRunning):   true,
+	string(v1.PodSucceeded): true,
+	string(v1.PodFailed):    true,
+	string(v1.PodUnknown):   true,
+	jobDoneAnnotation:       true,
+}
+
+// Recreated pods get new volume claims, the claims of the failed pods are deleted with them.
+// The pod is returned when it was created, even when its claim could not be created.
+func (d *StuckPodDetector) submitRetryPod(retryPod *v1.Pod, owner string) (*v1.Pod, error) {
+	if err := renameVolumeOfRetriedPod(retryPod); err != nil {
+		return nil, err
+	}
+	recreatedPod, err := d.clusterContext.SubmitPod(retryPod, owner)
+	if err != nil {
+		return nil, err
+	}
+	_, err = submitVolumeClaim(d.clusterContext, recreatedPod)
+	return recreatedPod, err
+}
+
+func createRetryPod(pod *v1.Pod, attempt int) *v1.Pod {
+	labels := mergeMaps(pod.Labels, map[string]string{})
+	annotations := map[string]string{}
+	for key, value := range pod.Annotations {
+		if !reportedStateAnnotations[key] {
+			annotations[key] = value
+		}
+	}
+	annotations[domain.JobAttempt] = strconv.Itoa(attempt)
+
+	spec := pod.Spec.DeepCopy()
+	// the pod is scheduled again, possibly on another node
+	spec.NodeName = ""
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pod.Name,
+			Namespace:   pod.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: *spec,
+	}
+}
+
 func (d *StuckPodDetector) HandleStuckPods() {
 	allRunningJobs, err := d.jobContext.GetRunningJobs()
 	if err != nil {
 		log.Errorf("Failed to load all pods for stuck pod handling %s ", err)
 		return
 	}
+	d.trackUnknownPods(allRunningJobs)
 
 	for _, job := range allRunningJobs {
 		_, exists := d.stuckJobCache[job.JobId]
-		if exists {
+		if exists || hasBeenDrained(job) {
+			continue
+		}
+
+		if hasOnlySidecarContainersRunning(job) {
+			d.stuckJobCache[job.JobId] = &stuckJobRecord{
+				job:          job,
+				pod:          job.Pods[0].DeepCopy(),
+				sidecarsOnly: true}
 			continue
 		}
 
 		for _, pod := range job.Pods {
-			if pod.DeletionTimestamp != nil && pod.DeletionTimestamp.Add(d.stuckPodExpiry).Before(time.Now()) {
+			if pod.DeletionTimestamp != nil && pod.DeletionTimestamp.Add(d.stuckPodExpiry).Before(d.clock.Now()) {
 				// pod is stuck in terminating phase, this sometimes happen on node failure
 				// its safer to produce failed event than retrying as the job might have run already
 				d.stuckJobCache[job.JobId] = &stuckJobRecord{
 					job:       job,
 					pod:       pod.DeepCopy(),
-					message:   "pod stuck in terminating phase, this might be due to platform problems",
+					message:   "pod stuck in terminating phase, this might be due to platform problems" + d.lastWarningEventMessage(pod),
 					retryable: false}
 
-			} else if (pod.Status.Phase == v1.PodUnknown || pod.Status.Phase == v1.PodPending) &&
-				reporter.HasPodBeenInStateForLongerThanGivenDuration(pod, d.stuckPodExpiry) {
+			} else if util.ShouldBeRetried(pod) {
+				if record := d.createRetryRecord(job, pod); record != nil {
+					d.stuckJobCache[job.JobId] = record
+				}
+				break
 
-				err, retryable, message := d.determineStuckPodState(pod)
+			} else if d.isReportedDeadlineExceeded(pod) {
+				d.stuckJobCache[job.JobId] = &stuckJobRecord{
+					job:             job,
+					pod:             pod.DeepCopy(),
+					message:         util.ExtractDeadlineExceededReason(pod),
+					retryable:       false,
+					failureReported: true}
+				break
+
+			} else if d.isLostOnUnreachableNode(pod) {
+				err := d.returnLeaseOfUnreachablePod(pod)
+				if err == nil {
+					d.stuckJobCache[job.JobId] = &stuckJobRecord{
+						job:           job,
+						pod:           pod.DeepCopy(),
+						retryable:     true,
+						leaseReturned: true,
+						requeueReason: api.RequeueReason_NodeUnreachable}
+					break
+				}
+
+			} else if reason, isMissing := d.missingVolumeReason(pod); isMissing {
+				err, message := d.reportMissingVolume(pod, reason)
 				if err == nil {
 					d.stuckJobCache[job.JobId] = &stuckJobRecord{
-						job:       job,
-						pod:       pod.DeepCopy(),
-						message:   message,
-						retryable: retryable}
+						job:           job,
+						pod:           pod.DeepCopy(),
+						message:       message,
+						retryable:     true,
+						requeueReason: api.RequeueReason_VolumeUnavailable}
+				}
+
+			} else if (pod.Status.Phase == v1.PodUnknown && d.unknownPodExpiry <= 0 || pod.Status.Phase == v1.PodPending) &&
+				reporter.HasPodBeenInStateForLongerThanGivenDurationAt(pod, d.pendingPodExpiry(pod), d.clock.Now()) {
+
+				retryable, message := d.diagnoseStuckPod(pod)
+				if retryable && d.isWithinMinimumLeaseHoldTime(pod) {
+					// pods with unrecoverable problems fail regardless of the hold
+					continue
+				}
+				if err := d.reportStuckPod(pod, message); err == nil {
+					d.stuckJobCache[job.JobId] = &stuckJobRecord{
+						job:           job,
+						pod:           pod.DeepCopy(),
+						message:       message,
+						retryable:     retryable,
+						requeueReason: api.RequeueReason_PodStuck}
 				}
 			}
 		}
@@ -194,3 +561,15 @@ func (d *StuckPodDetector) markStuckPodsForDeletion(records []*stuckJobRecord) {
 		d.jobContext.DeleteJobs(append(remainingRetryableJobs, remainingNonRetryableJobs...))
 	}
 }
+
+func hasOnlySidecarContainersRunning(job *job_context.RunningJob) bool {
+	sidecarsRunning := false
+	for _, pod := range job.Pods {
+		if util.HasOnlySidecarContainersRunning(pod) {
+			sidecarsRunning = true
+		} else if !util.IsInTerminalState(pod) {
+			return false
+		}
+	}
+	return sidecarsRunning
+}
@@ -1,11 +1,14 @@
 package service
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
@@ -75,6 +78,7 @@ func TestStuckPodDetector_DeletesPodAndReportsFailedIfStuckTerminating(t *testin
 	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
 
 	addPod(t, fakeClusterContext, terminatingPod)
+	fakeClusterContext.(*syncFakeClusterContext).podEvents = []*v1.Event{{Reason: "FailedKillPod", Type: v1.EventTypeWarning, Message: "error killing pod"}}
 
 	stuckPodDetector.HandleStuckPods()
 
@@ -89,6 +93,159 @@ func TestStuckPodDetector_DeletesPodAndReportsFailedIfStuckTerminating(t *testin
 	failedEvent, ok := eventsReporter.receivedEvents[0].(*api.JobFailedEvent)
 	assert.True(t, ok)
 	assert.Contains(t, failedEvent.Reason, "terminating")
+	assert.Contains(t, failedEvent.Reason, "FailedKillPod: error killing pod")
+}
+
+func TestStuckPodDetector_RecreatesPodsFailedBecauseOfInfrastructure_UntilRetriesAreExhausted(t *testing.T) {
+	evictedPod := makeTestPod(v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"})
+	evictedPod.Name = "armada-job-id-1-0"
+	evictedPod.Spec.NodeName = "node1"
+	evictedPod.Annotations[domain.JobMaxRetries] = "1"
+	evictedPod.Annotations[domain.JobAttempt] = "1"
+	evictedPod.Annotations[string(v1.PodRunning)] = "reported"
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	addPod(t, fakeClusterContext, evictedPod)
+
+	stuckPodDetector.HandleStuckPods()
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+
+	stuckPodDetector.HandleStuckPods()
+	recreatedPods := getActivePods(t, fakeClusterContext)
+	assert.Len(t, recreatedPods, 1)
+	assert.Equal(t, "armada-job-id-1-0", recreatedPods[0].Name)
+	assert.Equal(t, "2", recreatedPods[0].Annotations[domain.JobAttempt])
+	assert.NotContains(t, recreatedPods[0].Annotations, string(v1.PodRunning))
+	assert.Empty(t, recreatedPods[0].Spec.NodeName)
+	assert.Empty(t, recreatedPods[0].Status.Phase)
+	assert.Zero(t, mockLeaseService.returnLeaseCalls)
+	assert.Empty(t, mockLeaseService.reportDoneArg)
+	assert.Empty(t, eventsReporter.receivedEvents)
+
+	fakeClusterContext.(*syncFakeClusterContext).pods["job-id-1"].Status = v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"}
+	stuckPodDetector.HandleStuckPods()
+	// retries are exhausted, failure is reported by the event reporter
+	assert.Len(t, getActivePods(t, fakeClusterContext), 1)
+}
+
+func TestStuckPodDetector_RecreatesVolumeClaimOfRecreatedPods(t *testing.T) {
+	evictedPod := makeVolumeClaimTestPod()
+	evictedPod.Status = v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"}
+	evictedPod.CreationTimestamp = metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+	evictedPod.Annotations[domain.JobMaxRetries] = "1"
+	evictedPod.Annotations[domain.JobAttempt] = "1"
+	assert.NoError(t, addVolume(evictedPod, testVolumeClaimTemplate))
+
+	fakeClusterContext, _, _, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	addPod(t, fakeClusterContext, evictedPod)
+
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+
+	recreatedPods := getActivePods(t, fakeClusterContext)
+	assert.Len(t, recreatedPods, 1)
+	assert.Equal(t, "armada-job1-0-scratch-2", recreatedPods[0].Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Contains(t, fakeClusterContext.(*syncFakeClusterContext).volumeClaims, "armada-job1-0-scratch-2")
+}
+
+func TestStuckPodDetector_RecreatesOomKilledPodsWithMoreMemory_UpToMaxMemory(t *testing.T) {
+	oomKilledPod := makeOomKilledPod("1Gi")
+	oomKilledPod.Annotations[domain.OomRetryMemoryFactor] = "2"
+	oomKilledPod.Annotations[domain.OomRetryMaxMemory] = "3Gi"
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	addPod(t, fakeClusterContext, oomKilledPod)
+
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+	recreatedPods := getActivePods(t, fakeClusterContext)
+	assert.Len(t, recreatedPods, 1)
+	assert.Equal(t, "2", recreatedPods[0].Annotations[domain.JobAttempt])
+	assert.Equal(t, "main: 1Gi to 2Gi", recreatedPods[0].Annotations[domain.JobMemoryIncrease])
+	assert.Equal(t, "2Gi", recreatedPods[0].Spec.Containers[0].Resources.Limits.Memory().String())
+	assert.Equal(t, "2Gi", recreatedPods[0].Spec.Containers[0].Resources.Requests.Memory().String())
+	assert.Equal(t, "1", recreatedPods[0].Spec.Containers[0].Resources.Limits.Cpu().String())
+	assert.Zero(t, mockLeaseService.returnLeaseCalls)
+	assert.Empty(t, mockLeaseService.reportDoneArg)
+
+	assert.Len(t, eventsReporter.receivedEvents, 1)
+	memoryIncreasedEvent, ok := eventsReporter.receivedEvents[0].(*api.JobMemoryIncreasedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), memoryIncreasedEvent.Attempt)
+	memoryLimit := memoryIncreasedEvent.MemoryLimits["main"]
+	assert.Equal(t, "2Gi", memoryLimit.String())
+	assert.Contains(t, memoryIncreasedEvent.Reason, "main: 1Gi to 2Gi")
+
+	pods := fakeClusterContext.(*syncFakeClusterContext).pods
+	pods["job-id-1"].Status = oomKilledPod.Status
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+	recreatedPods = getActivePods(t, fakeClusterContext)
+	assert.Len(t, recreatedPods, 1)
+	assert.Equal(t, "3", recreatedPods[0].Annotations[domain.JobAttempt])
+	assert.Equal(t, "3Gi", recreatedPods[0].Spec.Containers[0].Resources.Limits.Memory().String())
+
+	pods["job-id-1"].Status = oomKilledPod.Status
+	stuckPodDetector.HandleStuckPods()
+	// memory can not be increased further, failure is reported by the event reporter
+	assert.Len(t, getActivePods(t, fakeClusterContext), 1)
+	assert.Len(t, eventsReporter.receivedEvents, 2)
+}
+
+func TestStuckPodDetector_FailsOomKilledPodWhenMoreMemoryDoesNotFitOnCluster(t *testing.T) {
+	oomKilledPod := makeOomKilledPod("3Gi")
+	oomKilledPod.Annotations[domain.OomRetryMemoryFactor] = "2"
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	addPod(t, fakeClusterContext, oomKilledPod)
+
+	stuckPodDetector.HandleStuckPods()
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{"job-id-1"})
+
+	stuckPodDetector.HandleStuckPods()
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+	assert.Len(t, eventsReporter.receivedEvents, 1)
+	failedEvent, ok := eventsReporter.receivedEvents[0].(*api.JobFailedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, failedEvent.Reason, "Not retried with more memory (main: 3Gi to 6Gi)")
+}
+
+func TestStuckPodDetector_DeletesPodAndReportsSucceededWhenOnlySidecarsAreRunning(t *testing.T) {
+	pod := makeSidecarOnlyRunningPod(0)
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+
+	addPod(t, fakeClusterContext, pod)
+
+	stuckPodDetector.HandleStuckPods()
+
+	remainingActivePods := getActivePods(t, fakeClusterContext)
+	assert.Equal(t, []*v1.Pod{}, remainingActivePods)
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{pod.Labels[domain.JobId]})
+	assert.Empty(t, eventsReporter.receivedEvents)
+
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Len(t, eventsReporter.receivedEvents, 1)
+	_, ok := eventsReporter.receivedEvents[0].(*api.JobSucceededEvent)
+	assert.True(t, ok)
+}
+
+func TestStuckPodDetector_ReportsFailedWhenPrimaryContainerFailedAndOnlySidecarsAreRunning(t *testing.T) {
+	pod := makeSidecarOnlyRunningPod(2)
+
+	fakeClusterContext, _, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+
+	addPod(t, fakeClusterContext, pod)
+
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Len(t, eventsReporter.receivedEvents, 1)
+	failedEvent, ok := eventsReporter.receivedEvents[0].(*api.JobFailedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int32{"main": 2}, failedEvent.ExitCodes)
 }
 
 func TestStuckPodDetector_ReturnsLeaseAndDeletesRetryableStuckPod(t *testing.T) {
@@ -120,6 +277,174 @@ func TestStuckPodDetector_ReturnsLeaseAndDeletesRetryableStuckPod(t *testing.T)
 	// Return lease for retry
 	assert.Equal(t, 1, mockLeaseService.returnLeaseCalls)
 	assert.Equal(t, retryableStuckPod, mockLeaseService.returnLeaseArg)
+	assert.Equal(t, api.RequeueReason_PodStuck, mockLeaseService.returnLeaseReason)
+}
+
+func TestStuckPodDetector_KeepsStuckPodsWithinMinimumLeaseHoldTime(t *testing.T) {
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	stuckPodDetector.minimumLeaseHoldTime = time.Hour
+
+	missingVolumePod := makeMissingVolumePod()
+	missingVolumePod.Name = "missing-volume"
+	missingVolumePod.Labels[domain.JobId] = "job-id-2"
+	addPod(t, fakeClusterContext, makeRetryableStuckPod())
+	addPod(t, fakeClusterContext, missingVolumePod)
+
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Equal(t, 0, mockLeaseService.returnLeaseCalls)
+	assert.Empty(t, eventsReporter.receivedEvents)
+	assert.Equal(t, 2, len(getActivePods(t, fakeClusterContext)))
+
+	// pods are 10 minutes old
+	stuckPodDetector.minimumLeaseHoldTime = 5 * time.Minute
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+}
+
+func TestStuckPodDetector_FailsUnretryableStuckPodsWithinMinimumLeaseHoldTime(t *testing.T) {
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	stuckPodDetector.minimumLeaseHoldTime = time.Hour
+	unretryableStuckPod := makeUnretryableStuckPod()
+	addPod(t, fakeClusterContext, unretryableStuckPod)
+
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+	assert.Zero(t, mockLeaseService.returnLeaseCalls)
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{unretryableStuckPod.Labels[domain.JobId]})
+	_, ok := eventsReporter.receivedEvents[0].(*api.JobUnableToScheduleEvent)
+	assert.True(t, ok)
+}
+
+func TestStuckPodDetector_UsesImagePullTimeoutForPodsPullingImages(t *testing.T) {
+	fakeClusterContext, mockLeaseService, _, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	fakeClusterContext.(*syncFakeClusterContext).podEvents = []*v1.Event{{Reason: "Pulling", Type: v1.EventTypeNormal}}
+	addPod(t, fakeClusterContext, makePullingImagePod())
+
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Equal(t, 0, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, 1, len(getActivePods(t, fakeClusterContext)))
+
+	// pod is 10 minutes old
+	stuckPodDetector.imagePullTimeout = 5 * time.Minute
+	stuckPodDetector.HandleStuckPods()
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Equal(t, 1, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, api.RequeueReason_PodStuck, mockLeaseService.returnLeaseReason)
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+}
+
+func TestStuckPodDetector_UsesStuckPodExpiryForPodsWaitingForVolumes(t *testing.T) {
+	fakeClusterContext, mockLeaseService, _, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+	fakeClusterContext.(*syncFakeClusterContext).podEvents = []*v1.Event{{Reason: "FailedMount", Type: v1.EventTypeWarning}}
+	pod := makePullingImagePod()
+	addPod(t, fakeClusterContext, pod)
+
+	stuckPodDetector.HandleStuckPods()
+
+	// failed mounts are warning events, which make the stuck pod fail
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{pod.Labels[domain.JobId]})
+	assert.Empty(t, getActivePods(t, fakeClusterContext))
+}
+
+func TestStuckPodDetector_ReturnsLeaseWithVolumeNotAvailableReasonForMissingVolume(t *testing.T) {
+	missingVolumePod := makeMissingVolumePod()
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+
+	addPod(t, fakeClusterContext, missingVolumePod)
+
+	stuckPodDetector.HandleStuckPods()
+
+	unableToScheduleEvent, ok := eventsReporter.receivedEvents[0].(*api.JobUnableToScheduleEvent)
+	assert.True(t, ok)
+	assert.Contains(t, unableToScheduleEvent.Reason, "Volume not available")
+
+	// Not done as can be retried
+	assert.Equal(t, []string{}, mockLeaseService.reportDoneArg)
+	remainingActivePods := getActivePods(t, fakeClusterContext)
+	assert.Equal(t, []*v1.Pod{}, remainingActivePods)
+
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Equal(t, 1, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, api.RequeueReason_VolumeUnavailable, mockLeaseService.returnLeaseReason)
+	leaseReturnedEvent, ok := eventsReporter.receivedEvents[1].(*api.JobLeaseReturnedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, leaseReturnedEvent.Reason, "Volume not available")
+	assert.Equal(t, api.RequeueReason_VolumeUnavailable, leaseReturnedEvent.RequeueReason)
+}
+
+func TestStuckPodDetector_ReturnsLeaseWhenPodOnUnreachableNodeStaysUnknown(t *testing.T) {
+	pod := makeRunningPod()
+	pod.Spec.NodeName = "node-1"
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+
+	addPod(t, fakeClusterContext, pod)
+
+	// Brief blip, node recovers before the grace period passes
+	pod.Status.Phase = v1.PodUnknown
+	stuckPodDetector.HandleStuckPods()
+	assert.Contains(t, stuckPodDetector.unknownPodSince, pod.UID)
+
+	pod.Status.Phase = v1.PodRunning
+	stuckPodDetector.HandleStuckPods()
+	assert.NotContains(t, stuckPodDetector.unknownPodSince, pod.UID)
+	assert.Equal(t, 0, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, []*v1.Pod{pod}, getActivePods(t, fakeClusterContext))
+
+	// Node lost, pod stays Unknown past the grace period
+	pod.Status.Phase = v1.PodUnknown
+	stuckPodDetector.HandleStuckPods()
+	assert.Equal(t, 0, mockLeaseService.returnLeaseCalls)
+
+	stuckPodDetector.unknownPodSince[pod.UID] = time.Now().Add(-2 * time.Minute)
+	stuckPodDetector.HandleStuckPods()
+
+	assert.Equal(t, 1, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, pod, mockLeaseService.returnLeaseArg)
+	leaseReturnedEvent, ok := eventsReporter.receivedEvents[0].(*api.JobLeaseReturnedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, leaseReturnedEvent.Reason, "Node node-1 unreachable")
+	assert.Equal(t, api.RequeueReason_NodeUnreachable, leaseReturnedEvent.RequeueReason)
+	assert.Equal(t, []*v1.Pod{}, getActivePods(t, fakeClusterContext))
+
+	// Lease is not returned again once the pod is gone
+	stuckPodDetector.HandleStuckPods()
+	assert.Equal(t, 1, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, 1, len(eventsReporter.receivedEvents))
+}
+
+func TestStuckPodDetector_ReportsDoneAndDeletesPodWhenDeadlineExceededIsReported(t *testing.T) {
+	pod := makeDeadlineExceededPod()
+
+	fakeClusterContext, mockLeaseService, eventsReporter, stuckPodDetector := makeStuckPodDetectorWithTestDoubles()
+
+	addPod(t, fakeClusterContext, pod)
+
+	// failed event not sent by the event reporter yet
+	stuckPodDetector.HandleStuckPods()
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{})
+	assert.Equal(t, []*v1.Pod{pod}, getActivePods(t, fakeClusterContext))
+
+	pod.Annotations[string(v1.PodFailed)] = time.Now().String()
+	mockLeaseService.reportDoneCalls = 0
+	stuckPodDetector.HandleStuckPods()
+
+	mockLeaseService.assertReportDoneCalledOnceWith(t, []string{pod.Labels[domain.JobId]})
+	assert.Zero(t, mockLeaseService.returnLeaseCalls)
+	assert.Equal(t, []*v1.Pod{}, getActivePods(t, fakeClusterContext))
+
+	// failure was reported by the event reporter, no further events are needed
+	stuckPodDetector.HandleStuckPods()
+	assert.Equal(t, 0, len(eventsReporter.receivedEvents))
 }
 
 func getActivePods(t *testing.T, clusterContext context.ClusterContext) []*v1.Pod {
@@ -174,6 +499,76 @@ func makeRetryableStuckPod() *v1.Pod {
 	})
 }
 
+func makePullingImagePod() *v1.Pod {
+	return makeTestPod(v1.PodStatus{
+		Phase:      "Pending",
+		Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+		ContainerStatuses: []v1.ContainerStatus{
+			{
+				State: v1.ContainerState{
+					Waiting: &v1.ContainerStateWaiting{
+						Reason: "ContainerCreating",
+					},
+				},
+			},
+		},
+	})
+}
+
+func makeSidecarOnlyRunningPod(exitCode int32) *v1.Pod {
+	pod := makeTestPod(v1.PodStatus{
+		Phase: v1.PodRunning,
+		ContainerStatuses: []v1.ContainerStatus{
+			{Name: "main", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: exitCode}}},
+			{Name: "logging", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+		},
+	})
+	pod.Annotations[domain.SidecarContainers] = "logging"
+	pod.Spec.Containers = []v1.Container{{Name: "main"}, {Name: "logging"}}
+	return pod
+}
+
+func makeDeadlineExceededPod() *v1.Pod {
+	pod := makeTestPod(v1.PodStatus{
+		Phase:   v1.PodFailed,
+		Reason:  "DeadlineExceeded",
+		Message: "Pod was active on the node longer than the specified deadline",
+	})
+	deadline := int64(60)
+	pod.Spec.ActiveDeadlineSeconds = &deadline
+	return pod
+}
+
+func makeMissingVolumePod() *v1.Pod {
+	return makeTestPod(v1.PodStatus{
+		Phase: "Pending",
+		Conditions: []v1.PodCondition{
+			{
+				Type:    v1.PodScheduled,
+				Status:  v1.ConditionFalse,
+				Reason:  v1.PodReasonUnschedulable,
+				Message: `persistentvolumeclaim "data" not found`,
+			},
+		},
+	})
+}
+
+func makeOomKilledPod(memory string) *v1.Pod {
+	pod := makeTestPod(v1.PodStatus{
+		Phase: v1.PodFailed,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:  "main",
+			State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+		}},
+	})
+	pod.Name = "armada-job-id-1-0"
+	pod.Annotations[domain.JobMaxRetries] = "3"
+	pod.Annotations[domain.JobAttempt] = "1"
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse(memory)}
+	pod.Spec.Containers = []v1.Container{{Name: "main", Resources: v1.ResourceRequirements{Limits: resources, Requests: resources.DeepCopy()}}}
+	return pod
+}
+
 func makeTestPod(status v1.PodStatus) *v1.Pod {
 	return &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -184,7 +579,7 @@ func makeTestPod(status v1.PodStatus) *v1.Pod {
 			Annotations: map[string]string{
 				domain.JobSetId: "job-set-id-1",
 			},
-			CreationTimestamp: metav1.Time{time.Now().Add(-10 * time.Minute)},
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
 			UID:               types.UID(util.NewULID()),
 		},
 		Status: status,
@@ -210,31 +605,62 @@ func makeStuckPodDetectorWithTestDoubles() (context.ClusterContext, *mockLeaseSe
 		jobContext,
 		eventReporter,
 		mockLeaseService,
-		time.Second)
+		&stubUtilisationService{nodeTypes: []*api.NodeType{testNodeType}},
+		time.Second,
+		time.Hour,
+		time.Second,
+		time.Minute,
+		true,
+		nil,
+		0)
 
 	return fakeClusterContext, mockLeaseService, eventReporter, stuckPodDetector
 }
 
+var testNodeType = &api.NodeType{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")}}
+
+type stubUtilisationService struct {
+	nodeTypes []*api.NodeType
+}
+
+func (s *stubUtilisationService) GetAvailableClusterCapacity() (*ClusterAvailableCapacityReport, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *stubUtilisationService) GetTotalAllocatableClusterCapacity() (*common.ComputeResources, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *stubUtilisationService) GetAllAvailableProcessingNodes() ([]*v1.Node, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *stubUtilisationService) GetNodeTypes() ([]*api.NodeType, error) {
+	return s.nodeTypes, nil
+}
+
 type mockLeaseService struct {
 	returnLeaseCalls      int
 	requestJobLeasesCalls int
 	reportDoneCalls       int
 
-	returnLeaseArg *v1.Pod
-	reportDoneArg  []string
+	returnLeaseArg    *v1.Pod
+	returnLeaseReason api.RequeueReason
+	reportDoneArg     []string
 }
 
 func NewMockLeaseService() *mockLeaseService {
-	return &mockLeaseService{0, 0, 0, nil, nil}
+	return &mockLeaseService{0, 0, 0, nil, api.RequeueReason_UnspecifiedRequeueReason, nil}
 }
 
-func (ls *mockLeaseService) ReturnLease(pod *v1.Pod) error {
+func (ls *mockLeaseService) ReturnLease(pod *v1.Pod, reason api.RequeueReason) error {
 	ls.returnLeaseArg = pod
+	ls.returnLeaseReason = reason
 	ls.returnLeaseCalls++
 	return nil
 }
 
-func (ls *mockLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
+func (ls *mockLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources, maxJobsToLease uint32) ([]*api.Job, error) {
 	ls.requestJobLeasesCalls++
 	return make([]*api.Job, 0), nil
 }
@@ -251,11 +677,20 @@ func (ls *mockLeaseService) assertReportDoneCalledOnceWith(t *testing.T, expecte
 }
 
 type syncFakeClusterContext struct {
-	pods map[string]*v1.Pod
+	mutex        sync.Mutex
+	pods         map[string]*v1.Pod
+	services     map[string]*v1.Service
+	serviceError error
+	volumeClaims map[string]*v1.PersistentVolumeClaim
+	claimError   error
+	podErrors    map[string]error
+	podEvents    []*v1.Event
+
+	deletionGracePeriods []int64
 }
 
 func newSyncFakeClusterContext() *syncFakeClusterContext {
-	c := &syncFakeClusterContext{pods: map[string]*v1.Pod{}}
+	c := &syncFakeClusterContext{pods: map[string]*v1.Pod{}, services: map[string]*v1.Service{}, volumeClaims: map[string]*v1.PersistentVolumeClaim{}}
 	return c
 }
 
@@ -283,29 +718,88 @@ func (c *syncFakeClusterContext) GetNodes() ([]*v1.Node, error) {
 	return make([]*v1.Node, 0), nil
 }
 
+func (c *syncFakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
+	return nil, fmt.Errorf("node %s not found", nodeName)
+}
+
 func (c *syncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
+	if c.podEvents != nil {
+		return c.podEvents, nil
+	}
 	return []*v1.Event{}, nil
 }
 
+func (c *syncFakeClusterContext) GetNodeEvents(nodeName string) ([]*v1.Event, error) {
+	return []*v1.Event{}, nil
+}
+
+func (c *syncFakeClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	return "", nil
+}
+
 func (c *syncFakeClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.podErrors[pod.Labels[domain.JobId]]; ok {
+		return nil, e
+	}
 	c.pods[pod.Labels[domain.JobId]] = pod
 	return pod, nil
 }
 
+func (c *syncFakeClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.serviceError != nil {
+		return nil, c.serviceError
+	}
+	c.services[service.Name] = service
+	submitted := service.DeepCopy()
+	submitted.Spec.ClusterIP = "10.0.0.1"
+	return submitted, nil
+}
+
+func (c *syncFakeClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.claimError != nil {
+		return nil, c.claimError
+	}
+	c.volumeClaims[claim.Name] = claim
+	return claim, nil
+}
+
 func (c *syncFakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	return nil
 }
 
+func (c *syncFakeClusterContext) AddAnnotationToPods(pods []*v1.Pod, annotations map[string]string) error {
+	return nil
+}
+
 func (c *syncFakeClusterContext) DeletePods(pods []*v1.Pod) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	for _, p := range pods {
 		delete(c.pods, p.Labels[domain.JobId])
 	}
 }
 
+func (c *syncFakeClusterContext) DeletePodsWithGracePeriod(pods []*v1.Pod, gracePeriodSeconds int64) {
+	c.mutex.Lock()
+	c.deletionGracePeriods = append(c.deletionGracePeriods, gracePeriodSeconds)
+	c.mutex.Unlock()
+	c.DeletePods(pods)
+}
+
 func (c *syncFakeClusterContext) GetClusterId() string {
 	return "cluster-id-1"
 }
 
+func (c *syncFakeClusterContext) GetServerVersion() (string, error) {
+	return "v1.20.0", nil
+}
+
 func (c *syncFakeClusterContext) GetClusterPool() string {
 	return "pool"
 }
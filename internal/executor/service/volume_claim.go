@@ -0,0 +1,162 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/internal/executor/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Adds the volume of the claim provisioned from the volume claim template of the job, mounted into all containers of the pod.
+// The template is kept in an annotation, so pods recreated for a retry get a claim from it as well.
+// Pods with their own volume of the template name are left unchanged, containers with their own mount at the mount path
+// don't get the volume mounted. The volumes and containers are copied before they are changed, as the pod spec is shared with the job.
+func addVolume(pod *v1.Pod, template *api.VolumeClaimTemplate) error {
+	if template == nil {
+		return nil
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == template.Name {
+			return nil
+		}
+	}
+	encodedTemplate, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	claimName := volumeClaimName(pod, template)
+	volumes := make([]v1.Volume, 0, len(pod.Spec.Volumes)+1)
+	volumes = append(volumes, pod.Spec.Volumes...)
+	pod.Spec.Volumes = append(volumes, volumeOfClaim(template.Name, claimName))
+	pod.Spec.InitContainers = mountVolume(pod.Spec.InitContainers, template)
+	pod.Spec.Containers = mountVolume(pod.Spec.Containers, template)
+	pod.Annotations[domain.JobVolumeClaimName] = claimName
+	pod.Annotations[domain.JobVolumeClaimTemplate] = string(encodedTemplate)
+	return nil
+}
+
+// Claims of retried pods get the attempt appended to their name, the claim of the failed pod may still be terminating.
+// The mounts of the volume are already there.
+func renameVolumeOfRetriedPod(pod *v1.Pod) error {
+	template, err := volumeClaimTemplateOf(pod)
+	if err != nil || template == nil {
+		return err
+	}
+	claimName := volumeClaimName(pod, template)
+	volumes := make([]v1.Volume, 0, len(pod.Spec.Volumes))
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == template.Name {
+			volume = volumeOfClaim(template.Name, claimName)
+		}
+		volumes = append(volumes, volume)
+	}
+	pod.Spec.Volumes = volumes
+	pod.Annotations[domain.JobVolumeClaimName] = claimName
+	return nil
+}
+
+// Creates the claim of a submitted pod with volume added by addVolume, the claim is owned by the pod.
+// Returns nil without error for pods without such volume.
+func submitVolumeClaim(clusterContext context.ClusterContext, pod *v1.Pod) (*v1.PersistentVolumeClaim, error) {
+	template, err := volumeClaimTemplateOf(pod)
+	if err != nil || template == nil {
+		return nil, err
+	}
+	return clusterContext.SubmitPersistentVolumeClaim(createVolumeClaim(pod, template))
+}
+
+func volumeClaimTemplateOf(pod *v1.Pod) (*api.VolumeClaimTemplate, error) {
+	encodedTemplate, ok := pod.Annotations[domain.JobVolumeClaimTemplate]
+	if !ok {
+		return nil, nil
+	}
+	template := &api.VolumeClaimTemplate{}
+	if err := json.Unmarshal([]byte(encodedTemplate), template); err != nil {
+		return nil, fmt.Errorf("invalid volume claim template annotation: %s", err)
+	}
+	return template, nil
+}
+
+func volumeClaimName(pod *v1.Pod, template *api.VolumeClaimTemplate) string {
+	name := pod.Name + "-" + sanitizePodName(template.Name)
+	if attempt, _ := util.ExtractAttempt(pod); attempt > 1 {
+		name += "-" + strconv.Itoa(attempt)
+	}
+	return name
+}
+
+func volumeOfClaim(volumeName string, claimName string) v1.Volume {
+	return v1.Volume{
+		Name:         volumeName,
+		VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claimName}},
+	}
+}
+
+func mountVolume(containers []v1.Container, template *api.VolumeClaimTemplate) []v1.Container {
+	if len(containers) == 0 {
+		return containers
+	}
+	mounted := make([]v1.Container, 0, len(containers))
+	for _, container := range containers {
+		if !hasMountPath(container, template.MountPath) {
+			mounts := make([]v1.VolumeMount, 0, len(container.VolumeMounts)+1)
+			mounts = append(mounts, container.VolumeMounts...)
+			container.VolumeMounts = append(mounts, v1.VolumeMount{Name: template.Name, MountPath: template.MountPath})
+		}
+		mounted = append(mounted, container)
+	}
+	return mounted
+}
+
+func hasMountPath(container v1.Container, mountPath string) bool {
+	for _, mount := range container.VolumeMounts {
+		if mount.MountPath == mountPath {
+			return true
+		}
+	}
+	return false
+}
+
+// Claim is in the namespace of the pod returned by submission, as admission plugins can change it
+func createVolumeClaim(pod *v1.Pod, template *api.VolumeClaimTemplate) *v1.PersistentVolumeClaim {
+	accessModes := []v1.PersistentVolumeAccessMode{}
+	for _, accessMode := range template.AccessModes {
+		accessModes = append(accessModes, v1.PersistentVolumeAccessMode(accessMode))
+	}
+	if len(accessModes) == 0 {
+		accessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+	}
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: pod.Annotations[domain.JobVolumeClaimName],
+			Labels: map[string]string{
+				domain.JobId: pod.Labels[domain.JobId],
+				domain.Queue: pod.Labels[domain.Queue],
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: pod.Annotations[domain.JobSetId],
+			},
+			Namespace: pod.Namespace,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: accessModes,
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: template.Storage},
+			},
+		},
+	}
+	if template.StorageClassName != "" {
+		claim.Spec.StorageClassName = &template.StorageClassName
+	}
+	if pod.UID != "" {
+		claim.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: pod.Name, UID: pod.UID}}
+	}
+	return claim
+}
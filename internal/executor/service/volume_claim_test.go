@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var testVolumeClaimTemplate = &api.VolumeClaimTemplate{
+	Name:             "scratch",
+	MountPath:        "/scratch",
+	StorageClassName: "fast",
+	Storage:          resource.MustParse("10Gi"),
+}
+
+func TestAddVolume_MountsVolumeIntoAllContainers(t *testing.T) {
+	pod := makeVolumeClaimTestPod()
+	pod.Spec.InitContainers = []v1.Container{{Name: "init"}}
+	pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{
+		Name:         "own-mount",
+		VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/scratch"}},
+	})
+
+	err := addVolume(pod, testVolumeClaimTemplate)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "armada-job1-0-scratch", pod.Annotations[domain.JobVolumeClaimName])
+	assert.Equal(t, []v1.VolumeMount{{Name: "scratch", MountPath: "/scratch"}}, pod.Spec.InitContainers[0].VolumeMounts)
+	assert.Equal(t, []v1.VolumeMount{{Name: "scratch", MountPath: "/scratch"}}, pod.Spec.Containers[0].VolumeMounts)
+	assert.Equal(t, []v1.VolumeMount{{Name: "data", MountPath: "/scratch"}}, pod.Spec.Containers[1].VolumeMounts)
+}
+
+func TestAddVolume_LeavesPodsWithOwnVolumeOfTemplateName(t *testing.T) {
+	pod := makeVolumeClaimTestPod()
+	pod.Spec.Volumes = []v1.Volume{{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+
+	err := addVolume(pod, testVolumeClaimTemplate)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, pod.Annotations, domain.JobVolumeClaimName)
+	assert.Len(t, pod.Spec.Volumes, 1)
+	assert.NotNil(t, pod.Spec.Volumes[0].EmptyDir)
+	assert.Empty(t, pod.Spec.Containers[0].VolumeMounts)
+}
+
+func TestRenameVolumeOfRetriedPod_RenamesClaimOfRetriedPod(t *testing.T) {
+	pod := makeVolumeClaimTestPod()
+	assert.NoError(t, addVolume(pod, testVolumeClaimTemplate))
+
+	retryPod := createRetryPod(pod, 2)
+	err := renameVolumeOfRetriedPod(retryPod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "armada-job1-0-scratch-2", retryPod.Annotations[domain.JobVolumeClaimName])
+	assert.Len(t, retryPod.Spec.Volumes, 1)
+	assert.Equal(t, "armada-job1-0-scratch-2", retryPod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Len(t, retryPod.Spec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, "armada-job1-0-scratch", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+}
+
+func TestSubmitVolumeClaim_CreatesClaimFromTemplateOfPod(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	pod := makeVolumeClaimTestPod()
+	assert.NoError(t, addVolume(pod, testVolumeClaimTemplate))
+
+	claim, err := submitVolumeClaim(clusterContext, pod)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "armada-job1-0-scratch", claim.Name)
+	assert.Equal(t, "fast", *claim.Spec.StorageClassName)
+	assert.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, claim.Spec.AccessModes)
+	assert.Equal(t, resource.MustParse("10Gi"), claim.Spec.Resources.Requests[v1.ResourceStorage])
+}
+
+func TestSubmitVolumeClaim_LeavesPodsWithoutTemplate(t *testing.T) {
+	clusterContext := newSyncFakeClusterContext()
+	pod := makeVolumeClaimTestPod()
+
+	assert.NoError(t, addVolume(pod, nil))
+	claim, err := submitVolumeClaim(clusterContext, pod)
+
+	assert.NoError(t, err)
+	assert.Nil(t, claim)
+	assert.Empty(t, pod.Spec.Volumes)
+	assert.Empty(t, clusterContext.volumeClaims)
+}
+
+func makeVolumeClaimTestPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "armada-job1-0",
+			Labels:      map[string]string{domain.JobId: "job1", domain.Queue: "queue1"},
+			Annotations: map[string]string{domain.JobSetId: "set1"},
+		},
+		Spec: *makePodSpec(),
+	}
+}
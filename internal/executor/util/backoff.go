@@ -0,0 +1,122 @@
+package util
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ApiBackoff spaces out calls to the Armada server while it is unavailable. The delay after a failed call doubles
+// up to maxInterval and is randomised, so executors do not all retry at the same moment once the server is back.
+// Calls during the delay fail with *ApiUnavailableError, an Unavailable gRPC status, without reaching the server.
+// Nil backoff never delays calls.
+type ApiBackoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	now             func() time.Time
+
+	mutex        sync.Mutex
+	failures     int
+	failingSince time.Time
+	retryAt      time.Time
+	lastErr      error
+}
+
+type ApiUnavailableError struct {
+	Operation    string
+	Failures     int
+	FailingSince time.Time
+	RetryAt      time.Time
+	LastErr      error
+}
+
+func (e *ApiUnavailableError) Error() string {
+	return fmt.Sprintf("skipped %s, Armada server is unavailable since %s (%d failed calls), next attempt at %s, last error: %s",
+		e.Operation, e.FailingSince.Format(time.RFC3339), e.Failures, e.RetryAt.Format(time.RFC3339), e.LastErr)
+}
+
+func (e *ApiUnavailableError) Unwrap() error {
+	return e.LastErr
+}
+
+func (e *ApiUnavailableError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+func NewApiBackoff(initialInterval time.Duration, maxInterval time.Duration) *ApiBackoff {
+	if initialInterval > maxInterval {
+		initialInterval = maxInterval
+	}
+	return &ApiBackoff{initialInterval: initialInterval, maxInterval: maxInterval, now: time.Now}
+}
+
+// Call runs the call unless the server is backed off, any response of the server resets the backoff
+func (b *ApiBackoff) Call(operation string, call func() error) error {
+	if b == nil {
+		return call()
+	}
+	if err := b.checkAvailable(operation); err != nil {
+		return err
+	}
+	err := call()
+	b.record(operation, err)
+	return err
+}
+
+func (b *ApiBackoff) checkAvailable(operation string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.failures == 0 || !b.now().Before(b.retryAt) {
+		return nil
+	}
+	return &ApiUnavailableError{Operation: operation, Failures: b.failures, FailingSince: b.failingSince, RetryAt: b.retryAt, LastErr: b.lastErr}
+}
+
+func (b *ApiBackoff) record(operation string, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err == nil || !IsTransientError(err) {
+		if b.failures > 0 {
+			log.Infof("Armada server is available again after %d failed calls since %s", b.failures, b.failingSince.Format(time.RFC3339))
+		}
+		b.failures = 0
+		b.lastErr = nil
+		return
+	}
+
+	now := b.now()
+	if b.failures == 0 {
+		b.failingSince = now
+	}
+	b.failures++
+	b.lastErr = err
+	delay := b.delay()
+	b.retryAt = now.Add(delay)
+	log.Warnf("%s failed, %d failed calls to Armada server since %s, backing off for %s: %s",
+		operation, b.failures, b.failingSince.Format(time.RFC3339), delay.Round(time.Millisecond), err)
+}
+
+// Equal jitter: at least half of the exponential delay, so the delay still grows with every failure
+func (b *ApiBackoff) delay() time.Duration {
+	delay := b.maxInterval
+	if b.failures < 32 && b.initialInterval<<uint(b.failures-1) < b.maxInterval {
+		delay = b.initialInterval << uint(b.failures-1)
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// Errors of calls which did not reach the server or timed out, other errors come from a responding server
+func IsTransientError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApiBackoff_SkipsCallsUntilRetryTime(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	backoff := NewApiBackoff(time.Second, time.Minute)
+	backoff.now = func() time.Time { return now }
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	calls := 0
+	failingCall := func() error {
+		calls++
+		return unavailable
+	}
+
+	assert.Equal(t, unavailable, backoff.Call("lease", failingCall))
+	err := backoff.Call("lease", failingCall)
+	assert.Equal(t, 1, calls)
+	var unavailableError *ApiUnavailableError
+	assert.True(t, errors.As(err, &unavailableError))
+	assert.Equal(t, 1, unavailableError.Failures)
+	assert.True(t, errors.Is(err, unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, err.Error(), "connection refused")
+
+	now = now.Add(time.Second)
+	assert.Equal(t, unavailable, backoff.Call("lease", failingCall))
+	assert.Equal(t, 2, calls)
+}
+
+func TestApiBackoff_ResetsOnResponse(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	backoff := NewApiBackoff(time.Second, time.Minute)
+	backoff.now = func() time.Time { return now }
+
+	_ = backoff.Call("lease", func() error { return status.Error(codes.Unavailable, "") })
+	now = now.Add(time.Second)
+	_ = backoff.Call("lease", func() error { return status.Error(codes.PermissionDenied, "") })
+
+	calls := 0
+	assert.NoError(t, backoff.Call("lease", func() error {
+		calls++
+		return nil
+	}))
+	assert.Equal(t, 1, calls)
+}
+
+func TestApiBackoff_DelayGrowsUpToMaxInterval(t *testing.T) {
+	backoff := NewApiBackoff(time.Second, 10*time.Second)
+
+	for failures, maxDelay := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second, 5: 10 * time.Second, 100: 10 * time.Second} {
+		backoff.failures = failures
+		delay := backoff.delay()
+		assert.True(t, delay >= maxDelay/2 && delay <= maxDelay, "failures %d: delay %s", failures, delay)
+	}
+}
+
+func TestApiBackoff_NilBackoffAlwaysCalls(t *testing.T) {
+	var backoff *ApiBackoff
+	unavailable := status.Error(codes.Unavailable, "")
+
+	assert.Equal(t, unavailable, backoff.Call("lease", func() error { return unavailable }))
+	assert.Equal(t, unavailable, backoff.Call("lease", func() error { return unavailable }))
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/executor/metrics"
+)
+
+var clockSkewGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: metrics.ArmadaExecutorMetricsPrefix + "clock_skew_seconds",
+		Help: "Measured difference between server and executor clock, positive when executor clock is behind",
+	},
+)
+
+// ServerClock tracks skew of the local clock against the server clock, measured from server time in lease responses.
+// Nil clock is local clock without skew detection.
+type ServerClock struct {
+	warningThreshold time.Duration
+	useServerTime    bool
+
+	mutex     sync.RWMutex
+	skew      time.Duration
+	overLimit bool
+}
+
+func NewServerClock(warningThreshold time.Duration, useServerTime bool) *ServerClock {
+	return &ServerClock{warningThreshold: warningThreshold, useServerTime: useServerTime}
+}
+
+// Observe records server time received in response to request sent at requestSent,
+// the server time is assumed to correspond to the middle of the round trip.
+func (c *ServerClock) Observe(serverTime time.Time, requestSent time.Time, responseReceived time.Time) {
+	if c == nil || serverTime.IsZero() {
+		// older servers do not report their time
+		return
+	}
+	localTime := requestSent.Add(responseReceived.Sub(requestSent) / 2)
+	skew := serverTime.Sub(localTime)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.skew = skew
+	clockSkewGauge.Set(skew.Seconds())
+
+	overLimit := c.warningThreshold > 0 && (skew > c.warningThreshold || skew < -c.warningThreshold)
+	if overLimit && !c.overLimit {
+		log.Warnf("Executor clock differs from server clock by %s, which is over the threshold of %s", skew, c.warningThreshold)
+	} else if !overLimit && c.overLimit {
+		log.Infof("Executor clock is back within %s of server clock, skew is %s", c.warningThreshold, skew)
+	}
+	c.overLimit = overLimit
+}
+
+// Skew returns the last measured difference of server and local time, zero until the first measurement.
+func (c *ServerClock) Skew() time.Duration {
+	if c == nil {
+		return 0
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.skew
+}
+
+// Now returns the estimate of server time when the clock is configured to use it, local time otherwise.
+func (c *ServerClock) Now() time.Time {
+	if c == nil || !c.useServerTime {
+		return time.Now()
+	}
+	return time.Now().Add(c.Skew())
+}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerClock_Observe(t *testing.T) {
+	clock := NewServerClock(time.Second, true)
+	sent := time.Now()
+
+	clock.Observe(sent.Add(time.Minute+time.Second), sent, sent.Add(2*time.Second))
+	assert.Equal(t, time.Minute, clock.Skew())
+	assert.WithinDuration(t, time.Now().Add(time.Minute), clock.Now(), time.Second)
+
+	// missing server time keeps the last measurement
+	clock.Observe(time.Time{}, sent, sent)
+	assert.Equal(t, time.Minute, clock.Skew())
+}
+
+func TestServerClock_Now_UsesLocalTime(t *testing.T) {
+	clock := NewServerClock(time.Second, false)
+	sent := time.Now()
+	clock.Observe(sent.Add(time.Hour), sent, sent)
+
+	assert.Equal(t, time.Hour, clock.Skew())
+	assert.WithinDuration(t, time.Now(), clock.Now(), time.Second)
+
+	var nilClock *ServerClock
+	nilClock.Observe(sent.Add(time.Hour), sent, sent)
+	assert.Equal(t, time.Duration(0), nilClock.Skew())
+	assert.WithinDuration(t, time.Now(), nilClock.Now(), time.Second)
+}
@@ -0,0 +1,103 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+// Memory of a container killed for exceeding its memory limit, increased for the next attempt of its job
+type MemoryIncrease struct {
+	Container string
+	Memory    resource.Quantity
+	NewMemory resource.Quantity
+}
+
+// Failed because a container exceeded its memory limit, and the memory of the container can still be increased
+func IsRetryableOom(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodFailed && len(ExtractOomMemoryIncreases(pod)) > 0
+}
+
+// Returns the memory of containers of the pod killed for exceeding their memory limit multiplied by the oom retry factor
+// of the pod, up to its max memory. Containers at the max memory already are left out.
+func ExtractOomMemoryIncreases(pod *v1.Pod) []MemoryIncrease {
+	factor, maxMemory, ok := extractOomRetryPolicy(pod)
+	if !ok {
+		return nil
+	}
+
+	oomKilled := map[string]bool{}
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, containerStatus := range statuses {
+			if isOom(containerStatus) {
+				oomKilled[containerStatus.Name] = true
+			}
+		}
+	}
+
+	increases := []MemoryIncrease{}
+	for _, containers := range [][]v1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			memory, exists := containerMemory(container)
+			if !oomKilled[container.Name] || !exists {
+				continue
+			}
+			newMemory := *resource.NewQuantity(int64(math.Ceil(float64(memory.Value())*factor)), memory.Format)
+			if maxMemory != nil && newMemory.Cmp(*maxMemory) > 0 {
+				newMemory = maxMemory.DeepCopy()
+			}
+			if newMemory.Cmp(memory) > 0 {
+				increases = append(increases, MemoryIncrease{Container: container.Name, Memory: memory, NewMemory: newMemory})
+			}
+		}
+	}
+	return increases
+}
+
+func extractOomRetryPolicy(pod *v1.Pod) (factor float64, maxMemory *resource.Quantity, ok bool) {
+	factor, err := strconv.ParseFloat(pod.Annotations[domain.OomRetryMemoryFactor], 64)
+	if err != nil || factor <= 1 {
+		return 0, nil, false
+	}
+	if value, exists := pod.Annotations[domain.OomRetryMaxMemory]; exists {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return 0, nil, false
+		}
+		maxMemory = &quantity
+	}
+	return factor, maxMemory, true
+}
+
+// Memory limit of the container, or its request when it has no limit
+func containerMemory(container v1.Container) (resource.Quantity, bool) {
+	if memory, exists := container.Resources.Limits[v1.ResourceMemory]; exists {
+		return memory, true
+	}
+	memory, exists := container.Resources.Requests[v1.ResourceMemory]
+	return memory, exists
+}
+
+// The memory limit is set to the increased memory, the memory request grows in the same proportion
+func ApplyMemoryIncreases(spec *v1.PodSpec, increases []MemoryIncrease) {
+	newMemory := make(map[string]resource.Quantity, len(increases))
+	for _, increase := range increases {
+		newMemory[increase.Container] = increase.NewMemory
+	}
+	common.IncreaseContainerMemory(spec, newMemory)
+}
+
+func DescribeMemoryIncreases(increases []MemoryIncrease) string {
+	descriptions := make([]string, 0, len(increases))
+	for _, increase := range increases {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s to %s", increase.Container, increase.Memory.String(), increase.NewMemory.String()))
+	}
+	return strings.Join(descriptions, ", ")
+}
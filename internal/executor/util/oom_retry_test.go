@@ -0,0 +1,80 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func TestExtractOomMemoryIncreases_MultipliesMemoryOfOomKilledContainers(t *testing.T) {
+	pod := createOomRetryPod("2", "", "1Gi")
+
+	increases := ExtractOomMemoryIncreases(pod)
+
+	assert.Len(t, increases, 1)
+	assert.Equal(t, "custom-error", increases[0].Container)
+	assert.Equal(t, "2Gi", increases[0].NewMemory.String())
+	assert.True(t, IsRetryableOom(pod))
+}
+
+func TestExtractOomMemoryIncreases_CapsAtMaxMemory(t *testing.T) {
+	pod := createOomRetryPod("2", "1536Mi", "1Gi")
+
+	increases := ExtractOomMemoryIncreases(pod)
+
+	assert.Len(t, increases, 1)
+	assert.Equal(t, "1536Mi", increases[0].NewMemory.String())
+}
+
+func TestExtractOomMemoryIncreases_ReturnsNothing_WhenAtMaxMemory(t *testing.T) {
+	pod := createOomRetryPod("2", "1Gi", "1Gi")
+
+	assert.Empty(t, ExtractOomMemoryIncreases(pod))
+	assert.False(t, IsRetryableOom(pod))
+}
+
+func TestExtractOomMemoryIncreases_ReturnsNothing_WithoutValidFactor(t *testing.T) {
+	assert.Empty(t, ExtractOomMemoryIncreases(createOomRetryPod("", "", "1Gi")))
+	assert.Empty(t, ExtractOomMemoryIncreases(createOomRetryPod("1", "", "1Gi")))
+	assert.Empty(t, ExtractOomMemoryIncreases(createOomRetryPod("2", "invalid", "1Gi")))
+}
+
+func TestExtractOomMemoryIncreases_IgnoresContainersNotOomKilled(t *testing.T) {
+	pod := createOomRetryPod("2", "", "1Gi")
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{createCustomErrorContainerStatus()}
+
+	assert.Empty(t, ExtractOomMemoryIncreases(pod))
+}
+
+func TestApplyMemoryIncreases_ScalesRequestWithLimit(t *testing.T) {
+	spec := &v1.PodSpec{Containers: []v1.Container{{
+		Name: "main",
+		Resources: v1.ResourceRequirements{
+			Limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+			Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("512Mi")},
+		},
+	}}}
+
+	ApplyMemoryIncreases(spec, []MemoryIncrease{{Container: "main", Memory: resource.MustParse("1Gi"), NewMemory: resource.MustParse("2Gi")}})
+
+	assert.Equal(t, "2Gi", spec.Containers[0].Resources.Limits.Memory().String())
+	assert.Equal(t, "1Gi", spec.Containers[0].Resources.Requests.Memory().String())
+}
+
+func createOomRetryPod(factor string, maxMemory string, memory string) *v1.Pod {
+	pod := createFailedPod(createOomContainerStatus())
+	pod.Annotations = map[string]string{}
+	if factor != "" {
+		pod.Annotations[domain.OomRetryMemoryFactor] = factor
+	}
+	if maxMemory != "" {
+		pod.Annotations[domain.OomRetryMaxMemory] = maxMemory
+	}
+	resources := v1.ResourceList{v1.ResourceMemory: resource.MustParse(memory)}
+	pod.Spec.Containers = []v1.Container{{Name: "custom-error", Resources: v1.ResourceRequirements{Limits: resources, Requests: resources.DeepCopy()}}}
+	return pod
+}
@@ -2,11 +2,14 @@ package util
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -17,9 +20,33 @@ var expectedWarningsEventReasons = util.StringListToSet([]string{
 var imagePullBackOffStatesSet = util.StringListToSet([]string{"ImagePullBackOff", "ErrImagePull"})
 var invalidImageNameStatesSet = util.StringListToSet([]string{"InvalidImageName"})
 
+// Waiting reasons of containers of a scheduled pod while kubelet pulls images, init containers report PodInitializing
+var pullingImageStatesSet = util.StringListToSet([]string{"ContainerCreating", "PodInitializing"})
+
+// Reason of the event kubelet reports when it starts pulling an image of the pod
+const pullingImageEventReason = "Pulling"
+
 const oomKilledReason = "OOMKilled"
 const evictedReason = "Evicted"
 
+// Pod failure reasons caused by the infrastructure rather than by the job itself
+var infrastructureFailureReasons = util.StringListToSet([]string{evictedReason, "NodeLost", "Shutdown", "NodeShutdown"})
+
+// Reason kubelet sets on pods active for longer than their activeDeadlineSeconds
+const deadlineExceededReason = "DeadlineExceeded"
+
+// Containers killed by the kernel OOM killer outside of their cgroup limit terminate with SIGKILL and reason Error
+const sigKillExitCode = 137
+
+// Reasons of kubelet node events reporting the node ran out of memory
+var nodeMemoryEventReasons = util.StringListToSet([]string{"SystemOOM", "EvictionThresholdMet"})
+
+// Node events are matched to a container killed up to this long after them
+const nodeMemoryEventWindow = 5 * time.Minute
+
+// Scheduler reports both missing and unbound claims with message mentioning persistentvolumeclaim
+const missingVolumeMessage = "persistentvolumeclaim"
+
 func ExtractPodStuckReason(pod *v1.Pod) string {
 	containerStatuses := pod.Status.ContainerStatuses
 	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
@@ -40,7 +67,22 @@ func ExtractPodStuckReason(pod *v1.Pod) string {
 	return stuckMessage
 }
 
+func ExtractMissingVolumeReason(pod *v1.Pod) (reason string, isMissing bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled &&
+			condition.Status == v1.ConditionFalse &&
+			condition.Reason == v1.PodReasonUnschedulable &&
+			strings.Contains(strings.ToLower(condition.Message), missingVolumeMessage) {
+			return condition.Message, true
+		}
+	}
+	return "", false
+}
+
 func ExtractPodFailedReason(pod *v1.Pod) string {
+	if IsDeadlineExceeded(pod) {
+		return ExtractDeadlineExceededReason(pod)
+	}
 	if pod.Status.Message != "" {
 		return pod.Status.Message
 	}
@@ -59,6 +101,17 @@ func ExtractPodFailedReason(pod *v1.Pod) string {
 	return failedMessage
 }
 
+func IsDeadlineExceeded(pod *v1.Pod) bool {
+	return pod.Status.Reason == deadlineExceededReason
+}
+
+func ExtractDeadlineExceededReason(pod *v1.Pod) string {
+	if pod.Spec.ActiveDeadlineSeconds == nil {
+		return "Runtime deadline exceeded: pod was active for longer than its activeDeadlineSeconds"
+	}
+	return fmt.Sprintf("Runtime deadline exceeded: pod was active for longer than its activeDeadlineSeconds of %d seconds", *pod.Spec.ActiveDeadlineSeconds)
+}
+
 func ExtractPodFailedCause(pod *v1.Pod) api.Cause {
 	if pod.Status.Reason == evictedReason {
 		return api.Cause_Evicted
@@ -118,6 +171,64 @@ func isOom(containerStatus v1.ContainerStatus) bool {
 	return containerStatus.State.Terminated != nil && containerStatus.State.Terminated.Reason == oomKilledReason
 }
 
+// Returns names of containers killed with SIGKILL without being reported as OOMKilled,
+// they might have been killed because the node ran out of memory
+func ExtractPossiblyNodeOomKilledContainers(pod *v1.Pod) []string {
+	if pod.Status.Reason == evictedReason {
+		return nil
+	}
+	containerStatuses := pod.Status.ContainerStatuses
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+
+	names := []string{}
+	for _, containerStatus := range containerStatuses {
+		terminated := containerStatus.State.Terminated
+		if terminated != nil && terminated.ExitCode == sigKillExitCode && terminated.Reason != oomKilledReason {
+			names = append(names, containerStatus.Name)
+		}
+	}
+	return names
+}
+
+// Checks node conditions and kubelet node events for signs of the node running out of memory when the pod containers were killed.
+// Node can be nil if it no longer exists.
+func DiagnoseNodeMemoryPressure(pod *v1.Pod, node *v1.Node, nodeEvents []*v1.Event) (underPressure bool, message string) {
+	if node != nil {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == v1.NodeMemoryPressure && condition.Status == v1.ConditionTrue {
+				return true, fmt.Sprintf("node %s reports memory pressure: %s", node.Name, condition.Message)
+			}
+		}
+	}
+
+	killedAt := lastContainerTermination(pod)
+	for _, event := range nodeEvents {
+		if !nodeMemoryEventReasons[event.Reason] {
+			continue
+		}
+		eventTime := event.LastTimestamp.Time
+		if eventTime.IsZero() {
+			eventTime = event.EventTime.Time
+		}
+		if !killedAt.IsZero() && (eventTime.After(killedAt.Add(time.Minute)) || eventTime.Before(killedAt.Add(-nodeMemoryEventWindow))) {
+			continue
+		}
+		return true, fmt.Sprintf("node %s reported %s: %s", event.InvolvedObject.Name, event.Reason, event.Message)
+	}
+	return false, ""
+}
+
+func lastContainerTermination(pod *v1.Pod) time.Time {
+	last := time.Time{}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		terminated := containerStatus.State.Terminated
+		if terminated != nil && terminated.FinishedAt.Time.After(last) {
+			last = terminated.FinishedAt.Time
+		}
+	}
+	return last
+}
+
 func DiagnoseStuckPod(pod *v1.Pod, podEvents []*v1.Event) (retryable bool, message string) {
 	messages := []string{}
 	for _, event := range podEvents {
@@ -133,9 +244,33 @@ func DiagnoseStuckPod(pod *v1.Pod, podEvents []*v1.Event) (retryable bool, messa
 		return false, fmt.Sprintf("%s\n%s", podStuckReason, eventMessage)
 	}
 
+	// expected warnings do not make the pod unretryable, but usually carry the scheduler or kubelet explanation
+	if lastWarning := LastWarningEvent(podEvents); lastWarning != nil {
+		podStuckReason += fmt.Sprintf("Last warning event %v: %v\n", lastWarning.Reason, lastWarning.Message)
+	}
 	return ContainersAreRetryable(pod), podStuckReason
 }
 
+func LastWarningEvent(events []*v1.Event) *v1.Event {
+	var last *v1.Event
+	for _, event := range events {
+		if event.Type == v1.EventTypeWarning && (last == nil || !eventTime(event).Before(eventTime(last))) {
+			last = event
+		}
+	}
+	return last
+}
+
+func eventTime(event *v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
 func ContainersAreRetryable(pod *v1.Pod) bool {
 	containerStatuses := pod.Status.ContainerStatuses
 	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
@@ -154,3 +289,153 @@ func ContainersAreRetryable(pod *v1.Pod) bool {
 
 	return true
 }
+
+// Returns true for pods bound to a node whose containers are all still being created, which takes long for big images.
+// Pods with a container failing to pull its image or with a running init container are not pulling images.
+// Containers are also being created while kubelet waits for volumes, kubelet only starts pulling images once they are
+// mounted, so pods without a Pulling event are not pulling images.
+func IsPullingImages(pod *v1.Pod, podEvents []*v1.Event) bool {
+	if pod.Status.Phase != v1.PodPending || !isScheduled(pod) || !hasEventWithReason(podEvents, pullingImageEventReason) {
+		return false
+	}
+	containerStatuses := make([]v1.ContainerStatus, 0, len(pod.Status.ContainerStatuses)+len(pod.Status.InitContainerStatuses))
+	containerStatuses = append(containerStatuses, pod.Status.ContainerStatuses...)
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+
+	pulling := false
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Waiting == nil || !pullingImageStatesSet[containerStatus.State.Waiting.Reason] {
+			// init containers which already ran are terminated, the main containers are still pulled
+			if containerStatus.State.Terminated == nil {
+				return false
+			}
+			continue
+		}
+		pulling = true
+	}
+	return pulling
+}
+
+func hasEventWithReason(events []*v1.Event, reason string) bool {
+	for _, event := range events {
+		if event.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func isScheduled(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func extractSidecarContainerNames(pod *v1.Pod) map[string]bool {
+	sidecars := map[string]bool{}
+	for _, name := range strings.Split(pod.Annotations[domain.SidecarContainers], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			sidecars[name] = true
+		}
+	}
+	return sidecars
+}
+
+// Returns true when the pod declares sidecar containers and all the other containers have terminated
+func HavePrimaryContainersFinished(pod *v1.Pod) bool {
+	sidecars := extractSidecarContainerNames(pod)
+	if len(sidecars) == 0 {
+		return false
+	}
+
+	terminated := map[string]bool{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil {
+			terminated[status.Name] = true
+		}
+	}
+
+	primaryCount := 0
+	for _, container := range pod.Spec.Containers {
+		if sidecars[container.Name] {
+			continue
+		}
+		primaryCount++
+		if !terminated[container.Name] {
+			return false
+		}
+	}
+	return primaryCount > 0
+}
+
+func HasOnlySidecarContainersRunning(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodRunning && HavePrimaryContainersFinished(pod)
+}
+
+// Returns copy of the pod with the phase and container statuses it would have if it contained only primary containers
+func PodWithoutSidecarContainers(pod *v1.Pod) *v1.Pod {
+	sidecars := extractSidecarContainerNames(pod)
+	result := pod.DeepCopy()
+	result.Status.ContainerStatuses = []v1.ContainerStatus{}
+	result.Status.Phase = v1.PodSucceeded
+	for _, status := range pod.Status.ContainerStatuses {
+		if sidecars[status.Name] {
+			continue
+		}
+		result.Status.ContainerStatuses = append(result.Status.ContainerStatuses, status)
+		if status.State.Terminated == nil || status.State.Terminated.ExitCode != 0 {
+			result.Status.Phase = v1.PodFailed
+		}
+	}
+	return result
+}
+
+// Returns true for running pods which opted in to be reported as running only once ready and are not ready yet
+func IsWaitingForReadiness(pod *v1.Pod) bool {
+	if pod.Status.Phase != v1.PodRunning || pod.Annotations[domain.RunningWhenReady] != "true" {
+		return false
+	}
+	return hasReadinessProbe(pod) && !IsPodReady(pod)
+}
+
+func IsPodReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func hasReadinessProbe(pod *v1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.ReadinessProbe != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func IsInfrastructureFailure(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodFailed && infrastructureFailureReasons[pod.Status.Reason]
+}
+
+// Attempts are counted from 1, pods of jobs without retries have no annotations and 0 retries
+func ExtractAttempt(pod *v1.Pod) (attempt int, maxRetries int) {
+	attempt, err := strconv.Atoi(pod.Annotations[domain.JobAttempt])
+	if err != nil || attempt < 1 {
+		attempt = 1
+	}
+	maxRetries, _ = strconv.Atoi(pod.Annotations[domain.JobMaxRetries])
+	return attempt, maxRetries
+}
+
+// Pods failed because of the infrastructure are retried as they are, pods killed for exceeding their memory limit with more memory
+func ShouldBeRetried(pod *v1.Pod) bool {
+	attempt, maxRetries := ExtractAttempt(pod)
+	return attempt <= maxRetries && (IsInfrastructureFailure(pod) || IsRetryableOom(pod))
+}
@@ -3,10 +3,13 @@ package util
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -98,6 +101,40 @@ func TestDiagnoseStuckPod_ShouldIgnoreSchedulingFailures(t *testing.T) {
 	assert.True(t, retryable)
 }
 
+func TestDiagnoseStuckPod_ShouldReportLastExpectedWarning(t *testing.T) {
+	waitingContainer := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{waitingContainer}, []v1.ContainerState{})
+	now := time.Now()
+	events := []*v1.Event{
+		{Reason: "FailedScheduling", Type: v1.EventTypeWarning, Message: "0/3 nodes are available", LastTimestamp: metav1.NewTime(now)},
+		{Reason: "FailedScheduling", Type: v1.EventTypeWarning, Message: "0/2 nodes are available", LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+		{Reason: "Scheduled", Type: v1.EventTypeNormal, Message: "assigned", LastTimestamp: metav1.NewTime(now.Add(time.Minute))},
+	}
+
+	retryable, message := DiagnoseStuckPod(pod, events)
+	assert.True(t, retryable)
+	assert.Contains(t, message, "Last warning event FailedScheduling: 0/3 nodes are available")
+}
+
+func TestShouldBeRetried(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.JobMaxRetries: "1", domain.JobAttempt: "1"}},
+		Status:     v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"},
+	}
+	assert.True(t, ShouldBeRetried(pod))
+
+	pod.Annotations[domain.JobAttempt] = "2"
+	assert.False(t, ShouldBeRetried(pod))
+
+	pod.Annotations[domain.JobAttempt] = "1"
+	pod.Status.Reason = ""
+	assert.False(t, ShouldBeRetried(pod))
+
+	pod.Status.Reason = "Evicted"
+	delete(pod.Annotations, domain.JobMaxRetries)
+	assert.False(t, ShouldBeRetried(pod))
+}
+
 func makePodWithContainerStatuses(containerStates []v1.ContainerState, initContainerStates []v1.ContainerState) *v1.Pod {
 	containers := make([]v1.ContainerStatus, len(containerStates))
 	for i, state := range containerStates {
@@ -133,6 +170,115 @@ func TestExtractPodFailedReason(t *testing.T) {
 	assert.True(t, strings.Contains(failedReason, customErrorPod.Status.ContainerStatuses[0].State.Terminated.Message))
 }
 
+func TestExtractPodFailedReason_DeadlineExceeded(t *testing.T) {
+	deadline := int64(300)
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{ActiveDeadlineSeconds: &deadline},
+		Status: v1.PodStatus{
+			Phase:   v1.PodFailed,
+			Reason:  "DeadlineExceeded",
+			Message: "Pod was active on the node longer than the specified deadline",
+		},
+	}
+
+	assert.True(t, IsDeadlineExceeded(pod))
+	assert.Equal(t, "Runtime deadline exceeded: pod was active for longer than its activeDeadlineSeconds of 300 seconds", ExtractPodFailedReason(pod))
+	assert.False(t, IsDeadlineExceeded(evictedPod))
+}
+
+func TestExtractMissingVolumeReason(t *testing.T) {
+	pod := &v1.Pod{Status: v1.PodStatus{Conditions: []v1.PodCondition{{
+		Type:    v1.PodScheduled,
+		Status:  v1.ConditionFalse,
+		Reason:  v1.PodReasonUnschedulable,
+		Message: `persistentvolumeclaim "data" not found`,
+	}}}}
+	reason, isMissing := ExtractMissingVolumeReason(pod)
+	assert.True(t, isMissing)
+	assert.Equal(t, `persistentvolumeclaim "data" not found`, reason)
+
+	pod.Status.Conditions[0].Message = "pod has unbound immediate PersistentVolumeClaims"
+	_, isMissing = ExtractMissingVolumeReason(pod)
+	assert.True(t, isMissing)
+
+	pod.Status.Conditions[0].Message = "0/3 nodes are available: 3 Insufficient cpu."
+	_, isMissing = ExtractMissingVolumeReason(pod)
+	assert.False(t, isMissing)
+}
+
+func TestHavePrimaryContainersFinished(t *testing.T) {
+	terminated := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.SidecarContainers: "sidecar"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main"}, {Name: "sidecar"}}},
+		Status: v1.PodStatus{
+			Phase:             v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{Name: "main", State: terminated}, {Name: "sidecar", State: running}},
+		},
+	}
+	assert.True(t, HavePrimaryContainersFinished(pod))
+
+	withoutAnnotation := pod.DeepCopy()
+	withoutAnnotation.Annotations = map[string]string{}
+	assert.False(t, HavePrimaryContainersFinished(withoutAnnotation))
+
+	mainRunning := pod.DeepCopy()
+	mainRunning.Status.ContainerStatuses[0].State = running
+	assert.False(t, HavePrimaryContainersFinished(mainRunning))
+
+	onlySidecars := pod.DeepCopy()
+	onlySidecars.Annotations[domain.SidecarContainers] = "main, sidecar"
+	assert.False(t, HavePrimaryContainersFinished(onlySidecars))
+}
+
+func TestIsWaitingForReadiness(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.RunningWhenReady: "true"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main", ReadinessProbe: &v1.Probe{}}}},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+		},
+	}
+	assert.True(t, IsWaitingForReadiness(pod))
+
+	ready := pod.DeepCopy()
+	ready.Status.Conditions[0].Status = v1.ConditionTrue
+	assert.False(t, IsWaitingForReadiness(ready))
+
+	withoutProbe := pod.DeepCopy()
+	withoutProbe.Spec.Containers[0].ReadinessProbe = nil
+	assert.False(t, IsWaitingForReadiness(withoutProbe))
+
+	notOptedIn := pod.DeepCopy()
+	notOptedIn.Annotations = map[string]string{}
+	assert.False(t, IsWaitingForReadiness(notOptedIn))
+
+	pending := pod.DeepCopy()
+	pending.Status.Phase = v1.PodPending
+	assert.False(t, IsWaitingForReadiness(pending))
+}
+
+func TestPodWithoutSidecarContainers(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{domain.SidecarContainers: "sidecar"}},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "main", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}}},
+				{Name: "sidecar", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+			},
+		},
+	}
+
+	result := PodWithoutSidecarContainers(pod)
+
+	assert.Equal(t, v1.PodFailed, result.Status.Phase)
+	assert.Equal(t, map[string]int32{"main": 1}, ExtractPodExitCodes(result))
+	assert.Equal(t, v1.PodRunning, pod.Status.Phase)
+}
+
 func TestExtractPodFailedCause(t *testing.T) {
 	failedCause := ExtractPodFailedCause(evictedPod)
 	assert.Equal(t, failedCause, api.Cause_Evicted)
@@ -159,6 +305,78 @@ func TestExtractFailedPodContainerStatuses(t *testing.T) {
 	assert.Equal(t, containerStatuses[0].Cause, api.Cause_Error)
 }
 
+func TestExtractPossiblyNodeOomKilledContainers(t *testing.T) {
+	killedPod := createFailedPod(v1.ContainerStatus{
+		Name:  "killed",
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "Error"}},
+	})
+	assert.Equal(t, []string{"killed"}, ExtractPossiblyNodeOomKilledContainers(killedPod))
+	assert.Empty(t, ExtractPossiblyNodeOomKilledContainers(oomPod))
+	assert.Empty(t, ExtractPossiblyNodeOomKilledContainers(customErrorPod))
+	assert.Empty(t, ExtractPossiblyNodeOomKilledContainers(evictedPod))
+}
+
+func TestDiagnoseNodeMemoryPressure(t *testing.T) {
+	pressuredNode := &v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+		{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue, Message: "kubelet has insufficient memory available"},
+	}}}
+	underPressure, message := DiagnoseNodeMemoryPressure(customErrorPod, pressuredNode, nil)
+	assert.True(t, underPressure)
+	assert.Contains(t, message, "insufficient memory")
+
+	underPressure, _ = DiagnoseNodeMemoryPressure(customErrorPod, &v1.Node{}, nil)
+	assert.False(t, underPressure)
+
+	evictionEvent := &v1.Event{Reason: "EvictionThresholdMet", Message: "Attempting to reclaim memory"}
+	unrelatedEvent := &v1.Event{Reason: "NodeReady"}
+	underPressure, message = DiagnoseNodeMemoryPressure(customErrorPod, nil, []*v1.Event{unrelatedEvent, evictionEvent})
+	assert.True(t, underPressure)
+	assert.Contains(t, message, "Attempting to reclaim memory")
+}
+
+func TestIsPullingImages(t *testing.T) {
+	waiting := func(reason string) v1.ContainerStatus {
+		return v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: reason}}}
+	}
+	pod := func(scheduled v1.ConditionStatus, initContainers []v1.ContainerStatus, containers ...v1.ContainerStatus) *v1.Pod {
+		return &v1.Pod{Status: v1.PodStatus{
+			Phase:                 v1.PodPending,
+			Conditions:            []v1.PodCondition{{Type: v1.PodScheduled, Status: scheduled}},
+			InitContainerStatuses: initContainers,
+			ContainerStatuses:     containers,
+		}}
+	}
+	finishedInit := []v1.ContainerStatus{{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0}}}}
+	runningInit := []v1.ContainerStatus{{State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}}
+	pulling := []*v1.Event{{Reason: "Pulling", Type: v1.EventTypeNormal}}
+	failedMount := []*v1.Event{{Reason: "FailedMount", Type: v1.EventTypeWarning}}
+
+	assert.True(t, IsPullingImages(pod(v1.ConditionTrue, nil, waiting("ContainerCreating")), pulling))
+	assert.True(t, IsPullingImages(pod(v1.ConditionTrue, []v1.ContainerStatus{waiting("PodInitializing")}, waiting("PodInitializing")), pulling))
+	assert.True(t, IsPullingImages(pod(v1.ConditionTrue, finishedInit, waiting("ContainerCreating")), pulling))
+
+	assert.False(t, IsPullingImages(pod(v1.ConditionFalse, nil, waiting("ContainerCreating")), pulling))
+	assert.False(t, IsPullingImages(pod(v1.ConditionTrue, nil), pulling))
+	assert.False(t, IsPullingImages(pod(v1.ConditionTrue, nil, waiting("ContainerCreating"), waiting("ImagePullBackOff")), pulling))
+	assert.False(t, IsPullingImages(pod(v1.ConditionTrue, runningInit, waiting("PodInitializing")), pulling))
+	assert.False(t, IsPullingImages(pod(v1.ConditionTrue, nil, waiting("ContainerCreating")), failedMount))
+}
+
+func TestIsPullingImages_DoesNotChangeContainerStatusesOfPod(t *testing.T) {
+	containerStatuses := make([]v1.ContainerStatus, 1, 2)
+	containerStatuses[0] = v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}}
+	pod := &v1.Pod{Status: v1.PodStatus{
+		Phase:                 v1.PodPending,
+		Conditions:            []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+		InitContainerStatuses: []v1.ContainerStatus{{Name: "init", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}}},
+		ContainerStatuses:     containerStatuses,
+	}}
+
+	IsPullingImages(pod, []*v1.Event{{Reason: "Pulling"}})
+
+	assert.Equal(t, v1.ContainerStatus{}, containerStatuses[:2][1])
+}
+
 func createOomContainerStatus() v1.ContainerStatus {
 	return v1.ContainerStatus{
 		Name: "custom-error",
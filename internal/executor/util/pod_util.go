@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/executor/domain"
 )
@@ -33,6 +34,16 @@ func IsManagedPod(pod *v1.Pod) bool {
 	return ok
 }
 
+func IsProtectedJob(pod *v1.Pod) bool {
+	_, ok := pod.Annotations[common.ProtectedJobAnnotation]
+	return ok
+}
+
+func IsDrained(pod *v1.Pod) bool {
+	_, ok := pod.Annotations[domain.JobDrained]
+	return ok
+}
+
 func GetManagedPodSelector() labels.Selector {
 	return managedPodSelector.DeepCopySelector()
 }
@@ -102,6 +102,21 @@ func (p *EventProcessor) processEvent(event api.Event) error {
 
 	case *api.JobUtilisationEvent:
 		// TODO
+
+	case *api.JobServiceCreatedEvent:
+		// not used currently
+
+	case *api.JobGangPlacedEvent, *api.JobGangRejectedEvent:
+		// not used currently
+
+	case *api.JobMemoryIncreasedEvent:
+		// not used currently
+
+	case *api.JobDeadlineExceededEvent:
+		// job is marked cancelled by the following cancelled event
+
+	case *api.JobProtectedEvent:
+		// not used currently
 	}
 
 	return nil
@@ -91,7 +91,12 @@ func (r *SQLJobStore) MarkCancelled(event *api.JobCancelledEvent) error {
 }
 
 func (r *SQLJobStore) RecordJobPriorityChange(event *api.JobReprioritizedEvent) error {
-	panic("implement me")
+	ds := r.db.Update(jobTable).
+		Set(goqu.Record{"priority": event.NewPriority}).
+		Where(job_jobId.Eq(event.JobId))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
 }
 
 func (r *SQLJobStore) RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error {
@@ -635,6 +635,32 @@ func Test_Cancelled(t *testing.T) {
 	})
 }
 
+func Test_Reprioritized(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		err := jobStore.RecordJob(&api.Job{
+			Id:       jobId,
+			Queue:    queue,
+			Priority: 1,
+			Created:  someTime,
+		})
+		assert.NoError(t, err)
+
+		err = jobStore.RecordJobPriorityChange(&api.JobReprioritizedEvent{
+			JobId:       jobId,
+			Queue:       queue,
+			Created:     someTime,
+			NewPriority: 5,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 5, selectInt(t, db,
+			"SELECT priority FROM job"))
+	})
+}
+
 func Test_Duplicate(t *testing.T) {
 	withDatabase(t, func(db *goqu.Database) {
 		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
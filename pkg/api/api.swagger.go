@@ -22,6 +22,36 @@ func SwaggerJsonTemplate() string {
 		"    \"version\": \"version not set\"\n" +
 		"  },\n" +
 		"  \"paths\": {\n" +
+		"    \"/v1/clusters/capacity\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetClusterCapacityReport\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Only returns capacity of this pool when set.\",\n" +
+		"            \"name\": \"pool\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiClusterCapacityReport\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job-set/{queue}/{id}\": {\n" +
 		"      \"post\": {\n" +
 		"        \"produces\": [\n" +
@@ -78,6 +108,87 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/status\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobSetStatus\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetStatus\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/watch-jobs\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"WatchJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiJobStateUpdate\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiJobStateUpdate\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/cancel\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -110,6 +221,70 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/cancel-submitted-before\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobsSubmittedBefore\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobCancelSubmittedBeforeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCancellationCount\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/reprioritize\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReprioritizeJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/submit\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -142,12 +317,102 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/queue/{name}\": {\n" +
+		"    \"/v1/job/{jobId}/cancel-reason\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"operationId\": \"GetJobCancelReason\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobCancelReason\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/{jobId}/cluster\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobCluster\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobClusterInfo\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/{jobId}/requeues\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobRequeueHistory\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobRequeueHistory\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/pod-spec-template/{name}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetPodSpecTemplate\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
@@ -160,7 +425,7 @@ func SwaggerJsonTemplate() string {
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"              \"$ref\": \"#/definitions/apiPodSpecTemplate\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -175,7 +440,7 @@ func SwaggerJsonTemplate() string {
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"CreateQueue\",\n" +
+		"        \"operationId\": \"CreatePodSpecTemplate\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
@@ -188,7 +453,7 @@ func SwaggerJsonTemplate() string {
 		"            \"in\": \"body\",\n" +
 		"            \"required\": true,\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"              \"$ref\": \"#/definitions/apiPodSpecTemplate\"\n" +
 		"            }\n" +
 		"          }\n" +
 		"        ],\n" +
@@ -209,7 +474,7 @@ func SwaggerJsonTemplate() string {
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"operationId\": \"DeletePodSpecTemplate\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
@@ -231,117 +496,1487 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
-		"    }\n" +
-		"  },\n" +
-		"  \"definitions\": {\n" +
-		"    \"apiCancellationResult\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"cancelledIds\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
-		"        }\n" +
-		"      }\n" +
 		"    },\n" +
-		"    \"apiCause\": {\n" +
-		"      \"type\": \"string\",\n" +
-		"      \"default\": \"Error\",\n" +
-		"      \"enum\": [\n" +
-		"        \"Error\",\n" +
-		"        \"Evicted\",\n" +
-		"        \"OOM\"\n" +
-		"      ]\n" +
+		"    \"/v1/pools/capacity\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetPoolCapacity\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Only returns capacity of this pool when set.\",\n" +
+		"            \"name\": \"pool\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiPoolCapacityResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"put\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"patch\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"UpdateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/pause\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"PauseQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueuePauseRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ResumeQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/cancel\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobsInQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobCancelQueueRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueCancellationResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/client-id/{clientId}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobIdByClientId\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"clientId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobIdByClientIdResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/scheduling-status\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueSchedulingStatus\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueSchedulingStatus\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    }\n" +
+		"  },\n" +
+		"  \"definitions\": {\n" +
+		"    \"apiCancellationCount\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelledCount\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCancellationResult\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelledIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCause\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"- NodeMemoryPressure: killed because the node ran out of memory, not because of the container memory limit\",\n" +
+		"      \"default\": \"Error\",\n" +
+		"      \"enum\": [\n" +
+		"        \"Error\",\n" +
+		"        \"Evicted\",\n" +
+		"        \"OOM\",\n" +
+		"        \"NodeMemoryPressure\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiClusterCapacity\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allocatable\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"available\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiNodeType\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"reportAge\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Age of the oldest report the capacity is computed from\"\n" +
+		"        },\n" +
+		"        \"used\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Resources used by all pods on the processing nodes of the cluster, including pods not run by Armada\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiClusterCapacityReport\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"pools\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiPoolCapacityReport\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiContainerOverride\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"args\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"image\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Container of the template to change, can be empty when the template has a single container\"\n" +
+		"        },\n" +
+		"        \"resources\": {\n" +
+		"          \"title\": \"Replaces requests and limits of the listed resources, other resources of the template container are kept\",\n" +
+		"          \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiContainerStatus\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cause\": {\n" +
+		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        },\n" +
+		"        \"exitCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"logTail\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Last lines of the container logs, captured when the executor is configured to attach logs of failed pods\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEventMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
+		"        },\n" +
+		"        \"cancelling\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobCancellingEvent\"\n" +
+		"        },\n" +
+		"        \"deadlineExceeded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobDeadlineExceededEvent\"\n" +
+		"        },\n" +
+		"        \"duplicateFound\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobDuplicateFoundEvent\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobFailedEvent\"\n" +
+		"        },\n" +
+		"        \"gangPlaced\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobGangPlacedEvent\"\n" +
+		"        },\n" +
+		"        \"gangRejected\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobGangRejectedEvent\"\n" +
+		"        },\n" +
+		"        \"leaseExpired\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
+		"        },\n" +
+		"        \"leaseReturned\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeaseReturnedEvent\"\n" +
+		"        },\n" +
+		"        \"leased\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeasedEvent\"\n" +
+		"        },\n" +
+		"        \"memoryIncreased\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobMemoryIncreasedEvent\"\n" +
+		"        },\n" +
+		"        \"pending\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobPendingEvent\"\n" +
+		"        },\n" +
+		"        \"protected\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobProtectedEvent\"\n" +
+		"        },\n" +
+		"        \"queued\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobQueuedEvent\"\n" +
+		"        },\n" +
+		"        \"reprioritized\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobReprioritizedEvent\"\n" +
+		"        },\n" +
+		"        \"running\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
+		"        },\n" +
+		"        \"serviceCreated\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobServiceCreatedEvent\"\n" +
+		"        },\n" +
+		"        \"submitted\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmittedEvent\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSucceededEvent\"\n" +
+		"        },\n" +
+		"        \"terminated\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobTerminatedEvent\"\n" +
+		"        },\n" +
+		"        \"unableToSchedule\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUnableToScheduleEvent\"\n" +
+		"        },\n" +
+		"        \"utilisation\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUtilisationEvent\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEventStreamMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJob\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"clientId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"deadline\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"gangId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"gangSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"maxRetries\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"minKubernetesVersion\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"namespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podSpec\": {\n" +
+		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        },\n" +
+		"        \"podSpecs\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"priority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requiredClusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"requiredNodeLabels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"servicePorts\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ServicePort\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"toleratesPreemptibleNodes\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"volumeClaimTemplate\": {\n" +
+		"          \"title\": \"Copied from the queue when the job is submitted\",\n" +
+		"          \"$ref\": \"#/definitions/apiVolumeClaimTemplate\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelQueueRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelReason\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Empty when the job was not cancelled or was cancelled without reason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"optional explanation of the cancellation, included in cancel events\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelSubmittedBeforeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"submittedBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelledEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancellingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobClusterInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"leased\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"False when the job is no longer leased and cluster is the last one it ran on\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobDeadlineExceededEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported when a job is cancelled because it has not finished by its deadline, followed by the cancelling and cancelled events\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"deadline\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobDuplicateFoundEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"originalJobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobFailedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cause\": {\n" +
+		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        },\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"containerStatuses\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiContainerStatus\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"exitCodes\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runDetails\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRunDetails\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobGangPlacedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported for every member of a gang once pods of all its members are created\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"gangId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"gangSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobGangRejectedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported for every member of a gang the cluster could not create pods of all members for, none of the gang pods are kept\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"gangId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"gangSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobIdByClientIdResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseExpiredEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requeueReason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiRequeueReason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requeueReason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiRequeueReason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeasedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMemoryIncreasedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported when a pod killed for exceeding its memory limit is recreated with more memory for the next attempt of its job,\\nmemory_limits has the new memory limit of the containers which were killed\",\n" +
+		"      \"properties\": {\n" +
+		"        \"attempt\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"memoryLimits\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPendingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPhase\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"UnspecifiedJobPhase\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnspecifiedJobPhase\",\n" +
+		"        \"Submitted\",\n" +
+		"        \"Duplicate\",\n" +
+		"        \"Queued\",\n" +
+		"        \"Leased\",\n" +
+		"        \"Pending\",\n" +
+		"        \"Running\",\n" +
+		"        \"Succeeded\",\n" +
+		"        \"Failed\",\n" +
+		"        \"Cancelled\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiJobProtectedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported once per job and requeue reason when the protected job annotation keeps the job running where it would\\notherwise be requeued, requeue_reason is the one of the requeue which did not happen\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requeueReason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiRequeueReason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobQueuedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizeResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"notReprioritized\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Jobs which kept their priority with the reason, priority of leased and running jobs can not be changed\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"reprioritizedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRequeue\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Used to store requeue history of a job in Redis\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiRequeueReason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRequeueHistory\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requeues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Oldest requeue first\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobRequeue\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRunDetails\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Where and how long the pod of a finished job ran, e.g. to attribute costs\",\n" +
+		"      \"properties\": {\n" +
+		"        \"finished\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"When the last container of the pod finished\"\n" +
+		"        },\n" +
+		"        \"instanceType\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Value of the instance type label configured on the executor of the node the pod ran on\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"When the pod was accepted by the kubelet, not set for pods never started on a node\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRunningEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeLabels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"schedulingLatency\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSchedulingLatency\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSchedulingLatency\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Breakdown of the time it took the pod to start running since the job was submitted\",\n" +
+		"      \"properties\": {\n" +
+		"        \"podCreation\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"From the lease until the pod was created in kubernetes\"\n" +
+		"        },\n" +
+		"        \"podStartup\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"From pod creation until it was running, includes kubernetes scheduling, image pulls and container start\"\n" +
+		"        },\n" +
+		"        \"queued\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"From job submission until the job was leased by the executor\"\n" +
+		"        }\n" +
+		"      }\n" +
 		"    },\n" +
-		"    \"apiContainerStatus\": {\n" +
+		"    \"apiJobServiceCreatedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reported when the service exposing ports of the job pods is created\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cause\": {\n" +
-		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"exitCode\": {\n" +
+		"        \"clusterIp\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"message\": {\n" +
+		"        \"ports\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
+		"        \"serviceName\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"leasedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queuedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiEventMessage\": {\n" +
+		"    \"apiJobSetRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cancelled\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
+		"        \"eventTypes\": {\n" +
+		"          \"description\": \"Names of the event types to send, e.g. failed, succeeded, cancelled. All events are sent when empty.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"cancelling\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobCancellingEvent\"\n" +
+		"        \"fromMessageId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"duplicateFound\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobDuplicateFoundEvent\"\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"failed\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobFailedEvent\"\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"leaseExpired\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
+		"        \"watch\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetStatus\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Number of jobs of the job set in each state, pending jobs are counted as leased and duplicate jobs are not counted\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"leaseReturned\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeaseReturnedEvent\"\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"leased\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeasedEvent\"\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"pending\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobPendingEvent\"\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"queued\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobQueuedEvent\"\n" +
-		"        },\n" +
-		"        \"reprioritized\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobReprioritizedEvent\"\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
 		"        \"running\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
-		"        },\n" +
-		"        \"submitted\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobSubmittedEvent\"\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
 		"        \"succeeded\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobSucceededEvent\"\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobState\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"terminated\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobTerminatedEvent\"\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"unableToSchedule\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobUnableToScheduleEvent\"\n" +
+		"        \"lastTransitionTime\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
-		"        \"utilisation\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobUtilisationEvent\"\n" +
+		"        \"phase\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobPhase\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiEventStreamMessage\": {\n" +
+		"    \"apiJobStateUpdate\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobs\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"snapshot\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"The first update of the stream is a snapshot of all jobs of the job set, later updates only contain jobs which changed\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSubmitRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"id\": {\n" +
+		"        \"dryRun\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Validates the jobs, including whether they can be scheduled, without storing them or creating events\"\n" +
+		"        },\n" +
+		"        \"jobRequestItems\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitRequestItem\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"message\": {\n" +
-		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"rejectIfQueuePaused\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Fails the request when the queue is paused instead of queueing the jobs until it is resumed\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJob\": {\n" +
+		"    \"apiJobSubmitRequestItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"annotations\": {\n" +
@@ -353,15 +1988,25 @@ func SwaggerJsonTemplate() string {
 		"        \"clientId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"created\": {\n" +
+		"        \"containerOverrides\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Changes applied to containers of the pod spec template\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiContainerOverride\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"deadline\": {\n" +
 		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Job is cancelled if it has not finished by this time, queued or running\"\n" +
 		"        },\n" +
-		"        \"id\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"gangId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Jobs of a gang with gang_size members are only leased together to the same cluster, unique within the job set.\\nAll members have to be submitted in the same request\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"gangSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
 		"        },\n" +
 		"        \"labels\": {\n" +
 		"          \"type\": \"object\",\n" +
@@ -369,15 +2014,24 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"namespace\": {\n" +
+		"        \"maxRetries\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Number of times pods of the job are recreated after failing because of the infrastructure, e.g. eviction or node loss\"\n" +
+		"        },\n" +
+		"        \"minKubernetesVersion\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"owner\": {\n" +
+		"        \"namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"podSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
+		"        \"podSpecTemplate\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Name of a server side pod spec template used as the pod spec of the job, can not be combined with pod_spec or pod_specs\"\n" +
+		"        },\n" +
 		"        \"podSpecs\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -388,146 +2042,89 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"requiredClusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Job is only leased to clusters with one of these ids or in one of these pools, e.g. clusters the job data is replicated to\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
 		"        \"requiredNodeLabels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobCancelRequest\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobCancelledEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"servicePorts\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Ports of a service created with the job pods, selecting them by job id\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ServicePort\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"toleratesPreemptibleNodes\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Job is safe to run on preemptible (spot) nodes, e.g. because it is checkpointed. Other jobs are kept off these nodes\\non executors configured with the labels and taints of preemptible nodes\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobCancellingEvent\": {\n" +
+		"    \"apiJobSubmitResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"dryRun\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Jobs were only validated, their ids are not used by any stored job\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"jobResponseItems\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitResponseItem\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobDuplicateFoundEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"originalJobId\": {\n" +
+		"    \"apiJobSubmitResponseItem\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"error\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobFailedEvent\": {\n" +
+		"    \"apiJobSubmitStreamResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Results of one request of a submit stream\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cause\": {\n" +
-		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        \"dryRun\": {\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"firstItemIndex\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"title\": \"Index of the first job of the request among all jobs sent on the stream\"\n" +
 		"        },\n" +
-		"        \"containerStatuses\": {\n" +
+		"        \"jobResponseItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiContainerStatus\"\n" +
-		"          }\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"exitCodes\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"integer\",\n" +
-		"            \"format\": \"int32\"\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitResponseItem\"\n" +
 		"          }\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeaseExpiredEvent\": {\n" +
+		"    \"apiJobSubmittedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"job\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -539,7 +2136,7 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"    \"apiJobSucceededEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
@@ -555,15 +2152,25 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"kubernetesId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runDetails\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRunDetails\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeasedEvent\": {\n" +
+		"    \"apiJobTerminatedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
@@ -584,7 +2191,7 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobPendingEvent\": {\n" +
+		"    \"apiJobUnableToScheduleEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
@@ -603,54 +2210,30 @@ func SwaggerJsonTemplate() string {
 		"        \"kubernetesId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"podNumber\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobQueuedEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobReprioritizedEvent\": {\n" +
+		"    \"apiJobUtilisationEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"MaxResourcesForPeriod\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobRunningEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -679,311 +2262,393 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetInfo\": {\n" +
+		"    \"apiNodeType\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"leasedJobs\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"allocatableResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queuedJobs\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"taints\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Taint\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetRequest\": {\n" +
+		"    \"apiPodSpecTemplate\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"fromMessageId\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"id\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"podSpec\": {\n" +
+		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiPoolCapacity\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusters\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"largestNodeAllocatable\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Largest allocatable amount of each resource on a single node, amounts of different resources can come from different nodes\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"watch\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"totalAllocatable\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitRequest\": {\n" +
+		"    \"apiPoolCapacityReport\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"jobRequestItems\": {\n" +
+		"        \"allocatable\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"available\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"clusters\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSubmitRequestItem\"\n" +
+		"            \"$ref\": \"#/definitions/apiClusterCapacity\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"pool\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"reportAge\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"used\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitRequestItem\": {\n" +
+		"    \"apiPoolCapacityResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"annotations\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
+		"        \"pools\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiPoolCapacity\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueue\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allowedVolumeTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Volume types (e.g. persistentVolumeClaim, configMap) jobs can use, empty allows all types\",\n" +
+		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"clientId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"labels\": {\n" +
+		"        \"defaultPodLabels\": {\n" +
 		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Labels added to pods of every job of the queue, labels set by the job take precedence\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"namespace\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podSpec\": {\n" +
-		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        \"eventRetention\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueEventRetention\"\n" +
 		"        },\n" +
-		"        \"podSpecs\": {\n" +
+		"        \"groupOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"priority\": {\n" +
+		"        \"maxPodSpecSizeBytes\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Overrides server wide maximum serialized size of a single pod spec, 0 means server default is used\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"paused\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Jobs of a paused queue are not leased, already leased jobs keep running. Set by PauseQueue and ResumeQueue, CreateQueue and UpdateQueue keep the current value\"\n" +
+		"        },\n" +
+		"        \"priorityFactor\": {\n" +
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"requiredNodeLabels\": {\n" +
+		"        \"requireContainerCommand\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Rejects jobs with containers relying on the image default entrypoint, without their own command or args\"\n" +
+		"        },\n" +
+		"        \"requireEmptyDirSizeLimit\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Rejects jobs with emptyDir volumes without size limit\"\n" +
+		"        },\n" +
+		"        \"resourceFloor\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Fraction of total capacity per resource always reserved for the queue while it has queued jobs\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"resourceLimits\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"resourceQuota\": {\n" +
 		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Maximum resources leased and running jobs of the queue use together on all clusters, resources without quota are not limited\",\n" +
 		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"schedulingWindows\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueSchedulingWindow\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"template\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Server configured queue template providing settings not set when the queue is created\"\n" +
+		"        },\n" +
+		"        \"updateMask\": {\n" +
+		"          \"description\": \"Fields UpdateQueue changes, e.g. priority_factor or event_retention.max_length, other fields keep their current value.\\nWithout mask UpdateQueue replaces all settings. Not stored with the queue.\",\n" +
+		"          \"$ref\": \"#/definitions/protobufFieldMask\"\n" +
+		"        },\n" +
+		"        \"userOwners\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"volumeClaimTemplate\": {\n" +
+		"          \"title\": \"Volume claim created for every pod of the queue jobs\",\n" +
+		"          \"$ref\": \"#/definitions/apiVolumeClaimTemplate\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitResponse\": {\n" +
+		"    \"apiQueueCancellationResult\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"jobResponseItems\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSubmitResponseItem\"\n" +
-		"          }\n" +
+		"        \"alreadyFinishedCount\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"title\": \"Jobs which finished before they could be cancelled\"\n" +
+		"        },\n" +
+		"        \"cancelledCount\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitResponseItem\": {\n" +
+		"    \"apiQueueEventRetention\": {\n" +
+		"      \"description\": \"Overrides global event retention for job sets of the queue, zero values fall back to the global policy.\\nChanges apply to events reported after up to 10 seconds.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"error\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"maxLength\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
 		"        },\n" +
-		"        \"jobId\": {\n" +
+		"        \"retentionDuration\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmittedEvent\": {\n" +
+		"    \"apiQueueInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
+		"        \"activeJobSets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSetInfo\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"job\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        \"eventRetention\": {\n" +
+		"          \"title\": \"Retention applied to events of the queue, zero duration means events do not expire and zero length means streams are not trimmed\",\n" +
+		"          \"$ref\": \"#/definitions/apiQueueEventRetention\"\n" +
 		"        },\n" +
-		"        \"jobId\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"resourceQuota\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"resourcesLeased\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Resources of leased and running jobs of the queue on active clusters, which count towards the quota\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSucceededEvent\": {\n" +
+		"    \"apiQueueNotScheduledReason\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"Why jobs of a queue are currently not leased\",\n" +
+		"      \"default\": \"UnspecifiedQueueNotScheduledReason\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnspecifiedQueueNotScheduledReason\",\n" +
+		"        \"NoQueuedJobs\",\n" +
+		"        \"OutsideSchedulingWindow\",\n" +
+		"        \"QueueResourceLimitReached\",\n" +
+		"        \"StarvedByFairShare\",\n" +
+		"        \"NoFreeCapacity\",\n" +
+		"        \"NoFeasibleCluster\",\n" +
+		"        \"QueuePaused\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiQueuePauseRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobTerminatedEvent\": {\n" +
+		"    \"apiQueueResumeRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobUnableToScheduleEvent\": {\n" +
+		"    \"apiQueueSchedulingStatus\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueSchedulingStatusReason\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"schedulable\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"True when jobs of the queue can be leased in at least one pool\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobUtilisationEvent\": {\n" +
+		"    \"apiQueueSchedulingStatusReason\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"MaxResourcesForPeriod\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
-		"          }\n" +
-		"        },\n" +
-		"        \"clusterId\": {\n" +
+		"        \"message\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"created\": {\n" +
+		"        \"pool\": {\n" +
 		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"          \"title\": \"Empty when the reason applies to all pools\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"reason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueNotScheduledReason\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueue\": {\n" +
+		"    \"apiQueueSchedulingWindow\": {\n" +
+		"      \"description\": \"Time window (HH:MM) when queue jobs can be leased, windows ending before they start continue past midnight.\\nDays (Mon, Tue, ...) refer to the day the window starts, no days means every day.\",\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"groupOwners\": {\n" +
+		"        \"days\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
+		"        \"end\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"priorityFactor\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
-		"        \"resourceLimits\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"number\",\n" +
-		"            \"format\": \"double\"\n" +
-		"          }\n" +
-		"        },\n" +
-		"        \"userOwners\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
+		"        \"start\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueueInfo\": {\n" +
+		"    \"apiRequeueReason\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"Why the lease of a job was lost and the job was put back to its queue\",\n" +
+		"      \"default\": \"UnspecifiedRequeueReason\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnspecifiedRequeueReason\",\n" +
+		"        \"LeaseExpired\",\n" +
+		"        \"PodCreationFailed\",\n" +
+		"        \"PodStuck\",\n" +
+		"        \"VolumeUnavailable\",\n" +
+		"        \"NodeUnreachable\",\n" +
+		"        \"ExecutorShutdown\",\n" +
+		"        \"NodeDrain\",\n" +
+		"        \"LeaseRenewalFailed\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiVolumeClaimTemplate\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
+		"      \"title\": \"Persistent volume claim created by the executor for every pod of a job, mounted into all its containers and deleted together with the pod\",\n" +
 		"      \"properties\": {\n" +
-		"        \"activeJobSets\": {\n" +
+		"        \"accessModes\": {\n" +
 		"          \"type\": \"array\",\n" +
+		"          \"title\": \"ReadWriteOnce when empty\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSetInfo\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
+		"        \"mountPath\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Name of the volume in the pod spec\"\n" +
+		"        },\n" +
+		"        \"storage\": {\n" +
+		"          \"title\": \"Requested storage of the claim\",\n" +
+		"          \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"        },\n" +
+		"        \"storageClassName\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Empty uses the default storage class of the cluster\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -1023,6 +2688,20 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"protobufFieldMask\": {\n" +
+		"      \"description\": \"paths: \\\"f.a\\\"\\n    paths: \\\"f.b.d\\\"\\n\\nHere `f` represents a field in some root message, `a` and `b`\\nfields in the message found in `f`, and `d` a field found in the\\nmessage in `f.b`.\\n\\nField masks are used to specify a subset of fields that should be\\nreturned by a get operation or modified by an update operation.\\nField masks also have a custom JSON encoding (see below).\\n\\n# Field Masks in Projections\\n\\nWhen used in the context of a projection, a response message or\\nsub-message is filtered by the API to only contain those fields as\\nspecified in the mask. For example, if the mask in the previous\\nexample is applied to a response message as follows:\\n\\n    f {\\n      a : 22\\n      b {\\n        d : 1\\n        x : 2\\n      }\\n      y : 13\\n    }\\n    z: 8\\n\\nThe result will not contain specific values for fields x,y and z\\n(their value will be set to the default, and omitted in proto text\\noutput):\\n\\n\\n    f {\\n      a : 22\\n      b {\\n        d : 1\\n      }\\n    }\\n\\nA repeated field is not allowed except at the last position of a\\npaths string.\\n\\nIf a FieldMask object is not present in a get operation, the\\noperation applies to all fields (as if a FieldMask of all fields\\nhad been specified).\\n\\nNote that a field mask does not necessarily apply to the\\ntop-level response message. In case of a REST get operation, the\\nfield mask applies directly to the response, but in case of a REST\\nlist operation, the mask instead applies to each individual message\\nin the returned resource list. In case of a REST custom method,\\nother definitions may be used. Where the mask applies will be\\nclearly documented together with its declaration in the API.  In\\nany case, the effect on the returned resource/resources is required\\nbehavior for APIs.\\n\\n# Field Masks in Update Operations\\n\\nA field mask in update operations specifies which fields of the\\ntargeted resource are going to be updated. The API is required\\nto only change the values of the fields as specified in the mask\\nand leave the others untouched. If a resource is passed in to\\ndescribe the updated values, the API ignores the values of all\\nfields not covered by the mask.\\n\\nIf a repeated field is specified for an update operation, new values will\\nbe appended to the existing repeated field in the target resource. Note that\\na repeated field is only allowed in the last position of a `paths` string.\\n\\nIf a sub-message is specified in the last position of the field mask for an\\nupdate operation, then new value will be merged into the existing sub-message\\nin the target resource.\\n\\nFor example, given the target message:\\n\\n    f {\\n      b {\\n        d: 1\\n        x: 2\\n      }\\n      c: [1]\\n    }\\n\\nAnd an update message:\\n\\n    f {\\n      b {\\n        d: 10\\n      }\\n      c: [2]\\n    }\\n\\nthen if the field mask is:\\n\\n paths: [\\\"f.b\\\", \\\"f.c\\\"]\\n\\nthen the result will be:\\n\\n    f {\\n      b {\\n        d: 10\\n        x: 2\\n      }\\n      c: [1, 2]\\n    }\\n\\nAn implementation may provide options to override this default behavior for\\nrepeated and message fields.\\n\\nIn order to reset a field's value to the default, the field must\\nbe in the mask and set to the default value in the provided resource.\\nHence, in order to reset all fields of a resource, provide a default\\ninstance of the resource and set all fields in the mask, or do\\nnot provide a mask as described below.\\n\\nIf a field mask is not present on update, the operation applies to\\nall fields (as if a field mask of all fields has been specified).\\nNote that in the presence of schema evolution, this may mean that\\nfields the client does not know and has therefore not filled into\\nthe request will be reset to their default. If this is unwanted\\nbehavior, a specific service may require a client to always specify\\na field mask, producing an error if not.\\n\\nAs with get operations, the location of the resource which\\ndescribes the updated values in the request message depends on the\\noperation kind. In any case, the effect of the field mask is\\nrequired to be honored by the API.\\n\\n## Considerations for HTTP REST\\n\\nThe HTTP kind of an update operation which uses a field mask must\\nbe set to PATCH instead of PUT in order to satisfy HTTP semantics\\n(PUT must only be used for full updates).\\n\\n# JSON Encoding of Field Masks\\n\\nIn JSON, a field mask is encoded as a single string where paths are\\nseparated by a comma. Fields name in each path are converted\\nto/from lower-camel naming conventions.\\n\\nAs an example, consider the following message declarations:\\n\\n    message Profile {\\n      User user = 1;\\n      Photo photo = 2;\\n    }\\n    message User {\\n      string display_name = 1;\\n      string address = 2;\\n    }\\n\\nIn proto a field mask for `Profile` may look as such:\\n\\n    mask {\\n      paths: \\\"user.display_name\\\"\\n      paths: \\\"photo\\\"\\n    }\\n\\nIn JSON, the same mask is represented as below:\\n\\n    {\\n      mask: \\\"user.displayName,photo\\\"\\n    }\\n\\n# Field Masks and Oneof Fields\\n\\nField masks treat fields in oneofs just as regular fields. Consider the\\nfollowing message:\\n\\n    message SampleMessage {\\n      oneof test_oneof {\\n        string name = 4;\\n        SubMessage sub_message = 9;\\n      }\\n    }\\n\\nThe field mask can be:\\n\\n    mask {\\n      paths: \\\"name\\\"\\n    }\\n\\nOr:\\n\\n    mask {\\n      paths: \\\"sub_message\\\"\\n    }\\n\\nNote that oneof type names (\\\"test_oneof\\\" in this case) cannot be used in\\npaths.\\n\\n## Field Mask Verification\\n\\nThe implementation of any API method which has a FieldMask type field in the\\nrequest should verify the included field paths, and return an\\n`INVALID_ARGUMENT` error if any path is duplicated or unmappable.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"`FieldMask` represents a set of symbolic field paths, for example:\",\n" +
+		"      \"properties\": {\n" +
+		"        \"paths\": {\n" +
+		"          \"description\": \"The set of field mask paths.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"resourceQuantity\": {\n" +
 		"      \"description\": \"The serialization format is:\\n\\n\\u003cquantity\\u003e        ::= \\u003csignedNumber\\u003e\\u003csuffix\\u003e\\n(Note that \\u003csuffix\\u003e may be empty, from the \\\"\\\" case in \\u003cdecimalSI\\u003e.)\\n\\u003cdigit\\u003e           ::= 0 | 1 | ... | 9\\n\\u003cdigits\\u003e          ::= \\u003cdigit\\u003e | \\u003cdigit\\u003e\\u003cdigits\\u003e\\n\\u003cnumber\\u003e          ::= \\u003cdigits\\u003e | \\u003cdigits\\u003e.\\u003cdigits\\u003e | \\u003cdigits\\u003e. | .\\u003cdigits\\u003e\\n\\u003csign\\u003e            ::= \\\"+\\\" | \\\"-\\\"\\n\\u003csignedNumber\\u003e    ::= \\u003cnumber\\u003e | \\u003csign\\u003e\\u003cnumber\\u003e\\n\\u003csuffix\\u003e          ::= \\u003cbinarySI\\u003e | \\u003cdecimalExponent\\u003e | \\u003cdecimalSI\\u003e\\n\\u003cbinarySI\\u003e        ::= Ki | Mi | Gi | Ti | Pi | Ei\\n(International System of units; See: http://physics.nist.gov/cuu/Units/binary.html)\\n\\u003cdecimalSI\\u003e       ::= m | \\\"\\\" | k | M | G | T | P | E\\n(Note that 1024 = 1Ki but 1000 = 1k; I didn't choose the capitalization.)\\n\\u003cdecimalExponent\\u003e ::= \\\"e\\\" \\u003csignedNumber\\u003e | \\\"E\\\" \\u003csignedNumber\\u003e\\n\\nNo matter which of the three exponent forms is used, no quantity may represent\\na number greater than 2^63-1 in magnitude, nor may it have more than 3 decimal\\nplaces. Numbers larger or more precise will be capped or rounded up.\\n(E.g.: 0.1m will rounded up to 1m.)\\nThis may be extended in the future if we require larger or smaller quantities.\\n\\nWhen a Quantity is parsed from a string, it will remember the type of suffix\\nit had, and will use the same type again when it is serialized.\\n\\nBefore serializing, Quantity will be put in \\\"canonical form\\\".\\nThis means that Exponent/suffix will be adjusted up or down (with a\\ncorresponding increase or decrease in Mantissa) such that:\\na. No precision is lost\\nb. No fractional digits will be emitted\\nc. The exponent (or suffix) is as large as possible.\\nThe sign will be omitted unless the number is negative.\\n\\nExamples:\\n1.5 will be serialized as \\\"1500m\\\"\\n1.5Gi will be serialized as \\\"1536Mi\\\"\\n\\nNote that the quantity will NEVER be internally represented by a\\nfloating point number. That is the whole point of this exercise.\\n\\nNon-canonical values will still parse as long as they are well formed,\\nbut will be re-emitted in their canonical form. (So always use canonical\\nform, or don't diff.)\\n\\nThis format is intended to make it difficult to use these numbers without\\nwriting some sort of special handling code in the hopes that that will\\ncause implementors to also use a fixed point implementation.\\n\\n+protobuf=true\\n+protobuf.embed=string\\n+protobuf.options.marshal=false\\n+protobuf.options.(gogoproto.goproto_stringer)=false\\n+k8s:deepcopy-gen=true\\n+k8s:openapi-gen=true\",\n" +
 		"      \"type\": \"string\",\n" +
@@ -1075,6 +2754,28 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"timeDuration\": {\n" +
+		"      \"description\": \"A Duration represents the elapsed time between two instants\\nas an int64 nanosecond count. The representation limits the\\nlargest representable duration to approximately 290 years.\",\n" +
+		"      \"type\": \"integer\",\n" +
+		"      \"format\": \"int64\",\n" +
+		"      \"x-go-package\": \"time\"\n" +
+		"    },\n" +
+		"    \"typesFieldMask\": {\n" +
+		"      \"description\": \"paths: \\\"f.a\\\"\\npaths: \\\"f.b.d\\\"\\n\\nHere `f` represents a field in some root message, `a` and `b`\\nfields in the message found in `f`, and `d` a field found in the\\nmessage in `f.b`.\\n\\nField masks are used to specify a subset of fields that should be\\nreturned by a get operation or modified by an update operation.\\nField masks also have a custom JSON encoding (see below).\\n\\n# Field Masks in Projections\\n\\nWhen used in the context of a projection, a response message or\\nsub-message is filtered by the API to only contain those fields as\\nspecified in the mask. For example, if the mask in the previous\\nexample is applied to a response message as follows:\\n\\nf {\\na : 22\\nb {\\nd : 1\\nx : 2\\n}\\ny : 13\\n}\\nz: 8\\n\\nThe result will not contain specific values for fields x,y and z\\n(their value will be set to the default, and omitted in proto text\\noutput):\\n\\n\\nf {\\na : 22\\nb {\\nd : 1\\n}\\n}\\n\\nA repeated field is not allowed except at the last position of a\\npaths string.\\n\\nIf a FieldMask object is not present in a get operation, the\\noperation applies to all fields (as if a FieldMask of all fields\\nhad been specified).\\n\\nNote that a field mask does not necessarily apply to the\\ntop-level response message. In case of a REST get operation, the\\nfield mask applies directly to the response, but in case of a REST\\nlist operation, the mask instead applies to each individual message\\nin the returned resource list. In case of a REST custom method,\\nother definitions may be used. Where the mask applies will be\\nclearly documented together with its declaration in the API.  In\\nany case, the effect on the returned resource/resources is required\\nbehavior for APIs.\\n\\n# Field Masks in Update Operations\\n\\nA field mask in update operations specifies which fields of the\\ntargeted resource are going to be updated. The API is required\\nto only change the values of the fields as specified in the mask\\nand leave the others untouched. If a resource is passed in to\\ndescribe the updated values, the API ignores the values of all\\nfields not covered by the mask.\\n\\nIf a repeated field is specified for an update operation, new values will\\nbe appended to the existing repeated field in the target resource. Note that\\na repeated field is only allowed in the last position of a `paths` string.\\n\\nIf a sub-message is specified in the last position of the field mask for an\\nupdate operation, then new value will be merged into the existing sub-message\\nin the target resource.\\n\\nFor example, given the target message:\\n\\nf {\\nb {\\nd: 1\\nx: 2\\n}\\nc: [1]\\n}\\n\\nAnd an update message:\\n\\nf {\\nb {\\nd: 10\\n}\\nc: [2]\\n}\\n\\nthen if the field mask is:\\n\\npaths: [\\\"f.b\\\", \\\"f.c\\\"]\\n\\nthen the result will be:\\n\\nf {\\nb {\\nd: 10\\nx: 2\\n}\\nc: [1, 2]\\n}\\n\\nAn implementation may provide options to override this default behavior for\\nrepeated and message fields.\\n\\nIn order to reset a field's value to the default, the field must\\nbe in the mask and set to the default value in the provided resource.\\nHence, in order to reset all fields of a resource, provide a default\\ninstance of the resource and set all fields in the mask, or do\\nnot provide a mask as described below.\\n\\nIf a field mask is not present on update, the operation applies to\\nall fields (as if a field mask of all fields has been specified).\\nNote that in the presence of schema evolution, this may mean that\\nfields the client does not know and has therefore not filled into\\nthe request will be reset to their default. If this is unwanted\\nbehavior, a specific service may require a client to always specify\\na field mask, producing an error if not.\\n\\nAs with get operations, the location of the resource which\\ndescribes the updated values in the request message depends on the\\noperation kind. In any case, the effect of the field mask is\\nrequired to be honored by the API.\\n\\n## Considerations for HTTP REST\\n\\nThe HTTP kind of an update operation which uses a field mask must\\nbe set to PATCH instead of PUT in order to satisfy HTTP semantics\\n(PUT must only be used for full updates).\\n\\n# JSON Encoding of Field Masks\\n\\nIn JSON, a field mask is encoded as a single string where paths are\\nseparated by a comma. Fields name in each path are converted\\nto/from lower-camel naming conventions.\\n\\nAs an example, consider the following message declarations:\\n\\nmessage Profile {\\nUser user = 1;\\nPhoto photo = 2;\\n}\\nmessage User {\\nstring display_name = 1;\\nstring address = 2;\\n}\\n\\nIn proto a field mask for `Profile` may look as such:\\n\\nmask {\\npaths: \\\"user.display_name\\\"\\npaths: \\\"photo\\\"\\n}\\n\\nIn JSON, the same mask is represented as below:\\n\\n{\\nmask: \\\"user.displayName,photo\\\"\\n}\\n\\n# Field Masks and Oneof Fields\\n\\nField masks treat fields in oneofs just as regular fields. Consider the\\nfollowing message:\\n\\nmessage SampleMessage {\\noneof test_oneof {\\nstring name = 4;\\nSubMessage sub_message = 9;\\n}\\n}\\n\\nThe field mask can be:\\n\\nmask {\\npaths: \\\"name\\\"\\n}\\n\\nOr:\\n\\nmask {\\npaths: \\\"sub_message\\\"\\n}\\n\\nNote that oneof type names (\\\"test_oneof\\\" in this case) cannot be used in\\npaths.\\n\\n## Field Mask Verification\\n\\nThe implementation of any API method which has a FieldMask type field in the\\nrequest should verify the included field paths, and return an\\n`INVALID_ARGUMENT` error if any path is duplicated or unmappable.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"`FieldMask` represents a set of symbolic field paths, for example:\",\n" +
+		"      \"properties\": {\n" +
+		"        \"paths\": {\n" +
+		"          \"description\": \"The set of field mask paths.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Paths\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"github.com/gogo/protobuf/types\"\n" +
+		"    },\n" +
 		"    \"typesUID\": {\n" +
 		"      \"description\": \"UID is a type that holds unique ID values, including UUIDs.  Because we\\ndon't ONLY use UUIDs, this is an alias to string.  Being a type captures\\nintent and helps make sure that UIDs and names do not get conflated.\",\n" +
 		"      \"type\": \"string\",\n" +
@@ -3837,6 +5538,41 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1ServicePort\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"ServicePort contains information on service's port.\",\n" +
+		"      \"properties\": {\n" +
+		"        \"appProtocol\": {\n" +
+		"          \"description\": \"The application protocol for this port.\\nThis field follows standard Kubernetes label syntax.\\nUn-prefixed names are reserved for IANA standard service names (as per\\nRFC-6335 and http://www.iana.org/assignments/service-names).\\nNon-standard protocols should use prefixed names such as\\nmycompany.com/my-custom-protocol.\\nThis is a beta field that is guarded by the ServiceAppProtocol feature\\ngate and enabled by default.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"AppProtocol\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"The name of this port within the service. This must be a DNS_LABEL.\\nAll ports within a ServiceSpec must have unique names. When considering\\nthe endpoints for a Service, this must match the 'name' field in the\\nEndpointPort.\\nOptional if only one ServicePort is defined on this service.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Name\"\n" +
+		"        },\n" +
+		"        \"nodePort\": {\n" +
+		"          \"description\": \"The port on each node on which this service is exposed when type is\\nNodePort or LoadBalancer.  Usually assigned by the system. If a value is\\nspecified, in-range, and not in use it will be used, otherwise the\\noperation will fail.  If not specified, a port will be allocated if this\\nService requires one.  If this field is specified when creating a\\nService which does not need it, creation will fail. This field will be\\nwiped when updating a Service to no longer need it (e.g. changing type\\nfrom NodePort to ClusterIP).\\nMore info: https://kubernetes.io/docs/concepts/services-networking/service/#type-nodeport\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"x-go-name\": \"NodePort\"\n" +
+		"        },\n" +
+		"        \"port\": {\n" +
+		"          \"description\": \"The port that will be exposed by this service.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"x-go-name\": \"Port\"\n" +
+		"        },\n" +
+		"        \"protocol\": {\n" +
+		"          \"$ref\": \"#/definitions/v1Protocol\"\n" +
+		"        },\n" +
+		"        \"targetPort\": {\n" +
+		"          \"$ref\": \"#/definitions/intstrIntOrString\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
+		"    },\n" +
 		"    \"v1StorageMedium\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"title\": \"StorageMedium defines ways that storage can be allocated to a volume.\",\n" +
@@ -3904,6 +5640,28 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1Taint\": {\n" +
+		"      \"description\": \"The node this Taint is attached to has the \\\"effect\\\" on\\nany pod that does not tolerate the Taint.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"effect\": {\n" +
+		"          \"description\": \"Required. The effect of the taint on pods\\nthat do not tolerate the taint.\\nValid effects are NoSchedule, PreferNoSchedule and NoExecute.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"key\": {\n" +
+		"          \"description\": \"Required. The taint key to be applied to a node.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"timeAdded\": {\n" +
+		"          \"title\": \"TimeAdded represents the time at which the taint was added.\\nIt is only written for NoExecute taints.\\n+optional\",\n" +
+		"          \"$ref\": \"#/definitions/v1Time\"\n" +
+		"        },\n" +
+		"        \"value\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Required. The taint value corresponding to the taint key.\\n+optional\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"v1TaintEffect\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
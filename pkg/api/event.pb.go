@@ -5,6 +5,7 @@ package api
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -43,18 +44,22 @@ const (
 	Cause_Error   Cause = 0
 	Cause_Evicted Cause = 1
 	Cause_OOM     Cause = 2
+	// killed because the node ran out of memory, not because of the container memory limit
+	Cause_NodeMemoryPressure Cause = 3
 )
 
 var Cause_name = map[int32]string{
 	0: "Error",
 	1: "Evicted",
 	2: "OOM",
+	3: "NodeMemoryPressure",
 }
 
 var Cause_value = map[string]int32{
-	"Error":   0,
-	"Evicted": 1,
-	"OOM":     2,
+	"Error":              0,
+	"Evicted":            1,
+	"OOM":                2,
+	"NodeMemoryPressure": 3,
 }
 
 func (x Cause) String() string {
@@ -65,6 +70,55 @@ func (Cause) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{0}
 }
 
+type JobPhase int32
+
+const (
+	JobPhase_UnspecifiedJobPhase JobPhase = 0
+	JobPhase_Submitted           JobPhase = 1
+	JobPhase_Duplicate           JobPhase = 2
+	JobPhase_Queued              JobPhase = 3
+	JobPhase_Leased              JobPhase = 4
+	JobPhase_Pending             JobPhase = 5
+	JobPhase_Running             JobPhase = 6
+	JobPhase_Succeeded           JobPhase = 7
+	JobPhase_Failed              JobPhase = 8
+	JobPhase_Cancelled           JobPhase = 9
+)
+
+var JobPhase_name = map[int32]string{
+	0: "UnspecifiedJobPhase",
+	1: "Submitted",
+	2: "Duplicate",
+	3: "Queued",
+	4: "Leased",
+	5: "Pending",
+	6: "Running",
+	7: "Succeeded",
+	8: "Failed",
+	9: "Cancelled",
+}
+
+var JobPhase_value = map[string]int32{
+	"UnspecifiedJobPhase": 0,
+	"Submitted":           1,
+	"Duplicate":           2,
+	"Queued":              3,
+	"Leased":              4,
+	"Pending":             5,
+	"Running":             6,
+	"Succeeded":           7,
+	"Failed":              8,
+	"Cancelled":           9,
+}
+
+func (x JobPhase) String() string {
+	return proto.EnumName(JobPhase_name, int32(x))
+}
+
+func (JobPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{1}
+}
+
 type JobSubmittedEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -358,12 +412,13 @@ func (m *JobLeasedEvent) GetClusterId() string {
 }
 
 type JobLeaseReturnedEvent struct {
-	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	JobId         string        `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId      string        `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue         string        `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created       time.Time     `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId     string        `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Reason        string        `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequeueReason RequeueReason `protobuf:"varint,7,opt,name=requeue_reason,json=requeueReason,proto3,enum=api.RequeueReason" json:"requeueReason,omitempty"`
 }
 
 func (m *JobLeaseReturnedEvent) Reset()      { *m = JobLeaseReturnedEvent{} }
@@ -440,11 +495,19 @@ func (m *JobLeaseReturnedEvent) GetReason() string {
 	return ""
 }
 
+func (m *JobLeaseReturnedEvent) GetRequeueReason() RequeueReason {
+	if m != nil {
+		return m.RequeueReason
+	}
+	return RequeueReason_UnspecifiedRequeueReason
+}
+
 type JobLeaseExpiredEvent struct {
-	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	JobId         string        `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId      string        `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue         string        `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created       time.Time     `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	RequeueReason RequeueReason `protobuf:"varint,5,opt,name=requeue_reason,json=requeueReason,proto3,enum=api.RequeueReason" json:"requeueReason,omitempty"`
 }
 
 func (m *JobLeaseExpiredEvent) Reset()      { *m = JobLeaseExpiredEvent{} }
@@ -507,6 +570,13 @@ func (m *JobLeaseExpiredEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobLeaseExpiredEvent) GetRequeueReason() RequeueReason {
+	if m != nil {
+		return m.RequeueReason
+	}
+	return RequeueReason_UnspecifiedRequeueReason
+}
+
 type JobPendingEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -599,14 +669,16 @@ func (m *JobPendingEvent) GetPodNumber() int32 {
 }
 
 type JobRunningEvent struct {
-	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
-	NodeName     string    `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	JobId             string                `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId          string                `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue             string                `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created           time.Time             `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId         string                `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId      string                `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	NodeName          string                `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber         int32                 `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	NodeLabels        map[string]string     `protobuf:"bytes,9,rep,name=node_labels,json=nodeLabels,proto3" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SchedulingLatency *JobSchedulingLatency `protobuf:"bytes,10,opt,name=scheduling_latency,json=schedulingLatency,proto3" json:"schedulingLatency,omitempty"`
 }
 
 func (m *JobRunningEvent) Reset()      { *m = JobRunningEvent{} }
@@ -697,6 +769,83 @@ func (m *JobRunningEvent) GetPodNumber() int32 {
 	return 0
 }
 
+func (m *JobRunningEvent) GetNodeLabels() map[string]string {
+	if m != nil {
+		return m.NodeLabels
+	}
+	return nil
+}
+
+func (m *JobRunningEvent) GetSchedulingLatency() *JobSchedulingLatency {
+	if m != nil {
+		return m.SchedulingLatency
+	}
+	return nil
+}
+
+// Breakdown of the time it took the pod to start running since the job was submitted
+type JobSchedulingLatency struct {
+	// From job submission until the job was leased by the executor
+	Queued time.Duration `protobuf:"bytes,1,opt,name=queued,proto3,stdduration" json:"queued"`
+	// From the lease until the pod was created in kubernetes
+	PodCreation time.Duration `protobuf:"bytes,2,opt,name=pod_creation,json=podCreation,proto3,stdduration" json:"pod_creation"`
+	// From pod creation until it was running, includes kubernetes scheduling, image pulls and container start
+	PodStartup time.Duration `protobuf:"bytes,3,opt,name=pod_startup,json=podStartup,proto3,stdduration" json:"pod_startup"`
+}
+
+func (m *JobSchedulingLatency) Reset()      { *m = JobSchedulingLatency{} }
+func (*JobSchedulingLatency) ProtoMessage() {}
+func (*JobSchedulingLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{8}
+}
+func (m *JobSchedulingLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSchedulingLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSchedulingLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSchedulingLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSchedulingLatency.Merge(m, src)
+}
+func (m *JobSchedulingLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSchedulingLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSchedulingLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSchedulingLatency proto.InternalMessageInfo
+
+func (m *JobSchedulingLatency) GetQueued() time.Duration {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *JobSchedulingLatency) GetPodCreation() time.Duration {
+	if m != nil {
+		return m.PodCreation
+	}
+	return 0
+}
+
+func (m *JobSchedulingLatency) GetPodStartup() time.Duration {
+	if m != nil {
+		return m.PodStartup
+	}
+	return 0
+}
+
 type JobUnableToScheduleEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -712,7 +861,7 @@ type JobUnableToScheduleEvent struct {
 func (m *JobUnableToScheduleEvent) Reset()      { *m = JobUnableToScheduleEvent{} }
 func (*JobUnableToScheduleEvent) ProtoMessage() {}
 func (*JobUnableToScheduleEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{8}
+	return fileDescriptor_7758595c3bb8cf56, []int{9}
 }
 func (m *JobUnableToScheduleEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,12 +966,13 @@ type JobFailedEvent struct {
 	PodNumber         int32              `protobuf:"varint,10,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 	ContainerStatuses []*ContainerStatus `protobuf:"bytes,11,rep,name=container_statuses,json=containerStatuses,proto3" json:"containerStatuses,omitempty"`
 	Cause             Cause              `protobuf:"varint,12,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	RunDetails        *JobRunDetails     `protobuf:"bytes,13,opt,name=run_details,json=runDetails,proto3" json:"runDetails,omitempty"`
 }
 
 func (m *JobFailedEvent) Reset()      { *m = JobFailedEvent{} }
 func (*JobFailedEvent) ProtoMessage() {}
 func (*JobFailedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{9}
+	return fileDescriptor_7758595c3bb8cf56, []int{10}
 }
 func (m *JobFailedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -936,21 +1086,29 @@ func (m *JobFailedEvent) GetCause() Cause {
 	return Cause_Error
 }
 
+func (m *JobFailedEvent) GetRunDetails() *JobRunDetails {
+	if m != nil {
+		return m.RunDetails
+	}
+	return nil
+}
+
 type JobSucceededEvent struct {
-	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
-	NodeName     string    `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	JobId        string         `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string         `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string         `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time      `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string         `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string         `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	NodeName     string         `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber    int32          `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	RunDetails   *JobRunDetails `protobuf:"bytes,9,opt,name=run_details,json=runDetails,proto3" json:"runDetails,omitempty"`
 }
 
 func (m *JobSucceededEvent) Reset()      { *m = JobSucceededEvent{} }
 func (*JobSucceededEvent) ProtoMessage() {}
 func (*JobSucceededEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{10}
+	return fileDescriptor_7758595c3bb8cf56, []int{11}
 }
 func (m *JobSucceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,6 +1193,84 @@ func (m *JobSucceededEvent) GetPodNumber() int32 {
 	return 0
 }
 
+func (m *JobSucceededEvent) GetRunDetails() *JobRunDetails {
+	if m != nil {
+		return m.RunDetails
+	}
+	return nil
+}
+
+// Where and how long the pod of a finished job ran, e.g. to attribute costs
+type JobRunDetails struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Value of the instance type label configured on the executor of the node the pod ran on
+	InstanceType string `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instanceType,omitempty"`
+	// When the pod was accepted by the kubelet, not set for pods never started on a node
+	Started *time.Time `protobuf:"bytes,3,opt,name=started,proto3,stdtime" json:"started,omitempty"`
+	// When the last container of the pod finished
+	Finished *time.Time `protobuf:"bytes,4,opt,name=finished,proto3,stdtime" json:"finished,omitempty"`
+}
+
+func (m *JobRunDetails) Reset()      { *m = JobRunDetails{} }
+func (*JobRunDetails) ProtoMessage() {}
+func (*JobRunDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{12}
+}
+func (m *JobRunDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunDetails.Merge(m, src)
+}
+func (m *JobRunDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunDetails proto.InternalMessageInfo
+
+func (m *JobRunDetails) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetInstanceType() string {
+	if m != nil {
+		return m.InstanceType
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetStarted() *time.Time {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *JobRunDetails) GetFinished() *time.Time {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
 type JobUtilisationEvent struct {
 	JobId                 string                       `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId              string                       `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1050,7 +1286,7 @@ type JobUtilisationEvent struct {
 func (m *JobUtilisationEvent) Reset()      { *m = JobUtilisationEvent{} }
 func (*JobUtilisationEvent) ProtoMessage() {}
 func (*JobUtilisationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{11}
+	return fileDescriptor_7758595c3bb8cf56, []int{13}
 }
 func (m *JobUtilisationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,16 +1379,17 @@ func (m *JobUtilisationEvent) GetPodNumber() int32 {
 }
 
 type JobReprioritizedEvent struct {
-	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue       string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created     time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	NewPriority float64   `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
 }
 
 func (m *JobReprioritizedEvent) Reset()      { *m = JobReprioritizedEvent{} }
 func (*JobReprioritizedEvent) ProtoMessage() {}
 func (*JobReprioritizedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{12}
+	return fileDescriptor_7758595c3bb8cf56, []int{14}
 }
 func (m *JobReprioritizedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,17 +1446,25 @@ func (m *JobReprioritizedEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobReprioritizedEvent) GetNewPriority() float64 {
+	if m != nil {
+		return m.NewPriority
+	}
+	return 0
+}
+
 type JobCancellingEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Reason   string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *JobCancellingEvent) Reset()      { *m = JobCancellingEvent{} }
 func (*JobCancellingEvent) ProtoMessage() {}
 func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{13}
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
 }
 func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,17 +1521,25 @@ func (m *JobCancellingEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobCancellingEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type JobCancelledEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Reason   string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *JobCancelledEvent) Reset()      { *m = JobCancelledEvent{} }
 func (*JobCancelledEvent) ProtoMessage() {}
 func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{14}
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
 }
 func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1343,25 +1596,38 @@ func (m *JobCancelledEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
-type JobTerminatedEvent struct {
-	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+func (m *JobCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
-func (*JobTerminatedEvent) ProtoMessage() {}
-func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+// Reported when the service exposing ports of the job pods is created
+type JobServiceCreatedEvent struct {
+	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	PodNumber    int32     `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	ServiceName  string    `protobuf:"bytes,8,opt,name=service_name,json=serviceName,proto3" json:"serviceName,omitempty"`
+	ClusterIp    string    `protobuf:"bytes,9,opt,name=cluster_ip,json=clusterIp,proto3" json:"clusterIp,omitempty"`
+	Ports        []int32   `protobuf:"varint,10,rep,packed,name=ports,proto3" json:"ports,omitempty"`
 }
-func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *JobServiceCreatedEvent) Reset()      { *m = JobServiceCreatedEvent{} }
+func (*JobServiceCreatedEvent) ProtoMessage() {}
+func (*JobServiceCreatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
+}
+func (m *JobServiceCreatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobServiceCreatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobTerminatedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobServiceCreatedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1371,338 +1637,203 @@ func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JobTerminatedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobTerminatedEvent.Merge(m, src)
+func (m *JobServiceCreatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobServiceCreatedEvent.Merge(m, src)
 }
-func (m *JobTerminatedEvent) XXX_Size() int {
+func (m *JobServiceCreatedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobTerminatedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobTerminatedEvent.DiscardUnknown(m)
+func (m *JobServiceCreatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobServiceCreatedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobTerminatedEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobServiceCreatedEvent proto.InternalMessageInfo
 
-func (m *JobTerminatedEvent) GetJobId() string {
+func (m *JobServiceCreatedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetJobSetId() string {
+func (m *JobServiceCreatedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetQueue() string {
+func (m *JobServiceCreatedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetCreated() time.Time {
+func (m *JobServiceCreatedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobTerminatedEvent) GetClusterId() string {
+func (m *JobServiceCreatedEvent) GetClusterId() string {
 	if m != nil {
 		return m.ClusterId
 	}
 	return ""
 }
 
-type EventMessage struct {
-	// Types that are valid to be assigned to Events:
-	//	*EventMessage_Submitted
-	//	*EventMessage_Queued
-	//	*EventMessage_DuplicateFound
-	//	*EventMessage_Leased
-	//	*EventMessage_LeaseReturned
-	//	*EventMessage_LeaseExpired
-	//	*EventMessage_Pending
-	//	*EventMessage_Running
-	//	*EventMessage_UnableToSchedule
-	//	*EventMessage_Failed
-	//	*EventMessage_Succeeded
-	//	*EventMessage_Reprioritized
-	//	*EventMessage_Cancelling
-	//	*EventMessage_Cancelled
-	//	*EventMessage_Terminated
-	//	*EventMessage_Utilisation
-	Events isEventMessage_Events `protobuf_oneof:"events"`
+func (m *JobServiceCreatedEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
+	}
+	return ""
 }
 
-func (m *EventMessage) Reset()      { *m = EventMessage{} }
-func (*EventMessage) ProtoMessage() {}
-func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
-}
-func (m *EventMessage) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *JobServiceCreatedEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
 }
-func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *JobServiceCreatedEvent) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
 	}
+	return ""
 }
-func (m *EventMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMessage.Merge(m, src)
+
+func (m *JobServiceCreatedEvent) GetClusterIp() string {
+	if m != nil {
+		return m.ClusterIp
+	}
+	return ""
 }
-func (m *EventMessage) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMessage.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventMessage proto.InternalMessageInfo
-
-type isEventMessage_Events interface {
-	isEventMessage_Events()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type EventMessage_Submitted struct {
-	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof" json:"submitted,omitempty"`
-}
-type EventMessage_Queued struct {
-	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
-}
-type EventMessage_DuplicateFound struct {
-	DuplicateFound *JobDuplicateFoundEvent `protobuf:"bytes,16,opt,name=duplicate_found,json=duplicateFound,proto3,oneof" json:"duplicateFound,omitempty"`
-}
-type EventMessage_Leased struct {
-	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof" json:"leased,omitempty"`
-}
-type EventMessage_LeaseReturned struct {
-	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=lease_returned,json=leaseReturned,proto3,oneof" json:"leaseReturned,omitempty"`
-}
-type EventMessage_LeaseExpired struct {
-	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=lease_expired,json=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
-}
-type EventMessage_Pending struct {
-	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
-}
-type EventMessage_Running struct {
-	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof" json:"running,omitempty"`
-}
-type EventMessage_UnableToSchedule struct {
-	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unable_to_schedule,json=unableToSchedule,proto3,oneof" json:"unableToSchedule,omitempty"`
-}
-type EventMessage_Failed struct {
-	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
-}
-type EventMessage_Succeeded struct {
-	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof" json:"succeeded,omitempty"`
-}
-type EventMessage_Reprioritized struct {
-	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof" json:"reprioritized,omitempty"`
-}
-type EventMessage_Cancelling struct {
-	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof" json:"cancelling,omitempty"`
-}
-type EventMessage_Cancelled struct {
-	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof" json:"cancelled,omitempty"`
-}
-type EventMessage_Terminated struct {
-	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof" json:"terminated,omitempty"`
-}
-type EventMessage_Utilisation struct {
-	Utilisation *JobUtilisationEvent `protobuf:"bytes,15,opt,name=utilisation,proto3,oneof" json:"utilisation,omitempty"`
-}
-
-func (*EventMessage_Submitted) isEventMessage_Events()        {}
-func (*EventMessage_Queued) isEventMessage_Events()           {}
-func (*EventMessage_DuplicateFound) isEventMessage_Events()   {}
-func (*EventMessage_Leased) isEventMessage_Events()           {}
-func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
-func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
-func (*EventMessage_Pending) isEventMessage_Events()          {}
-func (*EventMessage_Running) isEventMessage_Events()          {}
-func (*EventMessage_UnableToSchedule) isEventMessage_Events() {}
-func (*EventMessage_Failed) isEventMessage_Events()           {}
-func (*EventMessage_Succeeded) isEventMessage_Events()        {}
-func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
-func (*EventMessage_Cancelling) isEventMessage_Events()       {}
-func (*EventMessage_Cancelled) isEventMessage_Events()        {}
-func (*EventMessage_Terminated) isEventMessage_Events()       {}
-func (*EventMessage_Utilisation) isEventMessage_Events()      {}
 
-func (m *EventMessage) GetEvents() isEventMessage_Events {
+func (m *JobServiceCreatedEvent) GetPorts() []int32 {
 	if m != nil {
-		return m.Events
-	}
-	return nil
-}
-
-func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
-		return x.Submitted
+		return m.Ports
 	}
 	return nil
 }
 
-func (m *EventMessage) GetQueued() *JobQueuedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
-		return x.Queued
-	}
-	return nil
-}
-
-func (m *EventMessage) GetDuplicateFound() *JobDuplicateFoundEvent {
-	if x, ok := m.GetEvents().(*EventMessage_DuplicateFound); ok {
-		return x.DuplicateFound
-	}
-	return nil
+// Reported for every member of a gang once pods of all its members are created
+type JobGangPlacedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	GangId    string    `protobuf:"bytes,6,opt,name=gang_id,json=gangId,proto3" json:"gangId,omitempty"`
+	GangSize  uint32    `protobuf:"varint,7,opt,name=gang_size,json=gangSize,proto3" json:"gangSize,omitempty"`
 }
 
-func (m *EventMessage) GetLeased() *JobLeasedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
-		return x.Leased
-	}
-	return nil
+func (m *JobGangPlacedEvent) Reset()      { *m = JobGangPlacedEvent{} }
+func (*JobGangPlacedEvent) ProtoMessage() {}
+func (*JobGangPlacedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
-
-func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
-		return x.LeaseReturned
-	}
-	return nil
+func (m *JobGangPlacedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
-		return x.LeaseExpired
+func (m *JobGangPlacedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobGangPlacedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *EventMessage) GetPending() *JobPendingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
-		return x.Pending
-	}
-	return nil
+func (m *JobGangPlacedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGangPlacedEvent.Merge(m, src)
 }
-
-func (m *EventMessage) GetRunning() *JobRunningEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
-		return x.Running
-	}
-	return nil
+func (m *JobGangPlacedEvent) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
-	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
-		return x.UnableToSchedule
-	}
-	return nil
+func (m *JobGangPlacedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGangPlacedEvent.DiscardUnknown(m)
 }
 
-func (m *EventMessage) GetFailed() *JobFailedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
-		return x.Failed
-	}
-	return nil
-}
+var xxx_messageInfo_JobGangPlacedEvent proto.InternalMessageInfo
 
-func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
-		return x.Succeeded
+func (m *JobGangPlacedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
-		return x.Reprioritized
+func (m *JobGangPlacedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetCancelling() *JobCancellingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
-		return x.Cancelling
+func (m *JobGangPlacedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetCancelled() *JobCancelledEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
-		return x.Cancelled
+func (m *JobGangPlacedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
-		return x.Terminated
+func (m *JobGangPlacedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetUtilisation() *JobUtilisationEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Utilisation); ok {
-		return x.Utilisation
+func (m *JobGangPlacedEvent) GetGangId() string {
+	if m != nil {
+		return m.GangId
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*EventMessage) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*EventMessage_Submitted)(nil),
-		(*EventMessage_Queued)(nil),
-		(*EventMessage_DuplicateFound)(nil),
-		(*EventMessage_Leased)(nil),
-		(*EventMessage_LeaseReturned)(nil),
-		(*EventMessage_LeaseExpired)(nil),
-		(*EventMessage_Pending)(nil),
-		(*EventMessage_Running)(nil),
-		(*EventMessage_UnableToSchedule)(nil),
-		(*EventMessage_Failed)(nil),
-		(*EventMessage_Succeeded)(nil),
-		(*EventMessage_Reprioritized)(nil),
-		(*EventMessage_Cancelling)(nil),
-		(*EventMessage_Cancelled)(nil),
-		(*EventMessage_Terminated)(nil),
-		(*EventMessage_Utilisation)(nil),
+func (m *JobGangPlacedEvent) GetGangSize() uint32 {
+	if m != nil {
+		return m.GangSize
 	}
+	return 0
 }
 
-type ContainerStatus struct {
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
-	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	Cause    Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+// Reported for every member of a gang the cluster could not create pods of all members for, none of the gang pods are kept
+type JobGangRejectedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	GangId    string    `protobuf:"bytes,6,opt,name=gang_id,json=gangId,proto3" json:"gangId,omitempty"`
+	GangSize  uint32    `protobuf:"varint,7,opt,name=gang_size,json=gangSize,proto3" json:"gangSize,omitempty"`
+	Reason    string    `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
-func (*ContainerStatus) ProtoMessage() {}
-func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+func (m *JobGangRejectedEvent) Reset()      { *m = JobGangRejectedEvent{} }
+func (*JobGangRejectedEvent) ProtoMessage() {}
+func (*JobGangRejectedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
-func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
+func (m *JobGangRejectedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobGangRejectedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContainerStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobGangRejectedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1712,68 +1843,94 @@ func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ContainerStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerStatus.Merge(m, src)
+func (m *JobGangRejectedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGangRejectedEvent.Merge(m, src)
 }
-func (m *ContainerStatus) XXX_Size() int {
+func (m *JobGangRejectedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContainerStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerStatus.DiscardUnknown(m)
+func (m *JobGangRejectedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGangRejectedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerStatus proto.InternalMessageInfo
+var xxx_messageInfo_JobGangRejectedEvent proto.InternalMessageInfo
 
-func (m *ContainerStatus) GetName() string {
+func (m *JobGangRejectedEvent) GetJobId() string {
 	if m != nil {
-		return m.Name
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *ContainerStatus) GetExitCode() int32 {
+func (m *JobGangRejectedEvent) GetJobSetId() string {
 	if m != nil {
-		return m.ExitCode
+		return m.JobSetId
 	}
-	return 0
+	return ""
 }
 
-func (m *ContainerStatus) GetMessage() string {
+func (m *JobGangRejectedEvent) GetQueue() string {
 	if m != nil {
-		return m.Message
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *ContainerStatus) GetReason() string {
+func (m *JobGangRejectedEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.Reason
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobGangRejectedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
 	return ""
 }
 
-func (m *ContainerStatus) GetCause() Cause {
+func (m *JobGangRejectedEvent) GetGangId() string {
 	if m != nil {
-		return m.Cause
+		return m.GangId
 	}
-	return Cause_Error
+	return ""
 }
 
-type EventList struct {
-	Events []*EventMessage `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+func (m *JobGangRejectedEvent) GetGangSize() uint32 {
+	if m != nil {
+		return m.GangSize
+	}
+	return 0
 }
 
-func (m *EventList) Reset()      { *m = EventList{} }
-func (*EventList) ProtoMessage() {}
-func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+func (m *JobGangRejectedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *EventList) XXX_Unmarshal(b []byte) error {
+
+// Reported when a job is cancelled because it has not finished by its deadline, followed by the cancelling and cancelled events
+type JobDeadlineExceededEvent struct {
+	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Deadline time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *JobDeadlineExceededEvent) Reset()      { *m = JobDeadlineExceededEvent{} }
+func (*JobDeadlineExceededEvent) ProtoMessage() {}
+func (*JobDeadlineExceededEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
+}
+func (m *JobDeadlineExceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobDeadlineExceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobDeadlineExceededEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1783,42 +1940,79 @@ func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventList.Merge(m, src)
+func (m *JobDeadlineExceededEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDeadlineExceededEvent.Merge(m, src)
 }
-func (m *EventList) XXX_Size() int {
+func (m *JobDeadlineExceededEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventList) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventList.DiscardUnknown(m)
+func (m *JobDeadlineExceededEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDeadlineExceededEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventList proto.InternalMessageInfo
+var xxx_messageInfo_JobDeadlineExceededEvent proto.InternalMessageInfo
 
-func (m *EventList) GetEvents() []*EventMessage {
+func (m *JobDeadlineExceededEvent) GetJobId() string {
 	if m != nil {
-		return m.Events
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type EventStreamMessage struct {
-	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (m *JobDeadlineExceededEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
-func (*EventStreamMessage) ProtoMessage() {}
-func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+func (m *JobDeadlineExceededEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
-func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
+
+func (m *JobDeadlineExceededEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobDeadlineExceededEvent) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// Reported when a pod killed for exceeding its memory limit is recreated with more memory for the next attempt of its job,
+// memory_limits has the new memory limit of the containers which were killed
+type JobMemoryIncreasedEvent struct {
+	JobId        string                       `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string                       `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string                       `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time                    `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string                       `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string                       `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	PodNumber    int32                        `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	Attempt      int32                        `protobuf:"varint,8,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Reason       string                       `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	MemoryLimits map[string]resource.Quantity `protobuf:"bytes,10,rep,name=memory_limits,json=memoryLimits,proto3" json:"memoryLimits,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobMemoryIncreasedEvent) Reset()      { *m = JobMemoryIncreasedEvent{} }
+func (*JobMemoryIncreasedEvent) ProtoMessage() {}
+func (*JobMemoryIncreasedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
+}
+func (m *JobMemoryIncreasedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMemoryIncreasedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMemoryIncreasedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1828,51 +2022,111 @@ func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStreamMessage.Merge(m, src)
+func (m *JobMemoryIncreasedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMemoryIncreasedEvent.Merge(m, src)
 }
-func (m *EventStreamMessage) XXX_Size() int {
+func (m *JobMemoryIncreasedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventStreamMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
+func (m *JobMemoryIncreasedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMemoryIncreasedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+var xxx_messageInfo_JobMemoryIncreasedEvent proto.InternalMessageInfo
 
-func (m *EventStreamMessage) GetId() string {
+func (m *JobMemoryIncreasedEvent) GetJobId() string {
 	if m != nil {
-		return m.Id
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *EventStreamMessage) GetMessage() *EventMessage {
+func (m *JobMemoryIncreasedEvent) GetJobSetId() string {
 	if m != nil {
-		return m.Message
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobMemoryIncreasedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobMemoryIncreasedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobMemoryIncreasedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobMemoryIncreasedEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
+	}
+	return ""
+}
+
+func (m *JobMemoryIncreasedEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func (m *JobMemoryIncreasedEvent) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *JobMemoryIncreasedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobMemoryIncreasedEvent) GetMemoryLimits() map[string]resource.Quantity {
+	if m != nil {
+		return m.MemoryLimits
 	}
 	return nil
 }
 
-// swagger:model
-type JobSetRequest struct {
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Watch         bool   `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
-	FromMessageId string `protobuf:"bytes,3,opt,name=from_message_id,json=fromMessageId,proto3" json:"fromMessageId,omitempty"`
-	Queue         string `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+// Reported once per job and requeue reason when the protected job annotation keeps the job running where it would
+// otherwise be requeued, requeue_reason is the one of the requeue which did not happen
+type JobProtectedEvent struct {
+	JobId         string        `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId      string        `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue         string        `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created       time.Time     `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId     string        `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Reason        string        `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequeueReason RequeueReason `protobuf:"varint,7,opt,name=requeue_reason,json=requeueReason,proto3,enum=api.RequeueReason" json:"requeueReason,omitempty"`
 }
 
-func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
-func (*JobSetRequest) ProtoMessage() {}
-func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
+func (m *JobProtectedEvent) Reset()      { *m = JobProtectedEvent{} }
+func (*JobProtectedEvent) ProtoMessage() {}
+func (*JobProtectedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
-func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobProtectedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobProtectedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobProtectedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1882,1827 +2136,1668 @@ func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *JobSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetRequest.Merge(m, src)
+func (m *JobProtectedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobProtectedEvent.Merge(m, src)
 }
-func (m *JobSetRequest) XXX_Size() int {
+func (m *JobProtectedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+func (m *JobProtectedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobProtectedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobProtectedEvent proto.InternalMessageInfo
 
-func (m *JobSetRequest) GetId() string {
+func (m *JobProtectedEvent) GetJobId() string {
 	if m != nil {
-		return m.Id
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobSetRequest) GetWatch() bool {
+func (m *JobProtectedEvent) GetJobSetId() string {
 	if m != nil {
-		return m.Watch
+		return m.JobSetId
 	}
-	return false
+	return ""
 }
 
-func (m *JobSetRequest) GetFromMessageId() string {
+func (m *JobProtectedEvent) GetQueue() string {
 	if m != nil {
-		return m.FromMessageId
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobSetRequest) GetQueue() string {
+func (m *JobProtectedEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.Queue
+		return m.Created
 	}
-	return ""
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
-	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
-	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
-	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
-	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
-	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
-	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
-	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
-	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
-	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
-	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
-	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
-	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
-	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
-	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
-	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
-	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
-	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
-	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
-	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
-	proto.RegisterType((*EventList)(nil), "api.EventList")
-	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
-	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+func (m *JobProtectedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
-
-var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 1541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xf7, 0x3a, 0x71, 0x6c, 0x3f, 0x27, 0x4e, 0x18, 0x42, 0xd8, 0xaf, 0x01, 0x63, 0x2d, 0x12,
-	0xca, 0x97, 0x8a, 0x35, 0x35, 0x15, 0xa2, 0x08, 0x55, 0x55, 0x82, 0x53, 0xc7, 0x22, 0xfc, 0xd8,
-	0xd0, 0xb3, 0xb5, 0x3f, 0x26, 0xce, 0x24, 0xeb, 0x9d, 0x65, 0x77, 0x36, 0x4d, 0x8a, 0x90, 0xaa,
-	0x5e, 0x7b, 0x41, 0xaa, 0x7a, 0x6a, 0x05, 0x6a, 0xff, 0x8c, 0x4a, 0xad, 0x7a, 0x2b, 0x52, 0x2f,
-	0x48, 0xbd, 0xd0, 0x43, 0x7f, 0x85, 0xfe, 0x1f, 0xad, 0x66, 0x66, 0xd7, 0x5e, 0x3b, 0x09, 0x1c,
-	0xaa, 0x4a, 0x49, 0x6e, 0x9e, 0x37, 0xef, 0xcd, 0xbc, 0xf7, 0xd9, 0x99, 0xcf, 0x7c, 0x9e, 0xe1,
-	0xa4, 0xbf, 0xd9, 0xad, 0x9b, 0x3e, 0xa9, 0xe3, 0x2d, 0xec, 0x31, 0xdd, 0x0f, 0x28, 0xa3, 0x68,
-	0xcc, 0xf4, 0x49, 0xe5, 0x7c, 0x97, 0xd2, 0xae, 0x8b, 0xeb, 0xc2, 0x64, 0x45, 0x6b, 0x75, 0x46,
-	0x7a, 0x38, 0x64, 0x66, 0xcf, 0x97, 0x5e, 0x95, 0x7e, 0xe8, 0xc3, 0x08, 0x47, 0x38, 0x36, 0x9e,
-	0x19, 0x8d, 0xc2, 0x3d, 0x9f, 0xed, 0xc4, 0x93, 0x97, 0xbb, 0x84, 0xad, 0x47, 0x96, 0x6e, 0xd3,
-	0x5e, 0xbd, 0x4b, 0xbb, 0x74, 0xe0, 0xc5, 0x47, 0x62, 0x20, 0x7e, 0xc5, 0xee, 0x67, 0xe3, 0xb5,
-	0xf8, 0x1e, 0xa6, 0xe7, 0x51, 0x66, 0x32, 0x42, 0xbd, 0x30, 0x9e, 0x7d, 0x67, 0xf3, 0x7a, 0xa8,
-	0x13, 0xca, 0x67, 0x7b, 0xa6, 0xbd, 0x4e, 0x3c, 0x1c, 0xec, 0xd4, 0x93, 0x94, 0x02, 0x1c, 0xd2,
-	0x28, 0xb0, 0x71, 0xbd, 0x8b, 0x3d, 0x1c, 0x98, 0x0c, 0x3b, 0x32, 0x4a, 0xfb, 0x41, 0x81, 0x13,
-	0x6d, 0x6a, 0xad, 0x46, 0x56, 0x8f, 0x30, 0x86, 0x9d, 0x26, 0x2f, 0x1b, 0x9d, 0x82, 0x89, 0x0d,
-	0x6a, 0x75, 0x88, 0xa3, 0x2a, 0x35, 0x65, 0xbe, 0x68, 0xe4, 0x36, 0xa8, 0xb5, 0xec, 0xa0, 0xb3,
-	0x00, 0xdc, 0x1c, 0x62, 0xc6, 0xa7, 0xb2, 0x62, 0xaa, 0xb0, 0x41, 0xad, 0x55, 0xcc, 0x96, 0x1d,
-	0x34, 0x0b, 0x39, 0x51, 0xb9, 0x3a, 0x26, 0x63, 0xc4, 0x00, 0xbd, 0x07, 0x79, 0x3b, 0xc0, 0x7c,
-	0x47, 0x75, 0xbc, 0xa6, 0xcc, 0x97, 0x1a, 0x15, 0x5d, 0x96, 0xa1, 0x27, 0xc5, 0xea, 0x0f, 0x12,
-	0x20, 0x17, 0x0a, 0xcf, 0x7f, 0x3b, 0x9f, 0x79, 0xf2, 0xfb, 0x79, 0xc5, 0x48, 0x82, 0x50, 0x0d,
-	0xc6, 0x36, 0xa8, 0xa5, 0xe6, 0x44, 0x6c, 0x41, 0x37, 0x7d, 0xa2, 0xb7, 0xa9, 0xb5, 0x30, 0xce,
-	0x3d, 0x0d, 0x3e, 0xa5, 0x7d, 0xa9, 0x40, 0xb9, 0x4d, 0xad, 0xfb, 0x7c, 0xbb, 0x43, 0x97, 0xbf,
-	0xf6, 0x93, 0x02, 0x73, 0x6d, 0x6a, 0xdd, 0x8a, 0x7c, 0x97, 0xd8, 0x26, 0xc3, 0x4b, 0x34, 0xf2,
-	0x0e, 0x1f, 0xca, 0x17, 0x61, 0x9a, 0x06, 0xa4, 0x4b, 0x3c, 0xd3, 0xed, 0xc4, 0x39, 0xe5, 0xc4,
-	0xfa, 0x53, 0x89, 0xb9, 0xcd, 0x73, 0xd3, 0xbe, 0x95, 0x58, 0xdf, 0xc6, 0x66, 0x78, 0x08, 0xcf,
-	0xca, 0x39, 0x00, 0xdb, 0x8d, 0x42, 0x86, 0x83, 0x41, 0x01, 0xc5, 0xd8, 0xb2, 0xec, 0x68, 0xbf,
-	0x28, 0x70, 0x2a, 0x49, 0xde, 0xc0, 0x2c, 0x0a, 0xbc, 0x23, 0x57, 0x03, 0x9a, 0x83, 0x89, 0x00,
-	0x9b, 0x21, 0xf5, 0xd4, 0x09, 0x31, 0x15, 0x8f, 0xb4, 0xaf, 0x15, 0x98, 0x4d, 0x6a, 0x6b, 0x6e,
-	0xfb, 0x24, 0x38, 0x84, 0x57, 0xe1, 0x6f, 0x05, 0xa6, 0xdb, 0xd4, 0xba, 0x87, 0x3d, 0x87, 0x78,
-	0xdd, 0xa3, 0x86, 0xfc, 0x05, 0x98, 0xda, 0x8c, 0x2c, 0x1c, 0x78, 0x98, 0xe1, 0x90, 0x7b, 0xc8,
-	0x0f, 0x30, 0x39, 0x30, 0x2e, 0x8b, 0x35, 0x7c, 0xea, 0x74, 0xbc, 0xa8, 0x67, 0xe1, 0x40, 0xcd,
-	0xd7, 0x94, 0xf9, 0x9c, 0x51, 0xf4, 0xa9, 0x73, 0x47, 0x18, 0xb4, 0xaf, 0xb2, 0x02, 0x01, 0x23,
-	0xf2, 0xbc, 0xe3, 0x8a, 0xc0, 0x19, 0x28, 0x7a, 0xd4, 0xc1, 0x1d, 0xcf, 0xec, 0x61, 0x01, 0x40,
-	0xd1, 0x28, 0x70, 0xc3, 0x1d, 0xb3, 0x87, 0x47, 0xe0, 0x29, 0x8c, 0xc2, 0xf3, 0x5d, 0x16, 0xd4,
-	0x36, 0xb5, 0x3e, 0xf4, 0x4c, 0xcb, 0xc5, 0x0f, 0xe8, 0xaa, 0xbd, 0x8e, 0x9d, 0xc8, 0xc5, 0xc7,
-	0xe4, 0x8e, 0xee, 0xc5, 0x2f, 0xff, 0x26, 0xfc, 0x0a, 0xaf, 0xc5, 0xaf, 0x38, 0x8a, 0xdf, 0xb3,
-	0x71, 0xc1, 0xce, 0x4b, 0x26, 0x71, 0x8f, 0x0d, 0xb3, 0xa1, 0x26, 0x00, 0xde, 0x26, 0xac, 0x63,
-	0x53, 0x07, 0x87, 0x6a, 0xbe, 0x36, 0x36, 0x5f, 0x6a, 0x68, 0x89, 0x0e, 0x48, 0x95, 0xaa, 0x37,
-	0xb7, 0x09, 0x5b, 0xe4, 0x4e, 0x4d, 0x8f, 0x05, 0x3b, 0x0b, 0x59, 0x55, 0x31, 0x8a, 0x38, 0xb1,
-	0xed, 0x05, 0xbf, 0xf0, 0x26, 0xf0, 0x8b, 0xaf, 0x05, 0x1f, 0x46, 0xc0, 0x47, 0x8b, 0x80, 0x6c,
-	0xea, 0x31, 0x93, 0x0b, 0xaf, 0x4e, 0xc8, 0x4c, 0x16, 0x85, 0x38, 0x54, 0x4b, 0x22, 0xdf, 0x59,
-	0x91, 0xef, 0x62, 0x32, 0xbd, 0x2a, 0x66, 0x8d, 0x13, 0xf6, 0xb0, 0x01, 0x87, 0xa8, 0x06, 0x39,
-	0xdb, 0x8c, 0x42, 0xac, 0x4e, 0xd6, 0x94, 0xf9, 0x72, 0x03, 0x64, 0x1c, 0xb7, 0x18, 0x72, 0xa2,
-	0x72, 0x13, 0xca, 0xc3, 0x85, 0xa2, 0x19, 0x18, 0xdb, 0xc4, 0x3b, 0xf1, 0xf7, 0xe5, 0x3f, 0xf9,
-	0xf7, 0xdb, 0x32, 0xdd, 0x08, 0x8b, 0x0f, 0x9b, 0x33, 0xe4, 0xe0, 0x46, 0xf6, 0xba, 0xa2, 0x3d,
-	0xcd, 0xc6, 0x72, 0xcf, 0xb6, 0x31, 0x76, 0x8e, 0xde, 0x21, 0xf9, 0xcf, 0x29, 0xe8, 0xb3, 0x71,
-	0x38, 0xc9, 0x29, 0x88, 0x11, 0x97, 0x84, 0x42, 0x5f, 0x1f, 0x4b, 0x88, 0x28, 0x9c, 0x5a, 0x31,
-	0xb7, 0x8d, 0xb8, 0x2b, 0x08, 0x97, 0x68, 0x70, 0x0f, 0x07, 0x84, 0x3a, 0xf1, 0xfd, 0xba, 0x9a,
-	0xdc, 0xaf, 0x51, 0x1c, 0xf4, 0x7d, 0xa3, 0xe4, 0x85, 0x93, 0x92, 0x7c, 0xff, 0x75, 0xff, 0x0d,
-	0xad, 0x55, 0xb6, 0xa1, 0x72, 0xf0, 0xb6, 0xfb, 0x1c, 0xff, 0x5b, 0xe9, 0xe3, 0x5f, 0x6a, 0xe8,
-	0xba, 0xec, 0x8c, 0xf4, 0x74, 0x67, 0xa4, 0xfb, 0x9b, 0x5d, 0x51, 0x64, 0xd2, 0x19, 0xe9, 0xf7,
-	0x23, 0xd3, 0x63, 0x84, 0xed, 0xa4, 0xaf, 0xcb, 0x37, 0x52, 0x31, 0x1a, 0xd8, 0x0f, 0x08, 0x0d,
-	0x08, 0x23, 0x1f, 0x1f, 0x42, 0x59, 0xf5, 0x4c, 0x01, 0xd4, 0xa6, 0xd6, 0xa2, 0xe9, 0xd9, 0xd8,
-	0x75, 0x0f, 0xa1, 0xae, 0xd0, 0x9e, 0xca, 0x26, 0x33, 0xce, 0xf0, 0x10, 0x42, 0xf8, 0xbd, 0x84,
-	0xf0, 0x01, 0x0e, 0x7a, 0xc4, 0x33, 0xd9, 0xd1, 0x6b, 0x6d, 0x7e, 0xcc, 0xc3, 0xa4, 0xc8, 0x79,
-	0x05, 0x87, 0xa1, 0xd9, 0xc5, 0xe8, 0x1a, 0x14, 0xc3, 0xa4, 0xa7, 0x17, 0xd9, 0x97, 0x1a, 0x73,
-	0xc9, 0xa5, 0x1e, 0x6e, 0xf6, 0x5b, 0x19, 0x63, 0xe0, 0x8a, 0x2e, 0xc3, 0x84, 0x48, 0xd8, 0x89,
-	0x2f, 0xcf, 0xc9, 0x24, 0x28, 0xd5, 0x5e, 0xb7, 0x32, 0x46, 0xec, 0x84, 0x96, 0x60, 0xda, 0x49,
-	0x3a, 0xdb, 0xce, 0x1a, 0x6f, 0x6d, 0xd5, 0x19, 0x11, 0x77, 0x26, 0x89, 0xdb, 0xa7, 0xf1, 0x6d,
-	0x65, 0x8c, 0xb2, 0x33, 0x64, 0xe6, 0xdb, 0xba, 0xa2, 0xa7, 0x14, 0xa8, 0xa5, 0xb6, 0x4d, 0x75,
-	0x9a, 0x7c, 0x5b, 0xe9, 0x84, 0x16, 0xa1, 0x2c, 0x7e, 0x75, 0x82, 0xb8, 0x8d, 0xeb, 0x83, 0x9a,
-	0x0e, 0x1b, 0xea, 0xf1, 0x5a, 0x19, 0x63, 0xca, 0x4d, 0x5b, 0xd1, 0xfb, 0x20, 0x0d, 0x1d, 0x2c,
-	0xfb, 0xa5, 0xf8, 0x3f, 0x86, 0xff, 0x0d, 0xad, 0x91, 0xee, 0xa5, 0x5a, 0x19, 0x63, 0xd2, 0x4d,
-	0x19, 0xd1, 0x15, 0xc8, 0xfb, 0xb2, 0x99, 0x11, 0x24, 0x9b, 0xbc, 0xf3, 0x23, 0x3d, 0x4e, 0x2b,
-	0x63, 0x24, 0x6e, 0x3c, 0x22, 0x90, 0xe2, 0x5f, 0x3c, 0x4c, 0xa9, 0x88, 0x74, 0x4f, 0xc0, 0x23,
-	0x62, 0x37, 0xb4, 0x02, 0x28, 0x12, 0x7a, 0xb8, 0xc3, 0x68, 0x27, 0x8c, 0x15, 0xb1, 0x60, 0xd0,
-	0x52, 0xe3, 0x5c, 0x9f, 0xa6, 0xf7, 0x53, 0xcc, 0xad, 0x8c, 0x31, 0x13, 0x8d, 0x4c, 0x70, 0xa0,
-	0xd7, 0x84, 0x66, 0x12, 0x34, 0x9b, 0x02, 0x3a, 0xa5, 0xa4, 0x38, 0xd0, 0xd2, 0x49, 0x1e, 0xa3,
-	0x58, 0x2b, 0x08, 0xc9, 0x33, 0x74, 0x8c, 0xd2, 0x22, 0x42, 0x1e, 0xa3, 0xd8, 0x82, 0x16, 0x60,
-	0x2a, 0x48, 0x93, 0xa6, 0x5a, 0x1a, 0xfe, 0x3e, 0x7b, 0x19, 0x95, 0x7f, 0x9f, 0xa1, 0x10, 0xf4,
-	0x2e, 0x80, 0xdd, 0xe7, 0x34, 0x21, 0x88, 0x4a, 0x8d, 0xd3, 0xc9, 0x02, 0x23, 0x6c, 0xd7, 0xca,
-	0x18, 0x29, 0x67, 0x9e, 0xb6, 0x9d, 0x90, 0x8d, 0x3a, 0x35, 0x9c, 0xf6, 0x30, 0x0b, 0xf1, 0xb4,
-	0xfb, 0xae, 0x7c, 0x4b, 0xd6, 0xe7, 0x00, 0xb5, 0x3c, 0xbc, 0xe5, 0x08, 0x3b, 0xf0, 0x2d, 0x07,
-	0xce, 0xe8, 0x26, 0x94, 0xa2, 0xc1, 0x63, 0xa9, 0x4e, 0x8b, 0x58, 0xf5, 0xa0, 0x77, 0xb4, 0x95,
-	0x31, 0xd2, 0xee, 0x0b, 0x05, 0x98, 0x10, 0x7f, 0x38, 0x86, 0xda, 0x17, 0x0a, 0x4c, 0x8f, 0x08,
-	0x45, 0x84, 0x60, 0x5c, 0xbc, 0x9b, 0x92, 0x85, 0xc4, 0x6f, 0x54, 0x81, 0x42, 0x22, 0x6e, 0x63,
-	0x99, 0xd7, 0x1f, 0x23, 0x15, 0xf2, 0x3d, 0xc9, 0x03, 0x31, 0x09, 0x25, 0xc3, 0x94, 0xc8, 0x1e,
-	0x1f, 0x12, 0xd9, 0x7d, 0xdd, 0x99, 0x3b, 0x40, 0x77, 0x6a, 0xd7, 0xa0, 0x28, 0x32, 0xbf, 0x4d,
-	0x42, 0x86, 0xfe, 0x9f, 0xa4, 0xab, 0x2a, 0x42, 0x2f, 0x9c, 0x10, 0xfe, 0x69, 0x02, 0x32, 0x92,
-	0x7a, 0xee, 0x03, 0x12, 0xf6, 0x55, 0x16, 0x60, 0xb3, 0x97, 0xd0, 0x53, 0x19, 0xb2, 0x7d, 0x56,
-	0xcd, 0x12, 0x07, 0xbd, 0x35, 0xc8, 0x58, 0xf2, 0xce, 0x3e, 0x2b, 0x26, 0x1e, 0x5a, 0x08, 0x53,
-	0x6d, 0xc1, 0xb6, 0x06, 0x7e, 0x18, 0xe1, 0x90, 0xed, 0x59, 0x6d, 0x16, 0x72, 0x1f, 0x99, 0xcc,
-	0x5e, 0x17, 0x6b, 0x15, 0x0c, 0x39, 0x40, 0x17, 0x61, 0x7a, 0x2d, 0xa0, 0xbd, 0x4e, 0xbc, 0x0c,
-	0xe7, 0x51, 0x89, 0xce, 0x14, 0x37, 0xc7, 0xbb, 0xa4, 0x09, 0x7c, 0x3c, 0x45, 0xe0, 0x97, 0xe6,
-	0x21, 0x27, 0xf0, 0x40, 0x45, 0xc8, 0x35, 0x83, 0x80, 0x06, 0x33, 0x19, 0x54, 0x82, 0x7c, 0x73,
-	0x8b, 0xd8, 0x0c, 0x3b, 0x33, 0x0a, 0xca, 0xc3, 0xd8, 0xdd, 0xbb, 0x2b, 0x33, 0xd9, 0xc6, 0xaf,
-	0x0a, 0xe4, 0xe4, 0xfb, 0x71, 0x1d, 0xca, 0x06, 0xf6, 0x69, 0xc0, 0x56, 0x22, 0x97, 0x11, 0xdf,
-	0xc5, 0xa8, 0x3c, 0x28, 0x8b, 0x03, 0x59, 0x99, 0xdb, 0xf3, 0x0a, 0x34, 0x7b, 0x3e, 0xdb, 0x41,
-	0x57, 0x61, 0x42, 0x46, 0xa2, 0xbd, 0x40, 0x1c, 0x18, 0x84, 0x61, 0xfa, 0x03, 0xcc, 0x24, 0x34,
-	0x22, 0x20, 0x44, 0xa8, 0x7f, 0x59, 0xfb, 0x68, 0x55, 0x4e, 0x0f, 0x56, 0x1c, 0xfa, 0x28, 0xda,
-	0x85, 0x4f, 0x7f, 0xfe, 0xeb, 0xf3, 0xec, 0x39, 0x4d, 0xad, 0x6f, 0xbd, 0x5d, 0xdf, 0xa0, 0xd6,
-	0xe5, 0x10, 0xb3, 0xfa, 0x23, 0x51, 0xfe, 0xe3, 0xfa, 0x23, 0xe2, 0x3c, 0xbe, 0xa1, 0x5c, 0xba,
-	0xa2, 0x2c, 0xd4, 0x5e, 0xfe, 0x59, 0xcd, 0x7c, 0xb2, 0x5b, 0x55, 0x9e, 0xef, 0x56, 0x95, 0x17,
-	0xbb, 0x55, 0xe5, 0x8f, 0xdd, 0xaa, 0xf2, 0xe4, 0x55, 0x35, 0xf3, 0xe2, 0x55, 0x35, 0xf3, 0xf2,
-	0x55, 0x35, 0x63, 0x4d, 0x88, 0xc4, 0xae, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x5c, 0x91,
-	0xc4, 0x4d, 0x17, 0x00, 0x00,
+func (m *JobProtectedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// EventClient is the client API for Event service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EventClient interface {
-	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
-	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
-	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
+func (m *JobProtectedEvent) GetRequeueReason() RequeueReason {
+	if m != nil {
+		return m.RequeueReason
+	}
+	return RequeueReason_UnspecifiedRequeueReason
 }
 
-type eventClient struct {
-	cc *grpc.ClientConn
+type JobTerminatedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
 }
 
-func NewEventClient(cc *grpc.ClientConn) EventClient {
-	return &eventClient{cc}
+func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
+func (*JobTerminatedEvent) ProtoMessage() {}
+func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
-
-func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobTerminatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *JobTerminatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTerminatedEvent.Merge(m, src)
+}
+func (m *JobTerminatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTerminatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTerminatedEvent.DiscardUnknown(m)
 }
 
-func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &eventGetJobSetEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_JobTerminatedEvent proto.InternalMessageInfo
+
+func (m *JobTerminatedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return x, nil
+	return ""
 }
 
-type Event_GetJobSetEventsClient interface {
-	Recv() (*EventStreamMessage, error)
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-type eventGetJobSetEventsClient struct {
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
-	m := new(EventStreamMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return m, nil
+	return time.Time{}
 }
 
-// EventServer is the server API for Event service.
-type EventServer interface {
-	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
-	Report(context.Context, *EventMessage) (*types.Empty, error)
-	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
+func (m *JobTerminatedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
 }
 
-// UnimplementedEventServer can be embedded to have forward compatible implementations.
-type UnimplementedEventServer struct {
+type EventMessage struct {
+	// Types that are valid to be assigned to Events:
+	//	*EventMessage_Submitted
+	//	*EventMessage_Queued
+	//	*EventMessage_DuplicateFound
+	//	*EventMessage_Leased
+	//	*EventMessage_LeaseReturned
+	//	*EventMessage_LeaseExpired
+	//	*EventMessage_Pending
+	//	*EventMessage_Running
+	//	*EventMessage_UnableToSchedule
+	//	*EventMessage_Failed
+	//	*EventMessage_Succeeded
+	//	*EventMessage_Reprioritized
+	//	*EventMessage_Cancelling
+	//	*EventMessage_Cancelled
+	//	*EventMessage_Terminated
+	//	*EventMessage_Utilisation
+	//	*EventMessage_ServiceCreated
+	//	*EventMessage_GangPlaced
+	//	*EventMessage_GangRejected
+	//	*EventMessage_MemoryIncreased
+	//	*EventMessage_DeadlineExceeded
+	//	*EventMessage_Protected
+	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
-func (*UnimplementedEventServer) ReportMultiple(ctx context.Context, req *EventList) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportMultiple not implemented")
+func (m *EventMessage) Reset()      { *m = EventMessage{} }
+func (*EventMessage) ProtoMessage() {}
+func (*EventMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
 }
-func (*UnimplementedEventServer) Report(ctx context.Context, req *EventMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_GetJobSetEventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetJobSetEvents not implemented")
+func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMessage.Merge(m, src)
+}
+func (m *EventMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMessage.DiscardUnknown(m)
 }
 
-func RegisterEventServer(s *grpc.Server, srv EventServer) {
-	s.RegisterService(&_Event_serviceDesc, srv)
+var xxx_messageInfo_EventMessage proto.InternalMessageInfo
+
+type isEventMessage_Events interface {
+	isEventMessage_Events()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).ReportMultiple(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/ReportMultiple",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
-	}
-	return interceptor(ctx, in, info, handler)
+type EventMessage_Submitted struct {
+	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof" json:"submitted,omitempty"`
+}
+type EventMessage_Queued struct {
+	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
+}
+type EventMessage_DuplicateFound struct {
+	DuplicateFound *JobDuplicateFoundEvent `protobuf:"bytes,16,opt,name=duplicate_found,json=duplicateFound,proto3,oneof" json:"duplicateFound,omitempty"`
+}
+type EventMessage_Leased struct {
+	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof" json:"leased,omitempty"`
+}
+type EventMessage_LeaseReturned struct {
+	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=lease_returned,json=leaseReturned,proto3,oneof" json:"leaseReturned,omitempty"`
+}
+type EventMessage_LeaseExpired struct {
+	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=lease_expired,json=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
+}
+type EventMessage_Pending struct {
+	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
+}
+type EventMessage_Running struct {
+	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof" json:"running,omitempty"`
+}
+type EventMessage_UnableToSchedule struct {
+	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unable_to_schedule,json=unableToSchedule,proto3,oneof" json:"unableToSchedule,omitempty"`
+}
+type EventMessage_Failed struct {
+	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
+}
+type EventMessage_Succeeded struct {
+	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof" json:"succeeded,omitempty"`
+}
+type EventMessage_Reprioritized struct {
+	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof" json:"reprioritized,omitempty"`
+}
+type EventMessage_Cancelling struct {
+	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof" json:"cancelling,omitempty"`
+}
+type EventMessage_Cancelled struct {
+	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof" json:"cancelled,omitempty"`
+}
+type EventMessage_Terminated struct {
+	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof" json:"terminated,omitempty"`
+}
+type EventMessage_Utilisation struct {
+	Utilisation *JobUtilisationEvent `protobuf:"bytes,15,opt,name=utilisation,proto3,oneof" json:"utilisation,omitempty"`
+}
+type EventMessage_ServiceCreated struct {
+	ServiceCreated *JobServiceCreatedEvent `protobuf:"bytes,17,opt,name=service_created,json=serviceCreated,proto3,oneof" json:"serviceCreated,omitempty"`
+}
+type EventMessage_GangPlaced struct {
+	GangPlaced *JobGangPlacedEvent `protobuf:"bytes,18,opt,name=gang_placed,json=gangPlaced,proto3,oneof" json:"gangPlaced,omitempty"`
+}
+type EventMessage_GangRejected struct {
+	GangRejected *JobGangRejectedEvent `protobuf:"bytes,19,opt,name=gang_rejected,json=gangRejected,proto3,oneof" json:"gangRejected,omitempty"`
+}
+type EventMessage_MemoryIncreased struct {
+	MemoryIncreased *JobMemoryIncreasedEvent `protobuf:"bytes,20,opt,name=memory_increased,json=memoryIncreased,proto3,oneof" json:"memoryIncreased,omitempty"`
+}
+type EventMessage_DeadlineExceeded struct {
+	DeadlineExceeded *JobDeadlineExceededEvent `protobuf:"bytes,21,opt,name=deadline_exceeded,json=deadlineExceeded,proto3,oneof" json:"deadlineExceeded,omitempty"`
+}
+type EventMessage_Protected struct {
+	Protected *JobProtectedEvent `protobuf:"bytes,22,opt,name=protected,proto3,oneof" json:"protected,omitempty"`
 }
 
-func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).Report(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/Report",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).Report(ctx, req.(*EventMessage))
+func (*EventMessage_Submitted) isEventMessage_Events()        {}
+func (*EventMessage_Queued) isEventMessage_Events()           {}
+func (*EventMessage_DuplicateFound) isEventMessage_Events()   {}
+func (*EventMessage_Leased) isEventMessage_Events()           {}
+func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
+func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
+func (*EventMessage_Pending) isEventMessage_Events()          {}
+func (*EventMessage_Running) isEventMessage_Events()          {}
+func (*EventMessage_UnableToSchedule) isEventMessage_Events() {}
+func (*EventMessage_Failed) isEventMessage_Events()           {}
+func (*EventMessage_Succeeded) isEventMessage_Events()        {}
+func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
+func (*EventMessage_Cancelling) isEventMessage_Events()       {}
+func (*EventMessage_Cancelled) isEventMessage_Events()        {}
+func (*EventMessage_Terminated) isEventMessage_Events()       {}
+func (*EventMessage_Utilisation) isEventMessage_Events()      {}
+func (*EventMessage_ServiceCreated) isEventMessage_Events()   {}
+func (*EventMessage_GangPlaced) isEventMessage_Events()       {}
+func (*EventMessage_GangRejected) isEventMessage_Events()     {}
+func (*EventMessage_MemoryIncreased) isEventMessage_Events()  {}
+func (*EventMessage_DeadlineExceeded) isEventMessage_Events() {}
+func (*EventMessage_Protected) isEventMessage_Events()        {}
+
+func (m *EventMessage) GetEvents() isEventMessage_Events {
+	if m != nil {
+		return m.Events
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(JobSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
+		return x.Submitted
 	}
-	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
+	return nil
 }
 
-type Event_GetJobSetEventsServer interface {
-	Send(*EventStreamMessage) error
-	grpc.ServerStream
+func (m *EventMessage) GetQueued() *JobQueuedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
+		return x.Queued
+	}
+	return nil
 }
 
-type eventGetJobSetEventsServer struct {
-	grpc.ServerStream
+func (m *EventMessage) GetDuplicateFound() *JobDuplicateFoundEvent {
+	if x, ok := m.GetEvents().(*EventMessage_DuplicateFound); ok {
+		return x.DuplicateFound
+	}
+	return nil
 }
 
-func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
-	return x.ServerStream.SendMsg(m)
+func (m *EventMessage) GetLeased() *JobLeasedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
+		return x.Leased
+	}
+	return nil
 }
 
-var _Event_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Event",
-	HandlerType: (*EventServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ReportMultiple",
-			Handler:    _Event_ReportMultiple_Handler,
-		},
-		{
-			MethodName: "Report",
-			Handler:    _Event_Report_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetJobSetEvents",
-			Handler:       _Event_GetJobSetEvents_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/api/event.proto",
+func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
+		return x.LeaseReturned
+	}
+	return nil
 }
 
-func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
+		return x.LeaseExpired
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetPending() *JobPendingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
+		return x.Pending
+	}
+	return nil
 }
 
-func (m *JobSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintEvent(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMessage) GetRunning() *JobRunningEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
+		return x.Running
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
+	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
+		return x.UnableToSchedule
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetFailed() *JobFailedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
+		return x.Failed
+	}
+	return nil
 }
 
-func (m *JobQueuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintEvent(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
+		return x.Succeeded
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
+		return x.Reprioritized
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetCancelling() *JobCancellingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
+		return x.Cancelling
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetCancelled() *JobCancelledEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
+		return x.Cancelled
+	}
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalJobId) > 0 {
-		i -= len(m.OriginalJobId)
-		copy(dAtA[i:], m.OriginalJobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.OriginalJobId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
+		return x.Terminated
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err4 != nil {
-		return 0, err4
+	return nil
+}
+
+func (m *EventMessage) GetUtilisation() *JobUtilisationEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Utilisation); ok {
+		return x.Utilisation
 	}
-	i -= n4
-	i = encodeVarintEvent(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventMessage) GetServiceCreated() *JobServiceCreatedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_ServiceCreated); ok {
+		return x.ServiceCreated
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMessage) GetGangPlaced() *JobGangPlacedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_GangPlaced); ok {
+		return x.GangPlaced
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventMessage) GetGangRejected() *JobGangRejectedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_GangRejected); ok {
+		return x.GangRejected
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetMemoryIncreased() *JobMemoryIncreasedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_MemoryIncreased); ok {
+		return x.MemoryIncreased
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetDeadlineExceeded() *JobDeadlineExceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_DeadlineExceeded); ok {
+		return x.DeadlineExceeded
+	}
+	return nil
 }
 
-func (m *JobLeasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintEvent(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMessage) GetProtected() *JobProtectedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Protected); ok {
+		return x.Protected
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EventMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EventMessage_Submitted)(nil),
+		(*EventMessage_Queued)(nil),
+		(*EventMessage_DuplicateFound)(nil),
+		(*EventMessage_Leased)(nil),
+		(*EventMessage_LeaseReturned)(nil),
+		(*EventMessage_LeaseExpired)(nil),
+		(*EventMessage_Pending)(nil),
+		(*EventMessage_Running)(nil),
+		(*EventMessage_UnableToSchedule)(nil),
+		(*EventMessage_Failed)(nil),
+		(*EventMessage_Succeeded)(nil),
+		(*EventMessage_Reprioritized)(nil),
+		(*EventMessage_Cancelling)(nil),
+		(*EventMessage_Cancelled)(nil),
+		(*EventMessage_Terminated)(nil),
+		(*EventMessage_Utilisation)(nil),
+		(*EventMessage_ServiceCreated)(nil),
+		(*EventMessage_GangPlaced)(nil),
+		(*EventMessage_GangRejected)(nil),
+		(*EventMessage_MemoryIncreased)(nil),
+		(*EventMessage_DeadlineExceeded)(nil),
+		(*EventMessage_Protected)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ContainerStatus struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cause    Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	// Last lines of the container logs, captured when the executor is configured to attach logs of failed pods
+	LogTail string `protobuf:"bytes,6,opt,name=log_tail,json=logTail,proto3" json:"logTail,omitempty"`
 }
 
-func (m *JobLeaseReturnedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintEvent(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
+func (*ContainerStatus) ProtoMessage() {}
+func (*ContainerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
+}
+func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ContainerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerStatus.Merge(m, src)
+}
+func (m *ContainerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerStatus.DiscardUnknown(m)
 }
 
-func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContainerStatus proto.InternalMessageInfo
+
+func (m *ContainerStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContainerStatus) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
 }
 
-func (m *JobLeaseExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintEvent(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+func (m *ContainerStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ContainerStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ContainerStatus) GetCause() Cause {
+	if m != nil {
+		return m.Cause
 	}
-	return len(dAtA) - i, nil
+	return Cause_Error
 }
 
-func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContainerStatus) GetLogTail() string {
+	if m != nil {
+		return m.LogTail
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventList struct {
+	Events []*EventMessage `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (m *JobPendingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintEvent(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventList) Reset()      { *m = EventList{} }
+func (*EventList) ProtoMessage() {}
+func (*EventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{26}
+}
+func (m *EventList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventList.Merge(m, src)
+}
+func (m *EventList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventList.DiscardUnknown(m)
 }
 
-func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventList proto.InternalMessageInfo
+
+func (m *EventList) GetEvents() []*EventMessage {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// swagger:model
+type EventStreamMessage struct {
+	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
+func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
+func (*EventStreamMessage) ProtoMessage() {}
+func (*EventStreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{27}
+}
+func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStreamMessage.Merge(m, src)
+}
+func (m *EventStreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+
+func (m *EventStreamMessage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventStreamMessage) GetMessage() *EventMessage {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+// swagger:model
+type JobSetRequest struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Watch         bool   `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+	FromMessageId string `protobuf:"bytes,3,opt,name=from_message_id,json=fromMessageId,proto3" json:"fromMessageId,omitempty"`
+	Queue         string `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Names of the event types to send, e.g. failed, succeeded, cancelled. All events are sent when empty.
+	EventTypes []string `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"eventTypes,omitempty"`
+}
+
+func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
+func (*JobSetRequest) ProtoMessage() {}
+func (*JobSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{28}
+}
+func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err9 != nil {
-		return 0, err9
+}
+func (m *JobSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetRequest.Merge(m, src)
+}
+func (m *JobSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+
+func (m *JobSetRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	i -= n9
-	i = encodeVarintEvent(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *JobSetRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *JobSetRequest) GetFromMessageId() string {
+	if m != nil {
+		return m.FromMessageId
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *JobSetRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobSetRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WatchJobsRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 }
 
-func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintEvent(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *WatchJobsRequest) Reset()      { *m = WatchJobsRequest{} }
+func (*WatchJobsRequest) ProtoMessage() {}
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{29}
+}
+func (m *WatchJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *WatchJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobsRequest.Merge(m, src)
+}
+func (m *WatchJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobsRequest proto.InternalMessageInfo
+
+func (m *WatchJobsRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WatchJobsRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobState struct {
+	JobId              string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Phase              JobPhase  `protobuf:"varint,2,opt,name=phase,proto3,enum=api.JobPhase" json:"phase,omitempty"`
+	ClusterId          string    `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	LastTransitionTime time.Time `protobuf:"bytes,4,opt,name=last_transition_time,json=lastTransitionTime,proto3,stdtime" json:"last_transition_time"`
 }
 
-func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Cause != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ContainerStatuses) > 0 {
-		for iNdEx := len(m.ContainerStatuses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContainerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ExitCodes) > 0 {
-		for k := range m.ExitCodes {
-			v := m.ExitCodes[k]
-			baseI := i
-			i = encodeVarintEvent(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+func (m *JobState) Reset()      { *m = JobState{} }
+func (*JobState) ProtoMessage() {}
+func (*JobState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{30}
+}
+func (m *JobState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *JobState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobState.Merge(m, src)
+}
+func (m *JobState) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobState) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobState proto.InternalMessageInfo
+
+func (m *JobState) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *JobState) GetPhase() JobPhase {
+	if m != nil {
+		return m.Phase
 	}
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err11 != nil {
-		return 0, err11
+	return JobPhase_UnspecifiedJobPhase
+}
+
+func (m *JobState) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	i -= n11
-	i = encodeVarintEvent(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *JobState) GetLastTransitionTime() time.Time {
+	if m != nil {
+		return m.LastTransitionTime
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return time.Time{}
+}
+
+type JobStateUpdate struct {
+	// The first update of the stream is a snapshot of all jobs of the job set, later updates only contain jobs which changed
+	Snapshot bool        `protobuf:"varint,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Jobs     []*JobState `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *JobStateUpdate) Reset()      { *m = JobStateUpdate{} }
+func (*JobStateUpdate) ProtoMessage() {}
+func (*JobStateUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{31}
+}
+func (m *JobStateUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStateUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStateUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *JobStateUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStateUpdate.Merge(m, src)
+}
+func (m *JobStateUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStateUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStateUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStateUpdate proto.InternalMessageInfo
+
+func (m *JobStateUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobStateUpdate) GetJobs() []*JobState {
+	if m != nil {
+		return m.Jobs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobSetStatusRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 }
 
-func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err12 != nil {
-		return 0, err12
-	}
-	i -= n12
-	i = encodeVarintEvent(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *JobSetStatusRequest) Reset()      { *m = JobSetStatusRequest{} }
+func (*JobSetStatusRequest) ProtoMessage() {}
+func (*JobSetStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{32}
+}
+func (m *JobSetStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *JobSetStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetStatusRequest.Merge(m, src)
+}
+func (m *JobSetStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetStatusRequest proto.InternalMessageInfo
+
+func (m *JobSetStatusRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobUtilisationEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobSetStatusRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobUtilisationEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Number of jobs of the job set in each state, pending jobs are counted as leased and duplicate jobs are not counted
+type JobSetStatus struct {
+	Queue     string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId  string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queued    int32  `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	Leased    int32  `protobuf:"varint,4,opt,name=leased,proto3" json:"leased,omitempty"`
+	Running   int32  `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	Succeeded int32  `protobuf:"varint,6,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled int32  `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
-func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k := range m.MaxResourcesForPeriod {
-			v := m.MaxResourcesForPeriod[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+func (m *JobSetStatus) Reset()      { *m = JobSetStatus{} }
+func (*JobSetStatus) ProtoMessage() {}
+func (*JobSetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{33}
+}
+func (m *JobSetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err14 != nil {
-		return 0, err14
-	}
-	i -= n14
-	i = encodeVarintEvent(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *JobSetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetStatus.Merge(m, src)
+}
+func (m *JobSetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetStatus.DiscardUnknown(m)
 }
 
-func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSetStatus proto.InternalMessageInfo
+
+func (m *JobSetStatus) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobSetStatus) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err15 != nil {
-		return 0, err15
+func (m *JobSetStatus) GetQueued() int32 {
+	if m != nil {
+		return m.Queued
 	}
-	i -= n15
-	i = encodeVarintEvent(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *JobSetStatus) GetLeased() int32 {
+	if m != nil {
+		return m.Leased
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *JobSetStatus) GetRunning() int32 {
+	if m != nil {
+		return m.Running
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *JobSetStatus) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobSetStatus) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobSetStatus) GetCancelled() int32 {
+	if m != nil {
+		return m.Cancelled
+	}
+	return 0
 }
 
-func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err16 != nil {
-		return 0, err16
-	}
-	i -= n16
-	i = encodeVarintEvent(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
+	proto.RegisterEnum("api.JobPhase", JobPhase_name, JobPhase_value)
+	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
+	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
+	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
+	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
+	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
+	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
+	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
+	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobRunningEvent.NodeLabelsEntry")
+	proto.RegisterType((*JobSchedulingLatency)(nil), "api.JobSchedulingLatency")
+	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
+	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
+	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
+	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
+	proto.RegisterType((*JobRunDetails)(nil), "api.JobRunDetails")
+	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
+	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
+	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
+	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
+	proto.RegisterType((*JobServiceCreatedEvent)(nil), "api.JobServiceCreatedEvent")
+	proto.RegisterType((*JobGangPlacedEvent)(nil), "api.JobGangPlacedEvent")
+	proto.RegisterType((*JobGangRejectedEvent)(nil), "api.JobGangRejectedEvent")
+	proto.RegisterType((*JobDeadlineExceededEvent)(nil), "api.JobDeadlineExceededEvent")
+	proto.RegisterType((*JobMemoryIncreasedEvent)(nil), "api.JobMemoryIncreasedEvent")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobMemoryIncreasedEvent.MemoryLimitsEntry")
+	proto.RegisterType((*JobProtectedEvent)(nil), "api.JobProtectedEvent")
+	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
+	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
+	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
+	proto.RegisterType((*EventList)(nil), "api.EventList")
+	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
+	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+	proto.RegisterType((*WatchJobsRequest)(nil), "api.WatchJobsRequest")
+	proto.RegisterType((*JobState)(nil), "api.JobState")
+	proto.RegisterType((*JobStateUpdate)(nil), "api.JobStateUpdate")
+	proto.RegisterType((*JobSetStatusRequest)(nil), "api.JobSetStatusRequest")
+	proto.RegisterType((*JobSetStatus)(nil), "api.JobSetStatus")
 }
 
-func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
+
+var fileDescriptor_7758595c3bb8cf56 = []byte{
+	// 2581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x1a, 0x5b, 0x6b, 0x1b, 0xd9,
+	0x59, 0xa3, 0x8b, 0x25, 0x7d, 0xb2, 0x64, 0xf9, 0xd8, 0x71, 0x66, 0x95, 0xc4, 0xf1, 0x4e, 0x4a,
+	0x9b, 0xcd, 0x12, 0x29, 0x75, 0xca, 0x92, 0x4d, 0xd3, 0x12, 0x7c, 0xc9, 0xda, 0xc6, 0x4e, 0x9c,
+	0xb1, 0xd3, 0xd2, 0x27, 0x31, 0xd2, 0x1c, 0xcb, 0xc7, 0x19, 0xcd, 0x99, 0xce, 0x9c, 0x49, 0xec,
+	0x2c, 0x81, 0x52, 0xe8, 0x53, 0xa1, 0x04, 0xba, 0x0f, 0x85, 0xd2, 0xff, 0xd0, 0xa7, 0x16, 0x0a,
+	0xbb, 0x94, 0x42, 0x61, 0xa1, 0x2f, 0x0b, 0xdb, 0x87, 0x7d, 0x28, 0xe9, 0x6e, 0x52, 0xe8, 0x4b,
+	0x1f, 0xfb, 0xde, 0x72, 0x2e, 0x33, 0x9a, 0x91, 0x9c, 0xcb, 0x76, 0x1b, 0xb0, 0xb3, 0x6f, 0x3a,
+	0xdf, 0xed, 0x9c, 0xef, 0x3b, 0xdf, 0xed, 0x7c, 0x23, 0x98, 0xf2, 0xee, 0xf6, 0x5a, 0x96, 0x47,
+	0x5a, 0xf8, 0x1e, 0x76, 0x59, 0xd3, 0xf3, 0x29, 0xa3, 0x28, 0x67, 0x79, 0xa4, 0x71, 0xb6, 0x47,
+	0x69, 0xcf, 0xc1, 0x2d, 0x01, 0xea, 0x84, 0x3b, 0x2d, 0x46, 0xfa, 0x38, 0x60, 0x56, 0xdf, 0x93,
+	0x54, 0x8d, 0xd9, 0x61, 0x02, 0x3b, 0xf4, 0x2d, 0x46, 0xa8, 0xab, 0xf0, 0xb1, 0xe8, 0x1f, 0x87,
+	0x38, 0xc4, 0x0a, 0x78, 0x6a, 0x98, 0x09, 0xf7, 0x3d, 0x76, 0xa0, 0x90, 0x17, 0x7b, 0x84, 0xed,
+	0x86, 0x9d, 0x66, 0x97, 0xf6, 0x5b, 0x3d, 0xda, 0xa3, 0x03, 0x2a, 0xbe, 0x12, 0x0b, 0xf1, 0x4b,
+	0x91, 0x9f, 0x56, 0xb2, 0xf8, 0x1e, 0x96, 0xeb, 0x52, 0x26, 0x76, 0x0f, 0x14, 0xf6, 0x3b, 0x77,
+	0xaf, 0x04, 0x4d, 0x42, 0x39, 0xb6, 0x6f, 0x75, 0x77, 0x89, 0x8b, 0xfd, 0x83, 0x56, 0x74, 0x24,
+	0x1f, 0x07, 0x34, 0xf4, 0xbb, 0xb8, 0xd5, 0xc3, 0x2e, 0xf6, 0x2d, 0x86, 0x6d, 0xc9, 0x65, 0xfc,
+	0x51, 0x83, 0xc9, 0x35, 0xda, 0xd9, 0x0a, 0x3b, 0x7d, 0xc2, 0x18, 0xb6, 0x97, 0xb9, 0x59, 0xd0,
+	0x09, 0x18, 0xdb, 0xa3, 0x9d, 0x36, 0xb1, 0x75, 0x6d, 0x4e, 0x3b, 0x5f, 0x36, 0x0b, 0x7b, 0xb4,
+	0xb3, 0x6a, 0xa3, 0xd3, 0x00, 0x1c, 0x1c, 0x60, 0xc6, 0x51, 0x59, 0x81, 0x2a, 0xed, 0xd1, 0xce,
+	0x16, 0x66, 0xab, 0x36, 0x9a, 0x86, 0x82, 0xd0, 0x5c, 0xcf, 0x49, 0x1e, 0xb1, 0x40, 0xdf, 0x87,
+	0x62, 0xd7, 0xc7, 0x7c, 0x47, 0x3d, 0x3f, 0xa7, 0x9d, 0xaf, 0xcc, 0x37, 0x9a, 0x52, 0x8d, 0x66,
+	0xa4, 0x6c, 0x73, 0x3b, 0x32, 0xf4, 0x42, 0xe9, 0xe3, 0xc7, 0x67, 0x33, 0x8f, 0xfe, 0x7e, 0x56,
+	0x33, 0x23, 0x26, 0x34, 0x07, 0xb9, 0x3d, 0xda, 0xd1, 0x0b, 0x82, 0xb7, 0xd4, 0xb4, 0x3c, 0xd2,
+	0x5c, 0xa3, 0x9d, 0x85, 0x3c, 0xa7, 0x34, 0x39, 0xca, 0xf8, 0xb5, 0x06, 0xb5, 0x35, 0xda, 0xb9,
+	0xcd, 0xb7, 0x3b, 0x72, 0xe7, 0x37, 0xfe, 0xa2, 0xc1, 0xcc, 0x1a, 0xed, 0x2c, 0x85, 0x9e, 0x43,
+	0xba, 0x16, 0xc3, 0x37, 0x68, 0xe8, 0x1e, 0x3d, 0x2b, 0x7f, 0x13, 0x26, 0xa8, 0x4f, 0x7a, 0xc4,
+	0xb5, 0x9c, 0xb6, 0x3a, 0x53, 0x41, 0xc8, 0xaf, 0x46, 0xe0, 0x35, 0x7e, 0x36, 0xe3, 0x0f, 0xd2,
+	0xd6, 0xeb, 0xd8, 0x0a, 0x8e, 0xa0, 0xaf, 0x9c, 0x01, 0xe8, 0x3a, 0x61, 0xc0, 0xb0, 0x3f, 0x50,
+	0xa0, 0xac, 0x20, 0xab, 0xb6, 0xf1, 0x41, 0x16, 0x4e, 0x44, 0x87, 0x37, 0x31, 0x0b, 0x7d, 0xf7,
+	0xd8, 0xe9, 0x80, 0x66, 0x60, 0xcc, 0xc7, 0x56, 0x40, 0x5d, 0x7d, 0x4c, 0xa0, 0xd4, 0x0a, 0xbd,
+	0x0b, 0x35, 0x1f, 0x8b, 0x13, 0xb4, 0x15, 0xbe, 0x38, 0xa7, 0x9d, 0xaf, 0xcd, 0x23, 0x11, 0x31,
+	0xa6, 0x44, 0x99, 0x02, 0x63, 0x56, 0xfd, 0xe4, 0xd2, 0xf8, 0x9b, 0x06, 0xd3, 0x91, 0x59, 0x96,
+	0xf7, 0x3d, 0xe2, 0x1f, 0x41, 0xab, 0x8c, 0xaa, 0x57, 0x78, 0x59, 0xf5, 0xfe, 0xa3, 0xc1, 0xc4,
+	0x1a, 0xed, 0x6c, 0x62, 0xd7, 0x26, 0x6e, 0xef, 0xb8, 0xdd, 0xf7, 0x39, 0xa8, 0xde, 0x0d, 0x3b,
+	0xd8, 0x77, 0x31, 0xc3, 0x01, 0xa7, 0x90, 0xd7, 0x3e, 0x3e, 0x00, 0xae, 0x0a, 0x19, 0x1e, 0xb5,
+	0xdb, 0x6e, 0xd8, 0xef, 0x60, 0x5f, 0x5c, 0x7c, 0xc1, 0x2c, 0x7b, 0xd4, 0xbe, 0x29, 0x00, 0xc6,
+	0xbf, 0x72, 0xc2, 0x02, 0x66, 0xe8, 0xba, 0xaf, 0xab, 0x05, 0x4e, 0x41, 0xd9, 0xa5, 0x36, 0x6e,
+	0xbb, 0x56, 0x1f, 0x0b, 0x03, 0x94, 0xcd, 0x12, 0x07, 0xdc, 0xb4, 0xfa, 0x78, 0xc8, 0x3c, 0xa5,
+	0x21, 0xf3, 0xa0, 0x65, 0xa8, 0x08, 0x5e, 0xc7, 0xea, 0x60, 0x27, 0xd0, 0xcb, 0x73, 0xb9, 0xf3,
+	0x95, 0xf9, 0x6f, 0x44, 0x95, 0x26, 0x69, 0xb5, 0xe6, 0x4d, 0x6a, 0xe3, 0x75, 0x41, 0xb6, 0xec,
+	0x32, 0xff, 0xc0, 0x04, 0x37, 0x06, 0xa0, 0x15, 0x40, 0x41, 0x77, 0x17, 0xdb, 0xa1, 0x43, 0xdc,
+	0x5e, 0xdb, 0xb1, 0x18, 0x76, 0xbb, 0x07, 0x3a, 0x08, 0x8b, 0xbc, 0x11, 0x49, 0xdb, 0x8a, 0x29,
+	0xd6, 0x25, 0x81, 0x39, 0x19, 0x0c, 0x83, 0x1a, 0xdf, 0x83, 0x89, 0xa1, 0x8d, 0x50, 0x1d, 0x72,
+	0x77, 0xf1, 0x81, 0xba, 0x2b, 0xfe, 0x93, 0xdf, 0xc5, 0x3d, 0xcb, 0x09, 0xb1, 0xba, 0x24, 0xb9,
+	0xb8, 0x9a, 0xbd, 0xa2, 0x19, 0x5f, 0xc8, 0x78, 0x1e, 0xd9, 0x0a, 0x7d, 0x17, 0xc6, 0xc4, 0x8d,
+	0xc9, 0x3b, 0xe7, 0xa7, 0x1a, 0xbe, 0xa7, 0x25, 0xd5, 0xd1, 0xc8, 0x6b, 0xfa, 0x15, 0xbf, 0x26,
+	0xc5, 0x82, 0x6e, 0xc0, 0x38, 0x37, 0xa2, 0xb8, 0x34, 0x42, 0x5d, 0x3d, 0xfb, 0xf2, 0x22, 0x2a,
+	0x1e, 0xb5, 0x17, 0x15, 0x1f, 0x5a, 0x02, 0xbe, 0x6c, 0x07, 0xcc, 0xf2, 0x59, 0xe8, 0xe9, 0xb9,
+	0x97, 0x17, 0xc3, 0x2f, 0x71, 0x4b, 0xb2, 0x19, 0x1f, 0x66, 0x41, 0x5f, 0xa3, 0x9d, 0x3b, 0xae,
+	0xd5, 0x71, 0xf0, 0x36, 0x55, 0xba, 0xe2, 0xd7, 0x25, 0x9b, 0x8f, 0xf8, 0x7c, 0xf1, 0x45, 0x3e,
+	0x5f, 0x7a, 0xae, 0xcf, 0x97, 0x87, 0x53, 0xc2, 0x5f, 0xf3, 0xa2, 0x8e, 0xdf, 0xb0, 0x88, 0xf3,
+	0xfa, 0xd4, 0xc0, 0x65, 0x00, 0xbc, 0x4f, 0x58, 0xbb, 0x4b, 0x6d, 0x1c, 0xe8, 0x45, 0x11, 0xc7,
+	0x46, 0x14, 0x79, 0x09, 0x55, 0x9b, 0xcb, 0xfb, 0x84, 0x2d, 0x72, 0x22, 0x11, 0x5c, 0x0b, 0x59,
+	0x5d, 0x33, 0xcb, 0x38, 0x82, 0x8d, 0x1a, 0xbf, 0xf4, 0x22, 0xe3, 0x97, 0x9f, 0x6b, 0x7c, 0x18,
+	0x4e, 0x38, 0x8b, 0x80, 0xba, 0xd4, 0x65, 0x16, 0x6f, 0xd1, 0x79, 0x20, 0xb0, 0x30, 0xc0, 0x81,
+	0x5e, 0x11, 0xe7, 0x9d, 0x16, 0xe7, 0x5d, 0x8c, 0xd0, 0x5b, 0x02, 0x6b, 0x4e, 0x76, 0xd3, 0x00,
+	0x1c, 0xa0, 0x39, 0x28, 0x74, 0xad, 0x30, 0xc0, 0xfa, 0xb8, 0x28, 0x84, 0x20, 0xf9, 0x38, 0xc4,
+	0x94, 0x08, 0x74, 0x19, 0x2a, 0x7e, 0xe8, 0xb6, 0x6d, 0xcc, 0x2c, 0xe2, 0x04, 0x7a, 0x55, 0xdc,
+	0x04, 0x4a, 0xe4, 0xb5, 0x25, 0x89, 0x31, 0xc1, 0x8f, 0x7f, 0x37, 0xae, 0x41, 0x2d, 0x6d, 0x9d,
+	0x17, 0xa5, 0x9e, 0x42, 0x32, 0xf5, 0x7c, 0x9a, 0x55, 0xaf, 0x89, 0x6e, 0x17, 0x63, 0xfb, 0xf8,
+	0x79, 0xd6, 0x2b, 0xaf, 0x35, 0x43, 0x77, 0x52, 0x7e, 0x99, 0x3b, 0x31, 0xfe, 0xa4, 0x41, 0x35,
+	0x85, 0x45, 0x08, 0xf2, 0x1e, 0xa5, 0x8e, 0xb2, 0xa7, 0xf8, 0xcd, 0xcf, 0x4e, 0xdc, 0x80, 0x59,
+	0x6e, 0x17, 0xb7, 0xd9, 0x81, 0x17, 0x15, 0x86, 0xf1, 0x08, 0xb8, 0x7d, 0xe0, 0x61, 0x74, 0x15,
+	0x8a, 0x22, 0xf3, 0x62, 0x5b, 0xcf, 0xbd, 0xd0, 0x7e, 0x79, 0x69, 0x3b, 0xc5, 0x80, 0xae, 0x41,
+	0x69, 0x87, 0xb8, 0x24, 0xd8, 0x7d, 0x29, 0xe3, 0x4b, 0xe6, 0x98, 0xc3, 0xf8, 0x79, 0x1e, 0xa6,
+	0x78, 0xc6, 0x66, 0xc4, 0x21, 0x81, 0x48, 0xed, 0xaf, 0xa5, 0x73, 0x50, 0x38, 0xb1, 0x61, 0xed,
+	0x9b, 0xea, 0xb9, 0x1d, 0xdc, 0xa0, 0xfe, 0x26, 0xf6, 0x09, 0xb5, 0x55, 0x3a, 0xba, 0x1c, 0x5d,
+	0xf5, 0xb0, 0x1d, 0x9a, 0x87, 0x72, 0xc9, 0xfc, 0x24, 0xdf, 0xba, 0x87, 0xcb, 0xfd, 0x2a, 0x55,
+	0xa0, 0xb1, 0x0f, 0x8d, 0x67, 0x6f, 0x7b, 0x48, 0xe0, 0x2f, 0x25, 0x03, 0xbf, 0x32, 0xdf, 0x6c,
+	0xca, 0x91, 0x43, 0x33, 0x39, 0x72, 0x68, 0x7a, 0x77, 0x7b, 0x42, 0xc9, 0x68, 0xe4, 0xd0, 0xbc,
+	0x1d, 0x5a, 0x2e, 0x23, 0xec, 0x20, 0x99, 0x28, 0xfe, 0xac, 0x89, 0xa7, 0x98, 0x89, 0x3d, 0x9f,
+	0x50, 0x9f, 0x30, 0xf2, 0xe0, 0x08, 0x26, 0x8b, 0x37, 0x61, 0xdc, 0xc5, 0xf7, 0xdb, 0xea, 0x88,
+	0x07, 0xc2, 0x23, 0x34, 0xb3, 0xe2, 0xe2, 0xfb, 0x9b, 0x0a, 0x64, 0xfc, 0x5e, 0x03, 0xb4, 0x46,
+	0x3b, 0x8b, 0x3c, 0xc0, 0x1c, 0xe7, 0x28, 0x76, 0xd7, 0x83, 0x62, 0x59, 0x48, 0x16, 0x4b, 0xe3,
+	0x77, 0x72, 0xf0, 0xa3, 0x4e, 0x8e, 0xed, 0x63, 0x73, 0xf0, 0xc7, 0x59, 0x31, 0x50, 0xd9, 0xc2,
+	0xfe, 0x3d, 0xd2, 0xc5, 0x8b, 0x92, 0xfa, 0x6b, 0xf8, 0xac, 0xe3, 0xee, 0x19, 0x48, 0x23, 0x24,
+	0x83, 0xbf, 0xa2, 0x60, 0x51, 0xfc, 0xc7, 0xa7, 0xf0, 0xf4, 0x72, 0xfa, 0x14, 0x1e, 0x57, 0xdd,
+	0xa3, 0x3e, 0x0b, 0x74, 0x98, 0xcb, 0xf1, 0x42, 0x2e, 0x16, 0xc6, 0xbf, 0xa5, 0x4f, 0xbf, 0x67,
+	0xb9, 0xbd, 0x4d, 0xc7, 0xea, 0x1e, 0x3f, 0xe3, 0x9e, 0x84, 0x62, 0xcf, 0x72, 0x7b, 0x03, 0xb3,
+	0x8e, 0xf1, 0xa5, 0xac, 0xdc, 0x02, 0x11, 0x90, 0x07, 0xb2, 0x72, 0x57, 0xcd, 0x12, 0x07, 0x6c,
+	0x91, 0x07, 0xd8, 0xf8, 0x45, 0x16, 0xa6, 0x95, 0xda, 0x26, 0xde, 0xc3, 0x5d, 0xf6, 0x35, 0x51,
+	0x3c, 0x11, 0x68, 0xa5, 0x54, 0xa0, 0xfd, 0x53, 0x13, 0x6f, 0xac, 0x25, 0x6c, 0xd9, 0x0e, 0x71,
+	0xf1, 0xf2, 0xfe, 0x11, 0xed, 0xe9, 0xae, 0x43, 0xc9, 0x56, 0x67, 0xd4, 0x0b, 0x5f, 0x42, 0x40,
+	0xcc, 0x65, 0xfc, 0x2c, 0x0f, 0x27, 0xd7, 0x68, 0x67, 0x03, 0xf7, 0xa9, 0x7f, 0xb0, 0xea, 0x76,
+	0xfd, 0xe3, 0x38, 0xde, 0xfc, 0xbf, 0xe4, 0x14, 0x1d, 0x8a, 0x16, 0x63, 0xfc, 0x1b, 0x85, 0xea,
+	0x5d, 0xa3, 0x65, 0xc2, 0x4b, 0xca, 0xa9, 0x47, 0xd7, 0x8f, 0xa0, 0xda, 0x17, 0x76, 0x6b, 0x3b,
+	0xa4, 0x4f, 0x54, 0x2e, 0xe1, 0xbd, 0x81, 0x6a, 0x74, 0x0e, 0x33, 0x6a, 0x53, 0x02, 0xd7, 0x05,
+	0x43, 0xb2, 0xc7, 0x19, 0xef, 0x27, 0x10, 0x0d, 0x0a, 0x93, 0x23, 0x84, 0xaf, 0xb4, 0x2b, 0x79,
+	0x24, 0x9f, 0x2f, 0x9b, 0x3e, 0x65, 0xc7, 0x32, 0xfe, 0x5f, 0xc1, 0x70, 0xf8, 0x23, 0x59, 0x0c,
+	0xb6, 0xb1, 0xdf, 0x27, 0xee, 0x31, 0xac, 0xb4, 0xc6, 0x63, 0x80, 0x71, 0x71, 0xe6, 0x0d, 0x1c,
+	0x04, 0x56, 0x0f, 0xa3, 0x77, 0xa0, 0x1c, 0x44, 0x5f, 0xbb, 0xd4, 0x20, 0x6c, 0x26, 0x1e, 0xcf,
+	0xa5, 0x3e, 0x83, 0xad, 0x64, 0xcc, 0x01, 0x29, 0xba, 0x18, 0x4f, 0xcf, 0xa4, 0x9f, 0x4d, 0x45,
+	0x4c, 0x89, 0x0f, 0x4f, 0x2b, 0x99, 0xc4, 0xbc, 0x6c, 0xc2, 0x8e, 0xbe, 0xf9, 0xb4, 0x77, 0xf8,
+	0x47, 0x1f, 0xbd, 0x2e, 0xf8, 0x4e, 0x45, 0x7c, 0x87, 0x7c, 0x12, 0x5a, 0xc9, 0x98, 0x35, 0x3b,
+	0x05, 0xe6, 0xdb, 0x3a, 0x22, 0x72, 0xf4, 0x5c, 0x7a, 0xdb, 0xc4, 0x37, 0x18, 0xbe, 0xad, 0x24,
+	0x42, 0x8b, 0x50, 0x13, 0xbf, 0xda, 0xbe, 0xfa, 0xc0, 0x11, 0x1b, 0x35, 0xc9, 0x96, 0xfa, 0xfa,
+	0xb1, 0x92, 0x31, 0xab, 0x4e, 0x12, 0x8a, 0xae, 0x83, 0x04, 0xb4, 0xb1, 0xfc, 0x1c, 0xa0, 0x17,
+	0xd2, 0x53, 0xcc, 0x91, 0x4f, 0x05, 0x2b, 0x19, 0x73, 0xdc, 0x49, 0x00, 0xd1, 0x25, 0x28, 0x7a,
+	0x72, 0xe0, 0x2e, 0x5c, 0x31, 0x9a, 0x6b, 0x0c, 0xcd, 0xe1, 0x57, 0x32, 0x66, 0x44, 0xc6, 0x39,
+	0x7c, 0x39, 0x6a, 0xd5, 0x8b, 0x69, 0x8e, 0xe4, 0x04, 0x96, 0x73, 0x28, 0x32, 0xb4, 0x01, 0x28,
+	0x14, 0xf3, 0xbf, 0x36, 0xa3, 0x6d, 0x35, 0x45, 0x95, 0x5d, 0x50, 0x65, 0xfe, 0x4c, 0xfc, 0xce,
+	0x3a, 0x6c, 0x42, 0xb8, 0x92, 0x31, 0xeb, 0xe1, 0x10, 0x82, 0x1b, 0x7a, 0x47, 0xcc, 0x88, 0xf4,
+	0x72, 0xda, 0xd0, 0x89, 0xc9, 0x11, 0x37, 0xb4, 0x24, 0x92, 0x6e, 0xa4, 0xc6, 0x1c, 0x3a, 0x0c,
+	0xbb, 0x51, 0x72, 0xfe, 0x21, 0xdd, 0x48, 0x41, 0xd0, 0x02, 0x54, 0xfd, 0xe4, 0xab, 0x47, 0xaf,
+	0xa4, 0xef, 0x67, 0xf4, 0x49, 0xc4, 0xef, 0x27, 0xc5, 0x82, 0xde, 0x05, 0xe8, 0xc6, 0x2f, 0x0e,
+	0x31, 0x00, 0xaa, 0xcc, 0x9f, 0x8c, 0x04, 0x0c, 0xbd, 0x45, 0x56, 0x32, 0x66, 0x82, 0x98, 0x1f,
+	0x5b, 0xad, 0xb0, 0xad, 0x57, 0xd3, 0xc7, 0x4e, 0xbf, 0x05, 0xf8, 0xb1, 0x63, 0x52, 0xbe, 0x25,
+	0x8b, 0x73, 0x80, 0x5e, 0x4b, 0x6f, 0x39, 0x94, 0x1d, 0xf8, 0x96, 0x03, 0x62, 0x74, 0x0d, 0x2a,
+	0xe1, 0xe0, 0xb5, 0xab, 0x4f, 0x08, 0x5e, 0xfd, 0x59, 0x0f, 0xe1, 0x95, 0x8c, 0x99, 0x24, 0xe7,
+	0x71, 0x14, 0x75, 0xb9, 0x51, 0x9a, 0x98, 0x4c, 0xc7, 0xd1, 0x21, 0x2f, 0x01, 0x1e, 0x47, 0x41,
+	0x0a, 0x8c, 0xae, 0x42, 0x45, 0xb4, 0x40, 0x9e, 0x68, 0x69, 0x75, 0x94, 0xd6, 0x60, 0xa8, 0xd9,
+	0xe5, 0x1a, 0xf4, 0x62, 0x10, 0x8f, 0x07, 0xc1, 0xeb, 0xab, 0xbe, 0x50, 0x9f, 0x4a, 0xc7, 0xc3,
+	0x48, 0xcf, 0xc8, 0xe3, 0xa1, 0x97, 0x00, 0xa2, 0x55, 0xa8, 0xab, 0x2a, 0x49, 0xa2, 0x4a, 0xa8,
+	0x4f, 0x0b, 0x21, 0xa7, 0x9f, 0x57, 0x28, 0x57, 0x32, 0xe6, 0x44, 0x3f, 0x0d, 0x47, 0xeb, 0x30,
+	0x19, 0x35, 0x2e, 0x6d, 0xac, 0x7a, 0x32, 0xfd, 0x44, 0xda, 0xeb, 0x0f, 0xed, 0xd9, 0xb8, 0xd7,
+	0xdb, 0x43, 0x08, 0xee, 0x0f, 0x5e, 0x54, 0xee, 0xf4, 0x99, 0xb4, 0x3f, 0xa4, 0xeb, 0x20, 0xf7,
+	0x87, 0x98, 0x74, 0xa1, 0x04, 0x63, 0xe2, 0x1f, 0x14, 0x81, 0xf1, 0x5b, 0x0d, 0x26, 0x86, 0xe6,
+	0x95, 0x7c, 0x3e, 0x25, 0x9e, 0x24, 0x6a, 0x3e, 0xc5, 0x7f, 0xa3, 0x06, 0x94, 0xa2, 0x19, 0xab,
+	0x1a, 0x1c, 0xc6, 0x6b, 0xde, 0x76, 0xf4, 0x65, 0x7a, 0x56, 0xb5, 0x21, 0x5a, 0x26, 0x4a, 0x5a,
+	0x3e, 0x55, 0xd2, 0xe2, 0xf1, 0x67, 0xe1, 0x59, 0xe3, 0xcf, 0x37, 0xa0, 0xe4, 0xd0, 0x5e, 0x9b,
+	0x0f, 0xcc, 0x54, 0x39, 0x2c, 0x3a, 0xb4, 0xb7, 0x6d, 0x11, 0xc7, 0x78, 0x07, 0xca, 0x42, 0xa5,
+	0x75, 0x12, 0x30, 0xf4, 0x56, 0xa4, 0x89, 0xae, 0x89, 0xce, 0x65, 0x52, 0x88, 0x4a, 0x96, 0x0c,
+	0x33, 0x52, 0xf5, 0x36, 0x20, 0x01, 0xdf, 0x62, 0x3e, 0xb6, 0xfa, 0x0a, 0x8b, 0x6a, 0x90, 0x8d,
+	0xeb, 0x60, 0x96, 0xd8, 0xe8, 0xed, 0x81, 0x32, 0xb2, 0x52, 0x1c, 0x22, 0x31, 0xa2, 0x30, 0x3e,
+	0x90, 0xb3, 0xbd, 0x2d, 0xcc, 0x44, 0x19, 0x0e, 0xd8, 0x88, 0xb8, 0x69, 0x28, 0xdc, 0xb7, 0x58,
+	0x77, 0x57, 0x08, 0x2b, 0x99, 0x72, 0xc1, 0x3f, 0xd8, 0xef, 0xf8, 0xb4, 0xdf, 0x56, 0x72, 0x78,
+	0xe9, 0x93, 0x96, 0xab, 0x72, 0xb0, 0xda, 0x26, 0x59, 0x73, 0xf3, 0xc9, 0x9a, 0x7b, 0x16, 0x2a,
+	0x42, 0x25, 0x31, 0x28, 0x0c, 0xf4, 0xc2, 0x5c, 0xee, 0x7c, 0xd9, 0x04, 0x01, 0xe2, 0x63, 0xc2,
+	0xc0, 0xb8, 0x01, 0xf5, 0x1f, 0xf2, 0x7d, 0xd6, 0x68, 0x27, 0x88, 0x0e, 0x16, 0x8b, 0xd2, 0x92,
+	0xa2, 0x9e, 0x5b, 0xf2, 0x8d, 0x0f, 0x35, 0x28, 0x71, 0xf5, 0x98, 0xc5, 0xf0, 0xb3, 0x9a, 0x86,
+	0x73, 0x50, 0xf0, 0x76, 0xad, 0x40, 0x5a, 0xab, 0x36, 0x5f, 0x8d, 0xdd, 0x8f, 0x03, 0x4d, 0x89,
+	0x1b, 0xaa, 0xf2, 0xb9, 0xe1, 0xce, 0xe7, 0x07, 0x30, 0xed, 0x58, 0x01, 0x6b, 0x33, 0xdf, 0x72,
+	0x03, 0xc2, 0x13, 0x47, 0x9b, 0x91, 0x3e, 0xfe, 0x52, 0x1d, 0x05, 0xe2, 0x12, 0xb6, 0x63, 0x01,
+	0x9c, 0xc4, 0xb8, 0x05, 0xb5, 0xe8, 0xf8, 0x77, 0x3c, 0x9b, 0x2b, 0xd1, 0x80, 0x52, 0xe0, 0x5a,
+	0x5e, 0xb0, 0x4b, 0x99, 0x50, 0xa3, 0x64, 0xc6, 0x6b, 0xf4, 0x26, 0xe4, 0xf7, 0x68, 0x27, 0xd0,
+	0xb3, 0xc2, 0x91, 0x62, 0x45, 0x04, 0xbb, 0x29, 0x50, 0xc6, 0xaa, 0x98, 0x82, 0x6e, 0x61, 0xa6,
+	0x26, 0xfb, 0x5f, 0xc1, 0xb6, 0x9f, 0x6b, 0x30, 0x9e, 0x94, 0xf5, 0xbf, 0x08, 0xe1, 0xf1, 0xa5,
+	0xba, 0x9a, 0x9c, 0x88, 0x49, 0xb5, 0xe2, 0x70, 0xd5, 0x76, 0xe4, 0x25, 0x5c, 0xae, 0x78, 0xa4,
+	0x46, 0x65, 0xba, 0x20, 0x10, 0xd1, 0x12, 0x9d, 0x4e, 0x16, 0xc4, 0x31, 0x81, 0x1b, 0x00, 0xb8,
+	0x3c, 0x55, 0x5d, 0xe5, 0x9b, 0x43, 0xad, 0x38, 0xd7, 0xa0, 0x1e, 0xa9, 0x71, 0x79, 0x0c, 0xb8,
+	0x70, 0x1d, 0x0a, 0x22, 0xa6, 0x51, 0x19, 0x0a, 0xcb, 0xbe, 0x4f, 0xfd, 0x7a, 0x06, 0x55, 0xa0,
+	0xb8, 0x7c, 0x8f, 0xf0, 0x24, 0x54, 0xd7, 0x50, 0x11, 0x72, 0xb7, 0x6e, 0x6d, 0xd4, 0xb3, 0x68,
+	0x06, 0x10, 0xff, 0x66, 0x2a, 0x93, 0xe8, 0xa6, 0x8f, 0x83, 0x20, 0xf4, 0x71, 0x3d, 0x77, 0xe1,
+	0x37, 0xd2, 0x01, 0x85, 0x2f, 0xa1, 0x93, 0x30, 0x75, 0xc7, 0x0d, 0x3c, 0xdc, 0x25, 0x3b, 0x04,
+	0xdb, 0x11, 0xb8, 0x9e, 0x41, 0x55, 0x28, 0xc7, 0xad, 0x5f, 0x5d, 0xe3, 0xcb, 0xb8, 0x39, 0xab,
+	0x67, 0x11, 0xc0, 0x98, 0xec, 0xf1, 0xea, 0x39, 0xfe, 0x5b, 0x36, 0x5e, 0xf5, 0x3c, 0x3f, 0x89,
+	0xea, 0x66, 0xea, 0x05, 0xbe, 0x50, 0x8d, 0x4a, 0x7d, 0x4c, 0xca, 0x53, 0xaa, 0xd7, 0x8b, 0x9c,
+	0x49, 0x36, 0x11, 0xf5, 0x12, 0x47, 0xc5, 0x75, 0xb6, 0x5e, 0x9e, 0xff, 0x28, 0x07, 0x05, 0xd9,
+	0x52, 0x5f, 0x81, 0x9a, 0x89, 0xf9, 0x04, 0x66, 0x23, 0x74, 0x18, 0xf1, 0x1c, 0x8c, 0x6a, 0x83,
+	0xbc, 0xc1, 0x33, 0x55, 0x63, 0x66, 0xc4, 0x8d, 0x97, 0xf9, 0x9f, 0xc9, 0xd0, 0x65, 0x18, 0x93,
+	0x9c, 0x68, 0x34, 0xd3, 0x3c, 0x93, 0x09, 0xc3, 0xc4, 0x7b, 0x98, 0x49, 0xff, 0x11, 0x0c, 0x01,
+	0x42, 0x83, 0x8a, 0x1a, 0x65, 0xa3, 0xc6, 0xc9, 0x81, 0xc4, 0x54, 0xd6, 0x33, 0xce, 0xfd, 0xf4,
+	0xd3, 0x7f, 0xfc, 0x32, 0x7b, 0xc6, 0xd0, 0x5b, 0xf7, 0xbe, 0xdd, 0xda, 0xa3, 0x9d, 0x8b, 0x01,
+	0x66, 0xad, 0xf7, 0x85, 0xf7, 0x3c, 0x6c, 0xbd, 0x4f, 0xec, 0x87, 0x57, 0xb5, 0x0b, 0x97, 0x34,
+	0xe4, 0x42, 0x39, 0x4e, 0x24, 0xe8, 0x84, 0x10, 0x36, 0x9c, 0x58, 0x1a, 0x53, 0xa9, 0x40, 0x91,
+	0x71, 0x66, 0x5c, 0x16, 0xf2, 0x2f, 0xa2, 0xb7, 0x0f, 0x95, 0x3f, 0xf0, 0xe8, 0x87, 0x2d, 0x91,
+	0x10, 0x2f, 0xf2, 0xe8, 0xba, 0xa4, 0x21, 0x9a, 0x50, 0x4b, 0x85, 0x85, 0x9e, 0x50, 0x2b, 0x15,
+	0x75, 0x8d, 0xc9, 0x11, 0x8c, 0xd1, 0x12, 0xdb, 0xbe, 0x85, 0xbe, 0xf5, 0xc2, 0x6d, 0xe5, 0x87,
+	0xbb, 0x85, 0xb9, 0xcf, 0xbe, 0x98, 0xcd, 0xfc, 0xe4, 0xc9, 0xac, 0xf6, 0xf1, 0x93, 0x59, 0xed,
+	0x93, 0x27, 0xb3, 0xda, 0xe7, 0x4f, 0x66, 0xb5, 0x47, 0x4f, 0x67, 0x33, 0x9f, 0x3c, 0x9d, 0xcd,
+	0x7c, 0xf6, 0x74, 0x36, 0xd3, 0x19, 0x13, 0x96, 0xbf, 0xfc, 0xdf, 0x01, 0x00, 0x9d, 0x27, 0x91,
+	0x50, 0x7b, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventClient is the client API for Event service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventClient interface {
+	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
+	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (Event_WatchJobsClient, error)
+	GetJobSetStatus(ctx context.Context, in *JobSetStatusRequest, opts ...grpc.CallOption) (*JobSetStatus, error)
+}
+
+type eventClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventClient(cc *grpc.ClientConn) EventClient {
+	return &eventClient{cc}
+}
+
+func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err17 != nil {
-		return 0, err17
-	}
-	i -= n17
-	i = encodeVarintEvent(dAtA, i, uint64(n17))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	x := &eventGetJobSetEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Event_GetJobSetEventsClient interface {
+	Recv() (*EventStreamMessage, error)
+	grpc.ClientStream
 }
 
-func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type eventGetJobSetEventsClient struct {
+	grpc.ClientStream
 }
 
-func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
+	m := new(EventStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err18 != nil {
-		return 0, err18
+	return m, nil
+}
+
+func (c *eventClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (Event_WatchJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[1], "/api.Event/WatchJobs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	i -= n18
-	i = encodeVarintEvent(dAtA, i, uint64(n18))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	x := &eventWatchJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return x, nil
+}
+
+type Event_WatchJobsClient interface {
+	Recv() (*JobStateUpdate, error)
+	grpc.ClientStream
+}
+
+type eventWatchJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventWatchJobsClient) Recv() (*JobStateUpdate, error) {
+	m := new(JobStateUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *EventMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *eventClient) GetJobSetStatus(ctx context.Context, in *JobSetStatusRequest, opts ...grpc.CallOption) (*JobSetStatus, error) {
+	out := new(JobSetStatus)
+	err := c.cc.Invoke(ctx, "/api.Event/GetJobSetStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventServer is the server API for Event service.
+type EventServer interface {
+	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
+	Report(context.Context, *EventMessage) (*types.Empty, error)
+	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
+	WatchJobs(*WatchJobsRequest, Event_WatchJobsServer) error
+	GetJobSetStatus(context.Context, *JobSetStatusRequest) (*JobSetStatus, error)
 }
 
-func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Events != nil {
-		{
-			size := m.Events.Size()
-			i -= size
-			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedEventServer can be embedded to have forward compatible implementations.
+type UnimplementedEventServer struct {
 }
 
-func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEventServer) ReportMultiple(ctx context.Context, req *EventList) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMultiple not implemented")
 }
-
-func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Submitted != nil {
-		{
-			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEventServer) Report(ctx context.Context, req *EventMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
 }
-func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_GetJobSetEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetJobSetEvents not implemented")
+}
+func (*UnimplementedEventServer) WatchJobs(req *WatchJobsRequest, srv Event_WatchJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (*UnimplementedEventServer) GetJobSetStatus(ctx context.Context, req *JobSetStatusRequest) (*JobSetStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSetStatus not implemented")
 }
 
-func (m *EventMessage_Queued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Queued != nil {
-		{
-			size, err := m.Queued.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func RegisterEventServer(s *grpc.Server, srv EventServer) {
+	s.RegisterService(&_Event_serviceDesc, srv)
+}
+
+func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(EventServer).ReportMultiple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/ReportMultiple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Report(ctx, req.(*EventMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventMessage_Leased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Leased != nil {
-		{
-			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
 }
-func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Event_GetJobSetEventsServer interface {
+	Send(*EventStreamMessage) error
+	grpc.ServerStream
 }
 
-func (m *EventMessage_LeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LeaseReturned != nil {
-		{
-			size, err := m.LeaseReturned.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+type eventGetJobSetEventsServer struct {
+	grpc.ServerStream
 }
-func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *EventMessage_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LeaseExpired != nil {
-		{
-			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func _Event_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(EventServer).WatchJobs(m, &eventWatchJobsServer{stream})
 }
-func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Event_WatchJobsServer interface {
+	Send(*JobStateUpdate) error
+	grpc.ServerStream
 }
 
-func (m *EventMessage_Pending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Pending != nil {
-		{
-			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+type eventWatchJobsServer struct {
+	grpc.ServerStream
 }
-func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *eventWatchJobsServer) Send(m *JobStateUpdate) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *EventMessage_Running) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Running != nil {
-		{
-			size, err := m.Running.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func _Event_GetJobSetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(EventServer).GetJobSetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/GetJobSetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetJobSetStatus(ctx, req.(*JobSetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventMessage_UnableToSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UnableToSchedule != nil {
+var _Event_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Event",
+	HandlerType: (*EventServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.UnableToSchedule.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+			MethodName: "ReportMultiple",
+			Handler:    _Event_ReportMultiple_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _Event_Report_Handler,
+		},
+		{
+			MethodName: "GetJobSetStatus",
+			Handler:    _Event_GetJobSetStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetJobSetEvents",
+			Handler:       _Event_GetJobSetEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _Event_WatchJobs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/event.proto",
+}
+
+func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_Failed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Failed != nil {
-		{
-			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Succeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Succeeded != nil {
-		{
-			size, err := m.Succeeded.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Reprioritized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Reprioritized != nil {
-		{
-			size, err := m.Reprioritized.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	i -= n2
+	i = encodeVarintEvent(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Cancelling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Cancelling != nil {
-		{
-			size, err := m.Cancelling.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Cancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Cancelled != nil {
-		{
-			size, err := m.Cancelled.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EventMessage_Terminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Terminated != nil {
-		{
-			size, err := m.Terminated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_Utilisation) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_Utilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobQueuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Utilisation != nil {
-		{
-			size, err := m.Utilisation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_DuplicateFound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_DuplicateFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DuplicateFound != nil {
-		{
-			size, err := m.DuplicateFound.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
+
+func (m *JobDuplicateFoundEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3712,51 +3807,56 @@ func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobDuplicateFoundEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContainerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobDuplicateFoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Cause != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+	if len(m.OriginalJobId) > 0 {
+		i -= len(m.OriginalJobId)
+		copy(dAtA[i:], m.OriginalJobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OriginalJobId)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Message)))
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ExitCode))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventList) Marshal() (dAtA []byte, err error) {
+func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3766,34 +3866,56 @@ func (m *EventList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3803,39 +3925,68 @@ func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeaseReturnedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if m.RequeueReason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RequeueReason))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintEvent(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3845,1364 +3996,8069 @@ func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeaseExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RequeueReason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RequeueReason))
+		i--
+		dAtA[i] = 0x28
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintEvent(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
 		i -= len(m.Queue)
 		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FromMessageId) > 0 {
-		i -= len(m.FromMessageId)
-		copy(dAtA[i:], m.FromMessageId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Watch {
-		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *JobSubmittedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Job.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+
+func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobQueuedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobPendingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *JobDuplicateFoundEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err8 != nil {
+		return 0, err8
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n8
+	i = encodeVarintEvent(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.OriginalJobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeasedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.SchedulingLatency != nil {
+		{
+			size, err := m.SchedulingLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeLabels) > 0 {
+		for k := range m.NodeLabels {
+			v := m.NodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *JobLeaseReturnedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n10
+	i = encodeVarintEvent(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeaseExpiredEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSchedulingLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSchedulingLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSchedulingLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PodStartup, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PodStartup):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n11
+	i = encodeVarintEvent(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PodCreation, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PodCreation):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n12
+	i = encodeVarintEvent(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Queued, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Queued):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	i -= n13
+	i = encodeVarintEvent(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *JobPendingEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintEvent(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunningEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.RunDetails != nil {
+		{
+			size, err := m.RunDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Cause != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ContainerStatuses) > 0 {
+		for iNdEx := len(m.ContainerStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
 	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *JobUnableToScheduleEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ExitCodes) > 0 {
+		for k := range m.ExitCodes {
+			v := m.ExitCodes[k]
+			baseI := i
+			i = encodeVarintEvent(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err16 != nil {
+		return 0, err16
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n16
+	i = encodeVarintEvent(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.RunDetails != nil {
+		{
+			size, err := m.RunDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.ExitCodes) > 0 {
-		for k, v := range m.ExitCodes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err18 != nil {
+		return 0, err18
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	i -= n18
+	i = encodeVarintEvent(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ContainerStatuses) > 0 {
-		for _, e := range m.ContainerStatuses {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Cause != 0 {
-		n += 1 + sovEvent(uint64(m.Cause))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSucceededEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobRunDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Finished != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Finished, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintEvent(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Started != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintEvent(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.InstanceType) > 0 {
+		i -= len(m.InstanceType)
+		copy(dAtA[i:], m.InstanceType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.InstanceType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobUtilisationEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUtilisationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUtilisationEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k := range m.MaxResourcesForPeriod {
+			v := m.MaxResourcesForPeriod[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k, v := range m.MaxResourcesForPeriod {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err22 != nil {
+		return 0, err22
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n22
+	i = encodeVarintEvent(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobReprioritizedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobCancellingEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x29
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err23 != nil {
+		return 0, err23
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n23
+	i = encodeVarintEvent(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err24 != nil {
+		return 0, err24
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n24
+	i = encodeVarintEvent(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err25 != nil {
+		return 0, err25
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n25
+	i = encodeVarintEvent(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Events != nil {
-		n += m.Events.Size()
+func (m *JobServiceCreatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMessage_Submitted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Submitted != nil {
-		l = m.Submitted.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+func (m *JobServiceCreatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventMessage_Queued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobServiceCreatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Queued != nil {
-		l = m.Queued.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Ports) > 0 {
+		dAtA27 := make([]byte, len(m.Ports)*10)
+		var j26 int
+		for _, num1 := range m.Ports {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintEvent(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-func (m *EventMessage_Leased) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterIp) > 0 {
+		i -= len(m.ClusterIp)
+		copy(dAtA[i:], m.ClusterIp)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterIp)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.Leased != nil {
-		l = m.Leased.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-func (m *EventMessage_LeaseReturned) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.LeaseReturned != nil {
-		l = m.LeaseReturned.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *EventMessage_LeaseExpired) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.LeaseExpired != nil {
-		l = m.LeaseExpired.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err28 != nil {
+		return 0, err28
 	}
-	return n
-}
-func (m *EventMessage_Pending) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n28
+	i = encodeVarintEvent(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Pending != nil {
-		l = m.Pending.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Running) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobGangPlacedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGangPlacedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGangPlacedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Running != nil {
-		l = m.Running.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if m.GangSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GangSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-func (m *EventMessage_UnableToSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.GangId) > 0 {
+		i -= len(m.GangId)
+		copy(dAtA[i:], m.GangId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.GangId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.UnableToSchedule != nil {
-		l = m.UnableToSchedule.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-func (m *EventMessage_Failed) Size() (n int) {
-	if m == nil {
-		return 0
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err29 != nil {
+		return 0, err29
 	}
-	var l int
-	_ = l
-	if m.Failed != nil {
-		l = m.Failed.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n29
+	i = encodeVarintEvent(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventMessage_Succeeded) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Succeeded != nil {
-		l = m.Succeeded.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Reprioritized) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobGangRejectedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGangRejectedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGangRejectedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Reprioritized != nil {
-		l = m.Reprioritized.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-func (m *EventMessage_Cancelling) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GangSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GangSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.Cancelling != nil {
-		l = m.Cancelling.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.GangId) > 0 {
+		i -= len(m.GangId)
+		copy(dAtA[i:], m.GangId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.GangId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *EventMessage_Cancelled) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Cancelled != nil {
-		l = m.Cancelled.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err30 != nil {
+		return 0, err30
 	}
-	return n
-}
-func (m *EventMessage_Terminated) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n30
+	i = encodeVarintEvent(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Terminated != nil {
-		l = m.Terminated.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Utilisation) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobDeadlineExceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDeadlineExceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDeadlineExceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Utilisation != nil {
-		l = m.Utilisation.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err31 != nil {
+		return 0, err31
 	}
-	return n
-}
-func (m *EventMessage_DuplicateFound) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n31
+	i = encodeVarintEvent(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x2a
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err32 != nil {
+		return 0, err32
 	}
-	var l int
-	_ = l
-	if m.DuplicateFound != nil {
-		l = m.DuplicateFound.Size()
-		n += 2 + l + sovEvent(uint64(l))
+	i -= n32
+	i = encodeVarintEvent(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *ContainerStatus) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobMemoryIncreasedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMemoryIncreasedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMemoryIncreasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.MemoryLimits) > 0 {
+		for k := range m.MemoryLimits {
+			v := m.MemoryLimits[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovEvent(uint64(m.ExitCode))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Attempt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Cause != 0 {
-		n += 1 + sovEvent(uint64(m.Cause))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *EventList) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err34 != nil {
+		return 0, err34
 	}
-	return n
-}
-
-func (m *EventStreamMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n34
+	i = encodeVarintEvent(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobProtectedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobProtectedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobProtectedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.RequeueReason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RequeueReason))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Watch {
-		n += 2
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.FromMessageId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err35 != nil {
+		return 0, err35
 	}
-	return n
+	i -= n35
+	i = encodeVarintEvent(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobSubmittedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&JobSubmittedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobQueuedEvent) String() string {
-	if this == nil {
-		return "nil"
+	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err36 != nil {
+		return 0, err36
 	}
-	s := strings.Join([]string{`&JobQueuedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobDuplicateFoundEvent) String() string {
-	if this == nil {
-		return "nil"
+	i -= n36
+	i = encodeVarintEvent(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&JobDuplicateFoundEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`OriginalJobId:` + fmt.Sprintf("%v", this.OriginalJobId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobLeasedEvent) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&JobLeasedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobLeaseReturnedEvent) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&JobLeaseReturnedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobLeaseExpiredEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&JobLeaseExpiredEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *JobPendingEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobPendingEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobRunningEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Events != nil {
+		{
+			size := m.Events.Size()
+			i -= size
+			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	s := strings.Join([]string{`&JobRunningEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobUnableToScheduleEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobUnableToScheduleEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobFailedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForContainerStatuses := "[]*ContainerStatus{"
-	for _, f := range this.ContainerStatuses {
-		repeatedStringForContainerStatuses += strings.Replace(f.String(), "ContainerStatus", "ContainerStatus", 1) + ","
-	}
-	repeatedStringForContainerStatuses += "}"
-	keysForExitCodes := make([]string, 0, len(this.ExitCodes))
-	for k, _ := range this.ExitCodes {
-		keysForExitCodes = append(keysForExitCodes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForExitCodes)
-	mapStringForExitCodes := "map[string]int32{"
-	for _, k := range keysForExitCodes {
-		mapStringForExitCodes += fmt.Sprintf("%v: %v,", k, this.ExitCodes[k])
+
+func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	mapStringForExitCodes += "}"
-	s := strings.Join([]string{`&JobFailedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`ExitCodes:` + mapStringForExitCodes + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`ContainerStatuses:` + repeatedStringForContainerStatuses + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobSucceededEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobSucceededEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobUtilisationEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForMaxResourcesForPeriod := make([]string, 0, len(this.MaxResourcesForPeriod))
-	for k, _ := range this.MaxResourcesForPeriod {
-		keysForMaxResourcesForPeriod = append(keysForMaxResourcesForPeriod, k)
+
+func (m *EventMessage_Queued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Queued != nil {
+		{
+			size, err := m.Queued.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxResourcesForPeriod)
-	mapStringForMaxResourcesForPeriod := "map[string]resource.Quantity{"
-	for _, k := range keysForMaxResourcesForPeriod {
-		mapStringForMaxResourcesForPeriod += fmt.Sprintf("%v: %v,", k, this.MaxResourcesForPeriod[k])
-	}
-	mapStringForMaxResourcesForPeriod += "}"
-	s := strings.Join([]string{`&JobUtilisationEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`MaxResourcesForPeriod:` + mapStringForMaxResourcesForPeriod + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobReprioritizedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobReprioritizedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobCancellingEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Leased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Leased != nil {
+		{
+			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&JobCancellingEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobCancelledEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobCancelledEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobTerminatedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_LeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LeaseReturned != nil {
+		{
+			size, err := m.LeaseReturned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&JobTerminatedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage{`,
-		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Submitted) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LeaseExpired != nil {
+		{
+			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&EventMessage_Submitted{`,
-		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "JobSubmittedEvent", "JobSubmittedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Queued) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Queued{`,
-		`Queued:` + strings.Replace(fmt.Sprintf("%v", this.Queued), "JobQueuedEvent", "JobQueuedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Leased) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Pending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	s := strings.Join([]string{`&EventMessage_Leased{`,
-		`Leased:` + strings.Replace(fmt.Sprintf("%v", this.Leased), "JobLeasedEvent", "JobLeasedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_LeaseReturned) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_LeaseReturned{`,
-		`LeaseReturned:` + strings.Replace(fmt.Sprintf("%v", this.LeaseReturned), "JobLeaseReturnedEvent", "JobLeaseReturnedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_LeaseExpired) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Running) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Running != nil {
+		{
+			size, err := m.Running.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&EventMessage_LeaseExpired{`,
-		`LeaseExpired:` + strings.Replace(fmt.Sprintf("%v", this.LeaseExpired), "JobLeaseExpiredEvent", "JobLeaseExpiredEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Pending) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Pending{`,
-		`Pending:` + strings.Replace(fmt.Sprintf("%v", this.Pending), "JobPendingEvent", "JobPendingEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Running) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_UnableToSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnableToSchedule != nil {
+		{
+			size, err := m.UnableToSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	s := strings.Join([]string{`&EventMessage_Running{`,
-		`Running:` + strings.Replace(fmt.Sprintf("%v", this.Running), "JobRunningEvent", "JobRunningEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_UnableToSchedule) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_UnableToSchedule{`,
-		`UnableToSchedule:` + strings.Replace(fmt.Sprintf("%v", this.UnableToSchedule), "JobUnableToScheduleEvent", "JobUnableToScheduleEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Failed) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Failed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Failed != nil {
+		{
+			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	s := strings.Join([]string{`&EventMessage_Failed{`,
-		`Failed:` + strings.Replace(fmt.Sprintf("%v", this.Failed), "JobFailedEvent", "JobFailedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Succeeded) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Succeeded{`,
-		`Succeeded:` + strings.Replace(fmt.Sprintf("%v", this.Succeeded), "JobSucceededEvent", "JobSucceededEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Reprioritized) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Succeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Succeeded != nil {
+		{
+			size, err := m.Succeeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	s := strings.Join([]string{`&EventMessage_Reprioritized{`,
-		`Reprioritized:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritized), "JobReprioritizedEvent", "JobReprioritizedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Cancelling) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Cancelling{`,
-		`Cancelling:` + strings.Replace(fmt.Sprintf("%v", this.Cancelling), "JobCancellingEvent", "JobCancellingEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Cancelled) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Reprioritized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reprioritized != nil {
+		{
+			size, err := m.Reprioritized.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	s := strings.Join([]string{`&EventMessage_Cancelled{`,
-		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "JobCancelledEvent", "JobCancelledEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Terminated) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Terminated{`,
-		`Terminated:` + strings.Replace(fmt.Sprintf("%v", this.Terminated), "JobTerminatedEvent", "JobTerminatedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Utilisation) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Cancelling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelling != nil {
+		{
+			size, err := m.Cancelling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	s := strings.Join([]string{`&EventMessage_Utilisation{`,
-		`Utilisation:` + strings.Replace(fmt.Sprintf("%v", this.Utilisation), "JobUtilisationEvent", "JobUtilisationEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_DuplicateFound) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_DuplicateFound{`,
-		`DuplicateFound:` + strings.Replace(fmt.Sprintf("%v", this.DuplicateFound), "JobDuplicateFoundEvent", "JobDuplicateFoundEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ContainerStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Cancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelled != nil {
+		{
+			size, err := m.Cancelled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&ContainerStatus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForEvents := "[]*EventMessage{"
-	for _, f := range this.Events {
-		repeatedStringForEvents += strings.Replace(f.String(), "EventMessage", "EventMessage", 1) + ","
-	}
-	repeatedStringForEvents += "}"
-	s := strings.Join([]string{`&EventList{`,
-		`Events:` + repeatedStringForEvents + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventStreamMessage) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Terminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Terminated != nil {
+		{
+			size, err := m.Terminated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	s := strings.Join([]string{`&EventStreamMessage{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Message:` + strings.Replace(this.Message.String(), "EventMessage", "EventMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobSetRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_Utilisation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Utilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Utilisation != nil {
+		{
+			size, err := m.Utilisation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	s := strings.Join([]string{`&JobSetRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Watch:` + fmt.Sprintf("%v", this.Watch) + `,`,
-		`FromMessageId:` + fmt.Sprintf("%v", this.FromMessageId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func valueToStringEvent(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *EventMessage_DuplicateFound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_DuplicateFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateFound != nil {
+		{
+			size, err := m.DuplicateFound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_ServiceCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_ServiceCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ServiceCreated != nil {
+		{
+			size, err := m.ServiceCreated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_GangPlaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_GangPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GangPlaced != nil {
+		{
+			size, err := m.GangPlaced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_GangRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_GangRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GangRejected != nil {
+		{
+			size, err := m.GangRejected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_MemoryIncreased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_MemoryIncreased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MemoryIncreased != nil {
+		{
+			size, err := m.MemoryIncreased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_DeadlineExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_DeadlineExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeadlineExceeded != nil {
+		{
+			size, err := m.DeadlineExceeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Protected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Protected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Protected != nil {
+		{
+			size, err := m.Protected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogTail) > 0 {
+		i -= len(m.LogTail)
+		copy(dAtA[i:], m.LogTail)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LogTail)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Cause != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromMessageId) > 0 {
+		i -= len(m.FromMessageId)
+		copy(dAtA[i:], m.FromMessageId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n60, err60 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTransitionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTransitionTime):])
+	if err60 != nil {
+		return 0, err60
+	}
+	i -= n60
+	i = encodeVarintEvent(dAtA, i, uint64(n60))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Phase != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStateUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cancelled))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Failed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Running != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Leased != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Leased))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Queued != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Queued))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmittedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Job.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobQueuedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobDuplicateFoundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.OriginalJobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeasedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeaseReturnedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RequeueReason != 0 {
+		n += 1 + sovEvent(uint64(m.RequeueReason))
+	}
+	return n
+}
+
+func (m *JobLeaseExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.RequeueReason != 0 {
+		n += 1 + sovEvent(uint64(m.RequeueReason))
+	}
+	return n
+}
+
+func (m *JobPendingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	return n
+}
+
+func (m *JobRunningEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if len(m.NodeLabels) > 0 {
+		for k, v := range m.NodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if m.SchedulingLatency != nil {
+		l = m.SchedulingLatency.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSchedulingLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Queued)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PodCreation)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PodStartup)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobUnableToScheduleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	return n
+}
+
+func (m *JobFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ExitCodes) > 0 {
+		for k, v := range m.ExitCodes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if len(m.ContainerStatuses) > 0 {
+		for _, e := range m.ContainerStatuses {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.Cause != 0 {
+		n += 1 + sovEvent(uint64(m.Cause))
+	}
+	if m.RunDetails != nil {
+		l = m.RunDetails.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSucceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if m.RunDetails != nil {
+		l = m.RunDetails.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.InstanceType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Started != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Finished != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUtilisationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k, v := range m.MaxResourcesForPeriod {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	return n
+}
+
+func (m *JobReprioritizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *JobCancellingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobServiceCreatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ClusterIp)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *JobGangPlacedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.GangId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GangSize != 0 {
+		n += 1 + sovEvent(uint64(m.GangSize))
+	}
+	return n
+}
+
+func (m *JobGangRejectedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.GangId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.GangSize != 0 {
+		n += 1 + sovEvent(uint64(m.GangSize))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobDeadlineExceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobMemoryIncreasedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvent(uint64(m.Attempt))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.MemoryLimits) > 0 {
+		for k, v := range m.MemoryLimits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobProtectedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RequeueReason != 0 {
+		n += 1 + sovEvent(uint64(m.RequeueReason))
+	}
+	return n
+}
+
+func (m *JobTerminatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		n += m.Events.Size()
+	}
+	return n
+}
+
+func (m *EventMessage_Submitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Queued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued != nil {
+		l = m.Queued.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Leased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leased != nil {
+		l = m.Leased.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseReturned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseReturned != nil {
+		l = m.LeaseReturned.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseExpired != nil {
+		l = m.LeaseExpired.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Pending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Running) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running != nil {
+		l = m.Running.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_UnableToSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnableToSchedule != nil {
+		l = m.UnableToSchedule.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Failed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failed != nil {
+		l = m.Failed.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Succeeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded != nil {
+		l = m.Succeeded.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Reprioritized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reprioritized != nil {
+		l = m.Reprioritized.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelling != nil {
+		l = m.Cancelling.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelled != nil {
+		l = m.Cancelled.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Terminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Terminated != nil {
+		l = m.Terminated.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Utilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Utilisation != nil {
+		l = m.Utilisation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_DuplicateFound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateFound != nil {
+		l = m.DuplicateFound.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_ServiceCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceCreated != nil {
+		l = m.ServiceCreated.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_GangPlaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GangPlaced != nil {
+		l = m.GangPlaced.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_GangRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GangRejected != nil {
+		l = m.GangRejected.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_MemoryIncreased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemoryIncreased != nil {
+		l = m.MemoryIncreased.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_DeadlineExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadlineExceeded != nil {
+		l = m.DeadlineExceeded.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Protected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Protected != nil {
+		l = m.Protected.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *ContainerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovEvent(uint64(m.ExitCode))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovEvent(uint64(m.Cause))
+	}
+	l = len(m.LogTail)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventStreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Watch {
+		n += 2
+	}
+	l = len(m.FromMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WatchJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovEvent(uint64(m.Phase))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTransitionTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot {
+		n += 2
+	}
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Queued != 0 {
+		n += 1 + sovEvent(uint64(m.Queued))
+	}
+	if m.Leased != 0 {
+		n += 1 + sovEvent(uint64(m.Leased))
+	}
+	if m.Running != 0 {
+		n += 1 + sovEvent(uint64(m.Running))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovEvent(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovEvent(uint64(m.Failed))
+	}
+	if m.Cancelled != 0 {
+		n += 1 + sovEvent(uint64(m.Cancelled))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobSubmittedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmittedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobQueuedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobQueuedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobDuplicateFoundEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobDuplicateFoundEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OriginalJobId:` + fmt.Sprintf("%v", this.OriginalJobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeasedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeasedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeaseReturnedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeaseReturnedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RequeueReason:` + fmt.Sprintf("%v", this.RequeueReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeaseExpiredEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeaseExpiredEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`RequeueReason:` + fmt.Sprintf("%v", this.RequeueReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobPendingEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobPendingEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRunningEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNodeLabels := make([]string, 0, len(this.NodeLabels))
+	for k, _ := range this.NodeLabels {
+		keysForNodeLabels = append(keysForNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeLabels)
+	mapStringForNodeLabels := "map[string]string{"
+	for _, k := range keysForNodeLabels {
+		mapStringForNodeLabels += fmt.Sprintf("%v: %v,", k, this.NodeLabels[k])
+	}
+	mapStringForNodeLabels += "}"
+	s := strings.Join([]string{`&JobRunningEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`NodeLabels:` + mapStringForNodeLabels + `,`,
+		`SchedulingLatency:` + strings.Replace(this.SchedulingLatency.String(), "JobSchedulingLatency", "JobSchedulingLatency", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSchedulingLatency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSchedulingLatency{`,
+		`Queued:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Queued), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`PodCreation:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PodCreation), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`PodStartup:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PodStartup), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUnableToScheduleEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobUnableToScheduleEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobFailedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainerStatuses := "[]*ContainerStatus{"
+	for _, f := range this.ContainerStatuses {
+		repeatedStringForContainerStatuses += strings.Replace(f.String(), "ContainerStatus", "ContainerStatus", 1) + ","
+	}
+	repeatedStringForContainerStatuses += "}"
+	keysForExitCodes := make([]string, 0, len(this.ExitCodes))
+	for k, _ := range this.ExitCodes {
+		keysForExitCodes = append(keysForExitCodes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExitCodes)
+	mapStringForExitCodes := "map[string]int32{"
+	for _, k := range keysForExitCodes {
+		mapStringForExitCodes += fmt.Sprintf("%v: %v,", k, this.ExitCodes[k])
+	}
+	mapStringForExitCodes += "}"
+	s := strings.Join([]string{`&JobFailedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ExitCodes:` + mapStringForExitCodes + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`ContainerStatuses:` + repeatedStringForContainerStatuses + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`RunDetails:` + strings.Replace(this.RunDetails.String(), "JobRunDetails", "JobRunDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSucceededEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSucceededEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`RunDetails:` + strings.Replace(this.RunDetails.String(), "JobRunDetails", "JobRunDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRunDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRunDetails{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`InstanceType:` + fmt.Sprintf("%v", this.InstanceType) + `,`,
+		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Finished:` + strings.Replace(fmt.Sprintf("%v", this.Finished), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUtilisationEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMaxResourcesForPeriod := make([]string, 0, len(this.MaxResourcesForPeriod))
+	for k, _ := range this.MaxResourcesForPeriod {
+		keysForMaxResourcesForPeriod = append(keysForMaxResourcesForPeriod, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxResourcesForPeriod)
+	mapStringForMaxResourcesForPeriod := "map[string]resource.Quantity{"
+	for _, k := range keysForMaxResourcesForPeriod {
+		mapStringForMaxResourcesForPeriod += fmt.Sprintf("%v: %v,", k, this.MaxResourcesForPeriod[k])
+	}
+	mapStringForMaxResourcesForPeriod += "}"
+	s := strings.Join([]string{`&JobUtilisationEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`MaxResourcesForPeriod:` + mapStringForMaxResourcesForPeriod + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancellingEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancellingEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelledEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelledEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobServiceCreatedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobServiceCreatedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`ClusterIp:` + fmt.Sprintf("%v", this.ClusterIp) + `,`,
+		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobGangPlacedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobGangPlacedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`GangId:` + fmt.Sprintf("%v", this.GangId) + `,`,
+		`GangSize:` + fmt.Sprintf("%v", this.GangSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobGangRejectedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobGangRejectedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`GangId:` + fmt.Sprintf("%v", this.GangId) + `,`,
+		`GangSize:` + fmt.Sprintf("%v", this.GangSize) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobDeadlineExceededEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobDeadlineExceededEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Deadline:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Deadline), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobMemoryIncreasedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMemoryLimits := make([]string, 0, len(this.MemoryLimits))
+	for k, _ := range this.MemoryLimits {
+		keysForMemoryLimits = append(keysForMemoryLimits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMemoryLimits)
+	mapStringForMemoryLimits := "map[string]resource.Quantity{"
+	for _, k := range keysForMemoryLimits {
+		mapStringForMemoryLimits += fmt.Sprintf("%v: %v,", k, this.MemoryLimits[k])
+	}
+	mapStringForMemoryLimits += "}"
+	s := strings.Join([]string{`&JobMemoryIncreasedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`MemoryLimits:` + mapStringForMemoryLimits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobProtectedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobProtectedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RequeueReason:` + fmt.Sprintf("%v", this.RequeueReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobTerminatedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobTerminatedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage{`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Submitted) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Submitted{`,
+		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "JobSubmittedEvent", "JobSubmittedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Queued) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Queued{`,
+		`Queued:` + strings.Replace(fmt.Sprintf("%v", this.Queued), "JobQueuedEvent", "JobQueuedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Leased) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Leased{`,
+		`Leased:` + strings.Replace(fmt.Sprintf("%v", this.Leased), "JobLeasedEvent", "JobLeasedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_LeaseReturned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_LeaseReturned{`,
+		`LeaseReturned:` + strings.Replace(fmt.Sprintf("%v", this.LeaseReturned), "JobLeaseReturnedEvent", "JobLeaseReturnedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_LeaseExpired) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_LeaseExpired{`,
+		`LeaseExpired:` + strings.Replace(fmt.Sprintf("%v", this.LeaseExpired), "JobLeaseExpiredEvent", "JobLeaseExpiredEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Pending) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Pending{`,
+		`Pending:` + strings.Replace(fmt.Sprintf("%v", this.Pending), "JobPendingEvent", "JobPendingEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Running) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Running{`,
+		`Running:` + strings.Replace(fmt.Sprintf("%v", this.Running), "JobRunningEvent", "JobRunningEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_UnableToSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_UnableToSchedule{`,
+		`UnableToSchedule:` + strings.Replace(fmt.Sprintf("%v", this.UnableToSchedule), "JobUnableToScheduleEvent", "JobUnableToScheduleEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Failed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Failed{`,
+		`Failed:` + strings.Replace(fmt.Sprintf("%v", this.Failed), "JobFailedEvent", "JobFailedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Succeeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Succeeded{`,
+		`Succeeded:` + strings.Replace(fmt.Sprintf("%v", this.Succeeded), "JobSucceededEvent", "JobSucceededEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Reprioritized) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Reprioritized{`,
+		`Reprioritized:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritized), "JobReprioritizedEvent", "JobReprioritizedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Cancelling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Cancelling{`,
+		`Cancelling:` + strings.Replace(fmt.Sprintf("%v", this.Cancelling), "JobCancellingEvent", "JobCancellingEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Cancelled) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Cancelled{`,
+		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "JobCancelledEvent", "JobCancelledEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Terminated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Terminated{`,
+		`Terminated:` + strings.Replace(fmt.Sprintf("%v", this.Terminated), "JobTerminatedEvent", "JobTerminatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Utilisation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Utilisation{`,
+		`Utilisation:` + strings.Replace(fmt.Sprintf("%v", this.Utilisation), "JobUtilisationEvent", "JobUtilisationEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_DuplicateFound) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_DuplicateFound{`,
+		`DuplicateFound:` + strings.Replace(fmt.Sprintf("%v", this.DuplicateFound), "JobDuplicateFoundEvent", "JobDuplicateFoundEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_ServiceCreated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_ServiceCreated{`,
+		`ServiceCreated:` + strings.Replace(fmt.Sprintf("%v", this.ServiceCreated), "JobServiceCreatedEvent", "JobServiceCreatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_GangPlaced) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_GangPlaced{`,
+		`GangPlaced:` + strings.Replace(fmt.Sprintf("%v", this.GangPlaced), "JobGangPlacedEvent", "JobGangPlacedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_GangRejected) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_GangRejected{`,
+		`GangRejected:` + strings.Replace(fmt.Sprintf("%v", this.GangRejected), "JobGangRejectedEvent", "JobGangRejectedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_MemoryIncreased) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_MemoryIncreased{`,
+		`MemoryIncreased:` + strings.Replace(fmt.Sprintf("%v", this.MemoryIncreased), "JobMemoryIncreasedEvent", "JobMemoryIncreasedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_DeadlineExceeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_DeadlineExceeded{`,
+		`DeadlineExceeded:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineExceeded), "JobDeadlineExceededEvent", "JobDeadlineExceededEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Protected) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Protected{`,
+		`Protected:` + strings.Replace(fmt.Sprintf("%v", this.Protected), "JobProtectedEvent", "JobProtectedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`LogTail:` + fmt.Sprintf("%v", this.LogTail) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]*EventMessage{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(f.String(), "EventMessage", "EventMessage", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&EventList{`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventStreamMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventStreamMessage{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Message:` + strings.Replace(this.Message.String(), "EventMessage", "EventMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Watch:` + fmt.Sprintf("%v", this.Watch) + `,`,
+		`FromMessageId:` + fmt.Sprintf("%v", this.FromMessageId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchJobsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchJobsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobState{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobStateUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobs := "[]*JobState{"
+	for _, f := range this.Jobs {
+		repeatedStringForJobs += strings.Replace(f.String(), "JobState", "JobState", 1) + ","
+	}
+	repeatedStringForJobs += "}"
+	s := strings.Join([]string{`&JobStateUpdate{`,
+		`Snapshot:` + fmt.Sprintf("%v", this.Snapshot) + `,`,
+		`Jobs:` + repeatedStringForJobs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetStatusRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetStatus{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queued:` + fmt.Sprintf("%v", this.Queued) + `,`,
+		`Leased:` + fmt.Sprintf("%v", this.Leased) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Cancelled:` + fmt.Sprintf("%v", this.Cancelled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEvent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobDuplicateFoundEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobDuplicateFoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueReason", wireType)
+			}
+			m.RequeueReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequeueReason |= RequeueReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueReason", wireType)
+			}
+			m.RequeueReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequeueReason |= RequeueReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLabels == nil {
+				m.NodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingLatency == nil {
+				m.SchedulingLatency = &JobSchedulingLatency{}
+			}
+			if err := m.SchedulingLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSchedulingLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSchedulingLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSchedulingLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Queued, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCreation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PodCreation, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodStartup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PodStartup, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExitCodes == nil {
+				m.ExitCodes = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExitCodes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
+			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= Cause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunDetails == nil {
+				m.RunDetails = &JobRunDetails{}
+			}
+			if err := m.RunDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunDetails == nil {
+				m.RunDetails = &JobRunDetails{}
+			}
+			if err := m.RunDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Finished, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5225,10 +12081,10 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5282,22 +12138,280 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxResourcesForPeriod == nil {
+				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MaxResourcesForPeriod[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5325,46 +12439,13 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var msglen int
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5374,25 +12455,11 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -5417,7 +12484,7 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5440,10 +12507,10 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5575,6 +12642,17 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -5599,7 +12677,7 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5622,10 +12700,10 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobDuplicateFoundEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobDuplicateFoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5759,7 +12837,7 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5787,7 +12865,7 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalJobId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5813,7 +12891,7 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5836,10 +12914,10 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5973,7 +13051,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6001,7 +13079,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6027,7 +13105,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobServiceCreatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6050,10 +13128,10 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobServiceCreatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobServiceCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6219,92 +13297,7 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6332,13 +13325,13 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var stringLen uint64
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6348,27 +13341,14 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6396,13 +13376,13 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6412,25 +13392,100 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -6455,7 +13510,7 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobGangPlacedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6478,10 +13533,10 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobGangPlacedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobGangPlacedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6647,7 +13702,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GangId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6675,13 +13730,13 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.GangId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GangSize", wireType)
 			}
-			m.PodNumber = 0
+			m.GangSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6691,7 +13746,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				m.GangSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6720,7 +13775,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+func (m *JobGangRejectedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6743,10 +13798,10 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobGangRejectedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobGangRejectedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6912,7 +13967,7 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GangId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6940,13 +13995,13 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.GangId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangSize", wireType)
 			}
-			var stringLen uint64
+			m.GangSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6956,29 +14011,16 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GangSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.PodNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6988,11 +14030,24 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -7017,7 +14072,7 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+func (m *JobDeadlineExceededEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7040,10 +14095,10 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobDeadlineExceededEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobDeadlineExceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7171,143 +14226,15 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.PodNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7317,11 +14244,25 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -7346,7 +14287,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobMemoryIncreasedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7369,10 +14310,10 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMemoryIncreasedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMemoryIncreasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7537,6 +14478,76 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -7568,9 +14579,9 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7597,11 +14608,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExitCodes == nil {
-				m.ExitCodes = make(map[string]int32)
+			if m.MemoryLimits == nil {
+				m.MemoryLimits = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue int32
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7650,6 +14661,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowEvent
@@ -7659,164 +14671,43 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthEvent
 					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExitCodes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
-			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
-			}
-			m.Cause = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
-				if b < 0x80 {
-					break
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.MemoryLimits[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -7841,7 +14732,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+func (m *JobProtectedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7864,10 +14755,10 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobProtectedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobProtectedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8033,7 +14924,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8061,45 +14952,13 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueReason", wireType)
 			}
-			m.PodNumber = 0
+			m.RequeueReason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8109,7 +14968,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				m.RequeueReason |= RequeueReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8138,7 +14997,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
+func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8161,10 +15020,10 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8328,41 +15187,62 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8389,111 +15269,52 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxResourcesForPeriod == nil {
-				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
+			v := &JobSubmittedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.Events = &EventMessage_Submitted{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MaxResourcesForPeriod[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobQueuedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Queued{v}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8503,29 +15324,32 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			v := &JobLeasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Leased{v}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
 			}
-			m.PodNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8535,69 +15359,32 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &JobLeaseReturnedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Events = &EventMessage_LeaseReturned{v}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8607,29 +15394,32 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobLeaseExpiredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_LeaseExpired{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8639,29 +15429,32 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobPendingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Pending{v}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8671,27 +15464,30 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobRunningEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Running{v}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8718,68 +15514,52 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobUnableToScheduleEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_UnableToSchedule{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &JobFailedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Events = &EventMessage_Failed{v}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8789,29 +15569,32 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobSucceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Succeeded{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8821,29 +15604,32 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobReprioritizedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Reprioritized{v}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8853,27 +15639,30 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobCancellingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Cancelling{v}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8900,68 +15689,17 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobCancelledEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_Cancelled{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8971,29 +15709,32 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobTerminatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Terminated{v}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9003,29 +15744,32 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobUtilisationEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Utilisation{v}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9035,27 +15779,30 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobDuplicateFoundEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_DuplicateFound{v}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceCreated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9082,68 +15829,17 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobServiceCreatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_ServiceCreated{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GangPlaced", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9153,29 +15849,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobGangPlacedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_GangPlaced{v}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GangRejected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9185,29 +15884,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobGangRejectedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_GangRejected{v}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryIncreased", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9217,27 +15919,30 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobMemoryIncreasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_MemoryIncreased{v}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExceeded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9264,15 +15969,17 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobDeadlineExceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_DeadlineExceeded{v}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9282,23 +15989,26 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			v := &JobProtectedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Protected{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9324,7 +16034,7 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMessage) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9347,17 +16057,17 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9367,32 +16077,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSubmittedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Submitted{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			var msglen int
+			m.ExitCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9402,32 +16109,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExitCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobQueuedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Queued{v}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9437,32 +16128,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeasedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Leased{v}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9472,32 +16160,48 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseReturnedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_LeaseReturned{v}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= Cause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogTail", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9507,30 +16211,80 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseExpiredEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogTail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Events = &EventMessage_LeaseExpired{v}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9557,17 +16311,69 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPendingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, &EventMessage{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Pending{v}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9577,30 +16383,27 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunningEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Running{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9627,52 +16430,71 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUnableToScheduleEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Message == nil {
+				m.Message = &EventMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_UnableToSchedule{v}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobFailedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			m.Events = &EventMessage_Failed{v}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9682,32 +16504,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSucceededEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Succeeded{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9717,32 +16536,17 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobReprioritizedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Reprioritized{v}
-			iNdEx = postIndex
-		case 12:
+			m.Watch = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9752,32 +16556,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancellingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Cancelling{v}
+			m.FromMessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9787,32 +16588,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancelledEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Cancelled{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9822,32 +16620,82 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobTerminatedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Terminated{v}
-			iNdEx = postIndex
-		case 15:
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9857,32 +16705,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUtilisationEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Utilisation{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9892,26 +16737,23 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobDuplicateFoundEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_DuplicateFound{v}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9937,7 +16779,7 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+func (m *JobState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9960,15 +16802,15 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9996,13 +16838,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.ExitCode = 0
+			m.Phase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10012,14 +16854,14 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= int32(b&0x7F) << shift
+				m.Phase |= JobPhase(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10047,13 +16889,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10063,43 +16905,25 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
-			}
-			m.Cause = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastTransitionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -10124,7 +16948,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventList) Unmarshal(dAtA []byte) error {
+func (m *JobStateUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10147,15 +16971,35 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobStateUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10182,8 +17026,8 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &EventMessage{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Jobs = append(m.Jobs, &JobState{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10211,7 +17055,7 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+func (m *JobSetStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10234,15 +17078,15 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10270,13 +17114,13 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10286,27 +17130,23 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &EventMessage{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10332,7 +17172,7 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10355,15 +17195,15 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10391,13 +17231,13 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10407,17 +17247,29 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Watch = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
 			}
-			var stringLen uint64
+			m.Queued = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10427,29 +17279,54 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Queued |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			m.Leased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leased |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			m.FromMessageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			m.Running = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Running |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10459,24 +17336,49 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Succeeded |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			m.Cancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cancelled |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
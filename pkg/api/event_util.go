@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
+
+	"github.com/gogo/protobuf/proto"
 )
 
 type Event interface {
@@ -21,6 +24,28 @@ type KubernetesEvent interface {
 	GetPodNumber() int32
 }
 
+// event type names are the names of the fields of the EventMessage oneof, e.g. failed or lease_expired
+var eventTypeNames = func() map[reflect.Type]string {
+	names := map[reflect.Type]string{}
+	for name, oneof := range proto.GetProperties(reflect.TypeOf(EventMessage{})).OneofTypes {
+		names[oneof.Type] = name
+	}
+	return names
+}()
+
+func EventTypeName(message *EventMessage) string {
+	return eventTypeNames[reflect.TypeOf(message.Events)]
+}
+
+func EventTypeNames() []string {
+	names := make([]string, 0, len(eventTypeNames))
+	for _, name := range eventTypeNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // customize oneof serialization
 func (message *EventMessage) MarshalJSON() ([]byte, error) {
 	return json.Marshal(message.Events)
@@ -63,6 +88,18 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Terminated, nil
 	case *EventMessage_Utilisation:
 		return event.Utilisation, nil
+	case *EventMessage_ServiceCreated:
+		return event.ServiceCreated, nil
+	case *EventMessage_GangPlaced:
+		return event.GangPlaced, nil
+	case *EventMessage_GangRejected:
+		return event.GangRejected, nil
+	case *EventMessage_MemoryIncreased:
+		return event.MemoryIncreased, nil
+	case *EventMessage_DeadlineExceeded:
+		return event.DeadlineExceeded, nil
+	case *EventMessage_Protected:
+		return event.Protected, nil
 	}
 	return nil, fmt.Errorf("unknow event type: %s", reflect.TypeOf(message.Events))
 }
@@ -165,6 +202,42 @@ func Wrap(event Event) (*EventMessage, error) {
 				Utilisation: typed,
 			},
 		}, nil
+	case *JobServiceCreatedEvent:
+		return &EventMessage{
+			Events: &EventMessage_ServiceCreated{
+				ServiceCreated: typed,
+			},
+		}, nil
+	case *JobGangPlacedEvent:
+		return &EventMessage{
+			Events: &EventMessage_GangPlaced{
+				GangPlaced: typed,
+			},
+		}, nil
+	case *JobGangRejectedEvent:
+		return &EventMessage{
+			Events: &EventMessage_GangRejected{
+				GangRejected: typed,
+			},
+		}, nil
+	case *JobMemoryIncreasedEvent:
+		return &EventMessage{
+			Events: &EventMessage_MemoryIncreased{
+				MemoryIncreased: typed,
+			},
+		}, nil
+	case *JobDeadlineExceededEvent:
+		return &EventMessage{
+			Events: &EventMessage_DeadlineExceeded{
+				DeadlineExceeded: typed,
+			},
+		}, nil
+	case *JobProtectedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Protected{
+				Protected: typed,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
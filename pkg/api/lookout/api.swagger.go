@@ -126,6 +126,17 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"deadline\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"gangId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"gangSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
 		"        \"id\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -138,6 +149,13 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"maxRetries\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"minKubernetesVersion\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -160,11 +178,58 @@ func SwaggerJsonTemplate() string {
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"requiredClusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"requiredNodeLabels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"servicePorts\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ServicePort\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"toleratesPreemptibleNodes\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"volumeClaimTemplate\": {\n" +
+		"          \"title\": \"Copied from the queue when the job is submitted\",\n" +
+		"          \"$ref\": \"#/definitions/apiVolumeClaimTemplate\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiVolumeClaimTemplate\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Persistent volume claim created by the executor for every pod of a job, mounted into all its containers and deleted together with the pod\",\n" +
+		"      \"properties\": {\n" +
+		"        \"accessModes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"ReadWriteOnce when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"mountPath\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Name of the volume in the pod spec\"\n" +
+		"        },\n" +
+		"        \"storage\": {\n" +
+		"          \"title\": \"Requested storage of the claim\",\n" +
+		"          \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"        },\n" +
+		"        \"storageClassName\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Empty uses the default storage class of the cluster\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -473,6 +538,28 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"timeDuration\": {\n" +
+		"      \"description\": \"A Duration represents the elapsed time between two instants\\nas an int64 nanosecond count. The representation limits the\\nlargest representable duration to approximately 290 years.\",\n" +
+		"      \"type\": \"integer\",\n" +
+		"      \"format\": \"int64\",\n" +
+		"      \"x-go-package\": \"time\"\n" +
+		"    },\n" +
+		"    \"typesFieldMask\": {\n" +
+		"      \"description\": \"paths: \\\"f.a\\\"\\npaths: \\\"f.b.d\\\"\\n\\nHere `f` represents a field in some root message, `a` and `b`\\nfields in the message found in `f`, and `d` a field found in the\\nmessage in `f.b`.\\n\\nField masks are used to specify a subset of fields that should be\\nreturned by a get operation or modified by an update operation.\\nField masks also have a custom JSON encoding (see below).\\n\\n# Field Masks in Projections\\n\\nWhen used in the context of a projection, a response message or\\nsub-message is filtered by the API to only contain those fields as\\nspecified in the mask. For example, if the mask in the previous\\nexample is applied to a response message as follows:\\n\\nf {\\na : 22\\nb {\\nd : 1\\nx : 2\\n}\\ny : 13\\n}\\nz: 8\\n\\nThe result will not contain specific values for fields x,y and z\\n(their value will be set to the default, and omitted in proto text\\noutput):\\n\\n\\nf {\\na : 22\\nb {\\nd : 1\\n}\\n}\\n\\nA repeated field is not allowed except at the last position of a\\npaths string.\\n\\nIf a FieldMask object is not present in a get operation, the\\noperation applies to all fields (as if a FieldMask of all fields\\nhad been specified).\\n\\nNote that a field mask does not necessarily apply to the\\ntop-level response message. In case of a REST get operation, the\\nfield mask applies directly to the response, but in case of a REST\\nlist operation, the mask instead applies to each individual message\\nin the returned resource list. In case of a REST custom method,\\nother definitions may be used. Where the mask applies will be\\nclearly documented together with its declaration in the API.  In\\nany case, the effect on the returned resource/resources is required\\nbehavior for APIs.\\n\\n# Field Masks in Update Operations\\n\\nA field mask in update operations specifies which fields of the\\ntargeted resource are going to be updated. The API is required\\nto only change the values of the fields as specified in the mask\\nand leave the others untouched. If a resource is passed in to\\ndescribe the updated values, the API ignores the values of all\\nfields not covered by the mask.\\n\\nIf a repeated field is specified for an update operation, new values will\\nbe appended to the existing repeated field in the target resource. Note that\\na repeated field is only allowed in the last position of a `paths` string.\\n\\nIf a sub-message is specified in the last position of the field mask for an\\nupdate operation, then new value will be merged into the existing sub-message\\nin the target resource.\\n\\nFor example, given the target message:\\n\\nf {\\nb {\\nd: 1\\nx: 2\\n}\\nc: [1]\\n}\\n\\nAnd an update message:\\n\\nf {\\nb {\\nd: 10\\n}\\nc: [2]\\n}\\n\\nthen if the field mask is:\\n\\npaths: [\\\"f.b\\\", \\\"f.c\\\"]\\n\\nthen the result will be:\\n\\nf {\\nb {\\nd: 10\\nx: 2\\n}\\nc: [1, 2]\\n}\\n\\nAn implementation may provide options to override this default behavior for\\nrepeated and message fields.\\n\\nIn order to reset a field's value to the default, the field must\\nbe in the mask and set to the default value in the provided resource.\\nHence, in order to reset all fields of a resource, provide a default\\ninstance of the resource and set all fields in the mask, or do\\nnot provide a mask as described below.\\n\\nIf a field mask is not present on update, the operation applies to\\nall fields (as if a field mask of all fields has been specified).\\nNote that in the presence of schema evolution, this may mean that\\nfields the client does not know and has therefore not filled into\\nthe request will be reset to their default. If this is unwanted\\nbehavior, a specific service may require a client to always specify\\na field mask, producing an error if not.\\n\\nAs with get operations, the location of the resource which\\ndescribes the updated values in the request message depends on the\\noperation kind. In any case, the effect of the field mask is\\nrequired to be honored by the API.\\n\\n## Considerations for HTTP REST\\n\\nThe HTTP kind of an update operation which uses a field mask must\\nbe set to PATCH instead of PUT in order to satisfy HTTP semantics\\n(PUT must only be used for full updates).\\n\\n# JSON Encoding of Field Masks\\n\\nIn JSON, a field mask is encoded as a single string where paths are\\nseparated by a comma. Fields name in each path are converted\\nto/from lower-camel naming conventions.\\n\\nAs an example, consider the following message declarations:\\n\\nmessage Profile {\\nUser user = 1;\\nPhoto photo = 2;\\n}\\nmessage User {\\nstring display_name = 1;\\nstring address = 2;\\n}\\n\\nIn proto a field mask for `Profile` may look as such:\\n\\nmask {\\npaths: \\\"user.display_name\\\"\\npaths: \\\"photo\\\"\\n}\\n\\nIn JSON, the same mask is represented as below:\\n\\n{\\nmask: \\\"user.displayName,photo\\\"\\n}\\n\\n# Field Masks and Oneof Fields\\n\\nField masks treat fields in oneofs just as regular fields. Consider the\\nfollowing message:\\n\\nmessage SampleMessage {\\noneof test_oneof {\\nstring name = 4;\\nSubMessage sub_message = 9;\\n}\\n}\\n\\nThe field mask can be:\\n\\nmask {\\npaths: \\\"name\\\"\\n}\\n\\nOr:\\n\\nmask {\\npaths: \\\"sub_message\\\"\\n}\\n\\nNote that oneof type names (\\\"test_oneof\\\" in this case) cannot be used in\\npaths.\\n\\n## Field Mask Verification\\n\\nThe implementation of any API method which has a FieldMask type field in the\\nrequest should verify the included field paths, and return an\\n`INVALID_ARGUMENT` error if any path is duplicated or unmappable.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"`FieldMask` represents a set of symbolic field paths, for example:\",\n" +
+		"      \"properties\": {\n" +
+		"        \"paths\": {\n" +
+		"          \"description\": \"The set of field mask paths.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Paths\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"github.com/gogo/protobuf/types\"\n" +
+		"    },\n" +
 		"    \"typesUID\": {\n" +
 		"      \"description\": \"UID is a type that holds unique ID values, including UUIDs.  Because we\\ndon't ONLY use UUIDs, this is an alias to string.  Being a type captures\\nintent and helps make sure that UIDs and names do not get conflated.\",\n" +
 		"      \"type\": \"string\",\n" +
@@ -3235,6 +3322,41 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1ServicePort\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"ServicePort contains information on service's port.\",\n" +
+		"      \"properties\": {\n" +
+		"        \"appProtocol\": {\n" +
+		"          \"description\": \"The application protocol for this port.\\nThis field follows standard Kubernetes label syntax.\\nUn-prefixed names are reserved for IANA standard service names (as per\\nRFC-6335 and http://www.iana.org/assignments/service-names).\\nNon-standard protocols should use prefixed names such as\\nmycompany.com/my-custom-protocol.\\nThis is a beta field that is guarded by the ServiceAppProtocol feature\\ngate and enabled by default.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"AppProtocol\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"The name of this port within the service. This must be a DNS_LABEL.\\nAll ports within a ServiceSpec must have unique names. When considering\\nthe endpoints for a Service, this must match the 'name' field in the\\nEndpointPort.\\nOptional if only one ServicePort is defined on this service.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Name\"\n" +
+		"        },\n" +
+		"        \"nodePort\": {\n" +
+		"          \"description\": \"The port on each node on which this service is exposed when type is\\nNodePort or LoadBalancer.  Usually assigned by the system. If a value is\\nspecified, in-range, and not in use it will be used, otherwise the\\noperation will fail.  If not specified, a port will be allocated if this\\nService requires one.  If this field is specified when creating a\\nService which does not need it, creation will fail. This field will be\\nwiped when updating a Service to no longer need it (e.g. changing type\\nfrom NodePort to ClusterIP).\\nMore info: https://kubernetes.io/docs/concepts/services-networking/service/#type-nodeport\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"x-go-name\": \"NodePort\"\n" +
+		"        },\n" +
+		"        \"port\": {\n" +
+		"          \"description\": \"The port that will be exposed by this service.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\",\n" +
+		"          \"x-go-name\": \"Port\"\n" +
+		"        },\n" +
+		"        \"protocol\": {\n" +
+		"          \"$ref\": \"#/definitions/v1Protocol\"\n" +
+		"        },\n" +
+		"        \"targetPort\": {\n" +
+		"          \"$ref\": \"#/definitions/intstrIntOrString\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
+		"    },\n" +
 		"    \"v1StorageMedium\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"title\": \"StorageMedium defines ways that storage can be allocated to a volume.\",\n" +
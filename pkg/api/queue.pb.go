@@ -38,20 +38,77 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Why the lease of a job was lost and the job was put back to its queue
+type RequeueReason int32
+
+const (
+	RequeueReason_UnspecifiedRequeueReason RequeueReason = 0
+	RequeueReason_LeaseExpired             RequeueReason = 1
+	RequeueReason_PodCreationFailed        RequeueReason = 2
+	RequeueReason_PodStuck                 RequeueReason = 3
+	RequeueReason_VolumeUnavailable        RequeueReason = 4
+	RequeueReason_NodeUnreachable          RequeueReason = 5
+	RequeueReason_ExecutorShutdown         RequeueReason = 6
+	RequeueReason_NodeDrain                RequeueReason = 7
+	RequeueReason_LeaseRenewalFailed       RequeueReason = 8
+)
+
+var RequeueReason_name = map[int32]string{
+	0: "UnspecifiedRequeueReason",
+	1: "LeaseExpired",
+	2: "PodCreationFailed",
+	3: "PodStuck",
+	4: "VolumeUnavailable",
+	5: "NodeUnreachable",
+	6: "ExecutorShutdown",
+	7: "NodeDrain",
+	8: "LeaseRenewalFailed",
+}
+
+var RequeueReason_value = map[string]int32{
+	"UnspecifiedRequeueReason": 0,
+	"LeaseExpired":             1,
+	"PodCreationFailed":        2,
+	"PodStuck":                 3,
+	"VolumeUnavailable":        4,
+	"NodeUnreachable":          5,
+	"ExecutorShutdown":         6,
+	"NodeDrain":                7,
+	"LeaseRenewalFailed":       8,
+}
+
+func (x RequeueReason) String() string {
+	return proto.EnumName(RequeueReason_name, int32(x))
+}
+
+func (RequeueReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{0}
+}
+
 type Job struct {
-	Id                 string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientId           string            `protobuf:"bytes,13,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
-	JobSetId           string            `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue              string            `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Namespace          string            `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Labels             map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Annotations        map[string]string `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RequiredNodeLabels map[string]string `protobuf:"bytes,11,rep,name=required_node_labels,json=requiredNodeLabels,proto3" json:"requiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Deprecated: Do not use.
-	Owner              string            `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
-	Priority           float64           `protobuf:"fixed64,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	PodSpec            *v1.PodSpec       `protobuf:"bytes,5,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"` // Deprecated: Do not use.
-	PodSpecs           []*v1.PodSpec     `protobuf:"bytes,12,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
-	Created            time.Time         `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created"`
+	Id                        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId                  string            `protobuf:"bytes,13,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
+	JobSetId                  string            `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue                     string            `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Namespace                 string            `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels                    map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations               map[string]string `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RequiredNodeLabels        map[string]string `protobuf:"bytes,11,rep,name=required_node_labels,json=requiredNodeLabels,proto3" json:"requiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Deprecated: Do not use.
+	Owner                     string            `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	Priority                  float64           `protobuf:"fixed64,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	PodSpec                   *v1.PodSpec       `protobuf:"bytes,5,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"` // Deprecated: Do not use.
+	PodSpecs                  []*v1.PodSpec     `protobuf:"bytes,12,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
+	Created                   time.Time         `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created"`
+	MinKubernetesVersion      string            `protobuf:"bytes,14,opt,name=min_kubernetes_version,json=minKubernetesVersion,proto3" json:"minKubernetesVersion,omitempty"`
+	ServicePorts              []*v1.ServicePort `protobuf:"bytes,15,rep,name=service_ports,json=servicePorts,proto3" json:"servicePorts,omitempty"`
+	MaxRetries                uint32            `protobuf:"varint,16,opt,name=max_retries,json=maxRetries,proto3" json:"maxRetries,omitempty"`
+	RequiredClusters          []string          `protobuf:"bytes,17,rep,name=required_clusters,json=requiredClusters,proto3" json:"requiredClusters,omitempty"`
+	GangId                    string            `protobuf:"bytes,18,opt,name=gang_id,json=gangId,proto3" json:"gangId,omitempty"`
+	GangSize                  uint32            `protobuf:"varint,19,opt,name=gang_size,json=gangSize,proto3" json:"gangSize,omitempty"`
+	Deadline                  *time.Time        `protobuf:"bytes,20,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+	ToleratesPreemptibleNodes bool              `protobuf:"varint,21,opt,name=tolerates_preemptible_nodes,json=toleratesPreemptibleNodes,proto3" json:"toleratesPreemptibleNodes,omitempty"`
+	// Copied from the queue when the job is submitted
+	VolumeClaimTemplate *VolumeClaimTemplate `protobuf:"bytes,22,opt,name=volume_claim_template,json=volumeClaimTemplate,proto3" json:"volumeClaimTemplate,omitempty"`
 }
 
 func (m *Job) Reset()      { *m = Job{} }
@@ -179,6 +236,149 @@ func (m *Job) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *Job) GetMinKubernetesVersion() string {
+	if m != nil {
+		return m.MinKubernetesVersion
+	}
+	return ""
+}
+
+func (m *Job) GetServicePorts() []*v1.ServicePort {
+	if m != nil {
+		return m.ServicePorts
+	}
+	return nil
+}
+
+func (m *Job) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Job) GetRequiredClusters() []string {
+	if m != nil {
+		return m.RequiredClusters
+	}
+	return nil
+}
+
+func (m *Job) GetGangId() string {
+	if m != nil {
+		return m.GangId
+	}
+	return ""
+}
+
+func (m *Job) GetGangSize() uint32 {
+	if m != nil {
+		return m.GangSize
+	}
+	return 0
+}
+
+func (m *Job) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *Job) GetToleratesPreemptibleNodes() bool {
+	if m != nil {
+		return m.ToleratesPreemptibleNodes
+	}
+	return false
+}
+
+func (m *Job) GetVolumeClaimTemplate() *VolumeClaimTemplate {
+	if m != nil {
+		return m.VolumeClaimTemplate
+	}
+	return nil
+}
+
+// Persistent volume claim created by the executor for every pod of a job, mounted into all its containers and deleted together with the pod
+type VolumeClaimTemplate struct {
+	// Name of the volume in the pod spec
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MountPath string `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mountPath,omitempty"`
+	// Empty uses the default storage class of the cluster
+	StorageClassName string `protobuf:"bytes,3,opt,name=storage_class_name,json=storageClassName,proto3" json:"storageClassName,omitempty"`
+	// Requested storage of the claim
+	Storage resource.Quantity `protobuf:"bytes,4,opt,name=storage,proto3" json:"storage"`
+	// ReadWriteOnce when empty
+	AccessModes []string `protobuf:"bytes,5,rep,name=access_modes,json=accessModes,proto3" json:"accessModes,omitempty"`
+}
+
+func (m *VolumeClaimTemplate) Reset()      { *m = VolumeClaimTemplate{} }
+func (*VolumeClaimTemplate) ProtoMessage() {}
+func (*VolumeClaimTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{1}
+}
+func (m *VolumeClaimTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeClaimTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeClaimTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeClaimTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeClaimTemplate.Merge(m, src)
+}
+func (m *VolumeClaimTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeClaimTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeClaimTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeClaimTemplate proto.InternalMessageInfo
+
+func (m *VolumeClaimTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeClaimTemplate) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+func (m *VolumeClaimTemplate) GetStorageClassName() string {
+	if m != nil {
+		return m.StorageClassName
+	}
+	return ""
+}
+
+func (m *VolumeClaimTemplate) GetStorage() resource.Quantity {
+	if m != nil {
+		return m.Storage
+	}
+	return resource.Quantity{}
+}
+
+func (m *VolumeClaimTemplate) GetAccessModes() []string {
+	if m != nil {
+		return m.AccessModes
+	}
+	return nil
+}
+
 type LeaseRequest struct {
 	ClusterId           string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
 	Pool                string                       `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -186,12 +386,15 @@ type LeaseRequest struct {
 	ClusterLeasedReport ClusterLeasedReport          `protobuf:"bytes,4,opt,name=cluster_leased_report,json=clusterLeasedReport,proto3" json:"cluster_leased_report"`
 	MinimumJobSize      map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Nodes               []NodeInfo                   `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes"`
+	MaxJobsToLease      uint32                       `protobuf:"varint,9,opt,name=max_jobs_to_lease,json=maxJobsToLease,proto3" json:"maxJobsToLease,omitempty"`
+	KubernetesVersion   string                       `protobuf:"bytes,10,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	PreemptibleNodes    *PreemptibleNodes            `protobuf:"bytes,11,opt,name=preemptible_nodes,json=preemptibleNodes,proto3" json:"preemptibleNodes,omitempty"`
 }
 
 func (m *LeaseRequest) Reset()      { *m = LeaseRequest{} }
 func (*LeaseRequest) ProtoMessage() {}
 func (*LeaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{1}
+	return fileDescriptor_d92c0c680df9617a, []int{2}
 }
 func (m *LeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,6 +465,89 @@ func (m *LeaseRequest) GetNodes() []NodeInfo {
 	return nil
 }
 
+func (m *LeaseRequest) GetMaxJobsToLease() uint32 {
+	if m != nil {
+		return m.MaxJobsToLease
+	}
+	return 0
+}
+
+func (m *LeaseRequest) GetKubernetesVersion() string {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return ""
+}
+
+func (m *LeaseRequest) GetPreemptibleNodes() *PreemptibleNodes {
+	if m != nil {
+		return m.PreemptibleNodes
+	}
+	return nil
+}
+
+// Node label and taint of preemptible (spot) nodes, jobs not tolerating preemptible nodes are kept off nodes with the label
+// and don't tolerate the taint, other jobs tolerate the taint
+type PreemptibleNodes struct {
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// empty matches nodes with any value of the label
+	LabelValue string `protobuf:"bytes,2,opt,name=label_value,json=labelValue,proto3" json:"labelValue,omitempty"`
+	Taint      string `protobuf:"bytes,3,opt,name=taint,proto3" json:"taint,omitempty"`
+}
+
+func (m *PreemptibleNodes) Reset()      { *m = PreemptibleNodes{} }
+func (*PreemptibleNodes) ProtoMessage() {}
+func (*PreemptibleNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{3}
+}
+func (m *PreemptibleNodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreemptibleNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreemptibleNodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreemptibleNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreemptibleNodes.Merge(m, src)
+}
+func (m *PreemptibleNodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreemptibleNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreemptibleNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreemptibleNodes proto.InternalMessageInfo
+
+func (m *PreemptibleNodes) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PreemptibleNodes) GetLabelValue() string {
+	if m != nil {
+		return m.LabelValue
+	}
+	return ""
+}
+
+func (m *PreemptibleNodes) GetTaint() string {
+	if m != nil {
+		return m.Taint
+	}
+	return ""
+}
+
 type NodeInfo struct {
 	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Taints               []v1.Taint                   `protobuf:"bytes,2,rep,name=taints,proto3" json:"taints"`
@@ -273,7 +559,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()      { *m = NodeInfo{} }
 func (*NodeInfo) ProtoMessage() {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{2}
+	return fileDescriptor_d92c0c680df9617a, []int{4}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +632,7 @@ type NodeType struct {
 func (m *NodeType) Reset()      { *m = NodeType{} }
 func (*NodeType) ProtoMessage() {}
 func (*NodeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{3}
+	return fileDescriptor_d92c0c680df9617a, []int{5}
 }
 func (m *NodeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,17 +684,21 @@ func (m *NodeType) GetAllocatableResources() map[string]resource.Quantity {
 
 // Used to store last info in Redis
 type ClusterSchedulingInfoReport struct {
-	ClusterId      string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	Pool           string                       `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`
-	ReportTime     time.Time                    `protobuf:"bytes,2,opt,name=report_time,json=reportTime,proto3,stdtime" json:"report_time"`
-	NodeTypes      []*NodeType                  `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
-	MinimumJobSize map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ClusterId         string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool              string                       `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`
+	ReportTime        time.Time                    `protobuf:"bytes,2,opt,name=report_time,json=reportTime,proto3,stdtime" json:"report_time"`
+	NodeTypes         []*NodeType                  `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
+	MinimumJobSize    map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubernetesVersion string                       `protobuf:"bytes,8,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	// Sum of allocatable resources of all nodes of the cluster
+	TotalAllocatableResources map[string]resource.Quantity `protobuf:"bytes,9,rep,name=total_allocatable_resources,json=totalAllocatableResources,proto3" json:"totalAllocatableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PreemptibleNodes          *PreemptibleNodes            `protobuf:"bytes,10,opt,name=preemptible_nodes,json=preemptibleNodes,proto3" json:"preemptibleNodes,omitempty"`
 }
 
 func (m *ClusterSchedulingInfoReport) Reset()      { *m = ClusterSchedulingInfoReport{} }
 func (*ClusterSchedulingInfoReport) ProtoMessage() {}
 func (*ClusterSchedulingInfoReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{4}
+	return fileDescriptor_d92c0c680df9617a, []int{6}
 }
 func (m *ClusterSchedulingInfoReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,6 +762,27 @@ func (m *ClusterSchedulingInfoReport) GetMinimumJobSize() map[string]resource.Qu
 	return nil
 }
 
+func (m *ClusterSchedulingInfoReport) GetKubernetesVersion() string {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return ""
+}
+
+func (m *ClusterSchedulingInfoReport) GetTotalAllocatableResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.TotalAllocatableResources
+	}
+	return nil
+}
+
+func (m *ClusterSchedulingInfoReport) GetPreemptibleNodes() *PreemptibleNodes {
+	if m != nil {
+		return m.PreemptibleNodes
+	}
+	return nil
+}
+
 type QueueLeasedReport struct {
 	Name            string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ResourcesLeased map[string]resource.Quantity `protobuf:"bytes,2,rep,name=resources_leased,json=resourcesLeased,proto3" json:"resourcesLeased,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -480,7 +791,7 @@ type QueueLeasedReport struct {
 func (m *QueueLeasedReport) Reset()      { *m = QueueLeasedReport{} }
 func (*QueueLeasedReport) ProtoMessage() {}
 func (*QueueLeasedReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{5}
+	return fileDescriptor_d92c0c680df9617a, []int{7}
 }
 func (m *QueueLeasedReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +843,7 @@ type ClusterLeasedReport struct {
 func (m *ClusterLeasedReport) Reset()      { *m = ClusterLeasedReport{} }
 func (*ClusterLeasedReport) ProtoMessage() {}
 func (*ClusterLeasedReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{6}
+	return fileDescriptor_d92c0c680df9617a, []int{8}
 }
 func (m *ClusterLeasedReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +900,7 @@ type ComputeResource struct {
 func (m *ComputeResource) Reset()      { *m = ComputeResource{} }
 func (*ComputeResource) ProtoMessage() {}
 func (*ComputeResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{7}
+	return fileDescriptor_d92c0c680df9617a, []int{9}
 }
 func (m *ComputeResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +943,7 @@ type NodeLabeling struct {
 func (m *NodeLabeling) Reset()      { *m = NodeLabeling{} }
 func (*NodeLabeling) ProtoMessage() {}
 func (*NodeLabeling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{8}
+	return fileDescriptor_d92c0c680df9617a, []int{10}
 }
 func (m *NodeLabeling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,12 +981,14 @@ func (m *NodeLabeling) GetLabels() map[string]string {
 
 type JobLease struct {
 	Job []*Job `protobuf:"bytes,1,rep,name=job,proto3" json:"job,omitempty"`
+	// time of the server when the lease was made, used by executors to detect clock skew
+	ServerTime time.Time `protobuf:"bytes,2,opt,name=server_time,json=serverTime,proto3,stdtime" json:"server_time"`
 }
 
 func (m *JobLease) Reset()      { *m = JobLease{} }
 func (*JobLease) ProtoMessage() {}
 func (*JobLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{9}
+	return fileDescriptor_d92c0c680df9617a, []int{11}
 }
 func (m *JobLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,6 +1024,13 @@ func (m *JobLease) GetJob() []*Job {
 	return nil
 }
 
+func (m *JobLease) GetServerTime() time.Time {
+	if m != nil {
+		return m.ServerTime
+	}
+	return time.Time{}
+}
+
 type IdList struct {
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -718,7 +1038,7 @@ type IdList struct {
 func (m *IdList) Reset()      { *m = IdList{} }
 func (*IdList) ProtoMessage() {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{10}
+	return fileDescriptor_d92c0c680df9617a, []int{12}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +1082,7 @@ type RenewLeaseRequest struct {
 func (m *RenewLeaseRequest) Reset()      { *m = RenewLeaseRequest{} }
 func (*RenewLeaseRequest) ProtoMessage() {}
 func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{11}
+	return fileDescriptor_d92c0c680df9617a, []int{13}
 }
 func (m *RenewLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,14 +1126,15 @@ func (m *RenewLeaseRequest) GetIds() []string {
 }
 
 type ReturnLeaseRequest struct {
-	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	JobId     string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	ClusterId string        `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	JobId     string        `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Reason    RequeueReason `protobuf:"varint,3,opt,name=reason,proto3,enum=api.RequeueReason" json:"reason,omitempty"`
 }
 
 func (m *ReturnLeaseRequest) Reset()      { *m = ReturnLeaseRequest{} }
 func (*ReturnLeaseRequest) ProtoMessage() {}
 func (*ReturnLeaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{12}
+	return fileDescriptor_d92c0c680df9617a, []int{14}
 }
 func (m *ReturnLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,14 +1177,84 @@ func (m *ReturnLeaseRequest) GetJobId() string {
 	return ""
 }
 
+func (m *ReturnLeaseRequest) GetReason() RequeueReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RequeueReason_UnspecifiedRequeueReason
+}
+
+// Used to store requeue history of a job in Redis
+type JobRequeue struct {
+	Reason    RequeueReason `protobuf:"varint,1,opt,name=reason,proto3,enum=api.RequeueReason" json:"reason,omitempty"`
+	ClusterId string        `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Created   time.Time     `protobuf:"bytes,3,opt,name=created,proto3,stdtime" json:"created"`
+}
+
+func (m *JobRequeue) Reset()      { *m = JobRequeue{} }
+func (*JobRequeue) ProtoMessage() {}
+func (*JobRequeue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{15}
+}
+func (m *JobRequeue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRequeue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRequeue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRequeue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequeue.Merge(m, src)
+}
+func (m *JobRequeue) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRequeue) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequeue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRequeue proto.InternalMessageInfo
+
+func (m *JobRequeue) GetReason() RequeueReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RequeueReason_UnspecifiedRequeueReason
+}
+
+func (m *JobRequeue) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobRequeue) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("api.RequeueReason", RequeueReason_name, RequeueReason_value)
 	proto.RegisterType((*Job)(nil), "api.Job")
 	proto.RegisterMapType((map[string]string)(nil), "api.Job.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.Job.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.Job.RequiredNodeLabelsEntry")
+	proto.RegisterType((*VolumeClaimTemplate)(nil), "api.VolumeClaimTemplate")
 	proto.RegisterType((*LeaseRequest)(nil), "api.LeaseRequest")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.LeaseRequest.MinimumJobSizeEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.LeaseRequest.ResourcesEntry")
+	proto.RegisterType((*PreemptibleNodes)(nil), "api.PreemptibleNodes")
 	proto.RegisterType((*NodeInfo)(nil), "api.NodeInfo")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeInfo.AllocatableResourcesEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeInfo.AvailableResourcesEntry")
@@ -873,6 +1264,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "api.NodeType.LabelsEntry")
 	proto.RegisterType((*ClusterSchedulingInfoReport)(nil), "api.ClusterSchedulingInfoReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterSchedulingInfoReport.MinimumJobSizeEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterSchedulingInfoReport.TotalAllocatableResourcesEntry")
 	proto.RegisterType((*QueueLeasedReport)(nil), "api.QueueLeasedReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueLeasedReport.ResourcesLeasedEntry")
 	proto.RegisterType((*ClusterLeasedReport)(nil), "api.ClusterLeasedReport")
@@ -884,93 +1276,133 @@ func init() {
 	proto.RegisterType((*IdList)(nil), "api.IdList")
 	proto.RegisterType((*RenewLeaseRequest)(nil), "api.RenewLeaseRequest")
 	proto.RegisterType((*ReturnLeaseRequest)(nil), "api.ReturnLeaseRequest")
+	proto.RegisterType((*JobRequeue)(nil), "api.JobRequeue")
 }
 
 func init() { proto.RegisterFile("pkg/api/queue.proto", fileDescriptor_d92c0c680df9617a) }
 
 var fileDescriptor_d92c0c680df9617a = []byte{
-	// 1281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0x14, 0x47,
-	0x10, 0xf6, 0xec, 0x9f, 0x77, 0x6b, 0x31, 0x98, 0xb6, 0x81, 0x61, 0x0c, 0xcb, 0x6a, 0xa3, 0x10,
-	0x47, 0x81, 0x59, 0xd9, 0x21, 0x0a, 0x21, 0x12, 0x12, 0x60, 0x2b, 0xf2, 0x8a, 0x44, 0x61, 0x4c,
-	0x72, 0x42, 0x5a, 0xcd, 0x4f, 0x33, 0xb4, 0x3d, 0x3b, 0x3d, 0x9e, 0x1f, 0x5b, 0xcb, 0x89, 0x27,
-	0x88, 0x78, 0x82, 0xbc, 0x40, 0xde, 0x21, 0x67, 0x1f, 0x39, 0x22, 0x45, 0xca, 0x8f, 0xfd, 0x04,
-	0x39, 0x45, 0xb9, 0x45, 0xfd, 0x33, 0xb3, 0xb3, 0xbb, 0xe3, 0x60, 0x43, 0x9c, 0x28, 0xb7, 0xe9,
-	0xae, 0xaa, 0xaf, 0xba, 0xaa, 0xbf, 0xae, 0xaa, 0x81, 0x85, 0x60, 0xdb, 0xed, 0x9a, 0x01, 0xe9,
-	0xee, 0x24, 0x38, 0xc1, 0x7a, 0x10, 0xd2, 0x98, 0xa2, 0xb2, 0x19, 0x10, 0xed, 0x9a, 0x4b, 0xa9,
-	0xeb, 0xe1, 0x2e, 0xdf, 0xb2, 0x92, 0xa7, 0xdd, 0x98, 0x0c, 0x70, 0x14, 0x9b, 0x83, 0x40, 0x68,
-	0x69, 0x9d, 0xed, 0xdb, 0x91, 0x4e, 0x28, 0xb7, 0xb6, 0x69, 0x88, 0xbb, 0xbb, 0x2b, 0x5d, 0x17,
-	0xfb, 0x38, 0x34, 0x63, 0xec, 0x48, 0x9d, 0x5b, 0x23, 0x9d, 0x81, 0x69, 0x3f, 0x23, 0x3e, 0x0e,
-	0x87, 0xdd, 0xd4, 0x65, 0x88, 0x23, 0x9a, 0x84, 0x36, 0x9e, 0xb2, 0xba, 0xe9, 0x92, 0xf8, 0x59,
-	0x62, 0xe9, 0x36, 0x1d, 0x74, 0x5d, 0xea, 0xd2, 0xd1, 0x19, 0xd8, 0x8a, 0x2f, 0xf8, 0x97, 0x54,
-	0x5f, 0x9a, 0x3c, 0x29, 0x1e, 0x04, 0xf1, 0x50, 0x08, 0x3b, 0xbf, 0x57, 0xa1, 0xdc, 0xa3, 0x16,
-	0x3a, 0x0b, 0x25, 0xe2, 0xa8, 0x4a, 0x5b, 0x59, 0x6e, 0x18, 0x25, 0xe2, 0xa0, 0x25, 0x68, 0xd8,
-	0x1e, 0xc1, 0x7e, 0xdc, 0x27, 0x8e, 0x3a, 0xc7, 0xb7, 0xeb, 0x62, 0x63, 0xc3, 0x41, 0x57, 0x00,
-	0xb6, 0xa8, 0xd5, 0x8f, 0x30, 0x97, 0x96, 0x84, 0x74, 0x8b, 0x5a, 0x9b, 0x98, 0x49, 0x17, 0xa1,
-	0xca, 0xb3, 0xa5, 0x96, 0xb9, 0x40, 0x2c, 0xd0, 0x15, 0x68, 0xf8, 0xe6, 0x00, 0x47, 0x81, 0x69,
-	0x63, 0x75, 0x96, 0x4b, 0x46, 0x1b, 0xe8, 0x06, 0xd4, 0x3c, 0xd3, 0xc2, 0x5e, 0xa4, 0x36, 0xda,
-	0xe5, 0xe5, 0xe6, 0xea, 0xa2, 0x6e, 0x06, 0x44, 0xef, 0x51, 0x4b, 0x7f, 0xc8, 0xb7, 0xd7, 0xfd,
-	0x38, 0x1c, 0x1a, 0x52, 0x07, 0x7d, 0x0e, 0x4d, 0xd3, 0xf7, 0x69, 0x6c, 0xc6, 0x84, 0xfa, 0x91,
-	0x0a, 0xdc, 0xe4, 0x72, 0x66, 0x72, 0x6f, 0x24, 0x13, 0x76, 0x79, 0x6d, 0xf4, 0x2d, 0x2c, 0x86,
-	0x78, 0x27, 0x21, 0x21, 0x76, 0xfa, 0x3e, 0x75, 0x70, 0x5f, 0x3a, 0x6e, 0x72, 0x94, 0x76, 0x86,
-	0x62, 0x48, 0xa5, 0xaf, 0xa8, 0x83, 0x73, 0x87, 0xb8, 0x5f, 0x52, 0x15, 0x03, 0x85, 0x53, 0x42,
-	0x16, 0x36, 0xdd, 0xf3, 0x71, 0xa8, 0xd6, 0x45, 0xd8, 0x7c, 0x81, 0x34, 0xa8, 0x07, 0x21, 0xa1,
-	0x21, 0x89, 0x87, 0x6a, 0xa5, 0xad, 0x2c, 0x2b, 0x46, 0xb6, 0x46, 0x77, 0xa0, 0x1e, 0x50, 0xa7,
-	0x1f, 0x05, 0xd8, 0x56, 0xab, 0x6d, 0x65, 0xb9, 0xb9, 0xba, 0xa4, 0x0b, 0x42, 0xf0, 0x43, 0x30,
-	0xd2, 0xe8, 0xbb, 0x2b, 0xfa, 0xd7, 0xd4, 0xd9, 0x0c, 0xb0, 0xcd, 0x1d, 0xcf, 0x06, 0x62, 0x81,
-	0x6e, 0x43, 0x23, 0xb5, 0x8d, 0xd4, 0x33, 0xfc, 0xe8, 0x7f, 0x67, 0x6c, 0xd4, 0xa5, 0x61, 0x84,
-	0xee, 0xc2, 0xac, 0x1d, 0x62, 0x46, 0x27, 0xb5, 0xc6, 0x9d, 0x6a, 0xba, 0x20, 0x88, 0x9e, 0x12,
-	0x44, 0x7f, 0x9c, 0x52, 0xf9, 0x7e, 0x7d, 0xff, 0xe7, 0x6b, 0x33, 0x2f, 0x7f, 0xb9, 0xa6, 0x18,
-	0xa9, 0x91, 0xf6, 0x19, 0x34, 0x73, 0xe9, 0x40, 0xf3, 0x50, 0xde, 0xc6, 0x43, 0xc9, 0x1c, 0xf6,
-	0xc9, 0x12, 0xb1, 0x6b, 0x7a, 0x09, 0x96, 0xc4, 0x10, 0x8b, 0x3b, 0xa5, 0xdb, 0x8a, 0x76, 0x17,
-	0xe6, 0x27, 0xef, 0xe6, 0x44, 0xf6, 0xeb, 0x70, 0xe9, 0x88, 0x5b, 0x39, 0x09, 0x4c, 0xe7, 0xc7,
-	0x0a, 0x9c, 0x79, 0x88, 0xcd, 0x08, 0x33, 0x30, 0x1c, 0xc5, 0xe8, 0x2a, 0x80, 0xed, 0x25, 0x51,
-	0x8c, 0xc3, 0x7e, 0xf6, 0x08, 0x1a, 0x72, 0x67, 0xc3, 0x41, 0x08, 0x2a, 0x01, 0xa5, 0x9e, 0xbc,
-	0x58, 0xfe, 0x8d, 0xd6, 0xa0, 0x91, 0xbe, 0xcf, 0x48, 0x2d, 0xe5, 0xa8, 0x93, 0x07, 0xd6, 0x8d,
-	0x54, 0x45, 0x50, 0xa7, 0xc2, 0xb2, 0x69, 0x8c, 0x0c, 0x91, 0x01, 0x17, 0x52, 0xc7, 0x1e, 0xb3,
-	0x73, 0xfa, 0x21, 0x0e, 0x68, 0x18, 0x73, 0xaa, 0x34, 0x57, 0x55, 0x8e, 0xf8, 0x40, 0x68, 0x70,
-	0x60, 0xc7, 0xe0, 0x72, 0x89, 0xb4, 0x60, 0x4f, 0x8b, 0xd0, 0x37, 0x30, 0x3f, 0x20, 0x3e, 0x19,
-	0x24, 0x83, 0x3e, 0x7f, 0xa4, 0xe4, 0x39, 0x56, 0x6b, 0xfc, 0x80, 0xef, 0x4f, 0x1f, 0xf0, 0x4b,
-	0xa1, 0xd9, 0xa3, 0xd6, 0x26, 0x79, 0x8e, 0xf3, 0xa7, 0x3c, 0x3b, 0x18, 0x13, 0xa1, 0x0f, 0xa1,
-	0xca, 0x5e, 0x4b, 0xa4, 0xce, 0x72, 0xac, 0x39, 0x8e, 0xc5, 0x6e, 0x61, 0xc3, 0x7f, 0x4a, 0xa5,
-	0x8d, 0xd0, 0xd0, 0x3c, 0x38, 0x3b, 0x1e, 0x78, 0xc1, 0xed, 0xac, 0xe5, 0x6f, 0xa7, 0xb9, 0xaa,
-	0xe7, 0xb8, 0x9b, 0x55, 0x42, 0x3d, 0xd8, 0x76, 0xb9, 0x9b, 0x34, 0x61, 0xfa, 0xa3, 0xc4, 0xf4,
-	0x63, 0x12, 0x0f, 0xf3, 0xa4, 0xd8, 0x81, 0x85, 0x82, 0x28, 0x4e, 0xd3, 0x65, 0xe7, 0x8f, 0x0a,
-	0xd4, 0xd3, 0xd0, 0x19, 0x3b, 0x58, 0x1d, 0x93, 0x9e, 0xf8, 0x37, 0xfa, 0x14, 0x6a, 0xb1, 0x49,
-	0xfc, 0x38, 0xa5, 0xc6, 0xe5, 0xa2, 0xa7, 0xf9, 0x98, 0x69, 0xc8, 0xcc, 0x49, 0x75, 0xb4, 0x92,
-	0xd5, 0xc1, 0x72, 0xae, 0xa8, 0xa5, 0xbe, 0x0a, 0x8b, 0xa1, 0x05, 0x17, 0x4c, 0xcf, 0xa3, 0xb6,
-	0x19, 0x9b, 0x96, 0x87, 0xfb, 0x23, 0x56, 0x56, 0x38, 0xc2, 0x07, 0xe3, 0x08, 0xf7, 0x46, 0xaa,
-	0x85, 0xe4, 0x5c, 0x34, 0x0b, 0x14, 0xd0, 0x13, 0x58, 0x30, 0x77, 0x4d, 0xe2, 0x4d, 0x78, 0xa8,
-	0xe6, 0x68, 0x35, 0xf2, 0x90, 0x2a, 0x16, 0xe2, 0x23, 0x73, 0x4a, 0xfc, 0x2e, 0x15, 0x65, 0x0f,
-	0x2e, 0x1f, 0x19, 0xd1, 0xa9, 0xb2, 0x2e, 0x81, 0x4b, 0x47, 0x04, 0x7a, 0xaa, 0xcc, 0xfb, 0xae,
-	0x2c, 0x98, 0xf7, 0x78, 0x18, 0xe4, 0x59, 0xa6, 0xbc, 0x2d, 0xcb, 0x4a, 0x13, 0x2c, 0x63, 0xb8,
-	0x27, 0x63, 0x59, 0x79, 0x82, 0x65, 0x1c, 0xe1, 0xad, 0x58, 0xf6, 0x7f, 0xe4, 0x41, 0xe7, 0xfb,
-	0x32, 0x2c, 0xc9, 0x02, 0xbd, 0x69, 0x3f, 0xc3, 0x4e, 0xe2, 0x11, 0xdf, 0x65, 0xef, 0x40, 0x56,
-	0xe3, 0x63, 0xb6, 0x96, 0xd9, 0x5c, 0x6b, 0x59, 0x87, 0xa6, 0xe8, 0x02, 0x7d, 0x36, 0x52, 0xca,
-	0x23, 0x1e, 0xaf, 0x49, 0x83, 0x30, 0x64, 0x22, 0x74, 0x03, 0x80, 0x8f, 0x37, 0xf1, 0x30, 0xc8,
-	0x9e, 0xea, 0xdc, 0xd8, 0x35, 0x19, 0x0d, 0x5f, 0x7e, 0x45, 0xc8, 0x39, 0xb2, 0x6b, 0xdc, 0xca,
-	0x37, 0xa1, 0xa2, 0x18, 0x8f, 0xdf, 0x44, 0xfe, 0x8b, 0x5a, 0xfd, 0xa7, 0x02, 0xe7, 0x1f, 0xb1,
-	0x09, 0x74, 0xac, 0x49, 0x16, 0x15, 0xed, 0x27, 0x30, 0x9f, 0xd1, 0x5a, 0xb6, 0x63, 0xf9, 0x3e,
-	0x3e, 0xe2, 0x6e, 0xa6, 0x50, 0x46, 0xed, 0x5d, 0xec, 0xe6, 0x23, 0x3f, 0x17, 0x8e, 0xcb, 0xb4,
-	0x10, 0x16, 0x8b, 0xd4, 0x4f, 0x35, 0xf6, 0x1f, 0x14, 0x58, 0x28, 0x98, 0x1e, 0xde, 0x44, 0xca,
-	0x7f, 0x88, 0x80, 0x3a, 0xd4, 0xf8, 0xe8, 0x9f, 0xd6, 0x88, 0x8b, 0xc5, 0x59, 0x34, 0xa4, 0x56,
-	0x67, 0x5f, 0x81, 0x73, 0x0f, 0xe8, 0x20, 0x48, 0xe2, 0xec, 0x01, 0xa3, 0x2f, 0xf2, 0x63, 0x96,
-	0xa8, 0x72, 0xef, 0x09, 0x3e, 0x8e, 0x2b, 0xbe, 0x69, 0xd2, 0xfa, 0x77, 0x67, 0x92, 0xce, 0x0b,
-	0x05, 0xce, 0x64, 0x13, 0x2a, 0xf1, 0x5d, 0xf4, 0xc9, 0x44, 0x5f, 0xbf, 0x9a, 0x3d, 0xc4, 0x54,
-	0xa5, 0xa8, 0xea, 0xbe, 0x43, 0x45, 0xec, 0x5c, 0x87, 0x7a, 0x8f, 0x5a, 0x3c, 0xd1, 0x48, 0x83,
-	0xf2, 0x16, 0xb5, 0x64, 0xfe, 0xea, 0xe9, 0x1f, 0x8e, 0xc1, 0x36, 0x3b, 0x1a, 0xd4, 0x36, 0x9c,
-	0x87, 0x24, 0x8a, 0x19, 0x3a, 0x71, 0x44, 0x96, 0x1b, 0x06, 0xfb, 0xec, 0xac, 0xc1, 0x79, 0x03,
-	0xfb, 0x78, 0xef, 0x24, 0xc3, 0xb2, 0x44, 0x29, 0x8d, 0x50, 0x7a, 0x80, 0x0c, 0x1c, 0x27, 0xa1,
-	0x7f, 0x12, 0x98, 0x0b, 0x50, 0x63, 0x75, 0x28, 0xfb, 0xbd, 0xac, 0x6e, 0x51, 0x6b, 0xc3, 0x59,
-	0xfd, 0x49, 0x81, 0x73, 0xf7, 0x5c, 0x37, 0xc4, 0x2e, 0xfb, 0x17, 0xe1, 0x5c, 0x42, 0x37, 0xa1,
-	0xc1, 0x91, 0x7b, 0xd4, 0x8a, 0xd0, 0xf9, 0xa9, 0x19, 0x57, 0x9b, 0x4b, 0x03, 0x16, 0xc9, 0x58,
-	0x01, 0x18, 0x05, 0x85, 0x04, 0x29, 0xa7, 0xa2, 0xd4, 0x9a, 0x7c, 0x5f, 0x66, 0xe6, 0x2e, 0x34,
-	0x73, 0x11, 0xa0, 0x4b, 0xd2, 0x66, 0x32, 0x26, 0xed, 0xe2, 0xd4, 0x1b, 0x59, 0x67, 0xbf, 0xda,
-	0xe8, 0x3a, 0x73, 0xc9, 0xb8, 0xbe, 0x46, 0x7d, 0x8c, 0xf2, 0xd0, 0x63, 0x7e, 0xee, 0xb7, 0x5f,
-	0xff, 0xd6, 0x9a, 0x79, 0x71, 0xd0, 0x52, 0xf6, 0x0f, 0x5a, 0xca, 0xab, 0x83, 0x96, 0xf2, 0xeb,
-	0x41, 0x4b, 0x79, 0x79, 0xd8, 0x9a, 0x79, 0x75, 0xd8, 0x9a, 0x79, 0x7d, 0xd8, 0x9a, 0xb1, 0x6a,
-	0x1c, 0xf9, 0xe3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x72, 0x13, 0x23, 0x02, 0x98, 0x10, 0x00,
-	0x00,
+	// 1912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0xdc, 0xc6,
+	0x1d, 0x17, 0x77, 0xa5, 0xd5, 0xee, 0x7f, 0xf5, 0xa0, 0x46, 0x0f, 0x53, 0x2b, 0x47, 0xda, 0x6e,
+	0xd1, 0x56, 0x49, 0xec, 0x15, 0xac, 0xba, 0xa8, 0x9b, 0x16, 0x2e, 0xac, 0x47, 0x0b, 0xa9, 0x8e,
+	0xa1, 0x50, 0xb2, 0x4f, 0x01, 0x88, 0x59, 0x72, 0xbc, 0x1a, 0x89, 0xe4, 0xd0, 0xe4, 0x50, 0x8f,
+	0xa0, 0x87, 0x5c, 0x7a, 0x2d, 0x72, 0xcb, 0x87, 0xe8, 0x67, 0xe8, 0xdd, 0x97, 0x02, 0x39, 0x06,
+	0x68, 0xd1, 0x87, 0x7d, 0xea, 0x27, 0x28, 0x7a, 0x2b, 0xe6, 0xc1, 0x5d, 0xee, 0x2e, 0x55, 0x55,
+	0x4e, 0x15, 0x20, 0x37, 0xce, 0xff, 0x3d, 0x33, 0xbf, 0xff, 0x83, 0x03, 0xf3, 0xd1, 0x69, 0x77,
+	0x03, 0x47, 0x74, 0xe3, 0x55, 0x4a, 0x52, 0xd2, 0x8e, 0x62, 0xc6, 0x19, 0x2a, 0xe3, 0x88, 0x36,
+	0xd6, 0xba, 0x8c, 0x75, 0x7d, 0xb2, 0x21, 0x49, 0x9d, 0xf4, 0xe5, 0x06, 0xa7, 0x01, 0x49, 0x38,
+	0x0e, 0x22, 0x25, 0xd5, 0x68, 0x9d, 0x3e, 0x4a, 0xda, 0x94, 0x49, 0x6d, 0x97, 0xc5, 0x64, 0xe3,
+	0xec, 0xc1, 0x46, 0x97, 0x84, 0x24, 0xc6, 0x9c, 0x78, 0x5a, 0xe6, 0x61, 0x5f, 0x26, 0xc0, 0xee,
+	0x31, 0x0d, 0x49, 0x7c, 0xb9, 0x91, 0xb9, 0x8c, 0x49, 0xc2, 0xd2, 0xd8, 0x25, 0x23, 0x5a, 0xf7,
+	0xbb, 0x94, 0x1f, 0xa7, 0x9d, 0xb6, 0xcb, 0x82, 0x8d, 0x2e, 0xeb, 0xb2, 0x7e, 0x0c, 0x62, 0x25,
+	0x17, 0xf2, 0x4b, 0x8b, 0xaf, 0x0c, 0x47, 0x4a, 0x82, 0x88, 0x5f, 0x2a, 0x66, 0xeb, 0x8f, 0x35,
+	0x28, 0xef, 0xb3, 0x0e, 0x9a, 0x81, 0x12, 0xf5, 0x2c, 0xa3, 0x69, 0xac, 0xd7, 0xec, 0x12, 0xf5,
+	0xd0, 0x0a, 0xd4, 0x5c, 0x9f, 0x92, 0x90, 0x3b, 0xd4, 0xb3, 0xa6, 0x25, 0xb9, 0xaa, 0x08, 0x7b,
+	0x1e, 0xba, 0x0b, 0x70, 0xc2, 0x3a, 0x4e, 0x42, 0x24, 0xb7, 0xa4, 0xb8, 0x27, 0xac, 0x73, 0x48,
+	0x04, 0x77, 0x01, 0x26, 0xe4, 0x69, 0x59, 0x65, 0xc9, 0x50, 0x0b, 0x74, 0x17, 0x6a, 0x21, 0x0e,
+	0x48, 0x12, 0x61, 0x97, 0x58, 0x93, 0x92, 0xd3, 0x27, 0xa0, 0x7b, 0x50, 0xf1, 0x71, 0x87, 0xf8,
+	0x89, 0x55, 0x6b, 0x96, 0xd7, 0xeb, 0x9b, 0x0b, 0x6d, 0x1c, 0xd1, 0xf6, 0x3e, 0xeb, 0xb4, 0x9f,
+	0x4a, 0xf2, 0x6e, 0xc8, 0xe3, 0x4b, 0x5b, 0xcb, 0xa0, 0x9f, 0x43, 0x1d, 0x87, 0x21, 0xe3, 0x98,
+	0x53, 0x16, 0x26, 0x16, 0x48, 0x95, 0xe5, 0x9e, 0xca, 0x93, 0x3e, 0x4f, 0xe9, 0xe5, 0xa5, 0xd1,
+	0x0b, 0x58, 0x88, 0xc9, 0xab, 0x94, 0xc6, 0xc4, 0x73, 0x42, 0xe6, 0x11, 0x47, 0x3b, 0xae, 0x4b,
+	0x2b, 0xcd, 0x9e, 0x15, 0x5b, 0x0b, 0x3d, 0x63, 0x1e, 0xc9, 0x05, 0xb1, 0x55, 0xb2, 0x0c, 0x1b,
+	0xc5, 0x23, 0x4c, 0xb1, 0x6d, 0x76, 0x1e, 0x92, 0xd8, 0xaa, 0xaa, 0x6d, 0xcb, 0x05, 0x6a, 0x40,
+	0x35, 0x8a, 0x29, 0x8b, 0x29, 0xbf, 0xb4, 0xc6, 0x9b, 0xc6, 0xba, 0x61, 0xf7, 0xd6, 0xe8, 0x23,
+	0xa8, 0x46, 0xcc, 0x73, 0x92, 0x88, 0xb8, 0xd6, 0x44, 0xd3, 0x58, 0xaf, 0x6f, 0xae, 0xb4, 0x15,
+	0x20, 0x64, 0x10, 0x02, 0x34, 0xed, 0xb3, 0x07, 0xed, 0x03, 0xe6, 0x1d, 0x46, 0xc4, 0x95, 0x8e,
+	0x27, 0x23, 0xb5, 0x40, 0x8f, 0xa0, 0x96, 0xe9, 0x26, 0xd6, 0x54, 0xb3, 0x7c, 0x8d, 0xb2, 0x5d,
+	0xd5, 0x8a, 0x09, 0x7a, 0x0c, 0x93, 0x6e, 0x4c, 0x04, 0x9c, 0xac, 0x8a, 0x74, 0xda, 0x68, 0x2b,
+	0x80, 0xb4, 0x33, 0x80, 0xb4, 0x8f, 0x32, 0x28, 0x6f, 0x55, 0x5f, 0xff, 0x75, 0x6d, 0xec, 0x8b,
+	0xbf, 0xad, 0x19, 0x76, 0xa6, 0x84, 0x1e, 0xc2, 0x52, 0x40, 0x43, 0xe7, 0x34, 0xed, 0x90, 0x38,
+	0x24, 0x9c, 0x24, 0xce, 0x19, 0x89, 0x13, 0xca, 0x42, 0x6b, 0x46, 0x6e, 0x7c, 0x21, 0xa0, 0xe1,
+	0x6f, 0x7a, 0xcc, 0x17, 0x8a, 0x87, 0x76, 0x60, 0x3a, 0x21, 0xf1, 0x19, 0x75, 0x89, 0x13, 0xb1,
+	0x98, 0x27, 0xd6, 0xac, 0x8c, 0x79, 0xad, 0x28, 0xe6, 0x43, 0x25, 0x78, 0xc0, 0x62, 0x6e, 0x4f,
+	0x25, 0xfd, 0x45, 0x82, 0xd6, 0xa0, 0x1e, 0xe0, 0x0b, 0x27, 0x26, 0x3c, 0xa6, 0x24, 0xb1, 0xcc,
+	0xa6, 0xb1, 0x3e, 0x6d, 0x43, 0x80, 0x2f, 0x6c, 0x45, 0x41, 0x1f, 0xc2, 0x5c, 0xef, 0x72, 0x5d,
+	0x3f, 0x4d, 0x38, 0x89, 0x13, 0x6b, 0xae, 0x59, 0x5e, 0xaf, 0xd9, 0x66, 0xc6, 0xd8, 0xd6, 0x74,
+	0x74, 0x07, 0x26, 0xbb, 0x38, 0xec, 0x0a, 0x0c, 0x23, 0x19, 0x7a, 0x45, 0x2c, 0xf7, 0x24, 0xf8,
+	0x25, 0x23, 0xa1, 0x9f, 0x11, 0x6b, 0x5e, 0x3a, 0xa9, 0x0a, 0xc2, 0x21, 0xfd, 0x8c, 0xa0, 0x5f,
+	0x40, 0xd5, 0x23, 0xd8, 0xf3, 0x69, 0x48, 0xac, 0x85, 0x6b, 0x0f, 0x70, 0x5c, 0x1e, 0x5e, 0x4f,
+	0x03, 0x3d, 0x86, 0x15, 0xce, 0x7c, 0x99, 0xce, 0x89, 0x13, 0xc5, 0x44, 0xe4, 0x22, 0xed, 0xf8,
+	0x44, 0x42, 0x31, 0xb1, 0x16, 0x9b, 0xc6, 0x7a, 0xd5, 0x5e, 0xee, 0x89, 0x1c, 0xf4, 0x25, 0x04,
+	0xd4, 0x12, 0xf4, 0x14, 0x16, 0xcf, 0x98, 0x9f, 0x06, 0xc4, 0x71, 0x7d, 0x4c, 0x03, 0x87, 0x93,
+	0x20, 0xf2, 0x31, 0x27, 0xd6, 0x92, 0x0c, 0xc5, 0x92, 0x07, 0xf9, 0x42, 0x4a, 0x6c, 0x0b, 0x81,
+	0x23, 0xcd, 0xb7, 0xe7, 0xcf, 0x46, 0x89, 0x8d, 0x9f, 0x41, 0x3d, 0x07, 0x6d, 0x64, 0x42, 0xf9,
+	0x94, 0x5c, 0xea, 0x2a, 0x20, 0x3e, 0x05, 0xa8, 0xcf, 0xb0, 0x9f, 0x12, 0x9d, 0xe4, 0x6a, 0xf1,
+	0x51, 0xe9, 0x91, 0xd1, 0x78, 0x0c, 0xe6, 0x70, 0x9e, 0xdd, 0x48, 0x7f, 0x17, 0xee, 0x5c, 0x91,
+	0x61, 0x37, 0x31, 0xd3, 0xfa, 0xa7, 0x01, 0xf3, 0x05, 0xdb, 0x45, 0x08, 0xc6, 0x45, 0x75, 0xd1,
+	0x46, 0xe4, 0x37, 0x7a, 0x0f, 0x20, 0x60, 0x69, 0xc8, 0x9d, 0x08, 0xf3, 0x63, 0x6d, 0xaa, 0x26,
+	0x29, 0x07, 0x98, 0x1f, 0xa3, 0x7b, 0x80, 0x12, 0xce, 0x62, 0xdc, 0x95, 0x67, 0x9b, 0x24, 0x8e,
+	0x34, 0xa0, 0x8a, 0x98, 0xa9, 0x39, 0xdb, 0x82, 0xf1, 0x4c, 0x18, 0x7b, 0x06, 0x93, 0x9a, 0x26,
+	0xf3, 0xba, 0xbe, 0xd9, 0xce, 0x41, 0xb9, 0x57, 0xcc, 0xdb, 0xd1, 0x69, 0x57, 0x5e, 0x49, 0x56,
+	0xcc, 0xdb, 0x9f, 0xa4, 0x38, 0xe4, 0x94, 0x5f, 0x6e, 0x8d, 0x8b, 0xd4, 0xb2, 0x33, 0x23, 0xe8,
+	0x7b, 0x30, 0x85, 0x5d, 0x97, 0x24, 0x89, 0x13, 0x48, 0x24, 0x4c, 0x48, 0xd0, 0xd6, 0x15, 0xed,
+	0x63, 0x41, 0x6a, 0xfd, 0x65, 0x02, 0xa6, 0x9e, 0x12, 0x9c, 0x10, 0x71, 0x70, 0x24, 0xe1, 0x62,
+	0x43, 0x1a, 0xe4, 0x4e, 0xaf, 0x78, 0xd7, 0x34, 0x65, 0xcf, 0x13, 0x67, 0x10, 0x31, 0xe6, 0xeb,
+	0x82, 0x24, 0xbf, 0xd1, 0x0e, 0xd4, 0xb2, 0x50, 0x12, 0xab, 0x94, 0x2b, 0x79, 0x79, 0xc3, 0x6d,
+	0x3b, 0x13, 0x51, 0x25, 0x4f, 0x85, 0xda, 0x57, 0x44, 0x36, 0x2c, 0x66, 0x8e, 0x7d, 0xa1, 0xe7,
+	0x39, 0x31, 0x11, 0x69, 0x6d, 0x8d, 0xe7, 0x50, 0xa8, 0xf3, 0x4c, 0x1a, 0xf6, 0x6c, 0xc9, 0xd7,
+	0x96, 0xe6, 0xdd, 0x51, 0x16, 0x7a, 0x0e, 0x66, 0x40, 0x43, 0x1a, 0xa4, 0x81, 0x23, 0x9b, 0x8b,
+	0xc8, 0xbd, 0x8a, 0x0c, 0xf0, 0x07, 0xa3, 0x01, 0x7e, 0xac, 0x24, 0xf7, 0x59, 0x47, 0xe4, 0x64,
+	0x3e, 0xca, 0x99, 0x60, 0x80, 0x85, 0xde, 0x87, 0x09, 0x95, 0x5a, 0x93, 0xd2, 0xd6, 0xb4, 0xb4,
+	0x25, 0x10, 0xb7, 0x17, 0xbe, 0x64, 0x5a, 0x47, 0x49, 0xa0, 0xf7, 0x61, 0x4e, 0x54, 0x97, 0x13,
+	0xd6, 0x49, 0x1c, 0xce, 0xd4, 0xce, 0xac, 0x9a, 0x4c, 0xff, 0x99, 0x00, 0x5f, 0xec, 0xb3, 0x4e,
+	0x72, 0xc4, 0x64, 0x18, 0xe8, 0x3e, 0xa0, 0x82, 0x02, 0x08, 0xf2, 0xa0, 0xe7, 0x4e, 0x47, 0xaa,
+	0xdf, 0x16, 0xcc, 0x8d, 0xe6, 0x7a, 0x5d, 0x9e, 0xd5, 0xa2, 0x0c, 0x68, 0x38, 0xcf, 0x6d, 0x33,
+	0x1a, 0xa2, 0x34, 0x7c, 0x98, 0x19, 0xbc, 0x96, 0x82, 0x3c, 0xd9, 0xc9, 0xe7, 0xc9, 0x8d, 0x21,
+	0x99, 0x4f, 0xcf, 0x57, 0x30, 0x5f, 0x70, 0xc6, 0xb7, 0xe9, 0xb2, 0xe5, 0x80, 0x39, 0x52, 0xee,
+	0x16, 0x60, 0x42, 0xb6, 0x67, 0xed, 0x51, 0x2d, 0x44, 0x1b, 0x90, 0x1f, 0x4e, 0xbe, 0x28, 0x80,
+	0x24, 0xbd, 0x10, 0x14, 0xa1, 0xc6, 0x31, 0x0d, 0x79, 0x36, 0x82, 0xc8, 0x45, 0xeb, 0x5f, 0xe3,
+	0x50, 0xcd, 0x6e, 0xbe, 0xb0, 0x40, 0xfc, 0x14, 0x2a, 0x52, 0x32, 0xcb, 0x8c, 0xe5, 0xa2, 0xee,
+	0x74, 0x24, 0x24, 0x34, 0x70, 0xb4, 0x38, 0x7a, 0xd0, 0x1b, 0x5f, 0xca, 0xb9, 0x59, 0x24, 0xf3,
+	0x55, 0x38, 0xc3, 0x74, 0x60, 0x11, 0xfb, 0x3e, 0x73, 0x31, 0xc7, 0x02, 0x12, 0xfd, 0xa4, 0x1c,
+	0x97, 0x16, 0x7e, 0x34, 0x68, 0xe1, 0x49, 0x5f, 0xb4, 0x30, 0x37, 0x17, 0x70, 0x81, 0x00, 0xfa,
+	0x14, 0xe6, 0xf1, 0x19, 0xa6, 0xfe, 0x90, 0x87, 0x89, 0x5c, 0x56, 0xf5, 0x3d, 0x64, 0x82, 0x85,
+	0xf6, 0x11, 0x1e, 0x61, 0x7f, 0x93, 0xe6, 0x71, 0x0e, 0xcb, 0x57, 0xee, 0xe8, 0x56, 0x61, 0x9d,
+	0xc2, 0x9d, 0x2b, 0x36, 0x7a, 0xab, 0xd0, 0xfe, 0x7d, 0x59, 0x21, 0xef, 0xe8, 0x32, 0xca, 0xa3,
+	0xcc, 0x78, 0x57, 0x94, 0x95, 0x86, 0x50, 0x26, 0xec, 0xde, 0x0c, 0x65, 0xe5, 0x21, 0x94, 0x49,
+	0x0b, 0xef, 0x84, 0xb2, 0xef, 0x22, 0x0e, 0x5a, 0x5f, 0x56, 0x60, 0x45, 0xf7, 0xa7, 0x43, 0xf7,
+	0x98, 0x78, 0xa9, 0x4f, 0xc3, 0xae, 0xc8, 0x03, 0xdd, 0x8c, 0xfe, 0xc7, 0xce, 0x3a, 0x99, 0xeb,
+	0xac, 0xbb, 0x50, 0x57, 0x4d, 0xd0, 0x11, 0x7f, 0x82, 0x56, 0xe9, 0xda, 0xd1, 0xb0, 0x3f, 0x5b,
+	0x83, 0x52, 0x14, 0x2c, 0x74, 0x0f, 0x40, 0xfe, 0x95, 0xf0, 0xcb, 0xa8, 0x97, 0xaa, 0xd3, 0x03,
+	0xd7, 0x64, 0xd7, 0x42, 0xfd, 0x95, 0x20, 0xef, 0xca, 0xa6, 0xf9, 0x30, 0xdf, 0x83, 0x8b, 0xf6,
+	0x78, 0x83, 0x1e, 0x5a, 0xdc, 0xed, 0xaa, 0x57, 0x75, 0xbb, 0xdf, 0x19, 0x62, 0xc8, 0xe5, 0xd8,
+	0x77, 0x8a, 0xb1, 0xa7, 0x7e, 0xf1, 0x7e, 0x79, 0x6d, 0x80, 0x47, 0xc2, 0xc6, 0x75, 0x98, 0x5c,
+	0xe6, 0x57, 0x49, 0x15, 0x77, 0x5d, 0xb8, 0x59, 0xd7, 0xfd, 0xf6, 0xfb, 0x60, 0xe3, 0xb7, 0xb0,
+	0xfa, 0xdf, 0x77, 0x7e, 0xab, 0x99, 0xf1, 0x6f, 0x03, 0xe6, 0x3e, 0x49, 0x49, 0x4a, 0x06, 0x86,
+	0xb3, 0xa2, 0x6e, 0xf9, 0x29, 0x98, 0xbd, 0x3b, 0xd5, 0x63, 0xa0, 0x2e, 0x4c, 0x1f, 0x4a, 0x37,
+	0x23, 0x56, 0xfa, 0x63, 0xa5, 0xa2, 0xe6, 0xaf, 0x71, 0x36, 0x1e, 0xe4, 0x35, 0x62, 0x58, 0x28,
+	0x12, 0xbf, 0xd5, 0xbd, 0xff, 0xc1, 0x80, 0xf9, 0x82, 0xa9, 0xf5, 0xba, 0x6a, 0xf0, 0x7f, 0xca,
+	0xfc, 0x36, 0x54, 0xe4, 0x53, 0x49, 0x56, 0x9c, 0x97, 0x8a, 0x4f, 0xd1, 0xd6, 0x52, 0xad, 0xd7,
+	0x06, 0xcc, 0x6e, 0xb3, 0x20, 0x4a, 0x79, 0x0f, 0x1f, 0xe8, 0xd7, 0xf9, 0xf1, 0x5e, 0xb5, 0x97,
+	0xef, 0xab, 0x3c, 0x1b, 0x14, 0xbc, 0x6e, 0xc2, 0xff, 0x76, 0xa7, 0xcd, 0xd6, 0xe7, 0x06, 0x4c,
+	0xf5, 0xfe, 0x02, 0x69, 0xd8, 0x45, 0x3f, 0x19, 0x1a, 0xa8, 0xde, 0xeb, 0x55, 0xc0, 0x4c, 0xa4,
+	0xa8, 0xdd, 0x7d, 0x83, 0x56, 0xd4, 0x0a, 0xa0, 0xba, 0xcf, 0x3a, 0x6a, 0xba, 0x6f, 0x40, 0xf9,
+	0x84, 0x75, 0xf4, 0xf9, 0x55, 0xb3, 0x17, 0x21, 0x5b, 0x10, 0xc5, 0x65, 0x8b, 0x27, 0x09, 0x12,
+	0xbf, 0xc3, 0x65, 0x2b, 0x45, 0xc1, 0x6a, 0x35, 0xa0, 0xb2, 0xe7, 0x3d, 0xa5, 0x09, 0x17, 0x41,
+	0x52, 0x4f, 0x5d, 0x56, 0xcd, 0x16, 0x9f, 0xad, 0x1d, 0x98, 0xb3, 0x49, 0x48, 0xce, 0x6f, 0xf2,
+	0xaf, 0xa7, 0xad, 0x94, 0xfa, 0x56, 0xce, 0x00, 0xd9, 0x84, 0xa7, 0x71, 0x78, 0x13, 0x33, 0x8b,
+	0x50, 0x11, 0x7d, 0xa4, 0xf7, 0xaa, 0x37, 0x71, 0xc2, 0x3a, 0x7b, 0x1e, 0xfa, 0x00, 0x2a, 0x31,
+	0xc1, 0x09, 0x0b, 0xe5, 0x40, 0x3d, 0xb3, 0x89, 0xe4, 0x99, 0x48, 0x9b, 0x29, 0xb1, 0x25, 0xc7,
+	0xd6, 0x12, 0xad, 0x2f, 0x0d, 0x00, 0x71, 0x5a, 0x8a, 0x99, 0x53, 0x35, 0xae, 0x53, 0x1d, 0x0a,
+	0xae, 0x34, 0x1c, 0x5c, 0xee, 0xe5, 0xaa, 0xfc, 0x0e, 0x2f, 0x57, 0x1f, 0xfc, 0xc9, 0x80, 0xe9,
+	0x01, 0xc7, 0xe8, 0x2e, 0x58, 0xcf, 0x43, 0xf1, 0x86, 0x46, 0x5f, 0x52, 0xe2, 0x0d, 0xf0, 0xcc,
+	0x31, 0x64, 0xea, 0xdf, 0xed, 0xdd, 0x8b, 0x48, 0x3c, 0x53, 0x98, 0x06, 0x5a, 0x84, 0xb9, 0x03,
+	0xe6, 0x6d, 0x0b, 0x7b, 0x94, 0x85, 0xbf, 0xc2, 0xd4, 0x27, 0x9e, 0x59, 0x42, 0x53, 0x50, 0x15,
+	0xef, 0x6c, 0x3c, 0x75, 0x4f, 0xcd, 0xb2, 0x10, 0x52, 0x2f, 0x12, 0xcf, 0xc3, 0xde, 0xd4, 0x6c,
+	0x8e, 0xa3, 0x79, 0x98, 0x15, 0xf8, 0x7d, 0x1e, 0xc6, 0x04, 0xbb, 0xc7, 0x92, 0x38, 0x81, 0x16,
+	0xc0, 0xdc, 0xbd, 0x20, 0x6e, 0xca, 0x59, 0x7c, 0x78, 0x9c, 0x72, 0x8f, 0x9d, 0x87, 0x66, 0x05,
+	0x4d, 0x43, 0x4d, 0x88, 0xee, 0xc4, 0x98, 0x86, 0xe6, 0x24, 0x5a, 0x02, 0xa4, 0xef, 0x30, 0x24,
+	0xe7, 0xd8, 0xd7, 0x6e, 0xab, 0x9b, 0x7f, 0x36, 0x60, 0xf6, 0x49, 0xb7, 0x1b, 0x93, 0xae, 0xd8,
+	0x9e, 0x2c, 0x14, 0xe8, 0x3e, 0xd4, 0xa4, 0xac, 0xf8, 0x59, 0x45, 0x73, 0x23, 0x3f, 0xce, 0x8d,
+	0xe9, 0x0c, 0xcd, 0x0a, 0xe9, 0x0f, 0x00, 0xfa, 0x50, 0x43, 0x4b, 0xfa, 0x6e, 0x86, 0xb0, 0xd7,
+	0xa8, 0x4b, 0xba, 0xc6, 0xeb, 0x63, 0xa8, 0xe7, 0x70, 0x85, 0xee, 0x68, 0x9d, 0x61, 0xa4, 0x35,
+	0x96, 0x46, 0x2e, 0x67, 0x57, 0xbc, 0x3b, 0xa3, 0x1f, 0x02, 0xa8, 0x42, 0xb6, 0xc3, 0x42, 0x82,
+	0xf2, 0xa6, 0x07, 0xfc, 0x6c, 0x35, 0xbf, 0xfe, 0xc7, 0xea, 0xd8, 0xe7, 0x6f, 0x56, 0x8d, 0xd7,
+	0x6f, 0x56, 0x8d, 0xaf, 0xde, 0xac, 0x1a, 0x7f, 0x7f, 0xb3, 0x6a, 0x7c, 0xf1, 0x76, 0x75, 0xec,
+	0xab, 0xb7, 0xab, 0x63, 0x5f, 0xbf, 0x5d, 0x1d, 0xeb, 0x54, 0xa4, 0xe5, 0x1f, 0xff, 0x67, 0x00,
+	0x10, 0xdc, 0x99, 0x4a, 0xa5, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1181,19 +1613,112 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQueue(dAtA, i, uint64(len(m.ClientId)))
+	if m.VolumeClaimTemplate != nil {
+		{
+			size, err := m.VolumeClaimTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if m.ToleratesPreemptibleNodes {
+		i--
+		if m.ToleratesPreemptibleNodes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Deadline != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQueue(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.GangSize != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.GangSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.GangId) > 0 {
+		i -= len(m.GangId)
+		copy(dAtA[i:], m.GangId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.GangId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RequiredClusters) > 0 {
+		for iNdEx := len(m.RequiredClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredClusters[iNdEx])
+			copy(dAtA[i:], m.RequiredClusters[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.RequiredClusters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ServicePorts) > 0 {
+		for iNdEx := len(m.ServicePorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServicePorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MinKubernetesVersion) > 0 {
+		i -= len(m.MinKubernetesVersion)
+		copy(dAtA[i:], m.MinKubernetesVersion)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.MinKubernetesVersion)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintQueue(dAtA, i, uint64(size))
@@ -1273,12 +1798,12 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintQueue(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintQueue(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x32
 	if m.PodSpec != nil {
@@ -1323,6 +1848,69 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolumeClaimTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeClaimTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeClaimTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessModes) > 0 {
+		for iNdEx := len(m.AccessModes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccessModes[iNdEx])
+			copy(dAtA[i:], m.AccessModes[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.AccessModes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.StorageClassName) > 0 {
+		i -= len(m.StorageClassName)
+		copy(dAtA[i:], m.StorageClassName)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.StorageClassName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MountPath) > 0 {
+		i -= len(m.MountPath)
+		copy(dAtA[i:], m.MountPath)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.MountPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LeaseRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1343,6 +1931,30 @@ func (m *LeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreemptibleNodes != nil {
+		{
+			size, err := m.PreemptibleNodes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.KubernetesVersion) > 0 {
+		i -= len(m.KubernetesVersion)
+		copy(dAtA[i:], m.KubernetesVersion)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.KubernetesVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxJobsToLease != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.MaxJobsToLease))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Pool) > 0 {
 		i -= len(m.Pool)
 		copy(dAtA[i:], m.Pool)
@@ -1432,6 +2044,50 @@ func (m *LeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PreemptibleNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreemptibleNodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreemptibleNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Taint) > 0 {
+		i -= len(m.Taint)
+		copy(dAtA[i:], m.Taint)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Taint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LabelValue) > 0 {
+		i -= len(m.LabelValue)
+		copy(dAtA[i:], m.LabelValue)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.LabelValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1643,6 +2299,49 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.PreemptibleNodes != nil {
+		{
+			size, err := m.PreemptibleNodes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TotalAllocatableResources) > 0 {
+		for k := range m.TotalAllocatableResources {
+			v := m.TotalAllocatableResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.KubernetesVersion) > 0 {
+		i -= len(m.KubernetesVersion)
+		copy(dAtA[i:], m.KubernetesVersion)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.KubernetesVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Pool) > 0 {
 		i -= len(m.Pool)
 		copy(dAtA[i:], m.Pool)
@@ -1688,12 +2387,12 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 			dAtA[i] = 0x2a
 		}
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err10 != nil {
-		return 0, err10
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n10
-	i = encodeVarintQueue(dAtA, i, uint64(n10))
+	i -= n16
+	i = encodeVarintQueue(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -1794,12 +2493,12 @@ func (m *ClusterLeasedReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err12 != nil {
-		return 0, err12
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n12
-	i = encodeVarintQueue(dAtA, i, uint64(n12))
+	i -= n18
+	i = encodeVarintQueue(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -1921,6 +2620,14 @@ func (m *JobLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ServerTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQueue(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Job) > 0 {
 		for iNdEx := len(m.Job) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2029,6 +2736,11 @@ func (m *ReturnLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.JobId) > 0 {
 		i -= len(m.JobId)
 		copy(dAtA[i:], m.JobId)
@@ -2046,6 +2758,49 @@ func (m *ReturnLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobRequeue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRequeue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRequeue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintQueue(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueue(v)
 	base := offset
@@ -2126,6 +2881,72 @@ func (m *Job) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	l = len(m.MinKubernetesVersion)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.ServicePorts) > 0 {
+		for _, e := range m.ServicePorts {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 2 + sovQueue(uint64(m.MaxRetries))
+	}
+	if len(m.RequiredClusters) > 0 {
+		for _, s := range m.RequiredClusters {
+			l = len(s)
+			n += 2 + l + sovQueue(uint64(l))
+		}
+	}
+	l = len(m.GangId)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	if m.GangSize != 0 {
+		n += 2 + sovQueue(uint64(m.GangSize))
+	}
+	if m.Deadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline)
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	if m.ToleratesPreemptibleNodes {
+		n += 3
+	}
+	if m.VolumeClaimTemplate != nil {
+		l = m.VolumeClaimTemplate.Size()
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *VolumeClaimTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.StorageClassName)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = m.Storage.Size()
+	n += 1 + l + sovQueue(uint64(l))
+	if len(m.AccessModes) > 0 {
+		for _, s := range m.AccessModes {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2169,23 +2990,55 @@ func (m *LeaseRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if m.MaxJobsToLease != 0 {
+		n += 1 + sovQueue(uint64(m.MaxJobsToLease))
+	}
+	l = len(m.KubernetesVersion)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.PreemptibleNodes != nil {
+		l = m.PreemptibleNodes.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
 	return n
 }
 
-func (m *NodeInfo) Size() (n int) {
+func (m *PreemptibleNodes) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Label)
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
-	if len(m.Taints) > 0 {
-		for _, e := range m.Taints {
-			l = e.Size()
-			n += 1 + l + sovQueue(uint64(l))
+	l = len(m.LabelValue)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = len(m.Taint)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.Taints) > 0 {
+		for _, e := range m.Taints {
+			l = e.Size()
+			n += 1 + l + sovQueue(uint64(l))
 		}
 	}
 	if len(m.Labels) > 0 {
@@ -2280,6 +3133,23 @@ func (m *ClusterSchedulingInfoReport) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	l = len(m.KubernetesVersion)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.TotalAllocatableResources) > 0 {
+		for k, v := range m.TotalAllocatableResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + 1 + l + sovQueue(uint64(l))
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
+	if m.PreemptibleNodes != nil {
+		l = m.PreemptibleNodes.Size()
+		n += 1 + l + sovQueue(uint64(l))
+	}
 	return n
 }
 
@@ -2373,6 +3243,8 @@ func (m *JobLease) Size() (n int) {
 			n += 1 + l + sovQueue(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ServerTime)
+	n += 1 + l + sovQueue(uint64(l))
 	return n
 }
 
@@ -2424,6 +3296,27 @@ func (m *ReturnLeaseRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if m.Reason != 0 {
+		n += 1 + sovQueue(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *JobRequeue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovQueue(uint64(m.Reason))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovQueue(uint64(l))
 	return n
 }
 
@@ -2442,6 +3335,11 @@ func (this *Job) String() string {
 		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
 	}
 	repeatedStringForPodSpecs += "}"
+	repeatedStringForServicePorts := "[]*ServicePort{"
+	for _, f := range this.ServicePorts {
+		repeatedStringForServicePorts += strings.Replace(fmt.Sprintf("%v", f), "ServicePort", "v1.ServicePort", 1) + ","
+	}
+	repeatedStringForServicePorts += "}"
 	keysForLabels := make([]string, 0, len(this.Labels))
 	for k, _ := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
@@ -2486,6 +3384,29 @@ func (this *Job) String() string {
 		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
 		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
 		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
+		`MinKubernetesVersion:` + fmt.Sprintf("%v", this.MinKubernetesVersion) + `,`,
+		`ServicePorts:` + repeatedStringForServicePorts + `,`,
+		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
+		`RequiredClusters:` + fmt.Sprintf("%v", this.RequiredClusters) + `,`,
+		`GangId:` + fmt.Sprintf("%v", this.GangId) + `,`,
+		`GangSize:` + fmt.Sprintf("%v", this.GangSize) + `,`,
+		`Deadline:` + strings.Replace(fmt.Sprintf("%v", this.Deadline), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ToleratesPreemptibleNodes:` + fmt.Sprintf("%v", this.ToleratesPreemptibleNodes) + `,`,
+		`VolumeClaimTemplate:` + strings.Replace(this.VolumeClaimTemplate.String(), "VolumeClaimTemplate", "VolumeClaimTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VolumeClaimTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VolumeClaimTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MountPath:` + fmt.Sprintf("%v", this.MountPath) + `,`,
+		`StorageClassName:` + fmt.Sprintf("%v", this.StorageClassName) + `,`,
+		`Storage:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Storage), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`AccessModes:` + fmt.Sprintf("%v", this.AccessModes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2526,6 +3447,21 @@ func (this *LeaseRequest) String() string {
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Nodes:` + repeatedStringForNodes + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`MaxJobsToLease:` + fmt.Sprintf("%v", this.MaxJobsToLease) + `,`,
+		`KubernetesVersion:` + fmt.Sprintf("%v", this.KubernetesVersion) + `,`,
+		`PreemptibleNodes:` + strings.Replace(this.PreemptibleNodes.String(), "PreemptibleNodes", "PreemptibleNodes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PreemptibleNodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreemptibleNodes{`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`LabelValue:` + fmt.Sprintf("%v", this.LabelValue) + `,`,
+		`Taint:` + fmt.Sprintf("%v", this.Taint) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2635,12 +3571,25 @@ func (this *ClusterSchedulingInfoReport) String() string {
 		mapStringForMinimumJobSize += fmt.Sprintf("%v: %v,", k, this.MinimumJobSize[k])
 	}
 	mapStringForMinimumJobSize += "}"
+	keysForTotalAllocatableResources := make([]string, 0, len(this.TotalAllocatableResources))
+	for k, _ := range this.TotalAllocatableResources {
+		keysForTotalAllocatableResources = append(keysForTotalAllocatableResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTotalAllocatableResources)
+	mapStringForTotalAllocatableResources := "map[string]resource.Quantity{"
+	for _, k := range keysForTotalAllocatableResources {
+		mapStringForTotalAllocatableResources += fmt.Sprintf("%v: %v,", k, this.TotalAllocatableResources[k])
+	}
+	mapStringForTotalAllocatableResources += "}"
 	s := strings.Join([]string{`&ClusterSchedulingInfoReport{`,
 		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
 		`ReportTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReportTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`KubernetesVersion:` + fmt.Sprintf("%v", this.KubernetesVersion) + `,`,
+		`TotalAllocatableResources:` + mapStringForTotalAllocatableResources + `,`,
+		`PreemptibleNodes:` + strings.Replace(this.PreemptibleNodes.String(), "PreemptibleNodes", "PreemptibleNodes", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2734,6 +3683,7 @@ func (this *JobLease) String() string {
 	repeatedStringForJob += "}"
 	s := strings.Join([]string{`&JobLease{`,
 		`Job:` + repeatedStringForJob + `,`,
+		`ServerTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServerTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2766,6 +3716,19 @@ func (this *ReturnLeaseRequest) String() string {
 	s := strings.Join([]string{`&ReturnLeaseRequest{`,
 		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
 		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRequeue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRequeue{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3494,62 +4457,9 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueue(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueue
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinKubernetesVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3577,11 +4487,11 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.MinKubernetesVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3608,111 +4518,35 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = make(map[string]resource.Quantity)
+			m.ServicePorts = append(m.ServicePorts, &v1.ServicePort{})
+			if err := m.ServicePorts[len(m.ServicePorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQueue
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQueue
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQueue
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQueue
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQueue(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Resources[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLeasedReport", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClusters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueue
@@ -3722,15 +4556,618 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueue
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredClusters = append(m.RequiredClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GangId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangSize", wireType)
+			}
+			m.GangSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GangSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToleratesPreemptibleNodes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToleratesPreemptibleNodes = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolumeClaimTemplate == nil {
+				m.VolumeClaimTemplate = &VolumeClaimTemplate{}
+			}
+			if err := m.VolumeClaimTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeClaimTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeClaimTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeClaimTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessModes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessModes = append(m.AccessModes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLeasedReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueue
 			}
@@ -3868,13 +5305,251 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MinimumJobSize[mapkey] = *mapvalue
+			m.MinimumJobSize[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, NodeInfo{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJobsToLease", wireType)
+			}
+			m.MaxJobsToLease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJobsToLease |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptibleNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreemptibleNodes == nil {
+				m.PreemptibleNodes = &PreemptibleNodes{}
+			}
+			if err := m.PreemptibleNodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreemptibleNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreemptibleNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreemptibleNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueue
@@ -3884,29 +5559,27 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueue
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueue
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, NodeInfo{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LabelValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3934,7 +5607,7 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = string(dAtA[iNdEx:postIndex])
+			m.Taint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4838,7 +6511,235 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeTypes = append(m.NodeTypes, &NodeType{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumJobSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinimumJobSize == nil {
+				m.MinimumJobSize = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MinimumJobSize[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4866,46 +6767,13 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueue
@@ -4915,29 +6783,27 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueue
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueue
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeTypes = append(m.NodeTypes, &NodeType{})
-			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KubernetesVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumJobSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocatableResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4964,8 +6830,8 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinimumJobSize == nil {
-				m.MinimumJobSize = make(map[string]resource.Quantity)
+			if m.TotalAllocatableResources == nil {
+				m.TotalAllocatableResources = make(map[string]resource.Quantity)
 			}
 			var mapkey string
 			mapvalue := &resource.Quantity{}
@@ -5062,13 +6928,13 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MinimumJobSize[mapkey] = *mapvalue
+			m.TotalAllocatableResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptibleNodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueue
@@ -5078,23 +6944,27 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQueue
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueue
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = string(dAtA[iNdEx:postIndex])
+			if m.PreemptibleNodes == nil {
+				m.PreemptibleNodes = &PreemptibleNodes{}
+			}
+			if err := m.PreemptibleNodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5911,6 +7781,39 @@ func (m *JobLease) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ServerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6230,6 +8133,162 @@ func (m *ReturnLeaseRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RequeueReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRequeue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRequeue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRequeue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RequeueReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -12,22 +12,26 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,15 +39,79 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Why jobs of a queue are currently not leased
+type QueueNotScheduledReason int32
+
+const (
+	QueueNotScheduledReason_UnspecifiedQueueNotScheduledReason QueueNotScheduledReason = 0
+	QueueNotScheduledReason_NoQueuedJobs                       QueueNotScheduledReason = 1
+	QueueNotScheduledReason_OutsideSchedulingWindow            QueueNotScheduledReason = 2
+	QueueNotScheduledReason_QueueResourceLimitReached          QueueNotScheduledReason = 3
+	QueueNotScheduledReason_StarvedByFairShare                 QueueNotScheduledReason = 4
+	QueueNotScheduledReason_NoFreeCapacity                     QueueNotScheduledReason = 5
+	QueueNotScheduledReason_NoFeasibleCluster                  QueueNotScheduledReason = 6
+	QueueNotScheduledReason_QueuePaused                        QueueNotScheduledReason = 7
+)
+
+var QueueNotScheduledReason_name = map[int32]string{
+	0: "UnspecifiedQueueNotScheduledReason",
+	1: "NoQueuedJobs",
+	2: "OutsideSchedulingWindow",
+	3: "QueueResourceLimitReached",
+	4: "StarvedByFairShare",
+	5: "NoFreeCapacity",
+	6: "NoFeasibleCluster",
+	7: "QueuePaused",
+}
+
+var QueueNotScheduledReason_value = map[string]int32{
+	"UnspecifiedQueueNotScheduledReason": 0,
+	"NoQueuedJobs":                       1,
+	"OutsideSchedulingWindow":            2,
+	"QueueResourceLimitReached":          3,
+	"StarvedByFairShare":                 4,
+	"NoFreeCapacity":                     5,
+	"NoFeasibleCluster":                  6,
+	"QueuePaused":                        7,
+}
+
+func (x QueueNotScheduledReason) String() string {
+	return proto.EnumName(QueueNotScheduledReason_name, int32(x))
+}
+
+func (QueueNotScheduledReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{0}
+}
+
 type JobSubmitRequestItem struct {
-	Priority           float64           `protobuf:"fixed64,1,opt,name=priority,proto3" json:"priority,omitempty"`
-	Namespace          string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ClientId           string            `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
-	Labels             map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Annotations        map[string]string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RequiredNodeLabels map[string]string `protobuf:"bytes,6,rep,name=required_node_labels,json=requiredNodeLabels,proto3" json:"requiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Deprecated: Do not use.
-	PodSpec            *v1.PodSpec       `protobuf:"bytes,2,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"`                                                                                                                           // Deprecated: Do not use.
-	PodSpecs           []*v1.PodSpec     `protobuf:"bytes,7,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
+	Priority             float64           `protobuf:"fixed64,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Namespace            string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClientId             string            `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations          map[string]string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RequiredNodeLabels   map[string]string `protobuf:"bytes,6,rep,name=required_node_labels,json=requiredNodeLabels,proto3" json:"requiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Deprecated: Do not use.
+	PodSpec              *v1.PodSpec       `protobuf:"bytes,2,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"`                                                                                                                           // Deprecated: Do not use.
+	PodSpecs             []*v1.PodSpec     `protobuf:"bytes,7,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
+	MinKubernetesVersion string            `protobuf:"bytes,9,opt,name=min_kubernetes_version,json=minKubernetesVersion,proto3" json:"minKubernetesVersion,omitempty"`
+	// Ports of a service created with the job pods, selecting them by job id
+	ServicePorts []*v1.ServicePort `protobuf:"bytes,10,rep,name=service_ports,json=servicePorts,proto3" json:"servicePorts,omitempty"`
+	// Number of times pods of the job are recreated after failing because of the infrastructure, e.g. eviction or node loss
+	MaxRetries uint32 `protobuf:"varint,11,opt,name=max_retries,json=maxRetries,proto3" json:"maxRetries,omitempty"`
+	// Job is only leased to clusters with one of these ids or in one of these pools, e.g. clusters the job data is replicated to
+	RequiredClusters []string `protobuf:"bytes,12,rep,name=required_clusters,json=requiredClusters,proto3" json:"requiredClusters,omitempty"`
+	// Jobs of a gang with gang_size members are only leased together to the same cluster, unique within the job set.
+	// All members have to be submitted in the same request
+	GangId   string `protobuf:"bytes,13,opt,name=gang_id,json=gangId,proto3" json:"gangId,omitempty"`
+	GangSize uint32 `protobuf:"varint,14,opt,name=gang_size,json=gangSize,proto3" json:"gangSize,omitempty"`
+	// Name of a server side pod spec template used as the pod spec of the job, can not be combined with pod_spec or pod_specs
+	PodSpecTemplate string `protobuf:"bytes,15,opt,name=pod_spec_template,json=podSpecTemplate,proto3" json:"podSpecTemplate,omitempty"`
+	// Changes applied to containers of the pod spec template
+	ContainerOverrides []*ContainerOverride `protobuf:"bytes,16,rep,name=container_overrides,json=containerOverrides,proto3" json:"containerOverrides,omitempty"`
+	// Job is cancelled if it has not finished by this time, queued or running
+	Deadline *time.Time `protobuf:"bytes,17,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+	// Job is safe to run on preemptible (spot) nodes, e.g. because it is checkpointed. Other jobs are kept off these nodes
+	// on executors configured with the labels and taints of preemptible nodes
+	ToleratesPreemptibleNodes bool `protobuf:"varint,18,opt,name=tolerates_preemptible_nodes,json=toleratesPreemptibleNodes,proto3" json:"toleratesPreemptibleNodes,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()      { *m = JobSubmitRequestItem{} }
@@ -136,17 +204,160 @@ func (m *JobSubmitRequestItem) GetPodSpecs() []*v1.PodSpec {
 	return nil
 }
 
+func (m *JobSubmitRequestItem) GetMinKubernetesVersion() string {
+	if m != nil {
+		return m.MinKubernetesVersion
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetServicePorts() []*v1.ServicePort {
+	if m != nil {
+		return m.ServicePorts
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *JobSubmitRequestItem) GetRequiredClusters() []string {
+	if m != nil {
+		return m.RequiredClusters
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetGangId() string {
+	if m != nil {
+		return m.GangId
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetGangSize() uint32 {
+	if m != nil {
+		return m.GangSize
+	}
+	return 0
+}
+
+func (m *JobSubmitRequestItem) GetPodSpecTemplate() string {
+	if m != nil {
+		return m.PodSpecTemplate
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetContainerOverrides() []*ContainerOverride {
+	if m != nil {
+		return m.ContainerOverrides
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetToleratesPreemptibleNodes() bool {
+	if m != nil {
+		return m.ToleratesPreemptibleNodes
+	}
+	return false
+}
+
+type ContainerOverride struct {
+	// Container of the template to change, can be empty when the template has a single container
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image string   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Args  []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Replaces requests and limits of the listed resources, other resources of the template container are kept
+	Resources v1.ResourceRequirements `protobuf:"bytes,4,opt,name=resources,proto3" json:"resources"`
+}
+
+func (m *ContainerOverride) Reset()      { *m = ContainerOverride{} }
+func (*ContainerOverride) ProtoMessage() {}
+func (*ContainerOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+func (m *ContainerOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContainerOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerOverride.Merge(m, src)
+}
+func (m *ContainerOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerOverride proto.InternalMessageInfo
+
+func (m *ContainerOverride) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerOverride) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ContainerOverride) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *ContainerOverride) GetResources() v1.ResourceRequirements {
+	if m != nil {
+		return m.Resources
+	}
+	return v1.ResourceRequirements{}
+}
+
 // swagger:model
 type JobSubmitRequest struct {
 	Queue           string                  `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobSetId        string                  `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	JobRequestItems []*JobSubmitRequestItem `protobuf:"bytes,3,rep,name=job_request_items,json=jobRequestItems,proto3" json:"jobRequestItems,omitempty"`
+	// Validates the jobs, including whether they can be scheduled, without storing them or creating events
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
+	// Fails the request when the queue is paused instead of queueing the jobs until it is resumed
+	RejectIfQueuePaused bool `protobuf:"varint,5,opt,name=reject_if_queue_paused,json=rejectIfQueuePaused,proto3" json:"rejectIfQueuePaused,omitempty"`
 }
 
 func (m *JobSubmitRequest) Reset()      { *m = JobSubmitRequest{} }
 func (*JobSubmitRequest) ProtoMessage() {}
 func (*JobSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{2}
 }
 func (m *JobSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,17 +407,33 @@ func (m *JobSubmitRequest) GetJobRequestItems() []*JobSubmitRequestItem {
 	return nil
 }
 
+func (m *JobSubmitRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *JobSubmitRequest) GetRejectIfQueuePaused() bool {
+	if m != nil {
+		return m.RejectIfQueuePaused
+	}
+	return false
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue    string `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// optional explanation of the cancellation, included in cancel events
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *JobCancelRequest) Reset()      { *m = JobCancelRequest{} }
 func (*JobCancelRequest) ProtoMessage() {}
 func (*JobCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
 }
 func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,22 +483,32 @@ func (m *JobCancelRequest) GetQueue() string {
 	return ""
 }
 
-type JobSubmitResponseItem struct {
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+func (m *JobCancelRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
-func (*JobSubmitResponseItem) ProtoMessage() {}
-func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{3}
+// swagger:model
+type JobCancelSubmittedBeforeRequest struct {
+	Queue           string    `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId        string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	SubmittedBefore time.Time `protobuf:"bytes,3,opt,name=submitted_before,json=submittedBefore,proto3,stdtime" json:"submitted_before"`
+	Reason          string    `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
+
+func (m *JobCancelSubmittedBeforeRequest) Reset()      { *m = JobCancelSubmittedBeforeRequest{} }
+func (*JobCancelSubmittedBeforeRequest) ProtoMessage() {}
+func (*JobCancelSubmittedBeforeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{4}
+}
+func (m *JobCancelSubmittedBeforeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCancelSubmittedBeforeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSubmitResponseItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCancelSubmittedBeforeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -281,96 +518,65 @@ func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *JobSubmitResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSubmitResponseItem.Merge(m, src)
+func (m *JobCancelSubmittedBeforeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelSubmittedBeforeRequest.Merge(m, src)
 }
-func (m *JobSubmitResponseItem) XXX_Size() int {
+func (m *JobCancelSubmittedBeforeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSubmitResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSubmitResponseItem.DiscardUnknown(m)
+func (m *JobCancelSubmittedBeforeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelSubmittedBeforeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSubmitResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_JobCancelSubmittedBeforeRequest proto.InternalMessageInfo
 
-func (m *JobSubmitResponseItem) GetJobId() string {
+func (m *JobCancelSubmittedBeforeRequest) GetQueue() string {
 	if m != nil {
-		return m.JobId
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobSubmitResponseItem) GetError() string {
+func (m *JobCancelSubmittedBeforeRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Error
+		return m.JobSetId
 	}
 	return ""
 }
 
-// swagger:model
-type JobSubmitResponse struct {
-	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
-}
-
-func (m *JobSubmitResponse) Reset()      { *m = JobSubmitResponse{} }
-func (*JobSubmitResponse) ProtoMessage() {}
-func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{4}
-}
-func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_JobSubmitResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *JobCancelSubmittedBeforeRequest) GetSubmittedBefore() time.Time {
+	if m != nil {
+		return m.SubmittedBefore
 	}
+	return time.Time{}
 }
-func (m *JobSubmitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSubmitResponse.Merge(m, src)
-}
-func (m *JobSubmitResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *JobSubmitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSubmitResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JobSubmitResponse proto.InternalMessageInfo
 
-func (m *JobSubmitResponse) GetJobResponseItems() []*JobSubmitResponseItem {
+func (m *JobCancelSubmittedBeforeRequest) GetReason() string {
 	if m != nil {
-		return m.JobResponseItems
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
 // swagger:model
-type Queue struct {
-	Name           string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PriorityFactor float64            `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
-	UserOwners     []string           `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
-	GroupOwners    []string           `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
-	ResourceLimits map[string]float64 `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+type JobReprioritizeRequest struct {
+	JobIds      []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId    string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue       string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	NewPriority float64  `protobuf:"fixed64,4,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
 }
 
-func (m *Queue) Reset()      { *m = Queue{} }
-func (*Queue) ProtoMessage() {}
-func (*Queue) Descriptor() ([]byte, []int) {
+func (m *JobReprioritizeRequest) Reset()      { *m = JobReprioritizeRequest{} }
+func (*JobReprioritizeRequest) ProtoMessage() {}
+func (*JobReprioritizeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{5}
 }
-func (m *Queue) XXX_Unmarshal(b []byte) error {
+func (m *JobReprioritizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Queue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -380,69 +586,64 @@ func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Queue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Queue.Merge(m, src)
+func (m *JobReprioritizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeRequest.Merge(m, src)
 }
-func (m *Queue) XXX_Size() int {
+func (m *JobReprioritizeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Queue) XXX_DiscardUnknown() {
-	xxx_messageInfo_Queue.DiscardUnknown(m)
+func (m *JobReprioritizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Queue proto.InternalMessageInfo
-
-func (m *Queue) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_JobReprioritizeRequest proto.InternalMessageInfo
 
-func (m *Queue) GetPriorityFactor() float64 {
+func (m *JobReprioritizeRequest) GetJobIds() []string {
 	if m != nil {
-		return m.PriorityFactor
+		return m.JobIds
 	}
-	return 0
+	return nil
 }
 
-func (m *Queue) GetUserOwners() []string {
+func (m *JobReprioritizeRequest) GetJobSetId() string {
 	if m != nil {
-		return m.UserOwners
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *Queue) GetGroupOwners() []string {
+func (m *JobReprioritizeRequest) GetQueue() string {
 	if m != nil {
-		return m.GroupOwners
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *Queue) GetResourceLimits() map[string]float64 {
+func (m *JobReprioritizeRequest) GetNewPriority() float64 {
 	if m != nil {
-		return m.ResourceLimits
+		return m.NewPriority
 	}
-	return nil
+	return 0
 }
 
 // swagger:model
-type CancellationResult struct {
-	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds"`
+type JobReprioritizeResponse struct {
+	ReprioritizedIds []string `protobuf:"bytes,1,rep,name=reprioritized_ids,json=reprioritizedIds,proto3" json:"reprioritizedIds,omitempty"`
+	// Jobs which kept their priority with the reason, priority of leased and running jobs can not be changed
+	NotReprioritized map[string]string `protobuf:"bytes,2,rep,name=not_reprioritized,json=notReprioritized,proto3" json:"notReprioritized,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
-func (*CancellationResult) ProtoMessage() {}
-func (*CancellationResult) Descriptor() ([]byte, []int) {
+func (m *JobReprioritizeResponse) Reset()      { *m = JobReprioritizeResponse{} }
+func (*JobReprioritizeResponse) ProtoMessage() {}
+func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{6}
 }
-func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
+func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancellationResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -452,41 +653,48 @@ func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CancellationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancellationResult.Merge(m, src)
+func (m *JobReprioritizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeResponse.Merge(m, src)
 }
-func (m *CancellationResult) XXX_Size() int {
+func (m *JobReprioritizeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancellationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancellationResult.DiscardUnknown(m)
+func (m *JobReprioritizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancellationResult proto.InternalMessageInfo
+var xxx_messageInfo_JobReprioritizeResponse proto.InternalMessageInfo
 
-func (m *CancellationResult) GetCancelledIds() []string {
+func (m *JobReprioritizeResponse) GetReprioritizedIds() []string {
 	if m != nil {
-		return m.CancelledIds
+		return m.ReprioritizedIds
 	}
 	return nil
 }
 
-//swagger:model
-type QueueInfoRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobReprioritizeResponse) GetNotReprioritized() map[string]string {
+	if m != nil {
+		return m.NotReprioritized
+	}
+	return nil
 }
 
-func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
-func (*QueueInfoRequest) ProtoMessage() {}
-func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
+type JobCancelQueueRequest struct {
+	Queue  string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *JobCancelQueueRequest) Reset()      { *m = JobCancelQueueRequest{} }
+func (*JobCancelQueueRequest) ProtoMessage() {}
+func (*JobCancelQueueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{7}
 }
-func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobCancelQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCancelQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCancelQueueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -496,41 +704,48 @@ func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueueInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueInfoRequest.Merge(m, src)
+func (m *JobCancelQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelQueueRequest.Merge(m, src)
 }
-func (m *QueueInfoRequest) XXX_Size() int {
+func (m *JobCancelQueueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueInfoRequest.DiscardUnknown(m)
+func (m *JobCancelQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelQueueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobCancelQueueRequest proto.InternalMessageInfo
 
-func (m *QueueInfoRequest) GetName() string {
+func (m *JobCancelQueueRequest) GetQueue() string {
 	if m != nil {
-		return m.Name
+		return m.Queue
 	}
 	return ""
 }
 
-//swagger:model
-type QueueDeleteRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobCancelQueueRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
-func (*QueueDeleteRequest) ProtoMessage() {}
-func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+type JobSubmitResponseItem struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
+func (*JobSubmitResponseItem) ProtoMessage() {}
+func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{8}
 }
-func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSubmitResponseItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -540,42 +755,50 @@ func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+func (m *JobSubmitResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitResponseItem.Merge(m, src)
 }
-func (m *QueueDeleteRequest) XXX_Size() int {
+func (m *JobSubmitResponseItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
+func (m *JobSubmitResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitResponseItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobSubmitResponseItem proto.InternalMessageInfo
 
-func (m *QueueDeleteRequest) GetName() string {
+func (m *JobSubmitResponseItem) GetJobId() string {
 	if m != nil {
-		return m.Name
+		return m.JobId
 	}
 	return ""
 }
 
-//swagger:model
-type QueueInfo struct {
-	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
+func (m *JobSubmitResponseItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
-func (*QueueInfo) ProtoMessage() {}
-func (*QueueInfo) Descriptor() ([]byte, []int) {
+// swagger:model
+type JobSubmitResponse struct {
+	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
+	// Jobs were only validated, their ids are not used by any stored job
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *JobSubmitResponse) Reset()      { *m = JobSubmitResponse{} }
+func (*JobSubmitResponse) ProtoMessage() {}
+func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{9}
 }
-func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
+func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSubmitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -585,49 +808,51 @@ func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueInfo.Merge(m, src)
+func (m *JobSubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitResponse.Merge(m, src)
 }
-func (m *QueueInfo) XXX_Size() int {
+func (m *JobSubmitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
+func (m *JobSubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
+var xxx_messageInfo_JobSubmitResponse proto.InternalMessageInfo
 
-func (m *QueueInfo) GetName() string {
+func (m *JobSubmitResponse) GetJobResponseItems() []*JobSubmitResponseItem {
 	if m != nil {
-		return m.Name
+		return m.JobResponseItems
 	}
-	return ""
+	return nil
 }
 
-func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+func (m *JobSubmitResponse) GetDryRun() bool {
 	if m != nil {
-		return m.ActiveJobSets
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-type JobSetInfo struct {
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
-	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+// Results of one request of a submit stream
+type JobSubmitStreamResponse struct {
+	// Index of the first job of the request among all jobs sent on the stream
+	FirstItemIndex   int32                    `protobuf:"varint,1,opt,name=first_item_index,json=firstItemIndex,proto3" json:"firstItemIndex,omitempty"`
+	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,2,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
+	DryRun           bool                     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
-func (*JobSetInfo) ProtoMessage() {}
-func (*JobSetInfo) Descriptor() ([]byte, []int) {
+func (m *JobSubmitStreamResponse) Reset()      { *m = JobSubmitStreamResponse{} }
+func (*JobSubmitStreamResponse) ProtoMessage() {}
+func (*JobSubmitStreamResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{10}
 }
-func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+func (m *JobSubmitStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSubmitStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSubmitStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -637,1354 +862,14955 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobSetInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetInfo.Merge(m, src)
+func (m *JobSubmitStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitStreamResponse.Merge(m, src)
 }
-func (m *JobSetInfo) XXX_Size() int {
+func (m *JobSubmitStreamResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+func (m *JobSubmitStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+var xxx_messageInfo_JobSubmitStreamResponse proto.InternalMessageInfo
 
-func (m *JobSetInfo) GetName() string {
+func (m *JobSubmitStreamResponse) GetFirstItemIndex() int32 {
 	if m != nil {
-		return m.Name
+		return m.FirstItemIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *JobSetInfo) GetQueuedJobs() int32 {
+func (m *JobSubmitStreamResponse) GetJobResponseItems() []*JobSubmitResponseItem {
 	if m != nil {
-		return m.QueuedJobs
+		return m.JobResponseItems
 	}
-	return 0
+	return nil
 }
 
-func (m *JobSetInfo) GetLeasedJobs() int32 {
+func (m *JobSubmitStreamResponse) GetDryRun() bool {
 	if m != nil {
-		return m.LeasedJobs
+		return m.DryRun
 	}
-	return 0
+	return false
 }
 
-func init() {
-	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
-	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
-	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
-	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
-	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
-	proto.RegisterType((*Queue)(nil), "api.Queue")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
-	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
-	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
-	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
-	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+// Attached as error detail when a submit stream fails, jobs before the failed item stay submitted
+type JobSubmitStreamFailure struct {
+	FailedItemIndex int32 `protobuf:"varint,1,opt,name=failed_item_index,json=failedItemIndex,proto3" json:"failedItemIndex,omitempty"`
 }
 
-func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
-
-var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 1014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0x8d, 0x77, 0xbb, 0xe9, 0xee, 0xdd, 0x34, 0xd9, 0x4e, 0x93, 0xc6, 0x75, 0xa2, 0x4d, 0xb0,
-	0x04, 0x44, 0x91, 0xf0, 0x2a, 0x41, 0x88, 0x10, 0x09, 0xa4, 0xa6, 0x0d, 0x25, 0x25, 0x6a, 0xc1,
-	0x91, 0xa0, 0x2f, 0x95, 0xe5, 0x8f, 0x9b, 0xc5, 0x89, 0xd7, 0xe3, 0x78, 0xc6, 0x41, 0x11, 0x42,
-	0x42, 0xbc, 0x23, 0x21, 0xc1, 0x1b, 0x7f, 0x83, 0x1f, 0xc1, 0x63, 0x25, 0x5e, 0xfa, 0x84, 0x20,
-	0xe1, 0x89, 0x5f, 0x81, 0x7c, 0xc7, 0xde, 0x8f, 0xec, 0x6e, 0xab, 0xbe, 0xf9, 0xde, 0x39, 0xf7,
-	0xcc, 0x99, 0x73, 0xef, 0xec, 0x2c, 0x2c, 0x26, 0xa7, 0xdd, 0x8e, 0x9b, 0x84, 0x1d, 0x91, 0x79,
-	0xbd, 0x50, 0x5a, 0x49, 0xca, 0x25, 0x67, 0x55, 0x37, 0x09, 0x8d, 0x95, 0x2e, 0xe7, 0xdd, 0x08,
-	0x3b, 0x94, 0xf2, 0xb2, 0xe3, 0x0e, 0xf6, 0x12, 0x79, 0xa1, 0x10, 0x86, 0x79, 0xba, 0x23, 0xac,
-	0x90, 0x53, 0xa9, 0xcf, 0x53, 0xec, 0x9c, 0x6f, 0x75, 0xba, 0x18, 0x63, 0xea, 0x4a, 0x0c, 0x0a,
-	0xcc, 0x6a, 0x41, 0x90, 0x63, 0xdc, 0x38, 0xe6, 0xd2, 0x95, 0x21, 0x8f, 0x45, 0xb1, 0xfa, 0x5e,
-	0x37, 0x94, 0xdf, 0x64, 0x9e, 0xe5, 0xf3, 0x5e, 0xa7, 0xcb, 0xbb, 0x7c, 0xb0, 0x4f, 0x1e, 0x51,
-	0x40, 0x5f, 0x0a, 0x6e, 0xfe, 0x56, 0x83, 0xc5, 0xc7, 0xdc, 0x3b, 0x22, 0x99, 0x36, 0x9e, 0x65,
-	0x28, 0xe4, 0x81, 0xc4, 0x1e, 0x33, 0xa0, 0x9e, 0xa4, 0x21, 0x4f, 0x43, 0x79, 0xa1, 0x6b, 0xeb,
-	0xda, 0x86, 0x66, 0xf7, 0x63, 0xb6, 0x0a, 0x8d, 0xd8, 0xed, 0xa1, 0x48, 0x5c, 0x1f, 0xf5, 0xea,
-	0xba, 0xb6, 0xd1, 0xb0, 0x07, 0x09, 0xb6, 0x02, 0x0d, 0x3f, 0x0a, 0x31, 0x96, 0x4e, 0x18, 0xe8,
-	0x75, 0x5a, 0xad, 0xab, 0xc4, 0x41, 0xc0, 0x3e, 0x86, 0xd9, 0xc8, 0xf5, 0x30, 0x12, 0xfa, 0x8d,
-	0xf5, 0xea, 0x46, 0x73, 0xfb, 0x6d, 0xcb, 0x4d, 0x42, 0x6b, 0x92, 0x02, 0xeb, 0x90, 0x70, 0xfb,
-	0xb1, 0x4c, 0x2f, 0xec, 0xa2, 0x88, 0x1d, 0x42, 0x73, 0xe8, 0xc8, 0x7a, 0x8d, 0x38, 0x36, 0xa7,
-	0x73, 0xdc, 0x1f, 0x80, 0x15, 0xd1, 0x70, 0x39, 0xeb, 0xc2, 0x62, 0x8a, 0x67, 0x59, 0x98, 0x62,
-	0xe0, 0xc4, 0x3c, 0x40, 0xa7, 0x90, 0x36, 0x4b, 0xb4, 0x5b, 0xd3, 0x69, 0xed, 0xa2, 0xea, 0x09,
-	0x0f, 0x70, 0x48, 0xe6, 0x5e, 0x45, 0xd7, 0x6c, 0x96, 0x8e, 0x2d, 0xb2, 0x5d, 0xa8, 0x27, 0x3c,
-	0x70, 0x44, 0x82, 0xbe, 0x5e, 0x59, 0xd7, 0x36, 0x9a, 0xdb, 0x2b, 0x96, 0xea, 0x34, 0xed, 0x91,
-	0x77, 0xda, 0x3a, 0xdf, 0xb2, 0xbe, 0xe0, 0xc1, 0x51, 0x82, 0x3e, 0xd1, 0xdc, 0x4c, 0x54, 0xc0,
-	0x76, 0xa0, 0x51, 0xd6, 0x0a, 0xfd, 0x26, 0x29, 0x7b, 0x55, 0xb1, 0x5d, 0x2f, 0x0a, 0x85, 0xf1,
-	0x11, 0x34, 0x87, 0xc4, 0xb1, 0x16, 0x54, 0x4f, 0x51, 0x35, 0xb3, 0x61, 0xe7, 0x9f, 0x6c, 0x11,
-	0x6a, 0xe7, 0x6e, 0x94, 0x21, 0x69, 0x6a, 0xd8, 0x2a, 0xd8, 0xad, 0xec, 0x68, 0xc6, 0x27, 0xd0,
-	0xba, 0x6e, 0xdd, 0x1b, 0xd5, 0xef, 0xc3, 0xf2, 0x14, 0x8f, 0xde, 0x84, 0xc6, 0xfc, 0x49, 0x83,
-	0xd6, 0xf5, 0x06, 0xe4, 0xf0, 0xb3, 0x0c, 0x33, 0x2c, 0x28, 0x54, 0xc0, 0x56, 0x01, 0x4e, 0xb8,
-	0xe7, 0x08, 0xa4, 0xb1, 0x53, 0x4c, 0xf5, 0x13, 0xee, 0x1d, 0x61, 0x3e, 0x76, 0xfb, 0x70, 0x3b,
-	0x5f, 0x4d, 0x15, 0x85, 0x13, 0x4a, 0xec, 0x09, 0xbd, 0x4a, 0x66, 0xde, 0x9b, 0xda, 0x66, 0x7b,
-	0xe1, 0x84, 0x7b, 0x43, 0xb1, 0x30, 0x9f, 0x93, 0x9c, 0x07, 0x6e, 0xec, 0x63, 0x54, 0xca, 0x59,
-	0x82, 0xd9, 0x9c, 0x3a, 0x0c, 0x4a, 0x3d, 0x27, 0xdc, 0x3b, 0x08, 0x5e, 0xa3, 0xa7, 0x7f, 0x86,
-	0xea, 0xd0, 0x19, 0xcc, 0x87, 0xb0, 0x34, 0xa4, 0x43, 0x24, 0x3c, 0x16, 0x48, 0x97, 0x71, 0xca,
-	0x1e, 0x8b, 0x50, 0xc3, 0x34, 0xe5, 0x69, 0x69, 0x1c, 0x05, 0xe6, 0x73, 0xb8, 0x3d, 0xc6, 0xc2,
-	0x3e, 0x03, 0xa6, 0x0c, 0x50, 0x71, 0xe1, 0x80, 0x46, 0x0e, 0x18, 0xd7, 0x1d, 0x18, 0xec, 0x6c,
-	0xb7, 0xc8, 0x82, 0x41, 0x42, 0x98, 0xbf, 0x56, 0xa0, 0xf6, 0x25, 0x59, 0xce, 0xe0, 0x46, 0x7e,
-	0xeb, 0x0b, 0x4d, 0xf4, 0xcd, 0xde, 0x85, 0x85, 0xf2, 0x67, 0xc2, 0x39, 0x76, 0x7d, 0x59, 0x88,
-	0xd3, 0xec, 0xf9, 0x32, 0xfd, 0x29, 0x65, 0xd9, 0x1a, 0x34, 0x33, 0x81, 0xa9, 0xc3, 0xbf, 0x8d,
-	0x31, 0x55, 0xbd, 0x68, 0xd8, 0x90, 0xa7, 0x9e, 0x52, 0x86, 0xbd, 0x05, 0x73, 0xdd, 0x94, 0x67,
-	0x49, 0x89, 0xb8, 0x41, 0x88, 0x26, 0xe5, 0x0a, 0xc8, 0x23, 0x58, 0x48, 0x51, 0xf0, 0x2c, 0xf5,
-	0xd1, 0x89, 0xc2, 0x5e, 0x28, 0xcb, 0x5f, 0x84, 0x36, 0x9d, 0x88, 0x54, 0x5a, 0x76, 0x81, 0x38,
-	0x24, 0x80, 0xfa, 0x15, 0x98, 0x4f, 0x47, 0x92, 0xc6, 0x7d, 0xb8, 0x33, 0x01, 0xf6, 0xba, 0x51,
-	0xd5, 0x86, 0x47, 0xf5, 0x73, 0x60, 0x6a, 0x2e, 0x22, 0xba, 0x33, 0x36, 0x8a, 0x2c, 0x92, 0xec,
-	0x03, 0xb8, 0xe5, 0xab, 0x2c, 0x06, 0x4e, 0x18, 0x28, 0xc7, 0x1b, 0x7b, 0xad, 0xff, 0xfe, 0x5a,
-	0x9b, 0xeb, 0x2f, 0x1c, 0x04, 0xc2, 0x1e, 0x89, 0xcc, 0x77, 0xa0, 0x45, 0xe2, 0x0f, 0xe2, 0x63,
-	0x5e, 0xce, 0xd9, 0x04, 0xb7, 0xcd, 0x0d, 0x60, 0x84, 0x7b, 0x88, 0x11, 0x4a, 0x7c, 0x15, 0xf2,
-	0x19, 0x34, 0xfa, 0x8c, 0x13, 0x1b, 0xf7, 0x21, 0x2c, 0xb8, 0xbe, 0x0c, 0xcf, 0xd1, 0x29, 0xc6,
-	0x56, 0xe8, 0x15, 0xf2, 0x72, 0xa1, 0x3f, 0x1d, 0x28, 0x49, 0xcf, 0x2d, 0x85, 0x53, 0x19, 0x61,
-	0x7a, 0x00, 0x83, 0xc5, 0x89, 0xd4, 0x6b, 0xd0, 0xa4, 0xf9, 0x0e, 0x72, 0x6a, 0x41, 0xd6, 0xd5,
-	0x6c, 0x50, 0xa9, 0xc7, 0xdc, 0x13, 0x39, 0x20, 0x42, 0x57, 0x94, 0x80, 0xaa, 0x02, 0xa8, 0x54,
-	0x0e, 0xd8, 0xfe, 0xbd, 0x0a, 0xb3, 0x6a, 0x38, 0xd9, 0x57, 0x00, 0xea, 0x8b, 0x2a, 0x97, 0x26,
-	0x5e, 0x5e, 0xe3, 0xee, 0xe4, 0x89, 0x36, 0xef, 0xfd, 0xf8, 0xe7, 0xbf, 0xbf, 0x54, 0xee, 0x98,
-	0xf3, 0xf9, 0xbb, 0x7a, 0xc2, 0xbd, 0xe2, 0x79, 0xde, 0xd5, 0x36, 0xd9, 0xd7, 0x00, 0xaa, 0x7f,
-	0xa3, 0xbc, 0x23, 0x77, 0xdd, 0x58, 0xa6, 0xf4, 0x78, 0x9f, 0xc7, 0x89, 0x55, 0x3b, 0x73, 0xe2,
-	0x27, 0xd0, 0x7c, 0x90, 0xa2, 0x2b, 0x51, 0x5d, 0x1a, 0x18, 0x8c, 0xa6, 0x71, 0xd7, 0x52, 0x4f,
-	0xb9, 0x55, 0xbe, 0xd1, 0xd6, 0x7e, 0xfe, 0x5f, 0xc0, 0x5c, 0x21, 0xb6, 0x25, 0xa3, 0x95, 0xb3,
-	0x91, 0x4f, 0x9d, 0xef, 0x72, 0x27, 0xbf, 0xcf, 0xf9, 0x9e, 0x41, 0x53, 0xb5, 0x5b, 0xf1, 0x2d,
-	0x0f, 0xf8, 0x46, 0xa6, 0x60, 0x2a, 0xb9, 0x4e, 0xe4, 0x6c, 0x73, 0x8c, 0x9c, 0x3d, 0x85, 0xb9,
-	0x47, 0x28, 0x07, 0x63, 0xb2, 0x34, 0xa0, 0x1e, 0x1a, 0x44, 0x63, 0x7e, 0x34, 0x5d, 0x12, 0xb2,
-	0x31, 0xc2, 0xbd, 0xf5, 0x97, 0xff, 0xb4, 0x67, 0x7e, 0xb8, 0x6c, 0x6b, 0x7f, 0x5c, 0xb6, 0xb5,
-	0x17, 0x97, 0x6d, 0xed, 0xef, 0xcb, 0xb6, 0xf6, 0xf3, 0x55, 0x7b, 0xe6, 0xc5, 0x55, 0x7b, 0xe6,
-	0xe5, 0x55, 0x7b, 0xc6, 0x9b, 0x25, 0x71, 0xef, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x76, 0xde,
-	0xde, 0x5b, 0x30, 0x09, 0x00, 0x00,
+func (m *JobSubmitStreamFailure) Reset()      { *m = JobSubmitStreamFailure{} }
+func (*JobSubmitStreamFailure) ProtoMessage() {}
+func (*JobSubmitStreamFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{11}
+}
+func (m *JobSubmitStreamFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSubmitStreamFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSubmitStreamFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSubmitStreamFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitStreamFailure.Merge(m, src)
+}
+func (m *JobSubmitStreamFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSubmitStreamFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitStreamFailure.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_JobSubmitStreamFailure proto.InternalMessageInfo
 
-// SubmitClient is the client API for Submit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SubmitClient interface {
-	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
-	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+func (m *JobSubmitStreamFailure) GetFailedItemIndex() int32 {
+	if m != nil {
+		return m.FailedItemIndex
+	}
+	return 0
 }
 
-type submitClient struct {
-	cc *grpc.ClientConn
+// Attached as error detail when a submitted job can not be scheduled on any cluster
+type JobSchedulingFeasibility struct {
+	JobIndex int32 `protobuf:"varint,1,opt,name=job_index,json=jobIndex,proto3" json:"jobIndex,omitempty"`
+	// Ordered from the closest match
+	Clusters         []*ClusterSchedulingFeasibility `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	LargestNodeTypes []*PoolNodeType                 `protobuf:"bytes,3,rep,name=largest_node_types,json=largestNodeTypes,proto3" json:"largestNodeTypes,omitempty"`
 }
 
-func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
-	return &submitClient{cc}
+func (m *JobSchedulingFeasibility) Reset()      { *m = JobSchedulingFeasibility{} }
+func (*JobSchedulingFeasibility) ProtoMessage() {}
+func (*JobSchedulingFeasibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{12}
 }
-
-func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
-	out := new(JobSubmitResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *JobSchedulingFeasibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
-	out := new(CancellationResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSchedulingFeasibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSchedulingFeasibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *JobSchedulingFeasibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSchedulingFeasibility.Merge(m, src)
+}
+func (m *JobSchedulingFeasibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSchedulingFeasibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSchedulingFeasibility.DiscardUnknown(m)
 }
 
-func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSchedulingFeasibility proto.InternalMessageInfo
+
+func (m *JobSchedulingFeasibility) GetJobIndex() int32 {
+	if m != nil {
+		return m.JobIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSchedulingFeasibility) GetClusters() []*ClusterSchedulingFeasibility {
+	if m != nil {
+		return m.Clusters
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
-	out := new(QueueInfo)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSchedulingFeasibility) GetLargestNodeTypes() []*PoolNodeType {
+	if m != nil {
+		return m.LargestNodeTypes
 	}
-	return out, nil
+	return nil
 }
 
-// SubmitServer is the server API for Submit service.
-type SubmitServer interface {
-	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
-	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
-	CreateQueue(context.Context, *Queue) (*types.Empty, error)
-	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
-	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+type ClusterSchedulingFeasibility struct {
+	ClusterId string   `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string   `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Reasons   []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Ordered from the closest match
+	NodeTypes []*NodeTypeSchedulingFeasibility `protobuf:"bytes,4,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
 }
 
-// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
-type UnimplementedSubmitServer struct {
+func (m *ClusterSchedulingFeasibility) Reset()      { *m = ClusterSchedulingFeasibility{} }
+func (*ClusterSchedulingFeasibility) ProtoMessage() {}
+func (*ClusterSchedulingFeasibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
-
-func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+func (m *ClusterSchedulingFeasibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+func (m *ClusterSchedulingFeasibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterSchedulingFeasibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+func (m *ClusterSchedulingFeasibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterSchedulingFeasibility.Merge(m, src)
 }
-func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+func (m *ClusterSchedulingFeasibility) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+func (m *ClusterSchedulingFeasibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterSchedulingFeasibility.DiscardUnknown(m)
 }
 
-func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
-	s.RegisterService(&_Submit_serviceDesc, srv)
-}
+var xxx_messageInfo_ClusterSchedulingFeasibility proto.InternalMessageInfo
 
-func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ClusterSchedulingFeasibility) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).SubmitJobs(ctx, in)
+	return ""
+}
+
+func (m *ClusterSchedulingFeasibility) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/SubmitJobs",
+	return ""
+}
+
+func (m *ClusterSchedulingFeasibility) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+	return nil
+}
+
+func (m *ClusterSchedulingFeasibility) GetNodeTypes() []*NodeTypeSchedulingFeasibility {
+	if m != nil {
+		return m.NodeTypes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type NodeTypeSchedulingFeasibility struct {
+	Labels               map[string]string            `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllocatableResources map[string]resource.Quantity `protobuf:"bytes,2,rep,name=allocatable_resources,json=allocatableResources,proto3" json:"allocatableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Reasons              []string                     `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *NodeTypeSchedulingFeasibility) Reset()      { *m = NodeTypeSchedulingFeasibility{} }
+func (*NodeTypeSchedulingFeasibility) ProtoMessage() {}
+func (*NodeTypeSchedulingFeasibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{14}
+}
+func (m *NodeTypeSchedulingFeasibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypeSchedulingFeasibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypeSchedulingFeasibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobs(ctx, in)
+}
+func (m *NodeTypeSchedulingFeasibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypeSchedulingFeasibility.Merge(m, src)
+}
+func (m *NodeTypeSchedulingFeasibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypeSchedulingFeasibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypeSchedulingFeasibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypeSchedulingFeasibility proto.InternalMessageInfo
+
+func (m *NodeTypeSchedulingFeasibility) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobs",
+	return nil
+}
+
+func (m *NodeTypeSchedulingFeasibility) GetAllocatableResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.AllocatableResources
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+	return nil
+}
+
+func (m *NodeTypeSchedulingFeasibility) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
+type PoolNodeType struct {
+	Pool                 string                       `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Labels               map[string]string            `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllocatableResources map[string]resource.Quantity `protobuf:"bytes,3,rep,name=allocatable_resources,json=allocatableResources,proto3" json:"allocatableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PoolNodeType) Reset()      { *m = PoolNodeType{} }
+func (*PoolNodeType) ProtoMessage() {}
+func (*PoolNodeType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{15}
+}
+func (m *PoolNodeType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolNodeType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolNodeType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueue(ctx, in)
+}
+func (m *PoolNodeType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolNodeType.Merge(m, src)
+}
+func (m *PoolNodeType) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolNodeType) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolNodeType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolNodeType proto.InternalMessageInfo
+
+func (m *PoolNodeType) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueue",
+	return ""
+}
+
+func (m *PoolNodeType) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+	return nil
+}
+
+func (m *PoolNodeType) GetAllocatableResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.AllocatableResources
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// swagger:model
+type Queue struct {
+	Name              string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriorityFactor    float64                  `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
+	UserOwners        []string                 `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
+	GroupOwners       []string                 `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
+	ResourceLimits    map[string]float64       `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	EventRetention    *QueueEventRetention     `protobuf:"bytes,6,opt,name=event_retention,json=eventRetention,proto3" json:"eventRetention,omitempty"`
+	SchedulingWindows []*QueueSchedulingWindow `protobuf:"bytes,7,rep,name=scheduling_windows,json=schedulingWindows,proto3" json:"schedulingWindows,omitempty"`
+	// Overrides server wide maximum serialized size of a single pod spec, 0 means server default is used
+	MaxPodSpecSizeBytes uint32 `protobuf:"varint,8,opt,name=max_pod_spec_size_bytes,json=maxPodSpecSizeBytes,proto3" json:"maxPodSpecSizeBytes,omitempty"`
+	// Rejects jobs with containers relying on the image default entrypoint, without their own command or args
+	RequireContainerCommand bool `protobuf:"varint,9,opt,name=require_container_command,json=requireContainerCommand,proto3" json:"requireContainerCommand,omitempty"`
+	// Fraction of total capacity per resource always reserved for the queue while it has queued jobs
+	ResourceFloor map[string]float64 `protobuf:"bytes,10,rep,name=resource_floor,json=resourceFloor,proto3" json:"resourceFloor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Server configured queue template providing settings not set when the queue is created
+	Template string `protobuf:"bytes,11,opt,name=template,proto3" json:"template,omitempty"`
+	// Volume claim created for every pod of the queue jobs
+	VolumeClaimTemplate *VolumeClaimTemplate `protobuf:"bytes,18,opt,name=volume_claim_template,json=volumeClaimTemplate,proto3" json:"volumeClaimTemplate,omitempty"`
+	// Volume types (e.g. persistentVolumeClaim, configMap) jobs can use, empty allows all types
+	AllowedVolumeTypes []string `protobuf:"bytes,12,rep,name=allowed_volume_types,json=allowedVolumeTypes,proto3" json:"allowedVolumeTypes,omitempty"`
+	// Rejects jobs with emptyDir volumes without size limit
+	RequireEmptyDirSizeLimit bool `protobuf:"varint,13,opt,name=require_empty_dir_size_limit,json=requireEmptyDirSizeLimit,proto3" json:"requireEmptyDirSizeLimit,omitempty"`
+	// Labels added to pods of every job of the queue, labels set by the job take precedence
+	DefaultPodLabels map[string]string `protobuf:"bytes,14,rep,name=default_pod_labels,json=defaultPodLabels,proto3" json:"defaultPodLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Jobs of a paused queue are not leased, already leased jobs keep running. Set by PauseQueue and ResumeQueue, CreateQueue and UpdateQueue keep the current value
+	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Maximum resources leased and running jobs of the queue use together on all clusters, resources without quota are not limited
+	ResourceQuota map[string]resource.Quantity `protobuf:"bytes,16,rep,name=resource_quota,json=resourceQuota,proto3" json:"resourceQuota,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Fields UpdateQueue changes, e.g. priority_factor or event_retention.max_length, other fields keep their current value.
+	// Without mask UpdateQueue replaces all settings. Not stored with the queue.
+	UpdateMask *types.FieldMask `protobuf:"bytes,17,opt,name=update_mask,json=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (m *Queue) Reset()      { *m = Queue{} }
+func (*Queue) ProtoMessage() {}
+func (*Queue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{16}
+}
+func (m *Queue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Queue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).DeleteQueue(ctx, in)
+}
+func (m *Queue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Queue.Merge(m, src)
+}
+func (m *Queue) XXX_Size() int {
+	return m.Size()
+}
+func (m *Queue) XXX_DiscardUnknown() {
+	xxx_messageInfo_Queue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Queue proto.InternalMessageInfo
+
+func (m *Queue) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/DeleteQueue",
+	return ""
+}
+
+func (m *Queue) GetPriorityFactor() float64 {
+	if m != nil {
+		return m.PriorityFactor
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	return 0
+}
+
+func (m *Queue) GetUserOwners() []string {
+	if m != nil {
+		return m.UserOwners
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Queue) GetGroupOwners() []string {
+	if m != nil {
+		return m.GroupOwners
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+	return nil
+}
+
+func (m *Queue) GetResourceLimits() map[string]float64 {
+	if m != nil {
+		return m.ResourceLimits
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueueInfo",
+	return nil
+}
+
+func (m *Queue) GetEventRetention() *QueueEventRetention {
+	if m != nil {
+		return m.EventRetention
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	return nil
+}
+
+func (m *Queue) GetSchedulingWindows() []*QueueSchedulingWindow {
+	if m != nil {
+		return m.SchedulingWindows
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
-		},
-		{
-			MethodName: "CreateQueue",
-			Handler:    _Submit_CreateQueue_Handler,
-		},
-		{
-			MethodName: "DeleteQueue",
-			Handler:    _Submit_DeleteQueue_Handler,
-		},
-		{
-			MethodName: "GetQueueInfo",
-			Handler:    _Submit_GetQueueInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/submit.proto",
+func (m *Queue) GetMaxPodSpecSizeBytes() uint32 {
+	if m != nil {
+		return m.MaxPodSpecSizeBytes
+	}
+	return 0
 }
 
-func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Queue) GetRequireContainerCommand() bool {
+	if m != nil {
+		return m.RequireContainerCommand
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Queue) GetResourceFloor() map[string]float64 {
+	if m != nil {
+		return m.ResourceFloor
+	}
+	return nil
 }
 
-func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+func (m *Queue) GetTemplate() string {
+	if m != nil {
+		return m.Template
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k := range m.RequiredNodeLabels {
-			v := m.RequiredNodeLabels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+	return ""
+}
+
+func (m *Queue) GetVolumeClaimTemplate() *VolumeClaimTemplate {
+	if m != nil {
+		return m.VolumeClaimTemplate
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+	return nil
+}
+
+func (m *Queue) GetAllowedVolumeTypes() []string {
+	if m != nil {
+		return m.AllowedVolumeTypes
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *Queue) GetRequireEmptyDirSizeLimit() bool {
+	if m != nil {
+		return m.RequireEmptyDirSizeLimit
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *Queue) GetDefaultPodLabels() map[string]string {
+	if m != nil {
+		return m.DefaultPodLabels
 	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Queue) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if m.Priority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i--
-		dAtA[i] = 0x9
+	return false
+}
+
+func (m *Queue) GetResourceQuota() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourceQuota
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Queue) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Time window (HH:MM) when queue jobs can be leased, windows ending before they start continue past midnight.
+// Days (Mon, Tue, ...) refer to the day the window starts, no days means every day.
+type QueueSchedulingWindow struct {
+	Days  []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Start string   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   string   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobRequestItems) > 0 {
-		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueueSchedulingWindow) Reset()      { *m = QueueSchedulingWindow{} }
+func (*QueueSchedulingWindow) ProtoMessage() {}
+func (*QueueSchedulingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{17}
+}
+func (m *QueueSchedulingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSchedulingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSchedulingWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueueSchedulingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingWindow.Merge(m, src)
+}
+func (m *QueueSchedulingWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSchedulingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSchedulingWindow proto.InternalMessageInfo
+
+func (m *QueueSchedulingWindow) GetDays() []string {
+	if m != nil {
+		return m.Days
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueueSchedulingWindow) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueSchedulingWindow) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Overrides global event retention for job sets of the queue, zero values fall back to the global policy.
+// Changes apply to events reported after up to 10 seconds.
+type QueueEventRetention struct {
+	RetentionDuration time.Duration `protobuf:"bytes,1,opt,name=retention_duration,json=retentionDuration,proto3,stdduration" json:"retention_duration"`
+	MaxLength         int64         `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"maxLength,omitempty"`
 }
 
-func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueueEventRetention) Reset()      { *m = QueueEventRetention{} }
+func (*QueueEventRetention) ProtoMessage() {}
+func (*QueueEventRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{18}
+}
+func (m *QueueEventRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEventRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEventRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueEventRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEventRetention.Merge(m, src)
+}
+func (m *QueueEventRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEventRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEventRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueEventRetention proto.InternalMessageInfo
+
+func (m *QueueEventRetention) GetRetentionDuration() time.Duration {
+	if m != nil {
+		return m.RetentionDuration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueEventRetention) GetMaxLength() int64 {
+	if m != nil {
+		return m.MaxLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// swagger:model
+type CancellationResult struct {
+	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds"`
 }
 
-func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
+func (*CancellationResult) ProtoMessage() {}
+func (*CancellationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{19}
+}
+func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancellationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CancellationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationResult.Merge(m, src)
+}
+func (m *CancellationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancellationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationResult.DiscardUnknown(m)
 }
 
-func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CancellationResult proto.InternalMessageInfo
+
+func (m *CancellationResult) GetCancelledIds() []string {
+	if m != nil {
+		return m.CancelledIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// swagger:model
+type CancellationCount struct {
+	CancelledCount int32 `protobuf:"varint,1,opt,name=cancelled_count,json=cancelledCount,proto3" json:"cancelledCount,omitempty"`
 }
 
-func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CancellationCount) Reset()      { *m = CancellationCount{} }
+func (*CancellationCount) ProtoMessage() {}
+func (*CancellationCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20}
+}
+func (m *CancellationCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancellationCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancellationCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CancellationCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationCount.Merge(m, src)
+}
+func (m *CancellationCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancellationCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationCount.DiscardUnknown(m)
 }
 
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CancellationCount proto.InternalMessageInfo
+
+func (m *CancellationCount) GetCancelledCount() int32 {
+	if m != nil {
+		return m.CancelledCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueCancellationResult struct {
+	CancelledCount int32 `protobuf:"varint,1,opt,name=cancelled_count,json=cancelledCount,proto3" json:"cancelledCount,omitempty"`
+	// Jobs which finished before they could be cancelled
+	AlreadyFinishedCount int32 `protobuf:"varint,2,opt,name=already_finished_count,json=alreadyFinishedCount,proto3" json:"alreadyFinishedCount,omitempty"`
 }
 
-func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceLimits) > 0 {
-		for k := range m.ResourceLimits {
-			v := m.ResourceLimits[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupOwners[iNdEx])
-			copy(dAtA[i:], m.GroupOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.UserOwners) > 0 {
-		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UserOwners[iNdEx])
-			copy(dAtA[i:], m.UserOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *QueueCancellationResult) Reset()      { *m = QueueCancellationResult{} }
+func (*QueueCancellationResult) ProtoMessage() {}
+func (*QueueCancellationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{21}
+}
+func (m *QueueCancellationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueCancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueCancellationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PriorityFactor != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueCancellationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueCancellationResult.Merge(m, src)
+}
+func (m *QueueCancellationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueCancellationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueCancellationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueCancellationResult proto.InternalMessageInfo
+
+func (m *QueueCancellationResult) GetCancelledCount() int32 {
+	if m != nil {
+		return m.CancelledCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueCancellationResult) GetAlreadyFinishedCount() int32 {
+	if m != nil {
+		return m.AlreadyFinishedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//swagger:model
+type QueueInfoRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CancelledIds[iNdEx])
-			copy(dAtA[i:], m.CancelledIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
+func (*QueueInfoRequest) ProtoMessage() {}
+func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{22}
+}
+func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueInfoRequest.Merge(m, src)
+}
+func (m *QueueInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueInfoRequest proto.InternalMessageInfo
+
+func (m *QueueInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//swagger:model
+type QueueDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
+func (*QueueDeleteRequest) ProtoMessage() {}
+func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{23}
+}
+func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+}
+func (m *QueueDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
 }
 
-func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+
+func (m *QueueDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//swagger:model
+type QueueInfo struct {
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
+	// Retention applied to events of the queue, zero duration means events do not expire and zero length means streams are not trimmed
+	EventRetention *QueueEventRetention         `protobuf:"bytes,3,opt,name=event_retention,json=eventRetention,proto3" json:"eventRetention,omitempty"`
+	ResourceQuota  map[string]resource.Quantity `protobuf:"bytes,4,rep,name=resource_quota,json=resourceQuota,proto3" json:"resourceQuota,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources of leased and running jobs of the queue on active clusters, which count towards the quota
+	ResourcesLeased map[string]resource.Quantity `protobuf:"bytes,5,rep,name=resources_leased,json=resourcesLeased,proto3" json:"resourcesLeased,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
+func (*QueueInfo) ProtoMessage() {}
+func (*QueueInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{24}
+}
+func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueInfo.Merge(m, src)
+}
+func (m *QueueInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
+
+func (m *QueueInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+	if m != nil {
+		return m.ActiveJobSets
+	}
+	return nil
 }
 
-func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveJobSets) > 0 {
-		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *QueueInfo) GetEventRetention() *QueueEventRetention {
+	if m != nil {
+		return m.EventRetention
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueueInfo) GetResourceQuota() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourceQuota
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueInfo) GetResourcesLeased() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourcesLeased
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobSetInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LeasedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
-		i--
-		dAtA[i] = 0x18
+func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
+func (*JobSetInfo) ProtoMessage() {}
+func (*JobSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{25}
+}
+func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.QueuedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *JobSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetInfo.Merge(m, src)
+}
+func (m *JobSetInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+
+func (m *JobSetInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *JobSetInfo) GetQueuedJobs() int32 {
+	if m != nil {
+		return m.QueuedJobs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSubmit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobSetInfo) GetLeasedJobs() int32 {
+	if m != nil {
+		return m.LeasedJobs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *JobSubmitRequestItem) Size() (n int) {
-	if m == nil {
-		return 0
+
+type JobClusterRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobClusterRequest) Reset()      { *m = JobClusterRequest{} }
+func (*JobClusterRequest) ProtoMessage() {}
+func (*JobClusterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{26}
+}
+func (m *JobClusterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobClusterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobClusterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		n += 9
-	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.PodSpecs) > 0 {
-		for _, e := range m.PodSpecs {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+}
+func (m *JobClusterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobClusterRequest.Merge(m, src)
+}
+func (m *JobClusterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobClusterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobClusterRequest.DiscardUnknown(m)
 }
 
-func (m *JobSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+var xxx_messageInfo_JobClusterRequest proto.InternalMessageInfo
+
+func (m *JobClusterRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if len(m.JobRequestItems) > 0 {
-		for _, e := range m.JobRequestItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	return ""
+}
+
+type JobClusterInfo struct {
+	JobId     string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	ClusterId string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	// False when the job is no longer leased and cluster is the last one it ran on
+	Leased bool `protobuf:"varint,4,opt,name=leased,proto3" json:"leased,omitempty"`
+}
+
+func (m *JobClusterInfo) Reset()      { *m = JobClusterInfo{} }
+func (*JobClusterInfo) ProtoMessage() {}
+func (*JobClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{27}
+}
+func (m *JobClusterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobClusterInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobClusterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobClusterInfo.Merge(m, src)
+}
+func (m *JobClusterInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobClusterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobClusterInfo.DiscardUnknown(m)
 }
 
-func (m *JobCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+var xxx_messageInfo_JobClusterInfo proto.InternalMessageInfo
+
+func (m *JobClusterInfo) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return n
+	return ""
 }
 
-func (m *JobSubmitResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *JobClusterInfo) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return n
+	return ""
 }
 
-func (m *JobSubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, e := range m.JobResponseItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+func (m *JobClusterInfo) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return n
+	return ""
 }
 
-func (m *Queue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.PriorityFactor != 0 {
-		n += 9
-	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+func (m *JobClusterInfo) GetLeased() bool {
+	if m != nil {
+		return m.Leased
 	}
-	if len(m.ResourceLimits) > 0 {
-		for k, v := range m.ResourceLimits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+	return false
+}
+
+type JobRequeueHistoryRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobRequeueHistoryRequest) Reset()      { *m = JobRequeueHistoryRequest{} }
+func (*JobRequeueHistoryRequest) ProtoMessage() {}
+func (*JobRequeueHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{28}
+}
+func (m *JobRequeueHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRequeueHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRequeueHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobRequeueHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequeueHistoryRequest.Merge(m, src)
+}
+func (m *JobRequeueHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRequeueHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequeueHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *CancellationResult) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_JobRequeueHistoryRequest proto.InternalMessageInfo
+
+func (m *JobRequeueHistoryRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	return ""
+}
+
+type JobRequeueHistory struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Oldest requeue first
+	Requeues []*JobRequeue `protobuf:"bytes,2,rep,name=requeues,proto3" json:"requeues,omitempty"`
+}
+
+func (m *JobRequeueHistory) Reset()      { *m = JobRequeueHistory{} }
+func (*JobRequeueHistory) ProtoMessage() {}
+func (*JobRequeueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{29}
+}
+func (m *JobRequeueHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRequeueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRequeueHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobRequeueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequeueHistory.Merge(m, src)
+}
+func (m *JobRequeueHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRequeueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequeueHistory.DiscardUnknown(m)
 }
 
-func (m *QueueInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+var xxx_messageInfo_JobRequeueHistory proto.InternalMessageInfo
+
+func (m *JobRequeueHistory) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return n
+	return ""
 }
 
-func (m *QueueDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *JobRequeueHistory) GetRequeues() []*JobRequeue {
+	if m != nil {
+		return m.Requeues
 	}
-	return n
+	return nil
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.ActiveJobSets) > 0 {
-		for _, e := range m.ActiveJobSets {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+type JobCancelReasonRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobCancelReasonRequest) Reset()      { *m = JobCancelReasonRequest{} }
+func (*JobCancelReasonRequest) ProtoMessage() {}
+func (*JobCancelReasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{30}
+}
+func (m *JobCancelReasonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobCancelReasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobCancelReasonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobCancelReasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelReasonRequest.Merge(m, src)
+}
+func (m *JobCancelReasonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobCancelReasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelReasonRequest.DiscardUnknown(m)
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.QueuedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.QueuedJobs))
-	}
-	if m.LeasedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+var xxx_messageInfo_JobCancelReasonRequest proto.InternalMessageInfo
+
+func (m *JobCancelReasonRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return n
+	return ""
 }
 
-func sovSubmit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type JobCancelReason struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Empty when the job was not cancelled or was cancelled without reason
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func sozSubmit(x uint64) (n int) {
-	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *JobCancelReason) Reset()      { *m = JobCancelReason{} }
+func (*JobCancelReason) ProtoMessage() {}
+func (*JobCancelReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{31}
 }
-func (this *JobSubmitRequestItem) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPodSpecs := "[]*PodSpec{"
-	for _, f := range this.PodSpecs {
-		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
-	}
-	repeatedStringForPodSpecs += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k, _ := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
-	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k, _ := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
-	}
-	mapStringForAnnotations += "}"
-	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
-	for k, _ := range this.RequiredNodeLabels {
-		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
-	mapStringForRequiredNodeLabels := "map[string]string{"
-	for _, k := range keysForRequiredNodeLabels {
-		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
-	}
-	mapStringForRequiredNodeLabels += "}"
-	s := strings.Join([]string{`&JobSubmitRequestItem{`,
-		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
-		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
-		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
-		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *JobCancelReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *JobSubmitRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *JobCancelReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobCancelReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
-	for _, f := range this.JobRequestItems {
-		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+}
+func (m *JobCancelReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelReason.Merge(m, src)
+}
+func (m *JobCancelReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobCancelReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobCancelReason proto.InternalMessageInfo
+
+func (m *JobCancelReason) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	repeatedStringForJobRequestItems += "}"
-	s := strings.Join([]string{`&JobSubmitRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func (this *JobCancelRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobCancelReason) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	s := strings.Join([]string{`&JobCancelRequest{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func (this *JobSubmitResponseItem) String() string {
-	if this == nil {
-		return "nil"
+
+type PoolCapacityRequest struct {
+	// Only returns capacity of this pool when set
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *PoolCapacityRequest) Reset()      { *m = PoolCapacityRequest{} }
+func (*PoolCapacityRequest) ProtoMessage() {}
+func (*PoolCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{32}
+}
+func (m *PoolCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&JobSubmitResponseItem{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *JobSubmitResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *PoolCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCapacityRequest.Merge(m, src)
+}
+func (m *PoolCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCapacityRequest proto.InternalMessageInfo
+
+func (m *PoolCapacityRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
-	for _, f := range this.JobResponseItems {
-		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	return ""
+}
+
+type PoolCapacity struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Largest allocatable amount of each resource on a single node, amounts of different resources can come from different nodes
+	LargestNodeAllocatable map[string]resource.Quantity `protobuf:"bytes,2,rep,name=largest_node_allocatable,json=largestNodeAllocatable,proto3" json:"largestNodeAllocatable,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TotalAllocatable       map[string]resource.Quantity `protobuf:"bytes,3,rep,name=total_allocatable,json=totalAllocatable,proto3" json:"totalAllocatable,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clusters               int32                        `protobuf:"varint,4,opt,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (m *PoolCapacity) Reset()      { *m = PoolCapacity{} }
+func (*PoolCapacity) ProtoMessage() {}
+func (*PoolCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{33}
+}
+func (m *PoolCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	repeatedStringForJobResponseItems += "}"
-	s := strings.Join([]string{`&JobSubmitResponse{`,
-		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *Queue) String() string {
-	if this == nil {
-		return "nil"
+func (m *PoolCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCapacity.Merge(m, src)
+}
+func (m *PoolCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCapacity proto.InternalMessageInfo
+
+func (m *PoolCapacity) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
-	for k, _ := range this.ResourceLimits {
-		keysForResourceLimits = append(keysForResourceLimits, k)
+	return ""
+}
+
+func (m *PoolCapacity) GetLargestNodeAllocatable() map[string]resource.Quantity {
+	if m != nil {
+		return m.LargestNodeAllocatable
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
-	mapStringForResourceLimits := "map[string]float64{"
-	for _, k := range keysForResourceLimits {
-		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+	return nil
+}
+
+func (m *PoolCapacity) GetTotalAllocatable() map[string]resource.Quantity {
+	if m != nil {
+		return m.TotalAllocatable
 	}
-	mapStringForResourceLimits += "}"
-	s := strings.Join([]string{`&Queue{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
-		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
-		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
-		`ResourceLimits:` + mapStringForResourceLimits + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *CancellationResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PoolCapacity) GetClusters() int32 {
+	if m != nil {
+		return m.Clusters
 	}
-	s := strings.Join([]string{`&CancellationResult{`,
-		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *QueueInfoRequest) String() string {
-	if this == nil {
-		return "nil"
+
+type PoolCapacityResponse struct {
+	Pools []*PoolCapacity `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (m *PoolCapacityResponse) Reset()      { *m = PoolCapacityResponse{} }
+func (*PoolCapacityResponse) ProtoMessage() {}
+func (*PoolCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{34}
+}
+func (m *PoolCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&QueueInfoRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *QueueDeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *PoolCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCapacityResponse.Merge(m, src)
+}
+func (m *PoolCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCapacityResponse proto.InternalMessageInfo
+
+func (m *PoolCapacityResponse) GetPools() []*PoolCapacity {
+	if m != nil {
+		return m.Pools
 	}
-	s := strings.Join([]string{`&QueueDeleteRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *QueueInfo) String() string {
-	if this == nil {
-		return "nil"
+
+type ClusterCapacityReportRequest struct {
+	// Only returns capacity of this pool when set
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *ClusterCapacityReportRequest) Reset()      { *m = ClusterCapacityReportRequest{} }
+func (*ClusterCapacityReportRequest) ProtoMessage() {}
+func (*ClusterCapacityReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{35}
+}
+func (m *ClusterCapacityReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCapacityReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCapacityReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
-	for _, f := range this.ActiveJobSets {
-		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+}
+func (m *ClusterCapacityReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCapacityReportRequest.Merge(m, src)
+}
+func (m *ClusterCapacityReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCapacityReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCapacityReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCapacityReportRequest proto.InternalMessageInfo
+
+func (m *ClusterCapacityReportRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	repeatedStringForActiveJobSets += "}"
-	s := strings.Join([]string{`&QueueInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func (this *JobSetInfo) String() string {
-	if this == nil {
-		return "nil"
+
+type ClusterCapacity struct {
+	ClusterId   string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Allocatable map[string]resource.Quantity `protobuf:"bytes,2,rep,name=allocatable,proto3" json:"allocatable" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources used by all pods on the processing nodes of the cluster, including pods not run by Armada
+	Used      map[string]resource.Quantity `protobuf:"bytes,3,rep,name=used,proto3" json:"used" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Available map[string]resource.Quantity `protobuf:"bytes,4,rep,name=available,proto3" json:"available" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NodeTypes []*NodeType                  `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
+	// Age of the oldest report the capacity is computed from
+	ReportAge time.Duration `protobuf:"bytes,6,opt,name=report_age,json=reportAge,proto3,stdduration" json:"report_age"`
+}
+
+func (m *ClusterCapacity) Reset()      { *m = ClusterCapacity{} }
+func (*ClusterCapacity) ProtoMessage() {}
+func (*ClusterCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{36}
+}
+func (m *ClusterCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCapacity.Merge(m, src)
+}
+func (m *ClusterCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCapacity proto.InternalMessageInfo
+
+func (m *ClusterCapacity) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ClusterCapacity) GetAllocatable() map[string]resource.Quantity {
+	if m != nil {
+		return m.Allocatable
+	}
+	return nil
+}
+
+func (m *ClusterCapacity) GetUsed() map[string]resource.Quantity {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+func (m *ClusterCapacity) GetAvailable() map[string]resource.Quantity {
+	if m != nil {
+		return m.Available
+	}
+	return nil
+}
+
+func (m *ClusterCapacity) GetNodeTypes() []*NodeType {
+	if m != nil {
+		return m.NodeTypes
+	}
+	return nil
+}
+
+func (m *ClusterCapacity) GetReportAge() time.Duration {
+	if m != nil {
+		return m.ReportAge
+	}
+	return 0
+}
+
+type PoolCapacityReport struct {
+	Pool        string                       `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Allocatable map[string]resource.Quantity `protobuf:"bytes,2,rep,name=allocatable,proto3" json:"allocatable" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Used        map[string]resource.Quantity `protobuf:"bytes,3,rep,name=used,proto3" json:"used" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Available   map[string]resource.Quantity `protobuf:"bytes,4,rep,name=available,proto3" json:"available" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clusters    []*ClusterCapacity           `protobuf:"bytes,5,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	ReportAge   time.Duration                `protobuf:"bytes,6,opt,name=report_age,json=reportAge,proto3,stdduration" json:"report_age"`
+}
+
+func (m *PoolCapacityReport) Reset()      { *m = PoolCapacityReport{} }
+func (*PoolCapacityReport) ProtoMessage() {}
+func (*PoolCapacityReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{37}
+}
+func (m *PoolCapacityReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCapacityReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCapacityReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCapacityReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCapacityReport.Merge(m, src)
+}
+func (m *PoolCapacityReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCapacityReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCapacityReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCapacityReport proto.InternalMessageInfo
+
+func (m *PoolCapacityReport) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *PoolCapacityReport) GetAllocatable() map[string]resource.Quantity {
+	if m != nil {
+		return m.Allocatable
+	}
+	return nil
+}
+
+func (m *PoolCapacityReport) GetUsed() map[string]resource.Quantity {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+func (m *PoolCapacityReport) GetAvailable() map[string]resource.Quantity {
+	if m != nil {
+		return m.Available
+	}
+	return nil
+}
+
+func (m *PoolCapacityReport) GetClusters() []*ClusterCapacity {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *PoolCapacityReport) GetReportAge() time.Duration {
+	if m != nil {
+		return m.ReportAge
+	}
+	return 0
+}
+
+type ClusterCapacityReport struct {
+	Pools []*PoolCapacityReport `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (m *ClusterCapacityReport) Reset()      { *m = ClusterCapacityReport{} }
+func (*ClusterCapacityReport) ProtoMessage() {}
+func (*ClusterCapacityReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{38}
+}
+func (m *ClusterCapacityReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCapacityReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCapacityReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterCapacityReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCapacityReport.Merge(m, src)
+}
+func (m *ClusterCapacityReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCapacityReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCapacityReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCapacityReport proto.InternalMessageInfo
+
+func (m *ClusterCapacityReport) GetPools() []*PoolCapacityReport {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type JobIdByClientIdRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
+}
+
+func (m *JobIdByClientIdRequest) Reset()      { *m = JobIdByClientIdRequest{} }
+func (*JobIdByClientIdRequest) ProtoMessage() {}
+func (*JobIdByClientIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{39}
+}
+func (m *JobIdByClientIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobIdByClientIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobIdByClientIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobIdByClientIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobIdByClientIdRequest.Merge(m, src)
+}
+func (m *JobIdByClientIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobIdByClientIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobIdByClientIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobIdByClientIdRequest proto.InternalMessageInfo
+
+func (m *JobIdByClientIdRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobIdByClientIdRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type JobIdByClientIdResponse struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobIdByClientIdResponse) Reset()      { *m = JobIdByClientIdResponse{} }
+func (*JobIdByClientIdResponse) ProtoMessage() {}
+func (*JobIdByClientIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{40}
+}
+func (m *JobIdByClientIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobIdByClientIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobIdByClientIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobIdByClientIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobIdByClientIdResponse.Merge(m, src)
+}
+func (m *JobIdByClientIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobIdByClientIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobIdByClientIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobIdByClientIdResponse proto.InternalMessageInfo
+
+func (m *JobIdByClientIdResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type QueuePauseRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueuePauseRequest) Reset()      { *m = QueuePauseRequest{} }
+func (*QueuePauseRequest) ProtoMessage() {}
+func (*QueuePauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{41}
+}
+func (m *QueuePauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuePauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuePauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuePauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuePauseRequest.Merge(m, src)
+}
+func (m *QueuePauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuePauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuePauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuePauseRequest proto.InternalMessageInfo
+
+func (m *QueuePauseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueueResumeRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueueResumeRequest) Reset()      { *m = QueueResumeRequest{} }
+func (*QueueResumeRequest) ProtoMessage() {}
+func (*QueueResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{42}
+}
+func (m *QueueResumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueResumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueResumeRequest.Merge(m, src)
+}
+func (m *QueueResumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueResumeRequest proto.InternalMessageInfo
+
+func (m *QueueResumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// swagger:model
+type PodSpecTemplate struct {
+	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PodSpec *v1.PodSpec `protobuf:"bytes,2,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"`
+}
+
+func (m *PodSpecTemplate) Reset()      { *m = PodSpecTemplate{} }
+func (*PodSpecTemplate) ProtoMessage() {}
+func (*PodSpecTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{43}
+}
+func (m *PodSpecTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodSpecTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodSpecTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PodSpecTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodSpecTemplate.Merge(m, src)
+}
+func (m *PodSpecTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodSpecTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodSpecTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodSpecTemplate proto.InternalMessageInfo
+
+func (m *PodSpecTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PodSpecTemplate) GetPodSpec() *v1.PodSpec {
+	if m != nil {
+		return m.PodSpec
+	}
+	return nil
+}
+
+type PodSpecTemplateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *PodSpecTemplateRequest) Reset()      { *m = PodSpecTemplateRequest{} }
+func (*PodSpecTemplateRequest) ProtoMessage() {}
+func (*PodSpecTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{44}
+}
+func (m *PodSpecTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodSpecTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodSpecTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PodSpecTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodSpecTemplateRequest.Merge(m, src)
+}
+func (m *PodSpecTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodSpecTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodSpecTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodSpecTemplateRequest proto.InternalMessageInfo
+
+func (m *PodSpecTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueueSchedulingStatusRequest struct {
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (m *QueueSchedulingStatusRequest) Reset()      { *m = QueueSchedulingStatusRequest{} }
+func (*QueueSchedulingStatusRequest) ProtoMessage() {}
+func (*QueueSchedulingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{45}
+}
+func (m *QueueSchedulingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSchedulingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSchedulingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueSchedulingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingStatusRequest.Merge(m, src)
+}
+func (m *QueueSchedulingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSchedulingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSchedulingStatusRequest proto.InternalMessageInfo
+
+func (m *QueueSchedulingStatusRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+type QueueSchedulingStatusReason struct {
+	Reason QueueNotScheduledReason `protobuf:"varint,1,opt,name=reason,proto3,enum=api.QueueNotScheduledReason" json:"reason,omitempty"`
+	// Empty when the reason applies to all pools
+	Pool    string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *QueueSchedulingStatusReason) Reset()      { *m = QueueSchedulingStatusReason{} }
+func (*QueueSchedulingStatusReason) ProtoMessage() {}
+func (*QueueSchedulingStatusReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{46}
+}
+func (m *QueueSchedulingStatusReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSchedulingStatusReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSchedulingStatusReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueSchedulingStatusReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingStatusReason.Merge(m, src)
+}
+func (m *QueueSchedulingStatusReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSchedulingStatusReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingStatusReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSchedulingStatusReason proto.InternalMessageInfo
+
+func (m *QueueSchedulingStatusReason) GetReason() QueueNotScheduledReason {
+	if m != nil {
+		return m.Reason
+	}
+	return QueueNotScheduledReason_UnspecifiedQueueNotScheduledReason
+}
+
+func (m *QueueSchedulingStatusReason) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *QueueSchedulingStatusReason) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type QueueSchedulingStatus struct {
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// True when jobs of the queue can be leased in at least one pool
+	Schedulable bool                           `protobuf:"varint,2,opt,name=schedulable,proto3" json:"schedulable,omitempty"`
+	Reasons     []*QueueSchedulingStatusReason `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *QueueSchedulingStatus) Reset()      { *m = QueueSchedulingStatus{} }
+func (*QueueSchedulingStatus) ProtoMessage() {}
+func (*QueueSchedulingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{47}
+}
+func (m *QueueSchedulingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueSchedulingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueSchedulingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueSchedulingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingStatus.Merge(m, src)
+}
+func (m *QueueSchedulingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueSchedulingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueSchedulingStatus proto.InternalMessageInfo
+
+func (m *QueueSchedulingStatus) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *QueueSchedulingStatus) GetSchedulable() bool {
+	if m != nil {
+		return m.Schedulable
+	}
+	return false
+}
+
+func (m *QueueSchedulingStatus) GetReasons() []*QueueSchedulingStatusReason {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("api.QueueNotScheduledReason", QueueNotScheduledReason_name, QueueNotScheduledReason_value)
+	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*ContainerOverride)(nil), "api.ContainerOverride")
+	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
+	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
+	proto.RegisterType((*JobCancelSubmittedBeforeRequest)(nil), "api.JobCancelSubmittedBeforeRequest")
+	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
+	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.NotReprioritizedEntry")
+	proto.RegisterType((*JobCancelQueueRequest)(nil), "api.JobCancelQueueRequest")
+	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
+	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
+	proto.RegisterType((*JobSubmitStreamResponse)(nil), "api.JobSubmitStreamResponse")
+	proto.RegisterType((*JobSubmitStreamFailure)(nil), "api.JobSubmitStreamFailure")
+	proto.RegisterType((*JobSchedulingFeasibility)(nil), "api.JobSchedulingFeasibility")
+	proto.RegisterType((*ClusterSchedulingFeasibility)(nil), "api.ClusterSchedulingFeasibility")
+	proto.RegisterType((*NodeTypeSchedulingFeasibility)(nil), "api.NodeTypeSchedulingFeasibility")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeTypeSchedulingFeasibility.AllocatableResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.NodeTypeSchedulingFeasibility.LabelsEntry")
+	proto.RegisterType((*PoolNodeType)(nil), "api.PoolNodeType")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolNodeType.AllocatableResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.PoolNodeType.LabelsEntry")
+	proto.RegisterType((*Queue)(nil), "api.Queue")
+	proto.RegisterMapType((map[string]string)(nil), "api.Queue.DefaultPodLabelsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceFloorEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.Queue.ResourceQuotaEntry")
+	proto.RegisterType((*QueueSchedulingWindow)(nil), "api.QueueSchedulingWindow")
+	proto.RegisterType((*QueueEventRetention)(nil), "api.QueueEventRetention")
+	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
+	proto.RegisterType((*CancellationCount)(nil), "api.CancellationCount")
+	proto.RegisterType((*QueueCancellationResult)(nil), "api.QueueCancellationResult")
+	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
+	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
+	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueInfo.ResourceQuotaEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueInfo.ResourcesLeasedEntry")
+	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+	proto.RegisterType((*JobClusterRequest)(nil), "api.JobClusterRequest")
+	proto.RegisterType((*JobClusterInfo)(nil), "api.JobClusterInfo")
+	proto.RegisterType((*JobRequeueHistoryRequest)(nil), "api.JobRequeueHistoryRequest")
+	proto.RegisterType((*JobRequeueHistory)(nil), "api.JobRequeueHistory")
+	proto.RegisterType((*JobCancelReasonRequest)(nil), "api.JobCancelReasonRequest")
+	proto.RegisterType((*JobCancelReason)(nil), "api.JobCancelReason")
+	proto.RegisterType((*PoolCapacityRequest)(nil), "api.PoolCapacityRequest")
+	proto.RegisterType((*PoolCapacity)(nil), "api.PoolCapacity")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolCapacity.LargestNodeAllocatableEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolCapacity.TotalAllocatableEntry")
+	proto.RegisterType((*PoolCapacityResponse)(nil), "api.PoolCapacityResponse")
+	proto.RegisterType((*ClusterCapacityReportRequest)(nil), "api.ClusterCapacityReportRequest")
+	proto.RegisterType((*ClusterCapacity)(nil), "api.ClusterCapacity")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterCapacity.AllocatableEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterCapacity.AvailableEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterCapacity.UsedEntry")
+	proto.RegisterType((*PoolCapacityReport)(nil), "api.PoolCapacityReport")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolCapacityReport.AllocatableEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolCapacityReport.AvailableEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.PoolCapacityReport.UsedEntry")
+	proto.RegisterType((*ClusterCapacityReport)(nil), "api.ClusterCapacityReport")
+	proto.RegisterType((*JobIdByClientIdRequest)(nil), "api.JobIdByClientIdRequest")
+	proto.RegisterType((*JobIdByClientIdResponse)(nil), "api.JobIdByClientIdResponse")
+	proto.RegisterType((*QueuePauseRequest)(nil), "api.QueuePauseRequest")
+	proto.RegisterType((*QueueResumeRequest)(nil), "api.QueueResumeRequest")
+	proto.RegisterType((*PodSpecTemplate)(nil), "api.PodSpecTemplate")
+	proto.RegisterType((*PodSpecTemplateRequest)(nil), "api.PodSpecTemplateRequest")
+	proto.RegisterType((*QueueSchedulingStatusRequest)(nil), "api.QueueSchedulingStatusRequest")
+	proto.RegisterType((*QueueSchedulingStatusReason)(nil), "api.QueueSchedulingStatusReason")
+	proto.RegisterType((*QueueSchedulingStatus)(nil), "api.QueueSchedulingStatus")
+}
+
+func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
+
+var fileDescriptor_e998bacb27df16c1 = []byte{
+	// 3681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x6c, 0x1b, 0x59,
+	0x72, 0x6e, 0x51, 0x92, 0xc5, 0xa2, 0x24, 0x92, 0x4f, 0xa4, 0xd4, 0xa2, 0x64, 0x49, 0xee, 0xf9,
+	0x58, 0xab, 0x59, 0x51, 0x33, 0x5a, 0x6f, 0xe2, 0x78, 0x67, 0x67, 0xd7, 0x92, 0x2c, 0xaf, 0x6c,
+	0xad, 0x2c, 0xb7, 0xec, 0x99, 0x20, 0x40, 0xd2, 0x68, 0xb2, 0x9f, 0xe8, 0xb6, 0x9b, 0xdd, 0xf4,
+	0xeb, 0xa6, 0x64, 0xcd, 0xc0, 0xc0, 0x66, 0x81, 0x04, 0x01, 0x02, 0x04, 0x0b, 0xe4, 0x92, 0x5b,
+	0x72, 0x4a, 0x6e, 0xb9, 0xe6, 0x10, 0x20, 0xc8, 0x71, 0x8f, 0x0b, 0xe4, 0xb2, 0x40, 0x80, 0x4d,
+	0x62, 0xe7, 0x14, 0xe4, 0x9a, 0x43, 0x6e, 0xc1, 0xab, 0xf7, 0xfa, 0x47, 0x76, 0x4b, 0xd6, 0x0c,
+	0x3c, 0x48, 0x80, 0x9c, 0xc8, 0x57, 0xaf, 0x5e, 0x55, 0xbd, 0x7a, 0x55, 0xf5, 0xaa, 0xaa, 0x1f,
+	0xd4, 0x7a, 0xcf, 0x3b, 0x1b, 0x66, 0xcf, 0xde, 0xf0, 0xfb, 0xad, 0xae, 0x1d, 0x34, 0x7b, 0xcc,
+	0x0b, 0x3c, 0x52, 0x30, 0x7b, 0x76, 0x63, 0xa1, 0xe3, 0x79, 0x1d, 0x87, 0x6e, 0x20, 0xa8, 0xd5,
+	0x3f, 0xde, 0xa0, 0xdd, 0x5e, 0x70, 0x26, 0x30, 0x1a, 0xcb, 0x83, 0x93, 0x81, 0xdd, 0xa5, 0x7e,
+	0x60, 0x76, 0x7b, 0x12, 0x61, 0x69, 0x10, 0xc1, 0xea, 0x33, 0x33, 0xb0, 0x3d, 0x57, 0xce, 0xaf,
+	0x0c, 0xce, 0x1f, 0xdb, 0xd4, 0xb1, 0x8c, 0xae, 0xe9, 0x3f, 0x97, 0x18, 0xda, 0xf3, 0x5b, 0x7e,
+	0xd3, 0xf6, 0x50, 0xba, 0xb6, 0xc7, 0xe8, 0xc6, 0xc9, 0x27, 0x1b, 0x1d, 0xea, 0x52, 0x66, 0x06,
+	0xd4, 0x92, 0x38, 0x37, 0x63, 0x9c, 0xae, 0xd9, 0x7e, 0x6a, 0xbb, 0x94, 0x9d, 0x6d, 0x84, 0x5b,
+	0x62, 0xd4, 0xf7, 0xfa, 0xac, 0x4d, 0x87, 0x56, 0x2d, 0x4a, 0xde, 0x1c, 0xc9, 0x74, 0x5d, 0x2f,
+	0x40, 0xc1, 0x7c, 0x39, 0xbb, 0xde, 0xb1, 0x83, 0xa7, 0xfd, 0x56, 0xb3, 0xed, 0x75, 0x37, 0x3a,
+	0x5e, 0xc7, 0x8b, 0x45, 0xe4, 0x23, 0x1c, 0xe0, 0x3f, 0x89, 0x3e, 0x13, 0xb2, 0x7b, 0xd1, 0xa7,
+	0x7d, 0x2a, 0x80, 0xda, 0x9f, 0x15, 0xa1, 0x76, 0xdf, 0x6b, 0x1d, 0xa1, 0x52, 0x75, 0xfa, 0xa2,
+	0x4f, 0xfd, 0x60, 0x2f, 0xa0, 0x5d, 0xd2, 0x80, 0x89, 0x1e, 0xb3, 0x3d, 0x66, 0x07, 0x67, 0xaa,
+	0xb2, 0xa2, 0xac, 0x2a, 0x7a, 0x34, 0x26, 0x8b, 0x50, 0x74, 0xcd, 0x2e, 0xf5, 0x7b, 0x66, 0x9b,
+	0xaa, 0x85, 0x15, 0x65, 0xb5, 0xa8, 0xc7, 0x00, 0xb2, 0x00, 0xc5, 0xb6, 0x63, 0x53, 0x37, 0x30,
+	0x6c, 0x4b, 0x9d, 0xc0, 0xd9, 0x09, 0x01, 0xd8, 0xb3, 0xc8, 0x0f, 0x61, 0xdc, 0x31, 0x5b, 0xd4,
+	0xf1, 0xd5, 0xd1, 0x95, 0xc2, 0x6a, 0x69, 0xf3, 0x83, 0xa6, 0xd9, 0xb3, 0x9b, 0x59, 0x12, 0x34,
+	0xf7, 0x11, 0xef, 0xae, 0x1b, 0xb0, 0x33, 0x5d, 0x2e, 0x22, 0xfb, 0x50, 0x4a, 0xe8, 0x41, 0x1d,
+	0x43, 0x1a, 0x6b, 0xf9, 0x34, 0xee, 0xc4, 0xc8, 0x82, 0x50, 0x72, 0x39, 0xe9, 0x40, 0x8d, 0xd1,
+	0x17, 0x7d, 0x9b, 0x51, 0xcb, 0x70, 0x3d, 0x8b, 0x1a, 0x52, 0xb4, 0x71, 0x24, 0xfb, 0x49, 0x3e,
+	0x59, 0x5d, 0xae, 0x3a, 0xf0, 0x2c, 0x9a, 0x10, 0x73, 0x6b, 0x44, 0x55, 0x74, 0xc2, 0x86, 0x26,
+	0xc9, 0x6d, 0x98, 0xe8, 0x79, 0x96, 0xe1, 0xf7, 0x68, 0x5b, 0x1d, 0x59, 0x51, 0x56, 0x4b, 0x9b,
+	0x0b, 0x4d, 0x61, 0x10, 0xc8, 0x83, 0x1b, 0x4d, 0xf3, 0xe4, 0x93, 0xe6, 0xa1, 0x67, 0x1d, 0xf5,
+	0x68, 0x1b, 0xc9, 0x5c, 0xed, 0x89, 0x01, 0xb9, 0x05, 0xc5, 0x70, 0xad, 0xaf, 0x5e, 0x5d, 0x29,
+	0x5c, 0xb0, 0x58, 0x9f, 0x90, 0x0b, 0x7d, 0x72, 0x13, 0x66, 0xbb, 0xb6, 0x6b, 0x3c, 0xef, 0xb7,
+	0x28, 0x73, 0x69, 0x40, 0x7d, 0xe3, 0x84, 0x32, 0xdf, 0xf6, 0x5c, 0xb5, 0x88, 0xa7, 0x52, 0xeb,
+	0xda, 0xee, 0x83, 0x68, 0xf2, 0x73, 0x31, 0x47, 0x76, 0x60, 0xca, 0xa7, 0xec, 0xc4, 0x6e, 0x53,
+	0xa3, 0xe7, 0xb1, 0xc0, 0x57, 0x01, 0x79, 0x2e, 0x67, 0xf1, 0x3c, 0x12, 0x88, 0x87, 0x1e, 0x0b,
+	0xf4, 0x49, 0x3f, 0x1e, 0xf8, 0x64, 0x19, 0x4a, 0x5d, 0xf3, 0xa5, 0xc1, 0x68, 0xc0, 0x6c, 0xea,
+	0xab, 0xa5, 0x15, 0x65, 0x75, 0x4a, 0x87, 0xae, 0xf9, 0x52, 0x17, 0x10, 0xf2, 0x11, 0x54, 0x23,
+	0xdd, 0xb7, 0x9d, 0xbe, 0x1f, 0x50, 0xe6, 0xab, 0x93, 0x2b, 0x85, 0xd5, 0xa2, 0x5e, 0x09, 0x27,
+	0xb6, 0x25, 0x9c, 0xcc, 0xc1, 0xd5, 0x8e, 0xe9, 0x76, 0xb8, 0x41, 0x4d, 0xa1, 0xe8, 0xe3, 0x7c,
+	0xb8, 0x67, 0x71, 0x5b, 0xc3, 0x09, 0xdf, 0xfe, 0x92, 0xaa, 0xd3, 0xc8, 0x64, 0x82, 0x03, 0x8e,
+	0xec, 0x2f, 0x29, 0x59, 0x83, 0x6a, 0xa8, 0x39, 0x23, 0xa0, 0xdd, 0x9e, 0x63, 0x06, 0x54, 0x2d,
+	0xe3, 0xfa, 0xb2, 0x54, 0xd2, 0x63, 0x09, 0x26, 0xf7, 0x60, 0xa6, 0xed, 0xb9, 0x81, 0xc9, 0x1d,
+	0xd3, 0xf0, 0x4e, 0x28, 0x63, 0xb6, 0x45, 0x7d, 0xb5, 0x82, 0x7b, 0x9f, 0xc5, 0x4d, 0x6f, 0x87,
+	0xf3, 0x0f, 0xe5, 0xb4, 0x4e, 0xda, 0x83, 0x20, 0x9f, 0x7c, 0x0a, 0x13, 0x16, 0x35, 0x2d, 0xc7,
+	0x76, 0xa9, 0x5a, 0xc5, 0xa3, 0x6e, 0x34, 0x85, 0x17, 0x37, 0x43, 0xf7, 0x6c, 0x3e, 0x0e, 0x43,
+	0xd0, 0xd6, 0xe8, 0x2f, 0xfe, 0x65, 0x59, 0xd1, 0xa3, 0x15, 0xe4, 0x33, 0x58, 0x08, 0x3c, 0x07,
+	0x63, 0x80, 0x6f, 0xf4, 0x18, 0xe5, 0x91, 0xcc, 0x6e, 0x39, 0x14, 0xcd, 0xd3, 0x57, 0xc9, 0x8a,
+	0xb2, 0x3a, 0xa1, 0xcf, 0x47, 0x28, 0x87, 0x31, 0x06, 0xb7, 0x36, 0xbf, 0xf1, 0x3b, 0x50, 0x4a,
+	0xd8, 0x23, 0xa9, 0x40, 0xe1, 0x39, 0x15, 0xfe, 0x5b, 0xd4, 0xf9, 0x5f, 0x52, 0x83, 0xb1, 0x13,
+	0xd3, 0xe9, 0x53, 0x34, 0xc3, 0xa2, 0x2e, 0x06, 0xb7, 0x47, 0x6e, 0x29, 0x8d, 0xcf, 0xa0, 0x32,
+	0xe8, 0x2d, 0x97, 0x5a, 0x7f, 0x17, 0xe6, 0x72, 0xdc, 0xe2, 0x32, 0x64, 0xb4, 0xbf, 0x54, 0xa0,
+	0x3a, 0xa4, 0x69, 0x42, 0x60, 0x94, 0x07, 0x18, 0x49, 0x02, 0xff, 0x73, 0x1a, 0x76, 0xd7, 0xec,
+	0x44, 0x34, 0x70, 0xc0, 0x31, 0x4d, 0xd6, 0xf1, 0xd5, 0x02, 0x9a, 0x12, 0xfe, 0x27, 0xfb, 0x50,
+	0x0c, 0x43, 0x2c, 0x8f, 0x3b, 0xfc, 0x50, 0x56, 0xb3, 0xcc, 0x59, 0x97, 0x48, 0x72, 0x1f, 0x5d,
+	0xea, 0x06, 0xfe, 0xd6, 0xe8, 0x2f, 0x7f, 0xb3, 0x7c, 0x45, 0x8f, 0x09, 0x68, 0xff, 0xac, 0x40,
+	0x65, 0x30, 0x2a, 0x70, 0x61, 0x30, 0xac, 0x4a, 0x09, 0xc5, 0x80, 0x2c, 0x02, 0x3c, 0xf3, 0x5a,
+	0x86, 0x4f, 0x31, 0x16, 0x0a, 0x39, 0x27, 0x9e, 0x79, 0xad, 0x23, 0xca, 0x63, 0xe1, 0x5d, 0xa8,
+	0xf2, 0x59, 0x26, 0x48, 0x18, 0x76, 0x40, 0xbb, 0x42, 0xee, 0xd2, 0xe6, 0x7c, 0x6e, 0xec, 0xd1,
+	0xcb, 0xcf, 0xbc, 0x56, 0x62, 0x8c, 0xce, 0x61, 0xb1, 0x33, 0x83, 0xf5, 0x5d, 0xdc, 0xdb, 0x84,
+	0x3e, 0x6e, 0xb1, 0x33, 0xbd, 0xef, 0x92, 0xef, 0xc1, 0x2c, 0xa3, 0xcf, 0x68, 0x3b, 0x30, 0xec,
+	0x63, 0x03, 0x05, 0x32, 0x7a, 0x66, 0xdf, 0xa7, 0x96, 0x3a, 0x86, 0x78, 0x33, 0x62, 0x76, 0xef,
+	0xf8, 0x11, 0x9f, 0x3b, 0xc4, 0x29, 0xad, 0x8f, 0x9b, 0xdb, 0x36, 0xdd, 0x36, 0x75, 0xc2, 0xcd,
+	0xd5, 0x61, 0x9c, 0x0b, 0x6a, 0x5b, 0xe1, 0xee, 0x9e, 0x79, 0xad, 0x3d, 0xeb, 0x82, 0xdd, 0x45,
+	0x1a, 0x29, 0x24, 0x35, 0x32, 0x0b, 0xe3, 0x8c, 0x9a, 0xbe, 0x27, 0x64, 0x2d, 0xea, 0x72, 0xa4,
+	0xfd, 0x83, 0x02, 0xcb, 0x11, 0x5f, 0xb1, 0xe9, 0x80, 0x5a, 0x5b, 0xf4, 0xd8, 0x63, 0xf4, 0x9b,
+	0xe8, 0xf8, 0x21, 0x54, 0xfc, 0x90, 0x9a, 0xd1, 0x42, 0x72, 0x6a, 0xe1, 0x42, 0xb7, 0x9c, 0xe0,
+	0x67, 0x8e, 0xae, 0x59, 0xf6, 0xd3, 0xb2, 0xe4, 0x6e, 0xe0, 0x4f, 0x14, 0x98, 0xbd, 0xcf, 0x4f,
+	0x46, 0xde, 0x92, 0xf6, 0x97, 0x91, 0xdc, 0x73, 0x70, 0x55, 0xa8, 0xcf, 0x57, 0x15, 0xb4, 0xca,
+	0x71, 0xd4, 0x9f, 0xff, 0xb5, 0x14, 0x78, 0x1d, 0x26, 0x5d, 0x7a, 0x6a, 0x44, 0x77, 0xf3, 0x28,
+	0xde, 0xcd, 0x25, 0x97, 0x9e, 0x1e, 0x4a, 0x90, 0xf6, 0x9f, 0x0a, 0xcc, 0x0d, 0x89, 0xe2, 0xf7,
+	0x3c, 0xd7, 0xa7, 0x22, 0xec, 0xc6, 0x70, 0x2b, 0x21, 0x55, 0x25, 0x35, 0xc1, 0xe5, 0x33, 0xa0,
+	0xea, 0x7a, 0x81, 0x91, 0x82, 0xab, 0x23, 0x68, 0xa0, 0x9b, 0xa1, 0x81, 0x66, 0x71, 0x69, 0x1e,
+	0x78, 0x41, 0x12, 0x6e, 0x89, 0xbb, 0xb7, 0xe2, 0x0e, 0x80, 0x1b, 0xdb, 0x50, 0xcf, 0x44, 0xbd,
+	0x54, 0xc4, 0xb8, 0x0b, 0xf5, 0xc8, 0x72, 0xd0, 0x92, 0xcf, 0xb7, 0x97, 0xf8, 0x00, 0x47, 0x52,
+	0x07, 0xb8, 0x83, 0x64, 0x42, 0x7f, 0x13, 0x1b, 0xc1, 0x4c, 0x28, 0xc7, 0xfa, 0x6b, 0x30, 0x46,
+	0x19, 0xf3, 0x58, 0x28, 0x10, 0x0e, 0xb4, 0x13, 0xa8, 0x0e, 0x51, 0x21, 0x3f, 0x01, 0x22, 0x1c,
+	0x5d, 0x8c, 0xa5, 0xa7, 0x2b, 0xa8, 0xc8, 0xc6, 0xa0, 0xa7, 0xc7, 0x9c, 0xf5, 0x0a, 0xba, 0x7a,
+	0x0c, 0x48, 0xf9, 0xfa, 0x48, 0xd2, 0xd7, 0xb5, 0xbf, 0x16, 0x67, 0x2e, 0x88, 0x1c, 0x05, 0x8c,
+	0x9a, 0xdd, 0x88, 0xfd, 0x2a, 0x54, 0x8e, 0x6d, 0x26, 0x23, 0x8c, 0x61, 0xbb, 0x16, 0x7d, 0x89,
+	0x5b, 0x19, 0xd3, 0xa7, 0x11, 0xce, 0x49, 0xef, 0x71, 0x68, 0x8e, 0xa0, 0x23, 0xdf, 0x4c, 0xd0,
+	0x42, 0x4a, 0xd0, 0x1d, 0x98, 0x8d, 0x68, 0x08, 0x39, 0x77, 0x4d, 0xdb, 0xe9, 0x33, 0xbc, 0xae,
+	0x8f, 0x4d, 0xdb, 0xa1, 0xd6, 0xb0, 0x9c, 0x65, 0x31, 0x11, 0x09, 0xaa, 0xfd, 0x9d, 0x02, 0x2a,
+	0x27, 0xd3, 0x7e, 0x4a, 0xad, 0xbe, 0x63, 0xbb, 0x9d, 0x5d, 0x6a, 0xfa, 0x76, 0xcb, 0x76, 0x78,
+	0x7a, 0xba, 0x00, 0x45, 0x3c, 0xb0, 0x04, 0x01, 0xee, 0x55, 0x62, 0x8b, 0x3f, 0x84, 0x89, 0x28,
+	0xdd, 0x10, 0x1b, 0xbb, 0x2e, 0x6e, 0x77, 0x01, 0xcc, 0xa4, 0xa8, 0x47, 0x4b, 0xc8, 0x8f, 0x80,
+	0x38, 0x26, 0xeb, 0xf0, 0x78, 0x8d, 0x19, 0x63, 0x70, 0xd6, 0xa3, 0x61, 0xd0, 0xae, 0x22, 0xa1,
+	0x43, 0xcf, 0x73, 0xf8, 0x05, 0xf8, 0xf8, 0xac, 0x47, 0xf5, 0x8a, 0x44, 0x0e, 0x01, 0xbe, 0xf6,
+	0xb7, 0x0a, 0x2c, 0x9e, 0xc7, 0x8b, 0x5c, 0x03, 0x90, 0xdc, 0x62, 0x93, 0x2b, 0x4a, 0xc8, 0x9e,
+	0xc5, 0xef, 0xb7, 0x9e, 0xe7, 0x39, 0xd2, 0xea, 0xf0, 0x3f, 0x51, 0xe1, 0xaa, 0x30, 0xe2, 0xf0,
+	0xda, 0x0b, 0x87, 0xe4, 0x0e, 0x40, 0x42, 0x4c, 0x91, 0x72, 0x6b, 0x28, 0x66, 0x28, 0x51, 0xf6,
+	0x86, 0x8b, 0x6e, 0x2c, 0x70, 0x01, 0xae, 0x9d, 0x8b, 0x4c, 0x76, 0xa3, 0x9c, 0x5e, 0x98, 0x74,
+	0xf3, 0x62, 0x06, 0x99, 0xc9, 0xfd, 0x29, 0xd4, 0x4d, 0xc7, 0xf1, 0xda, 0x66, 0x60, 0xf2, 0x94,
+	0x27, 0xbe, 0xb2, 0xc5, 0x39, 0x7d, 0xfa, 0x16, 0x64, 0xef, 0xc4, 0xeb, 0xc3, 0xcb, 0x5c, 0xa6,
+	0xe6, 0xe2, 0x1a, 0xaf, 0x99, 0x19, 0x08, 0xf9, 0xfa, 0xfb, 0x26, 0xf9, 0xd4, 0x29, 0xcc, 0xe7,
+	0x4a, 0x93, 0x41, 0x68, 0x27, 0x49, 0x88, 0xeb, 0x30, 0xce, 0x4f, 0xa2, 0x82, 0xb1, 0xd9, 0x7b,
+	0xde, 0x41, 0x25, 0x84, 0xaa, 0x69, 0x3e, 0xea, 0x9b, 0x6e, 0xc0, 0x0f, 0x2c, 0x11, 0x0f, 0xff,
+	0x6b, 0x04, 0x26, 0x93, 0x46, 0x18, 0x99, 0x8c, 0x92, 0x30, 0x99, 0xef, 0x47, 0x67, 0x26, 0x94,
+	0x7b, 0x6d, 0xc8, 0x76, 0x33, 0x8f, 0xe8, 0x38, 0xef, 0x88, 0x84, 0x07, 0x7c, 0x34, 0x4c, 0xe5,
+	0x6b, 0x9d, 0xc8, 0xff, 0x49, 0xbd, 0xff, 0x15, 0xc0, 0x18, 0xde, 0x3f, 0x99, 0xd9, 0xea, 0x0d,
+	0x28, 0x87, 0x77, 0xb6, 0x71, 0x6c, 0xb6, 0x03, 0x79, 0x71, 0x28, 0xfa, 0x74, 0x08, 0xde, 0x45,
+	0x28, 0xaf, 0x9c, 0xfa, 0x3e, 0x2f, 0x42, 0x4e, 0x5d, 0xca, 0x84, 0x62, 0x8b, 0x3a, 0x70, 0xd0,
+	0x43, 0x84, 0xf0, 0x0c, 0xa0, 0xc3, 0xbc, 0x7e, 0x2f, 0xc4, 0x18, 0x45, 0x8c, 0x12, 0xc2, 0x24,
+	0xca, 0x3d, 0x28, 0x87, 0xa2, 0x1a, 0x8e, 0xdd, 0xb5, 0x83, 0xb0, 0x54, 0x5e, 0xc2, 0x6d, 0xa0,
+	0x94, 0x51, 0xb6, 0xbb, 0x8f, 0x08, 0xe2, 0x9c, 0xa7, 0x59, 0x0a, 0x48, 0xee, 0x40, 0x99, 0x9e,
+	0xf0, 0x52, 0x9e, 0xd1, 0x80, 0xba, 0xbc, 0x32, 0x50, 0xc7, 0x51, 0x4f, 0x6a, 0x4c, 0xe8, 0x2e,
+	0x47, 0xd0, 0xc3, 0x79, 0x7d, 0x9a, 0xa6, 0xc6, 0x64, 0x0f, 0x88, 0x1f, 0xf9, 0xaa, 0x71, 0x6a,
+	0xbb, 0x96, 0x77, 0x1a, 0x16, 0xb2, 0x8d, 0x98, 0x4a, 0xec, 0xcf, 0x5f, 0x20, 0x8a, 0x5e, 0xf5,
+	0x07, 0x20, 0xbc, 0xa0, 0x9d, 0xe3, 0x45, 0x65, 0x54, 0xd4, 0xf1, 0xaa, 0xcf, 0x68, 0x9d, 0x05,
+	0xd4, 0xc7, 0x3e, 0xc3, 0x94, 0x3e, 0xd3, 0x35, 0x5f, 0xca, 0x3a, 0x98, 0x57, 0x80, 0x5b, 0x7c,
+	0x8a, 0xdc, 0x86, 0x79, 0x59, 0x50, 0x1a, 0x71, 0x89, 0xd7, 0xf6, 0xba, 0x5d, 0xd3, 0xb5, 0xb0,
+	0x12, 0x9e, 0xd0, 0xe7, 0x24, 0x42, 0x54, 0x78, 0x6c, 0x8b, 0x69, 0xb2, 0x03, 0x91, 0x46, 0x8c,
+	0x63, 0xc7, 0xf3, 0x98, 0x0a, 0x09, 0x77, 0x49, 0xeb, 0x71, 0x97, 0xcf, 0x0b, 0x35, 0x4e, 0xb1,
+	0x24, 0x8c, 0xf7, 0x52, 0xa2, 0xfa, 0xb3, 0x24, 0xb2, 0xbc, 0x70, 0x4c, 0xf6, 0xa1, 0x7e, 0xe2,
+	0x39, 0xfd, 0x2e, 0x35, 0xda, 0x8e, 0x69, 0x77, 0xe3, 0x42, 0x95, 0x24, 0xf4, 0xfc, 0x39, 0x62,
+	0x6c, 0x73, 0x84, 0xb0, 0x62, 0xd5, 0x67, 0x4e, 0x86, 0x81, 0xe4, 0x63, 0x40, 0x7f, 0x3a, 0xa5,
+	0x96, 0x21, 0xa9, 0x8a, 0xc8, 0x2f, 0x0a, 0x6b, 0x22, 0xe7, 0x04, 0x39, 0x0c, 0xef, 0xe4, 0x33,
+	0x58, 0x0c, 0xb5, 0x83, 0x6d, 0x33, 0xc3, 0xb2, 0x99, 0x50, 0x2c, 0x1a, 0x0e, 0xd6, 0xdb, 0x13,
+	0xba, 0x2a, 0x71, 0xee, 0x72, 0x94, 0x1d, 0x9b, 0x71, 0xed, 0xa2, 0x89, 0x90, 0x03, 0x20, 0x16,
+	0x3d, 0x36, 0xfb, 0x4e, 0x80, 0xe7, 0x22, 0x83, 0xca, 0x34, 0x6a, 0x69, 0x25, 0xa1, 0xa5, 0x1d,
+	0x81, 0x74, 0xe8, 0x59, 0xc9, 0xb8, 0x52, 0xb1, 0x06, 0xc0, 0x3c, 0x3d, 0x93, 0x45, 0x4a, 0x59,
+	0xe4, 0x0d, 0x62, 0x44, 0xee, 0x27, 0x4e, 0xe2, 0x45, 0xdf, 0x0b, 0x4c, 0xb5, 0x92, 0x7b, 0x12,
+	0x8f, 0xf8, 0x7c, 0x32, 0xc8, 0x4c, 0xb1, 0xe4, 0x0c, 0xf9, 0x01, 0x94, 0xfa, 0x3d, 0xcb, 0x0c,
+	0x28, 0x76, 0xf1, 0x72, 0xcb, 0xf4, 0x5d, 0xde, 0xe8, 0xfb, 0xa9, 0xe9, 0x3f, 0xd7, 0x41, 0xa0,
+	0xf3, 0xff, 0x8d, 0x3b, 0x30, 0x93, 0xe1, 0x39, 0x17, 0x85, 0x28, 0x25, 0x19, 0xa2, 0x7e, 0x0c,
+	0x64, 0xd8, 0x68, 0x2e, 0x45, 0x61, 0x1b, 0xea, 0x99, 0x0a, 0xbd, 0x54, 0xa4, 0xec, 0xc5, 0x62,
+	0xc4, 0x1a, 0x7b, 0xa7, 0x21, 0xf2, 0x08, 0xea, 0x99, 0xce, 0xce, 0x23, 0xa6, 0x65, 0x9e, 0x85,
+	0x95, 0x08, 0xfe, 0xe7, 0x82, 0xfb, 0x81, 0xc9, 0x82, 0x50, 0x70, 0x1c, 0x70, 0xf1, 0xa8, 0x6b,
+	0xc9, 0x9a, 0x88, 0xff, 0xe5, 0x95, 0xd7, 0x4c, 0x46, 0x20, 0x22, 0x3a, 0x90, 0x28, 0x6a, 0x19,
+	0x61, 0x53, 0x17, 0xf7, 0xc5, 0xeb, 0xeb, 0xc1, 0xc3, 0xde, 0x91, 0x08, 0xa2, 0xf6, 0xfb, 0x0b,
+	0x5e, 0xfb, 0x55, 0xa3, 0xe5, 0xe1, 0x24, 0x4f, 0xce, 0x78, 0x04, 0x72, 0xa8, 0xdb, 0x09, 0x9e,
+	0xa2, 0x60, 0x05, 0xbd, 0xd8, 0x35, 0x5f, 0xee, 0x23, 0x40, 0x7b, 0x00, 0x44, 0xd4, 0x21, 0x0e,
+	0xa2, 0xeb, 0xd4, 0xef, 0x3b, 0x01, 0xf9, 0x3e, 0x4c, 0xb5, 0x05, 0x34, 0x59, 0x6f, 0x6d, 0x55,
+	0xfe, 0xe3, 0x37, 0xcb, 0x93, 0xd1, 0xc4, 0x9e, 0xe5, 0xeb, 0xa9, 0x91, 0xf6, 0x29, 0x54, 0x93,
+	0xc4, 0xb6, 0xbd, 0xbe, 0x1b, 0xf0, 0x6b, 0x24, 0xa6, 0xd5, 0xe6, 0xa0, 0x30, 0x95, 0x8f, 0xc0,
+	0x88, 0xa8, 0xbd, 0x84, 0x39, 0x54, 0x4a, 0x86, 0x3c, 0x6f, 0x4b, 0x83, 0x37, 0x10, 0x4d, 0x87,
+	0x51, 0xd3, 0x3a, 0x33, 0x8e, 0x6d, 0xd7, 0xf6, 0x9f, 0x46, 0xf8, 0x23, 0x88, 0x5f, 0x93, 0xb3,
+	0xbb, 0x72, 0x52, 0x70, 0xfe, 0x10, 0x2a, 0xc8, 0x79, 0xcf, 0x3d, 0xf6, 0xc2, 0x52, 0x2c, 0xe3,
+	0x46, 0xd4, 0x56, 0x81, 0x20, 0xde, 0x0e, 0x75, 0x68, 0x40, 0xcf, 0xc3, 0xfc, 0x9b, 0x51, 0x28,
+	0x46, 0x24, 0x33, 0x6f, 0xd7, 0xdf, 0x86, 0xb2, 0xd9, 0x0e, 0xec, 0x13, 0x6a, 0xc8, 0x82, 0x3a,
+	0xcc, 0x6b, 0xca, 0x51, 0xd5, 0x42, 0x03, 0x14, 0x68, 0x4a, 0xe0, 0x09, 0x48, 0xe6, 0x05, 0x57,
+	0xb8, 0xe4, 0x05, 0x77, 0x30, 0x14, 0x99, 0x46, 0x13, 0x75, 0x45, 0x24, 0xf7, 0x5b, 0x47, 0xa7,
+	0xc7, 0x50, 0x09, 0x01, 0xbe, 0xe1, 0x50, 0x53, 0x34, 0x6c, 0x38, 0xc5, 0xf7, 0x72, 0x28, 0xfa,
+	0xfb, 0x88, 0x95, 0xa4, 0x59, 0x66, 0xe9, 0xb9, 0x6f, 0xdf, 0xd9, 0x1b, 0x0c, 0x6a, 0x59, 0x02,
+	0xbe, 0xd3, 0x00, 0xd3, 0x02, 0x88, 0xcf, 0x3a, 0xd3, 0x52, 0x96, 0xa1, 0x84, 0x7d, 0x00, 0x8b,
+	0x5b, 0x8a, 0x2f, 0x0d, 0x19, 0x04, 0xe8, 0xbe, 0xd7, 0xc2, 0xce, 0xb5, 0x50, 0xba, 0x40, 0x28,
+	0x08, 0x04, 0x01, 0xe2, 0x08, 0xda, 0x1a, 0x96, 0xf8, 0xb2, 0x86, 0x3b, 0xbf, 0x45, 0xa6, 0x31,
+	0x98, 0x8e, 0x71, 0x51, 0xa6, 0x6c, 0xc4, 0x81, 0xaa, 0x6f, 0x24, 0xaf, 0xea, 0x2b, 0x24, 0x52,
+	0xf8, 0x59, 0x18, 0x97, 0xd6, 0x21, 0xdb, 0x7e, 0x62, 0xa4, 0x7d, 0x82, 0xa5, 0x31, 0x0a, 0xd6,
+	0xa7, 0x3f, 0xb1, 0xfd, 0xc0, 0x63, 0x67, 0x17, 0x88, 0xf9, 0x05, 0x6e, 0x29, 0xbd, 0x24, 0x4f,
+	0xd2, 0x8f, 0x60, 0x82, 0x09, 0xc4, 0x21, 0x1f, 0x93, 0x04, 0xf4, 0x08, 0x41, 0xdb, 0xc0, 0x6a,
+	0x3f, 0xec, 0x26, 0xf2, 0x9a, 0xea, 0x02, 0x49, 0x7e, 0x0c, 0xe5, 0x81, 0x05, 0x79, 0x72, 0xe4,
+	0xf5, 0x71, 0xbe, 0x03, 0x33, 0xbc, 0x00, 0xd9, 0x36, 0x7b, 0x66, 0x9b, 0x5b, 0x47, 0x1c, 0x57,
+	0x06, 0x8b, 0x20, 0xed, 0xbf, 0x0b, 0x30, 0x99, 0xc4, 0xcd, 0x42, 0x22, 0x5d, 0x50, 0x53, 0x15,
+	0x7f, 0xa2, 0x5e, 0x91, 0xfb, 0x5f, 0x8f, 0xaa, 0x9e, 0x90, 0x50, 0x73, 0x3f, 0x2e, 0xfb, 0x13,
+	0xc5, 0x48, 0xd2, 0x41, 0x67, 0x9d, 0x4c, 0x14, 0xf2, 0x7b, 0x50, 0x0d, 0xbc, 0xc0, 0x74, 0x52,
+	0x7c, 0x44, 0x75, 0x75, 0x63, 0x98, 0xcf, 0x63, 0x8e, 0x9a, 0xc3, 0xa1, 0x12, 0x0c, 0x4c, 0xf2,
+	0x3c, 0x34, 0xea, 0x7d, 0x8c, 0x8a, 0xbe, 0x48, 0x38, 0x6e, 0x9c, 0xc1, 0xc2, 0x39, 0x42, 0xbf,
+	0xd3, 0x40, 0xe1, 0x43, 0x3d, 0x73, 0x1f, 0xef, 0x34, 0x52, 0xfc, 0x08, 0x6a, 0x69, 0x33, 0x91,
+	0xcd, 0xb2, 0x1b, 0x30, 0xc6, 0x8f, 0x3d, 0xec, 0x65, 0x54, 0x87, 0x74, 0xae, 0x8b, 0x79, 0x6d,
+	0x33, 0xea, 0xe3, 0xc4, 0x34, 0xf8, 0x07, 0xb3, 0xf3, 0x0c, 0xee, 0xef, 0xc7, 0xa0, 0x3c, 0xb0,
+	0xe8, 0xa2, 0x7e, 0xcf, 0x4f, 0xa1, 0x34, 0x6c, 0x71, 0x1f, 0x24, 0x5b, 0x56, 0x91, 0x31, 0xe4,
+	0xd8, 0x41, 0x72, 0x3d, 0xb9, 0x05, 0xa3, 0x98, 0x5c, 0x17, 0x12, 0xe5, 0xe0, 0x20, 0x9d, 0x27,
+	0x03, 0x77, 0x09, 0xae, 0x20, 0xf7, 0xa0, 0x68, 0x9e, 0x98, 0xb6, 0x83, 0x62, 0x8c, 0x26, 0xee,
+	0xa3, 0x21, 0x31, 0x42, 0xac, 0x24, 0x8d, 0x78, 0x2d, 0xf9, 0x6e, 0xaa, 0x27, 0x25, 0x6e, 0xb6,
+	0xa9, 0x54, 0x6f, 0x27, 0xd1, 0x7e, 0x22, 0x5b, 0x00, 0x0c, 0xf5, 0x6a, 0xf0, 0x4f, 0x3d, 0xe3,
+	0x6f, 0x9f, 0xbd, 0x15, 0xc5, 0xb2, 0x3b, 0x1d, 0xda, 0x70, 0xa1, 0xf2, 0xad, 0x1a, 0x74, 0x07,
+	0x8a, 0x4f, 0xbe, 0x8d, 0xeb, 0xae, 0xe1, 0xc0, 0x74, 0x5a, 0xdb, 0xef, 0xd4, 0x65, 0xfe, 0x71,
+	0x0c, 0x48, 0xda, 0x67, 0xb8, 0x82, 0x33, 0x83, 0xe6, 0x61, 0x96, 0xd5, 0xae, 0x0e, 0xfb, 0x12,
+	0x52, 0x78, 0x2b, 0xc3, 0xfd, 0x41, 0xca, 0x70, 0xaf, 0xe7, 0x91, 0xca, 0xb6, 0xdd, 0xfb, 0xc3,
+	0xb6, 0xfb, 0x61, 0xae, 0x30, 0x17, 0x98, 0xef, 0xc7, 0x89, 0x20, 0x2a, 0x8c, 0xb7, 0x96, 0xe5,
+	0x06, 0x89, 0x9e, 0xf1, 0xff, 0x9b, 0xf0, 0xff, 0x1a, 0x13, 0xde, 0x85, 0x7a, 0x66, 0xd0, 0x26,
+	0xeb, 0xe9, 0xb0, 0x3f, 0x97, 0x63, 0x1d, 0x61, 0xf0, 0x7f, 0x80, 0x79, 0xcd, 0x9e, 0xb5, 0x75,
+	0xb6, 0x2d, 0x5f, 0xb6, 0x9c, 0xff, 0xd1, 0x29, 0xf5, 0x26, 0x66, 0x24, 0xfd, 0x26, 0x46, 0xfb,
+	0x18, 0xe6, 0x86, 0x88, 0xc9, 0xdb, 0x28, 0x27, 0x4b, 0xba, 0x01, 0xd5, 0xf8, 0x9b, 0xed, 0xdb,
+	0xd4, 0x58, 0xbc, 0xf2, 0xeb, 0x9e, 0x8b, 0xf9, 0xfb, 0x50, 0x3e, 0x1c, 0x78, 0x13, 0x91, 0x81,
+	0x46, 0x7e, 0xeb, 0x52, 0x2f, 0x59, 0xa2, 0x57, 0x2c, 0xda, 0x77, 0x61, 0x76, 0x80, 0xfc, 0x79,
+	0xc2, 0xdc, 0x84, 0xc5, 0x81, 0x3e, 0xc1, 0x51, 0x60, 0x06, 0x7d, 0xff, 0x5c, 0x25, 0x6b, 0x7f,
+	0xa8, 0xc0, 0x42, 0xce, 0x32, 0x4c, 0x24, 0x6f, 0x46, 0x19, 0x23, 0x5f, 0x36, 0xbd, 0xb9, 0x18,
+	0x97, 0x53, 0x07, 0x5e, 0x20, 0x17, 0x51, 0x4b, 0x60, 0x87, 0xf9, 0x64, 0xde, 0x07, 0x97, 0x2e,
+	0xf5, 0x7d, 0xee, 0xce, 0x22, 0x23, 0x0f, 0x87, 0xda, 0x9f, 0x2a, 0x50, 0xcf, 0x94, 0x21, 0xc7,
+	0x30, 0x56, 0xa0, 0x24, 0xfb, 0x9c, 0x32, 0x50, 0xf2, 0x4c, 0x3e, 0x09, 0x22, 0xb7, 0xd3, 0x1f,
+	0x27, 0x52, 0x5d, 0xb5, 0xec, 0x8d, 0x46, 0x9f, 0x2f, 0xd6, 0xde, 0x28, 0x30, 0x97, 0xb3, 0x3f,
+	0xf2, 0x21, 0x68, 0x4f, 0x5c, 0x7e, 0x8e, 0xf6, 0xb1, 0x4d, 0xad, 0x1c, 0xac, 0xca, 0x15, 0x52,
+	0x81, 0xc9, 0x03, 0xef, 0x51, 0x54, 0x1f, 0x55, 0x14, 0xb2, 0x00, 0x73, 0x0f, 0xfb, 0x81, 0x6f,
+	0x5b, 0x43, 0x7d, 0x9c, 0xca, 0x08, 0xb9, 0x06, 0xf3, 0xa1, 0xc5, 0xc5, 0x3d, 0x32, 0x9d, 0x9a,
+	0x1c, 0xb3, 0x52, 0x20, 0xb3, 0x40, 0x8e, 0x02, 0x93, 0x9d, 0x50, 0x6b, 0xeb, 0x6c, 0xd7, 0xb4,
+	0xd9, 0xd1, 0x53, 0x93, 0xd1, 0xca, 0x28, 0x21, 0x30, 0x7d, 0xe0, 0xed, 0x32, 0x4a, 0x43, 0x7f,
+	0xab, 0x8c, 0x91, 0x3a, 0x54, 0x0f, 0x3c, 0xf1, 0x75, 0xc7, 0xa1, 0xd2, 0x6d, 0x2b, 0xe3, 0xa4,
+	0x0c, 0xa5, 0xc4, 0x83, 0x85, 0xca, 0xd5, 0xcd, 0x9f, 0x13, 0x18, 0x17, 0x1f, 0x14, 0xc9, 0xe7,
+	0x00, 0xe2, 0x1f, 0x96, 0x72, 0xf5, 0xcc, 0x57, 0x14, 0x8d, 0xd9, 0xec, 0x2f, 0x99, 0xda, 0xfc,
+	0xcf, 0xff, 0xe9, 0xdf, 0xff, 0x7c, 0x64, 0x46, 0x9b, 0xe6, 0x0f, 0xf8, 0x9e, 0x79, 0x2d, 0xf9,
+	0xd4, 0xf0, 0xb6, 0xb2, 0x46, 0x1e, 0x40, 0x25, 0xa6, 0x2b, 0x3e, 0x5b, 0xe6, 0x51, 0x5f, 0x4c,
+	0x83, 0xd3, 0xdf, 0x62, 0x57, 0x95, 0x8f, 0x15, 0xf2, 0x05, 0x80, 0x28, 0x70, 0xd2, 0x42, 0xa6,
+	0xde, 0x5c, 0x34, 0x44, 0x04, 0x1a, 0xee, 0xde, 0x0c, 0x4b, 0x29, 0x9a, 0x36, 0x5c, 0xca, 0x3f,
+	0x52, 0x60, 0x3e, 0xa6, 0x3c, 0xf0, 0x8a, 0x82, 0xbc, 0x9f, 0x66, 0x94, 0xfd, 0xc8, 0x42, 0x2a,
+	0x67, 0xa8, 0xf1, 0xa4, 0xad, 0x21, 0xdb, 0xf7, 0xb5, 0xe5, 0x34, 0xdb, 0xf5, 0xe8, 0x7d, 0xc4,
+	0xba, 0x78, 0x5d, 0xc1, 0xe5, 0x60, 0x50, 0x8d, 0xc5, 0xd8, 0x73, 0xc5, 0x47, 0x91, 0x46, 0x9a,
+	0x7d, 0xf2, 0x4b, 0x7d, 0x23, 0xe1, 0x89, 0x19, 0x3b, 0x7e, 0x0f, 0x59, 0x5f, 0xd3, 0x54, 0xce,
+	0x1a, 0xdd, 0x66, 0xe3, 0x2b, 0xfc, 0x79, 0x95, 0xd8, 0xbb, 0x0b, 0x95, 0xe4, 0x3b, 0x02, 0x54,
+	0xed, 0x42, 0xf6, 0x23, 0x85, 0x81, 0x73, 0xca, 0x7a, 0xc1, 0xa0, 0x2d, 0x23, 0xcf, 0x79, 0xad,
+	0x16, 0x6e, 0x37, 0xf9, 0x08, 0x82, 0xf3, 0x3b, 0x80, 0xd2, 0x36, 0xa3, 0x66, 0x40, 0xc5, 0xee,
+	0x20, 0xde, 0x41, 0x63, 0x76, 0xe8, 0x6e, 0xc7, 0xb6, 0xb9, 0xb6, 0x80, 0x34, 0xeb, 0x8d, 0x4a,
+	0x62, 0x1f, 0x3c, 0xdc, 0xbd, 0x92, 0xf4, 0x9e, 0x60, 0x93, 0xf9, 0xd2, 0xf4, 0x36, 0x33, 0xe9,
+	0xfd, 0x2e, 0x94, 0x44, 0x63, 0x4d, 0xd0, 0x9b, 0x8b, 0xe9, 0xa5, 0xfa, 0x6d, 0xb9, 0xc4, 0x55,
+	0x24, 0x4e, 0xd6, 0x86, 0x88, 0x13, 0x03, 0x00, 0x5d, 0x4f, 0x10, 0x9e, 0x8d, 0x09, 0x27, 0x6f,
+	0xa3, 0x5c, 0xba, 0xd7, 0x91, 0xee, 0x82, 0x36, 0x3b, 0x48, 0x77, 0x03, 0x1b, 0xfd, 0x5c, 0xf4,
+	0x16, 0x94, 0xc4, 0x7d, 0x35, 0x24, 0x7a, 0xea, 0x1a, 0xcb, 0x65, 0xa1, 0x21, 0x8b, 0x45, 0x6d,
+	0x6e, 0x88, 0x05, 0xc3, 0xf5, 0x9c, 0xc7, 0x43, 0x98, 0xbc, 0x47, 0x83, 0xb8, 0xa9, 0x58, 0x4f,
+	0xb7, 0xd6, 0x42, 0x16, 0xd3, 0x69, 0x70, 0xa8, 0x15, 0x32, 0xac, 0x95, 0x3f, 0x80, 0xa9, 0x7b,
+	0x34, 0x88, 0x9b, 0x3d, 0x24, 0x8a, 0x32, 0xe9, 0x4e, 0x51, 0x63, 0x66, 0x00, 0x8e, 0x74, 0x57,
+	0x90, 0x6e, 0x83, 0xa8, 0xa1, 0xb9, 0x7d, 0x25, 0xae, 0xfd, 0x57, 0x1b, 0x32, 0x8b, 0x24, 0x3d,
+	0xa8, 0x09, 0xfa, 0x03, 0x5d, 0x9a, 0x6b, 0x03, 0xcd, 0x97, 0x74, 0xc3, 0x27, 0x8e, 0x75, 0xe9,
+	0xe9, 0xf0, 0x18, 0xc8, 0xfc, 0x10, 0xc3, 0xb0, 0x77, 0x43, 0x3c, 0x20, 0x72, 0x47, 0xc9, 0x6e,
+	0xcc, 0xc2, 0x60, 0xb8, 0x4a, 0x34, 0x75, 0x1a, 0xb5, 0xac, 0x49, 0xed, 0x43, 0xe4, 0xb5, 0x42,
+	0x96, 0x86, 0x37, 0x27, 0x62, 0x88, 0xbc, 0x69, 0x5b, 0x50, 0xbe, 0x47, 0x83, 0x54, 0x43, 0x46,
+	0xcd, 0xc8, 0xc3, 0x04, 0xab, 0xf9, 0x8c, 0x19, 0xe9, 0xbb, 0x0d, 0xe4, 0x57, 0x23, 0x84, 0xf3,
+	0xc3, 0x7c, 0x6d, 0xa3, 0x1d, 0x12, 0x7c, 0x09, 0xea, 0x3d, 0x1a, 0x64, 0xe7, 0x80, 0xd7, 0x33,
+	0xf3, 0xf8, 0x64, 0x51, 0xdf, 0x68, 0xe4, 0xa3, 0x68, 0xd7, 0x90, 0xed, 0x1c, 0xa9, 0x73, 0xb6,
+	0x61, 0xf2, 0x1f, 0x73, 0xfe, 0x99, 0x12, 0xea, 0x33, 0x99, 0xe9, 0xc5, 0xfa, 0xcc, 0x48, 0x26,
+	0x1b, 0x8b, 0xd9, 0x93, 0x72, 0x9f, 0x1b, 0xc8, 0xf0, 0x3b, 0xe4, 0x46, 0x46, 0x5c, 0x44, 0xdc,
+	0x75, 0xdb, 0xda, 0xf8, 0x2a, 0xca, 0x3b, 0x5f, 0x91, 0x3f, 0x56, 0x70, 0xf7, 0xd9, 0xf9, 0xc9,
+	0xf5, 0xf3, 0xd2, 0x8a, 0xe4, 0xee, 0x33, 0x51, 0xb4, 0x8f, 0x50, 0x98, 0x0f, 0xc8, 0x7b, 0xc3,
+	0xc2, 0xc4, 0x1f, 0x72, 0xd7, 0x7d, 0xc1, 0xcb, 0x85, 0xba, 0x08, 0x9e, 0x83, 0x29, 0x67, 0x4d,
+	0x9e, 0x6a, 0x0a, 0x9a, 0xeb, 0xe8, 0x37, 0x90, 0xe7, 0xf5, 0xc6, 0xa2, 0x38, 0x68, 0x6b, 0x9d,
+	0xa7, 0x33, 0xeb, 0xe1, 0x17, 0xd4, 0x44, 0x30, 0xec, 0xa2, 0xea, 0x07, 0x99, 0x2d, 0x64, 0x31,
+	0x4b, 0x9b, 0xf2, 0xc0, 0xa4, 0xf6, 0x3e, 0x72, 0x5c, 0x22, 0xe7, 0x72, 0x24, 0x0c, 0xea, 0x22,
+	0xc8, 0x5e, 0x8a, 0x63, 0xde, 0x2e, 0x25, 0xcf, 0xb5, 0x73, 0x79, 0x6e, 0xad, 0xfc, 0xfa, 0xdf,
+	0x96, 0xae, 0xfc, 0xec, 0xf5, 0x92, 0xf2, 0xcb, 0xd7, 0x4b, 0xca, 0xaf, 0x5e, 0x2f, 0x29, 0xff,
+	0xfa, 0x7a, 0x49, 0xf9, 0xc5, 0x9b, 0xa5, 0x2b, 0xbf, 0x7a, 0xb3, 0x74, 0xe5, 0xd7, 0x6f, 0x96,
+	0xae, 0xb4, 0xc6, 0x91, 0xee, 0xf7, 0xfe, 0x67, 0x00, 0xab, 0x65, 0xae, 0xf1, 0x4a, 0x31, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubmitClient interface {
+	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	// All requests of the stream must be for the queue and job set of the first request
+	SubmitJobsStream(ctx context.Context, opts ...grpc.CallOption) (Submit_SubmitJobsStreamClient, error)
+	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	CancelJobsSubmittedBefore(ctx context.Context, in *JobCancelSubmittedBeforeRequest, opts ...grpc.CallOption) (*CancellationCount, error)
+	CancelJobsInQueue(ctx context.Context, in *JobCancelQueueRequest, opts ...grpc.CallOption) (*QueueCancellationResult, error)
+	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	PauseQueue(ctx context.Context, in *QueuePauseRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ResumeQueue(ctx context.Context, in *QueueResumeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+	GetJobCluster(ctx context.Context, in *JobClusterRequest, opts ...grpc.CallOption) (*JobClusterInfo, error)
+	GetJobRequeueHistory(ctx context.Context, in *JobRequeueHistoryRequest, opts ...grpc.CallOption) (*JobRequeueHistory, error)
+	GetJobCancelReason(ctx context.Context, in *JobCancelReasonRequest, opts ...grpc.CallOption) (*JobCancelReason, error)
+	GetPoolCapacity(ctx context.Context, in *PoolCapacityRequest, opts ...grpc.CallOption) (*PoolCapacityResponse, error)
+	GetClusterCapacityReport(ctx context.Context, in *ClusterCapacityReportRequest, opts ...grpc.CallOption) (*ClusterCapacityReport, error)
+	GetJobIdByClientId(ctx context.Context, in *JobIdByClientIdRequest, opts ...grpc.CallOption) (*JobIdByClientIdResponse, error)
+	GetQueueSchedulingStatus(ctx context.Context, in *QueueSchedulingStatusRequest, opts ...grpc.CallOption) (*QueueSchedulingStatus, error)
+	CreatePodSpecTemplate(ctx context.Context, in *PodSpecTemplate, opts ...grpc.CallOption) (*types.Empty, error)
+	GetPodSpecTemplate(ctx context.Context, in *PodSpecTemplateRequest, opts ...grpc.CallOption) (*PodSpecTemplate, error)
+	DeletePodSpecTemplate(ctx context.Context, in *PodSpecTemplateRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type submitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) SubmitJobsStream(ctx context.Context, opts ...grpc.CallOption) (Submit_SubmitJobsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Submit_serviceDesc.Streams[0], "/api.Submit/SubmitJobsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &submitSubmitJobsStreamClient{stream}
+	return x, nil
+}
+
+type Submit_SubmitJobsStreamClient interface {
+	Send(*JobSubmitRequest) error
+	Recv() (*JobSubmitStreamResponse, error)
+	grpc.ClientStream
+}
+
+type submitSubmitJobsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *submitSubmitJobsStreamClient) Send(m *JobSubmitRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *submitSubmitJobsStreamClient) Recv() (*JobSubmitStreamResponse, error) {
+	m := new(JobSubmitStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CancelJobsSubmittedBefore(ctx context.Context, in *JobCancelSubmittedBeforeRequest, opts ...grpc.CallOption) (*CancellationCount, error) {
+	out := new(CancellationCount)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobsSubmittedBefore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CancelJobsInQueue(ctx context.Context, in *JobCancelQueueRequest, opts ...grpc.CallOption) (*QueueCancellationResult, error) {
+	out := new(QueueCancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobsInQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) PauseQueue(ctx context.Context, in *QueuePauseRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/PauseQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ResumeQueue(ctx context.Context, in *QueueResumeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
+	out := new(QueueInfo)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetJobCluster(ctx context.Context, in *JobClusterRequest, opts ...grpc.CallOption) (*JobClusterInfo, error) {
+	out := new(JobClusterInfo)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetJobCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetJobRequeueHistory(ctx context.Context, in *JobRequeueHistoryRequest, opts ...grpc.CallOption) (*JobRequeueHistory, error) {
+	out := new(JobRequeueHistory)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetJobRequeueHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetJobCancelReason(ctx context.Context, in *JobCancelReasonRequest, opts ...grpc.CallOption) (*JobCancelReason, error) {
+	out := new(JobCancelReason)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetJobCancelReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetPoolCapacity(ctx context.Context, in *PoolCapacityRequest, opts ...grpc.CallOption) (*PoolCapacityResponse, error) {
+	out := new(PoolCapacityResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetPoolCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetClusterCapacityReport(ctx context.Context, in *ClusterCapacityReportRequest, opts ...grpc.CallOption) (*ClusterCapacityReport, error) {
+	out := new(ClusterCapacityReport)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetClusterCapacityReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetJobIdByClientId(ctx context.Context, in *JobIdByClientIdRequest, opts ...grpc.CallOption) (*JobIdByClientIdResponse, error) {
+	out := new(JobIdByClientIdResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetJobIdByClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetQueueSchedulingStatus(ctx context.Context, in *QueueSchedulingStatusRequest, opts ...grpc.CallOption) (*QueueSchedulingStatus, error) {
+	out := new(QueueSchedulingStatus)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueSchedulingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CreatePodSpecTemplate(ctx context.Context, in *PodSpecTemplate, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreatePodSpecTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetPodSpecTemplate(ctx context.Context, in *PodSpecTemplateRequest, opts ...grpc.CallOption) (*PodSpecTemplate, error) {
+	out := new(PodSpecTemplate)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetPodSpecTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) DeletePodSpecTemplate(ctx context.Context, in *PodSpecTemplateRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeletePodSpecTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubmitServer is the server API for Submit service.
+type SubmitServer interface {
+	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
+	// All requests of the stream must be for the queue and job set of the first request
+	SubmitJobsStream(Submit_SubmitJobsStreamServer) error
+	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
+	CancelJobsSubmittedBefore(context.Context, *JobCancelSubmittedBeforeRequest) (*CancellationCount, error)
+	CancelJobsInQueue(context.Context, *JobCancelQueueRequest) (*QueueCancellationResult, error)
+	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
+	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
+	PauseQueue(context.Context, *QueuePauseRequest) (*types.Empty, error)
+	ResumeQueue(context.Context, *QueueResumeRequest) (*types.Empty, error)
+	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+	GetJobCluster(context.Context, *JobClusterRequest) (*JobClusterInfo, error)
+	GetJobRequeueHistory(context.Context, *JobRequeueHistoryRequest) (*JobRequeueHistory, error)
+	GetJobCancelReason(context.Context, *JobCancelReasonRequest) (*JobCancelReason, error)
+	GetPoolCapacity(context.Context, *PoolCapacityRequest) (*PoolCapacityResponse, error)
+	GetClusterCapacityReport(context.Context, *ClusterCapacityReportRequest) (*ClusterCapacityReport, error)
+	GetJobIdByClientId(context.Context, *JobIdByClientIdRequest) (*JobIdByClientIdResponse, error)
+	GetQueueSchedulingStatus(context.Context, *QueueSchedulingStatusRequest) (*QueueSchedulingStatus, error)
+	CreatePodSpecTemplate(context.Context, *PodSpecTemplate) (*types.Empty, error)
+	GetPodSpecTemplate(context.Context, *PodSpecTemplateRequest) (*PodSpecTemplate, error)
+	DeletePodSpecTemplate(context.Context, *PodSpecTemplateRequest) (*types.Empty, error)
+}
+
+// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
+type UnimplementedSubmitServer struct {
+}
+
+func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) SubmitJobsStream(srv Submit_SubmitJobsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubmitJobsStream not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobsSubmittedBefore(ctx context.Context, req *JobCancelSubmittedBeforeRequest) (*CancellationCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobsSubmittedBefore not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobsInQueue(ctx context.Context, req *JobCancelQueueRequest) (*QueueCancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobsInQueue not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (*UnimplementedSubmitServer) PauseQueue(ctx context.Context, req *QueuePauseRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseQueue not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeQueue(ctx context.Context, req *QueueResumeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+}
+func (*UnimplementedSubmitServer) GetJobCluster(ctx context.Context, req *JobClusterRequest) (*JobClusterInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobCluster not implemented")
+}
+func (*UnimplementedSubmitServer) GetJobRequeueHistory(ctx context.Context, req *JobRequeueHistoryRequest) (*JobRequeueHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRequeueHistory not implemented")
+}
+func (*UnimplementedSubmitServer) GetJobCancelReason(ctx context.Context, req *JobCancelReasonRequest) (*JobCancelReason, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobCancelReason not implemented")
+}
+func (*UnimplementedSubmitServer) GetPoolCapacity(ctx context.Context, req *PoolCapacityRequest) (*PoolCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolCapacity not implemented")
+}
+func (*UnimplementedSubmitServer) GetClusterCapacityReport(ctx context.Context, req *ClusterCapacityReportRequest) (*ClusterCapacityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterCapacityReport not implemented")
+}
+func (*UnimplementedSubmitServer) GetJobIdByClientId(ctx context.Context, req *JobIdByClientIdRequest) (*JobIdByClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobIdByClientId not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueueSchedulingStatus(ctx context.Context, req *QueueSchedulingStatusRequest) (*QueueSchedulingStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueSchedulingStatus not implemented")
+}
+func (*UnimplementedSubmitServer) CreatePodSpecTemplate(ctx context.Context, req *PodSpecTemplate) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePodSpecTemplate not implemented")
+}
+func (*UnimplementedSubmitServer) GetPodSpecTemplate(ctx context.Context, req *PodSpecTemplateRequest) (*PodSpecTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPodSpecTemplate not implemented")
+}
+func (*UnimplementedSubmitServer) DeletePodSpecTemplate(ctx context.Context, req *PodSpecTemplateRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePodSpecTemplate not implemented")
+}
+
+func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
+	s.RegisterService(&_Submit_serviceDesc, srv)
+}
+
+func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_SubmitJobsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SubmitServer).SubmitJobsStream(&submitSubmitJobsStreamServer{stream})
+}
+
+type Submit_SubmitJobsStreamServer interface {
+	Send(*JobSubmitStreamResponse) error
+	Recv() (*JobSubmitRequest, error)
+	grpc.ServerStream
+}
+
+type submitSubmitJobsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *submitSubmitJobsStreamServer) Send(m *JobSubmitStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *submitSubmitJobsStreamServer) Recv() (*JobSubmitRequest, error) {
+	m := new(JobSubmitRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobsSubmittedBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelSubmittedBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobsSubmittedBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobsSubmittedBefore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobsSubmittedBefore(ctx, req.(*JobCancelSubmittedBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobsInQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobsInQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobsInQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobsInQueue(ctx, req.(*JobCancelQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_PauseQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).PauseQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/PauseQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).PauseQueue(ctx, req.(*QueuePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ResumeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeQueue(ctx, req.(*QueueResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetJobCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetJobCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetJobCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetJobCluster(ctx, req.(*JobClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetJobRequeueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequeueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetJobRequeueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetJobRequeueHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetJobRequeueHistory(ctx, req.(*JobRequeueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetJobCancelReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetJobCancelReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetJobCancelReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetJobCancelReason(ctx, req.(*JobCancelReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetPoolCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetPoolCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetPoolCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetPoolCapacity(ctx, req.(*PoolCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetClusterCapacityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCapacityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetClusterCapacityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetClusterCapacityReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetClusterCapacityReport(ctx, req.(*ClusterCapacityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetJobIdByClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobIdByClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetJobIdByClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetJobIdByClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetJobIdByClientId(ctx, req.(*JobIdByClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetQueueSchedulingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSchedulingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueSchedulingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueSchedulingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueSchedulingStatus(ctx, req.(*QueueSchedulingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CreatePodSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSpecTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CreatePodSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreatePodSpecTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreatePodSpecTemplate(ctx, req.(*PodSpecTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetPodSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSpecTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetPodSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetPodSpecTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetPodSpecTemplate(ctx, req.(*PodSpecTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_DeletePodSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSpecTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).DeletePodSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeletePodSpecTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeletePodSpecTemplate(ctx, req.(*PodSpecTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobsSubmittedBefore",
+			Handler:    _Submit_CancelJobsSubmittedBefore_Handler,
+		},
+		{
+			MethodName: "CancelJobsInQueue",
+			Handler:    _Submit_CancelJobsInQueue_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Submit_CreateQueue_Handler,
+		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _Submit_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Submit_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "PauseQueue",
+			Handler:    _Submit_PauseQueue_Handler,
+		},
+		{
+			MethodName: "ResumeQueue",
+			Handler:    _Submit_ResumeQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueInfo",
+			Handler:    _Submit_GetQueueInfo_Handler,
+		},
+		{
+			MethodName: "GetJobCluster",
+			Handler:    _Submit_GetJobCluster_Handler,
+		},
+		{
+			MethodName: "GetJobRequeueHistory",
+			Handler:    _Submit_GetJobRequeueHistory_Handler,
+		},
+		{
+			MethodName: "GetJobCancelReason",
+			Handler:    _Submit_GetJobCancelReason_Handler,
+		},
+		{
+			MethodName: "GetPoolCapacity",
+			Handler:    _Submit_GetPoolCapacity_Handler,
+		},
+		{
+			MethodName: "GetClusterCapacityReport",
+			Handler:    _Submit_GetClusterCapacityReport_Handler,
+		},
+		{
+			MethodName: "GetJobIdByClientId",
+			Handler:    _Submit_GetJobIdByClientId_Handler,
+		},
+		{
+			MethodName: "GetQueueSchedulingStatus",
+			Handler:    _Submit_GetQueueSchedulingStatus_Handler,
+		},
+		{
+			MethodName: "CreatePodSpecTemplate",
+			Handler:    _Submit_CreatePodSpecTemplate_Handler,
+		},
+		{
+			MethodName: "GetPodSpecTemplate",
+			Handler:    _Submit_GetPodSpecTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePodSpecTemplate",
+			Handler:    _Submit_DeletePodSpecTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubmitJobsStream",
+			Handler:       _Submit_SubmitJobsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/api/submit.proto",
+}
+
+func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToleratesPreemptibleNodes {
+		i--
+		if m.ToleratesPreemptibleNodes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Deadline != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSubmit(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ContainerOverrides) > 0 {
+		for iNdEx := len(m.ContainerOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PodSpecTemplate) > 0 {
+		i -= len(m.PodSpecTemplate)
+		copy(dAtA[i:], m.PodSpecTemplate)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.PodSpecTemplate)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.GangSize != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.GangSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.GangId) > 0 {
+		i -= len(m.GangId)
+		copy(dAtA[i:], m.GangId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.GangId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RequiredClusters) > 0 {
+		for iNdEx := len(m.RequiredClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredClusters[iNdEx])
+			copy(dAtA[i:], m.RequiredClusters[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RequiredClusters[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ServicePorts) > 0 {
+		for iNdEx := len(m.ServicePorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServicePorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MinKubernetesVersion) > 0 {
+		i -= len(m.MinKubernetesVersion)
+		copy(dAtA[i:], m.MinKubernetesVersion)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.MinKubernetesVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSubmit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectIfQueuePaused {
+		i--
+		if m.RejectIfQueuePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobRequestItems) > 0 {
+		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelSubmittedBeforeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelSubmittedBeforeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelSubmittedBeforeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedBefore):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSubmit(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NotReprioritized) > 0 {
+		for k := range m.NotReprioritized {
+			v := m.NotReprioritized[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReprioritizedIds) > 0 {
+		for iNdEx := len(m.ReprioritizedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReprioritizedIds[iNdEx])
+			copy(dAtA[i:], m.ReprioritizedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ReprioritizedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.FirstItemIndex != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.FirstItemIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitStreamFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitStreamFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitStreamFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedItemIndex != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.FailedItemIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSchedulingFeasibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSchedulingFeasibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSchedulingFeasibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LargestNodeTypes) > 0 {
+		for iNdEx := len(m.LargestNodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LargestNodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.JobIndex != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.JobIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterSchedulingFeasibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterSchedulingFeasibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterSchedulingFeasibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for iNdEx := len(m.NodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypeSchedulingFeasibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypeSchedulingFeasibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypeSchedulingFeasibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllocatableResources) > 0 {
+		for k := range m.AllocatableResources {
+			v := m.AllocatableResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolNodeType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolNodeType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolNodeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllocatableResources) > 0 {
+		for k := range m.AllocatableResources {
+			v := m.AllocatableResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Queue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VolumeClaimTemplate != nil {
+		{
+			size, err := m.VolumeClaimTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ResourceQuota) > 0 {
+		for k := range m.ResourceQuota {
+			v := m.ResourceQuota[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DefaultPodLabels) > 0 {
+		for k := range m.DefaultPodLabels {
+			v := m.DefaultPodLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RequireEmptyDirSizeLimit {
+		i--
+		if m.RequireEmptyDirSizeLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.AllowedVolumeTypes) > 0 {
+		for iNdEx := len(m.AllowedVolumeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedVolumeTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedVolumeTypes[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.AllowedVolumeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ResourceFloor) > 0 {
+		for k := range m.ResourceFloor {
+			v := m.ResourceFloor[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RequireContainerCommand {
+		i--
+		if m.RequireContainerCommand {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxPodSpecSizeBytes != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxPodSpecSizeBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SchedulingWindows) > 0 {
+		for iNdEx := len(m.SchedulingWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulingWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EventRetention != nil {
+		{
+			size, err := m.EventRetention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k := range m.ResourceLimits {
+			v := m.ResourceLimits[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupOwners[iNdEx])
+			copy(dAtA[i:], m.GroupOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserOwners) > 0 {
+		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserOwners[iNdEx])
+			copy(dAtA[i:], m.UserOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PriorityFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Days) > 0 {
+		for iNdEx := len(m.Days) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Days[iNdEx])
+			copy(dAtA[i:], m.Days[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Days[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEventRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEventRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEventRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLength != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RetentionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetentionDuration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintSubmit(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelledCount != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.CancelledCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueCancellationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueCancellationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueCancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AlreadyFinishedCount != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.AlreadyFinishedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CancelledCount != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.CancelledCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourcesLeased) > 0 {
+		for k := range m.ResourcesLeased {
+			v := m.ResourcesLeased[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ResourceQuota) > 0 {
+		for k := range m.ResourceQuota {
+			v := m.ResourceQuota[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EventRetention != nil {
+		{
+			size, err := m.EventRetention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActiveJobSets) > 0 {
+		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Leased {
+		i--
+		if m.Leased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRequeueHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRequeueHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRequeueHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRequeueHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRequeueHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRequeueHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requeues) > 0 {
+		for iNdEx := len(m.Requeues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requeues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelReasonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelReasonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelReasonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Clusters != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Clusters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalAllocatable) > 0 {
+		for k := range m.TotalAllocatable {
+			v := m.TotalAllocatable[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LargestNodeAllocatable) > 0 {
+		for k := range m.LargestNodeAllocatable {
+			v := m.LargestNodeAllocatable[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCapacityReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCapacityReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCapacityReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReportAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReportAge):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintSubmit(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	if len(m.NodeTypes) > 0 {
+		for iNdEx := len(m.NodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Available) > 0 {
+		for k := range m.Available {
+			v := m.Available[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Used) > 0 {
+		for k := range m.Used {
+			v := m.Used[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allocatable) > 0 {
+		for k := range m.Allocatable {
+			v := m.Allocatable[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCapacityReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCapacityReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCapacityReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReportAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReportAge):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintSubmit(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Available) > 0 {
+		for k := range m.Available {
+			v := m.Available[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Used) > 0 {
+		for k := range m.Used {
+			v := m.Used[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allocatable) > 0 {
+		for k := range m.Allocatable {
+			v := m.Allocatable[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCapacityReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCapacityReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCapacityReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobIdByClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobIdByClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobIdByClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobIdByClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobIdByClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobIdByClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuePauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuePauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuePauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PodSpecTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodSpecTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodSpecTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PodSpecTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodSpecTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodSpecTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingStatusReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingStatusReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingStatusReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Schedulable {
+		i--
+		if m.Schedulable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmitRequestItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodSpecs) > 0 {
+		for _, e := range m.PodSpecs {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.MinKubernetesVersion)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ServicePorts) > 0 {
+		for _, e := range m.ServicePorts {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxRetries))
+	}
+	if len(m.RequiredClusters) > 0 {
+		for _, s := range m.RequiredClusters {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.GangId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.GangSize != 0 {
+		n += 1 + sovSubmit(uint64(m.GangSize))
+	}
+	l = len(m.PodSpecTemplate)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ContainerOverrides) > 0 {
+		for _, e := range m.ContainerOverrides {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.Deadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline)
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if m.ToleratesPreemptibleNodes {
+		n += 3
+	}
+	return n
+}
+
+func (m *ContainerOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = m.Resources.Size()
+	n += 1 + l + sovSubmit(uint64(l))
+	return n
+}
+
+func (m *JobSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobRequestItems) > 0 {
+		for _, e := range m.JobRequestItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.RejectIfQueuePaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelSubmittedBeforeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedBefore)
+	n += 1 + l + sovSubmit(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *JobReprioritizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReprioritizedIds) > 0 {
+		for _, s := range m.ReprioritizedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.NotReprioritized) > 0 {
+		for k, v := range m.NotReprioritized {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobCancelQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobSubmitStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstItemIndex != 0 {
+		n += 1 + sovSubmit(uint64(m.FirstItemIndex))
+	}
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobSubmitStreamFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailedItemIndex != 0 {
+		n += 1 + sovSubmit(uint64(m.FailedItemIndex))
+	}
+	return n
+}
+
+func (m *JobSchedulingFeasibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobIndex != 0 {
+		n += 1 + sovSubmit(uint64(m.JobIndex))
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.LargestNodeTypes) > 0 {
+		for _, e := range m.LargestNodeTypes {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterSchedulingFeasibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.NodeTypes) > 0 {
+		for _, e := range m.NodeTypes {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeTypeSchedulingFeasibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AllocatableResources) > 0 {
+		for k, v := range m.AllocatableResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolNodeType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AllocatableResources) > 0 {
+		for k, v := range m.AllocatableResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PriorityFactor != 0 {
+		n += 9
+	}
+	if len(m.UserOwners) > 0 {
+		for _, s := range m.UserOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for _, s := range m.GroupOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k, v := range m.ResourceLimits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.EventRetention != nil {
+		l = m.EventRetention.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.SchedulingWindows) > 0 {
+		for _, e := range m.SchedulingWindows {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.MaxPodSpecSizeBytes != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxPodSpecSizeBytes))
+	}
+	if m.RequireContainerCommand {
+		n += 2
+	}
+	if len(m.ResourceFloor) > 0 {
+		for k, v := range m.ResourceFloor {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.AllowedVolumeTypes) > 0 {
+		for _, s := range m.AllowedVolumeTypes {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.RequireEmptyDirSizeLimit {
+		n += 2
+	}
+	if len(m.DefaultPodLabels) > 0 {
+		for k, v := range m.DefaultPodLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.ResourceQuota) > 0 {
+		for k, v := range m.ResourceQuota {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 2 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if m.VolumeClaimTemplate != nil {
+		l = m.VolumeClaimTemplate.Size()
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueSchedulingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Days) > 0 {
+		for _, s := range m.Days {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueEventRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetentionDuration)
+	n += 1 + l + sovSubmit(uint64(l))
+	if m.MaxLength != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxLength))
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancellationCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelledCount != 0 {
+		n += 1 + sovSubmit(uint64(m.CancelledCount))
+	}
+	return n
+}
+
+func (m *QueueCancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelledCount != 0 {
+		n += 1 + sovSubmit(uint64(m.CancelledCount))
+	}
+	if m.AlreadyFinishedCount != 0 {
+		n += 1 + sovSubmit(uint64(m.AlreadyFinishedCount))
+	}
+	return n
+}
+
+func (m *QueueInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ActiveJobSets) > 0 {
+		for _, e := range m.ActiveJobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.EventRetention != nil {
+		l = m.EventRetention.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ResourceQuota) > 0 {
+		for k, v := range m.ResourceQuota {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ResourcesLeased) > 0 {
+		for k, v := range m.ResourcesLeased {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	return n
+}
+
+func (m *JobClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Leased {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobRequeueHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRequeueHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Requeues) > 0 {
+		for _, e := range m.Requeues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobCancelReasonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.LargestNodeAllocatable) > 0 {
+		for k, v := range m.LargestNodeAllocatable {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TotalAllocatable) > 0 {
+		for k, v := range m.TotalAllocatable {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.Clusters != 0 {
+		n += 1 + sovSubmit(uint64(m.Clusters))
+	}
+	return n
+}
+
+func (m *PoolCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterCapacityReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Allocatable) > 0 {
+		for k, v := range m.Allocatable {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Available) > 0 {
+		for k, v := range m.Available {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NodeTypes) > 0 {
+		for _, e := range m.NodeTypes {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReportAge)
+	n += 1 + l + sovSubmit(uint64(l))
+	return n
+}
+
+func (m *PoolCapacityReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Allocatable) > 0 {
+		for k, v := range m.Allocatable {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Available) > 0 {
+		for k, v := range m.Available {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReportAge)
+	n += 1 + l + sovSubmit(uint64(l))
+	return n
+}
+
+func (m *ClusterCapacityReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobIdByClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobIdByClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuePauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *PodSpecTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *PodSpecTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueSchedulingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueSchedulingStatusReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovSubmit(uint64(m.Reason))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueSchedulingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Schedulable {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, e := range m.Reasons {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSubmit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmit(x uint64) (n int) {
+	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobSubmitRequestItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPodSpecs := "[]*PodSpec{"
+	for _, f := range this.PodSpecs {
+		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
+	}
+	repeatedStringForPodSpecs += "}"
+	repeatedStringForServicePorts := "[]*ServicePort{"
+	for _, f := range this.ServicePorts {
+		repeatedStringForServicePorts += strings.Replace(fmt.Sprintf("%v", f), "ServicePort", "v1.ServicePort", 1) + ","
+	}
+	repeatedStringForServicePorts += "}"
+	repeatedStringForContainerOverrides := "[]*ContainerOverride{"
+	for _, f := range this.ContainerOverrides {
+		repeatedStringForContainerOverrides += strings.Replace(f.String(), "ContainerOverride", "ContainerOverride", 1) + ","
+	}
+	repeatedStringForContainerOverrides += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k, _ := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
+	for k, _ := range this.RequiredNodeLabels {
+		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
+	mapStringForRequiredNodeLabels := "map[string]string{"
+	for _, k := range keysForRequiredNodeLabels {
+		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
+	}
+	mapStringForRequiredNodeLabels += "}"
+	s := strings.Join([]string{`&JobSubmitRequestItem{`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
+		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
+		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
+		`MinKubernetesVersion:` + fmt.Sprintf("%v", this.MinKubernetesVersion) + `,`,
+		`ServicePorts:` + repeatedStringForServicePorts + `,`,
+		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
+		`RequiredClusters:` + fmt.Sprintf("%v", this.RequiredClusters) + `,`,
+		`GangId:` + fmt.Sprintf("%v", this.GangId) + `,`,
+		`GangSize:` + fmt.Sprintf("%v", this.GangSize) + `,`,
+		`PodSpecTemplate:` + fmt.Sprintf("%v", this.PodSpecTemplate) + `,`,
+		`ContainerOverrides:` + repeatedStringForContainerOverrides + `,`,
+		`Deadline:` + strings.Replace(fmt.Sprintf("%v", this.Deadline), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ToleratesPreemptibleNodes:` + fmt.Sprintf("%v", this.ToleratesPreemptibleNodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerOverride{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
+	for _, f := range this.JobRequestItems {
+		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+	}
+	repeatedStringForJobRequestItems += "}"
+	s := strings.Join([]string{`&JobSubmitRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`RejectIfQueuePaused:` + fmt.Sprintf("%v", this.RejectIfQueuePaused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelSubmittedBeforeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelSubmittedBeforeRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`SubmittedBefore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SubmittedBefore), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizeRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNotReprioritized := make([]string, 0, len(this.NotReprioritized))
+	for k, _ := range this.NotReprioritized {
+		keysForNotReprioritized = append(keysForNotReprioritized, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNotReprioritized)
+	mapStringForNotReprioritized := "map[string]string{"
+	for _, k := range keysForNotReprioritized {
+		mapStringForNotReprioritized += fmt.Sprintf("%v: %v,", k, this.NotReprioritized[k])
+	}
+	mapStringForNotReprioritized += "}"
+	s := strings.Join([]string{`&JobReprioritizeResponse{`,
+		`ReprioritizedIds:` + fmt.Sprintf("%v", this.ReprioritizedIds) + `,`,
+		`NotReprioritized:` + mapStringForNotReprioritized + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelQueueRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponseItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmitResponseItem{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
+	for _, f := range this.JobResponseItems {
+		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	}
+	repeatedStringForJobResponseItems += "}"
+	s := strings.Join([]string{`&JobSubmitResponse{`,
+		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
+	for _, f := range this.JobResponseItems {
+		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	}
+	repeatedStringForJobResponseItems += "}"
+	s := strings.Join([]string{`&JobSubmitStreamResponse{`,
+		`FirstItemIndex:` + fmt.Sprintf("%v", this.FirstItemIndex) + `,`,
+		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitStreamFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmitStreamFailure{`,
+		`FailedItemIndex:` + fmt.Sprintf("%v", this.FailedItemIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSchedulingFeasibility) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*ClusterSchedulingFeasibility{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "ClusterSchedulingFeasibility", "ClusterSchedulingFeasibility", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	repeatedStringForLargestNodeTypes := "[]*PoolNodeType{"
+	for _, f := range this.LargestNodeTypes {
+		repeatedStringForLargestNodeTypes += strings.Replace(f.String(), "PoolNodeType", "PoolNodeType", 1) + ","
+	}
+	repeatedStringForLargestNodeTypes += "}"
+	s := strings.Join([]string{`&JobSchedulingFeasibility{`,
+		`JobIndex:` + fmt.Sprintf("%v", this.JobIndex) + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`LargestNodeTypes:` + repeatedStringForLargestNodeTypes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterSchedulingFeasibility) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodeTypes := "[]*NodeTypeSchedulingFeasibility{"
+	for _, f := range this.NodeTypes {
+		repeatedStringForNodeTypes += strings.Replace(f.String(), "NodeTypeSchedulingFeasibility", "NodeTypeSchedulingFeasibility", 1) + ","
+	}
+	repeatedStringForNodeTypes += "}"
+	s := strings.Join([]string{`&ClusterSchedulingFeasibility{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeTypeSchedulingFeasibility) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAllocatableResources := make([]string, 0, len(this.AllocatableResources))
+	for k, _ := range this.AllocatableResources {
+		keysForAllocatableResources = append(keysForAllocatableResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocatableResources)
+	mapStringForAllocatableResources := "map[string]resource.Quantity{"
+	for _, k := range keysForAllocatableResources {
+		mapStringForAllocatableResources += fmt.Sprintf("%v: %v,", k, this.AllocatableResources[k])
+	}
+	mapStringForAllocatableResources += "}"
+	s := strings.Join([]string{`&NodeTypeSchedulingFeasibility{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`AllocatableResources:` + mapStringForAllocatableResources + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolNodeType) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAllocatableResources := make([]string, 0, len(this.AllocatableResources))
+	for k, _ := range this.AllocatableResources {
+		keysForAllocatableResources = append(keysForAllocatableResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocatableResources)
+	mapStringForAllocatableResources := "map[string]resource.Quantity{"
+	for _, k := range keysForAllocatableResources {
+		mapStringForAllocatableResources += fmt.Sprintf("%v: %v,", k, this.AllocatableResources[k])
+	}
+	mapStringForAllocatableResources += "}"
+	s := strings.Join([]string{`&PoolNodeType{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`AllocatableResources:` + mapStringForAllocatableResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Queue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSchedulingWindows := "[]*QueueSchedulingWindow{"
+	for _, f := range this.SchedulingWindows {
+		repeatedStringForSchedulingWindows += strings.Replace(f.String(), "QueueSchedulingWindow", "QueueSchedulingWindow", 1) + ","
+	}
+	repeatedStringForSchedulingWindows += "}"
+	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
+	for k, _ := range this.ResourceLimits {
+		keysForResourceLimits = append(keysForResourceLimits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
+	mapStringForResourceLimits := "map[string]float64{"
+	for _, k := range keysForResourceLimits {
+		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+	}
+	mapStringForResourceLimits += "}"
+	keysForResourceFloor := make([]string, 0, len(this.ResourceFloor))
+	for k, _ := range this.ResourceFloor {
+		keysForResourceFloor = append(keysForResourceFloor, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceFloor)
+	mapStringForResourceFloor := "map[string]float64{"
+	for _, k := range keysForResourceFloor {
+		mapStringForResourceFloor += fmt.Sprintf("%v: %v,", k, this.ResourceFloor[k])
+	}
+	mapStringForResourceFloor += "}"
+	keysForDefaultPodLabels := make([]string, 0, len(this.DefaultPodLabels))
+	for k, _ := range this.DefaultPodLabels {
+		keysForDefaultPodLabels = append(keysForDefaultPodLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDefaultPodLabels)
+	mapStringForDefaultPodLabels := "map[string]string{"
+	for _, k := range keysForDefaultPodLabels {
+		mapStringForDefaultPodLabels += fmt.Sprintf("%v: %v,", k, this.DefaultPodLabels[k])
+	}
+	mapStringForDefaultPodLabels += "}"
+	keysForResourceQuota := make([]string, 0, len(this.ResourceQuota))
+	for k, _ := range this.ResourceQuota {
+		keysForResourceQuota = append(keysForResourceQuota, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceQuota)
+	mapStringForResourceQuota := "map[string]resource.Quantity{"
+	for _, k := range keysForResourceQuota {
+		mapStringForResourceQuota += fmt.Sprintf("%v: %v,", k, this.ResourceQuota[k])
+	}
+	mapStringForResourceQuota += "}"
+	s := strings.Join([]string{`&Queue{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
+		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
+		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
+		`ResourceLimits:` + mapStringForResourceLimits + `,`,
+		`EventRetention:` + strings.Replace(this.EventRetention.String(), "QueueEventRetention", "QueueEventRetention", 1) + `,`,
+		`SchedulingWindows:` + repeatedStringForSchedulingWindows + `,`,
+		`MaxPodSpecSizeBytes:` + fmt.Sprintf("%v", this.MaxPodSpecSizeBytes) + `,`,
+		`RequireContainerCommand:` + fmt.Sprintf("%v", this.RequireContainerCommand) + `,`,
+		`ResourceFloor:` + mapStringForResourceFloor + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`AllowedVolumeTypes:` + fmt.Sprintf("%v", this.AllowedVolumeTypes) + `,`,
+		`RequireEmptyDirSizeLimit:` + fmt.Sprintf("%v", this.RequireEmptyDirSizeLimit) + `,`,
+		`DefaultPodLabels:` + mapStringForDefaultPodLabels + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`ResourceQuota:` + mapStringForResourceQuota + `,`,
+		`UpdateMask:` + strings.Replace(fmt.Sprintf("%v", this.UpdateMask), "FieldMask", "types.FieldMask", 1) + `,`,
+		`VolumeClaimTemplate:` + strings.Replace(fmt.Sprintf("%v", this.VolumeClaimTemplate), "VolumeClaimTemplate", "VolumeClaimTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueSchedulingWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueSchedulingWindow{`,
+		`Days:` + fmt.Sprintf("%v", this.Days) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueEventRetention) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueEventRetention{`,
+		`RetentionDuration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RetentionDuration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`MaxLength:` + fmt.Sprintf("%v", this.MaxLength) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancellationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancellationResult{`,
+		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancellationCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancellationCount{`,
+		`CancelledCount:` + fmt.Sprintf("%v", this.CancelledCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueCancellationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueCancellationResult{`,
+		`CancelledCount:` + fmt.Sprintf("%v", this.CancelledCount) + `,`,
+		`AlreadyFinishedCount:` + fmt.Sprintf("%v", this.AlreadyFinishedCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueInfoRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueDeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueDeleteRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
+	for _, f := range this.ActiveJobSets {
+		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForActiveJobSets += "}"
+	keysForResourceQuota := make([]string, 0, len(this.ResourceQuota))
+	for k, _ := range this.ResourceQuota {
+		keysForResourceQuota = append(keysForResourceQuota, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceQuota)
+	mapStringForResourceQuota := "map[string]resource.Quantity{"
+	for _, k := range keysForResourceQuota {
+		mapStringForResourceQuota += fmt.Sprintf("%v: %v,", k, this.ResourceQuota[k])
+	}
+	mapStringForResourceQuota += "}"
+	keysForResourcesLeased := make([]string, 0, len(this.ResourcesLeased))
+	for k, _ := range this.ResourcesLeased {
+		keysForResourcesLeased = append(keysForResourcesLeased, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesLeased)
+	mapStringForResourcesLeased := "map[string]resource.Quantity{"
+	for _, k := range keysForResourcesLeased {
+		mapStringForResourcesLeased += fmt.Sprintf("%v: %v,", k, this.ResourcesLeased[k])
+	}
+	mapStringForResourcesLeased += "}"
+	s := strings.Join([]string{`&QueueInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
+		`EventRetention:` + strings.Replace(this.EventRetention.String(), "QueueEventRetention", "QueueEventRetention", 1) + `,`,
+		`ResourceQuota:` + mapStringForResourceQuota + `,`,
+		`ResourcesLeased:` + mapStringForResourcesLeased + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
+		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobClusterRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobClusterInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobClusterInfo{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Leased:` + fmt.Sprintf("%v", this.Leased) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRequeueHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRequeueHistoryRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRequeueHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequeues := "[]*JobRequeue{"
+	for _, f := range this.Requeues {
+		repeatedStringForRequeues += strings.Replace(fmt.Sprintf("%v", f), "JobRequeue", "JobRequeue", 1) + ","
+	}
+	repeatedStringForRequeues += "}"
+	s := strings.Join([]string{`&JobRequeueHistory{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Requeues:` + repeatedStringForRequeues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelReasonRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelReasonRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelReason) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelReason{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolCapacityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PoolCapacityRequest{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLargestNodeAllocatable := make([]string, 0, len(this.LargestNodeAllocatable))
+	for k, _ := range this.LargestNodeAllocatable {
+		keysForLargestNodeAllocatable = append(keysForLargestNodeAllocatable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLargestNodeAllocatable)
+	mapStringForLargestNodeAllocatable := "map[string]resource.Quantity{"
+	for _, k := range keysForLargestNodeAllocatable {
+		mapStringForLargestNodeAllocatable += fmt.Sprintf("%v: %v,", k, this.LargestNodeAllocatable[k])
+	}
+	mapStringForLargestNodeAllocatable += "}"
+	keysForTotalAllocatable := make([]string, 0, len(this.TotalAllocatable))
+	for k, _ := range this.TotalAllocatable {
+		keysForTotalAllocatable = append(keysForTotalAllocatable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTotalAllocatable)
+	mapStringForTotalAllocatable := "map[string]resource.Quantity{"
+	for _, k := range keysForTotalAllocatable {
+		mapStringForTotalAllocatable += fmt.Sprintf("%v: %v,", k, this.TotalAllocatable[k])
+	}
+	mapStringForTotalAllocatable += "}"
+	s := strings.Join([]string{`&PoolCapacity{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`LargestNodeAllocatable:` + mapStringForLargestNodeAllocatable + `,`,
+		`TotalAllocatable:` + mapStringForTotalAllocatable + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolCapacityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPools := "[]*PoolCapacity{"
+	for _, f := range this.Pools {
+		repeatedStringForPools += strings.Replace(f.String(), "PoolCapacity", "PoolCapacity", 1) + ","
+	}
+	repeatedStringForPools += "}"
+	s := strings.Join([]string{`&PoolCapacityResponse{`,
+		`Pools:` + repeatedStringForPools + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCapacityReportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCapacityReportRequest{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodeTypes := "[]*NodeType{"
+	for _, f := range this.NodeTypes {
+		repeatedStringForNodeTypes += strings.Replace(fmt.Sprintf("%v", f), "NodeType", "NodeType", 1) + ","
+	}
+	repeatedStringForNodeTypes += "}"
+	keysForAllocatable := make([]string, 0, len(this.Allocatable))
+	for k, _ := range this.Allocatable {
+		keysForAllocatable = append(keysForAllocatable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocatable)
+	mapStringForAllocatable := "map[string]resource.Quantity{"
+	for _, k := range keysForAllocatable {
+		mapStringForAllocatable += fmt.Sprintf("%v: %v,", k, this.Allocatable[k])
+	}
+	mapStringForAllocatable += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k, _ := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "map[string]resource.Quantity{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	keysForAvailable := make([]string, 0, len(this.Available))
+	for k, _ := range this.Available {
+		keysForAvailable = append(keysForAvailable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAvailable)
+	mapStringForAvailable := "map[string]resource.Quantity{"
+	for _, k := range keysForAvailable {
+		mapStringForAvailable += fmt.Sprintf("%v: %v,", k, this.Available[k])
+	}
+	mapStringForAvailable += "}"
+	s := strings.Join([]string{`&ClusterCapacity{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Allocatable:` + mapStringForAllocatable + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Available:` + mapStringForAvailable + `,`,
+		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
+		`ReportAge:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReportAge), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PoolCapacityReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*ClusterCapacity{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "ClusterCapacity", "ClusterCapacity", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	keysForAllocatable := make([]string, 0, len(this.Allocatable))
+	for k, _ := range this.Allocatable {
+		keysForAllocatable = append(keysForAllocatable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocatable)
+	mapStringForAllocatable := "map[string]resource.Quantity{"
+	for _, k := range keysForAllocatable {
+		mapStringForAllocatable += fmt.Sprintf("%v: %v,", k, this.Allocatable[k])
+	}
+	mapStringForAllocatable += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k, _ := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "map[string]resource.Quantity{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	keysForAvailable := make([]string, 0, len(this.Available))
+	for k, _ := range this.Available {
+		keysForAvailable = append(keysForAvailable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAvailable)
+	mapStringForAvailable := "map[string]resource.Quantity{"
+	for _, k := range keysForAvailable {
+		mapStringForAvailable += fmt.Sprintf("%v: %v,", k, this.Available[k])
+	}
+	mapStringForAvailable += "}"
+	s := strings.Join([]string{`&PoolCapacityReport{`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Allocatable:` + mapStringForAllocatable + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Available:` + mapStringForAvailable + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`ReportAge:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReportAge), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCapacityReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPools := "[]*PoolCapacityReport{"
+	for _, f := range this.Pools {
+		repeatedStringForPools += strings.Replace(f.String(), "PoolCapacityReport", "PoolCapacityReport", 1) + ","
+	}
+	repeatedStringForPools += "}"
+	s := strings.Join([]string{`&ClusterCapacityReport{`,
+		`Pools:` + repeatedStringForPools + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobIdByClientIdRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobIdByClientIdRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobIdByClientIdResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobIdByClientIdResponse{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueuePauseRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueuePauseRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueResumeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueResumeRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSpecTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSpecTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSpecTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSpecTemplateRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueSchedulingStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueSchedulingStatusRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueSchedulingStatusReason) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueSchedulingStatusReason{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueSchedulingStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReasons := "[]*QueueSchedulingStatusReason{"
+	for _, f := range this.Reasons {
+		repeatedStringForReasons += strings.Replace(f.String(), "QueueSchedulingStatusReason", "QueueSchedulingStatusReason", 1) + ","
+	}
+	repeatedStringForReasons += "}"
+	s := strings.Join([]string{`&QueueSchedulingStatus{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Schedulable:` + fmt.Sprintf("%v", this.Schedulable) + `,`,
+		`Reasons:` + repeatedStringForReasons + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSubmit(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
+			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinKubernetesVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinKubernetesVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicePorts = append(m.ServicePorts, &v1.ServicePort{})
+			if err := m.ServicePorts[len(m.ServicePorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredClusters = append(m.RequiredClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GangId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangSize", wireType)
+			}
+			m.GangSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GangSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerOverrides = append(m.ContainerOverrides, &ContainerOverride{})
+			if err := m.ContainerOverrides[len(m.ContainerOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToleratesPreemptibleNodes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToleratesPreemptibleNodes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
+			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectIfQueuePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectIfQueuePaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelSubmittedBeforeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelSubmittedBeforeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelSubmittedBeforeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmittedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprioritizedIds = append(m.ReprioritizedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotReprioritized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotReprioritized == nil {
+				m.NotReprioritized = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NotReprioritized[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstItemIndex", wireType)
+			}
+			m.FirstItemIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstItemIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitStreamFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitStreamFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitStreamFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedItemIndex", wireType)
+			}
+			m.FailedItemIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedItemIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSchedulingFeasibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSchedulingFeasibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSchedulingFeasibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIndex", wireType)
+			}
+			m.JobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ClusterSchedulingFeasibility{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargestNodeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LargestNodeTypes = append(m.LargestNodeTypes, &PoolNodeType{})
+			if err := m.LargestNodeTypes[len(m.LargestNodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterSchedulingFeasibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterSchedulingFeasibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterSchedulingFeasibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeTypes = append(m.NodeTypes, &NodeTypeSchedulingFeasibility{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeTypeSchedulingFeasibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeTypeSchedulingFeasibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeTypeSchedulingFeasibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatableResources == nil {
+				m.AllocatableResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatableResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolNodeType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolNodeType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolNodeType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatableResources == nil {
+				m.AllocatableResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatableResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Queue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriorityFactor = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceLimits[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventRetention == nil {
+				m.EventRetention = &QueueEventRetention{}
+			}
+			if err := m.EventRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchedulingWindows = append(m.SchedulingWindows, &QueueSchedulingWindow{})
+			if err := m.SchedulingWindows[len(m.SchedulingWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPodSpecSizeBytes", wireType)
+			}
+			m.MaxPodSpecSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPodSpecSizeBytes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireContainerCommand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireContainerCommand = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceFloor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceFloor == nil {
+				m.ResourceFloor = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceFloor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedVolumeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedVolumeTypes = append(m.AllowedVolumeTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireEmptyDirSizeLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireEmptyDirSizeLimit = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPodLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultPodLabels == nil {
+				m.DefaultPodLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DefaultPodLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceQuota == nil {
+				m.ResourceQuota = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceQuota[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolumeClaimTemplate == nil {
+				m.VolumeClaimTemplate = &VolumeClaimTemplate{}
+			}
+			if err := m.VolumeClaimTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueSchedulingWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueSchedulingWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueSchedulingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Days = append(m.Days, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueEventRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEventRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEventRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RetentionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
+			}
+			m.MaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledCount", wireType)
+			}
+			m.CancelledCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueCancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueCancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueCancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledCount", wireType)
+			}
+			m.CancelledCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyFinishedCount", wireType)
+			}
+			m.AlreadyFinishedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AlreadyFinishedCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
+			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventRetention == nil {
+				m.EventRetention = &QueueEventRetention{}
+			}
+			if err := m.EventRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceQuota == nil {
+				m.ResourceQuota = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceQuota[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesLeased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesLeased == nil {
+				m.ResourcesLeased = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesLeased[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+			}
+			m.QueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			}
+			m.LeasedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leased = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRequeueHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRequeueHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRequeueHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRequeueHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRequeueHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRequeueHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requeues = append(m.Requeues, &JobRequeue{})
+			if err := m.Requeues[len(m.Requeues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelReasonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelReasonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelReasonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargestNodeAllocatable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LargestNodeAllocatable == nil {
+				m.LargestNodeAllocatable = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LargestNodeAllocatable[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocatable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalAllocatable == nil {
+				m.TotalAllocatable = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalAllocatable[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			m.Clusters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Clusters |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolCapacity{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCapacityReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCapacityReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCapacityReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&JobSetInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
-		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringSubmit(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+func (m *ClusterCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1994,39 +15820,447 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowSubmit
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocatable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allocatable == nil {
+				m.Allocatable = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Allocatable[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 2:
+			if m.Available == nil {
+				m.Available = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Available[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2053,16 +16287,100 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
+			m.NodeTypes = append(m.NodeTypes, &NodeType{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ReportAge, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCapacityReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCapacityReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCapacityReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2090,11 +16408,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocatable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2121,11 +16439,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Allocatable == nil {
+				m.Allocatable = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2174,7 +16492,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -2184,24 +16502,26 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2217,11 +16537,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Allocatable[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2248,11 +16568,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Used == nil {
+				m.Used = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2301,7 +16621,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -2311,24 +16631,26 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2344,11 +16666,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Used[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2375,11 +16697,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
+			if m.Available == nil {
+				m.Available = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2404,231 +16726,82 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
-			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Available[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2638,27 +16811,29 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Clusters = append(m.Clusters, &ClusterCapacity{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2685,8 +16860,7 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
-			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ReportAge, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2714,7 +16888,7 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterCapacityReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2737,49 +16911,17 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCapacityReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCapacityReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2789,55 +16931,25 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Pools = append(m.Pools, &PoolCapacityReport{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2863,7 +16975,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+func (m *JobIdByClientIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2886,15 +16998,15 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobIdByClientIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobIdByClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2922,11 +17034,11 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2954,7 +17066,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2980,7 +17092,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *JobIdByClientIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3003,17 +17115,17 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobIdByClientIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobIdByClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3023,25 +17135,23 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
-			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3067,7 +17177,7 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Queue) Unmarshal(dAtA []byte) error {
+func (m *QueuePauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3090,10 +17200,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuePauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuePauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3128,52 +17238,62 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PriorityFactor = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,113 +17321,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = make(map[string]float64)
-			}
-			var mapkey string
-			var mapvalue float64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ResourceLimits[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3333,7 +17347,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+func (m *PodSpecTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3356,15 +17370,15 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSpecTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSpecTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3392,7 +17406,43 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3418,7 +17468,7 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *PodSpecTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3441,10 +17491,10 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSpecTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSpecTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3503,7 +17553,7 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueueSchedulingStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,15 +17576,15 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueSchedulingStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueSchedulingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3562,7 +17612,7 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3588,7 +17638,7 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *QueueSchedulingStatusReason) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3611,15 +17661,34 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueSchedulingStatusReason: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueSchedulingStatusReason: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= QueueNotScheduledReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3647,13 +17716,13 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3663,25 +17732,23 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
-			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3707,7 +17774,7 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *QueueSchedulingStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3730,15 +17797,15 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueSchedulingStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueSchedulingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3766,13 +17833,13 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedulable", wireType)
 			}
-			m.QueuedJobs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3782,16 +17849,17 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueuedJobs |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Schedulable = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
-			m.LeasedJobs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3801,11 +17869,26 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeasedJobs |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, &QueueSchedulingStatusReason{})
+			if err := m.Reasons[len(m.Reasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
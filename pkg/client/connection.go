@@ -21,6 +21,7 @@ type ApiConnectionDetails struct {
 	OpenIdClientCredentialsAuth oidc.ClientCredentialsDetails
 	KerberosAuth                kerberos.ClientConfig
 	ForceNoTls                  bool
+	Tls                         TlsConfig
 }
 
 func CreateApiConnection(config *ApiConnectionDetails, additionalDialOptions ...grpc.DialOption) (*grpc.ClientConn, error) {
@@ -34,11 +35,16 @@ func CreateApiConnection(config *ApiConnectionDetails, additionalDialOptions ...
 	unuaryInterceptors := grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...))
 	streamInterceptors := grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...))
 
+	transport, err := transportCredentials(config)
+	if err != nil {
+		return nil, err
+	}
+
 	dialOpts := append(additionalDialOptions,
 		defaultCallOptions,
 		unuaryInterceptors,
 		streamInterceptors,
-		transportCredentials(config))
+		transport)
 
 	creds, err := perRpcCredentials(config)
 	if err != nil {
@@ -70,9 +76,17 @@ func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredenti
 	return nil, nil
 }
 
-func transportCredentials(config *ApiConnectionDetails) grpc.DialOption {
+// Configured TLS material is used even for localhost, only ForceNoTls disables TLS then
+func transportCredentials(config *ApiConnectionDetails) (grpc.DialOption, error) {
+	if !config.ForceNoTls && config.Tls.IsConfigured() {
+		tlsConfig, err := config.Tls.ClientTlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+	}
 	if !config.ForceNoTls && !strings.Contains(config.ArmadaUrl, "localhost") {
-		return grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+		return grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")), nil
 	}
-	return grpc.WithInsecure()
+	return grpc.WithInsecure(), nil
 }
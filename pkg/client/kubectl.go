@@ -6,15 +6,16 @@ import (
 
 	"github.com/spf13/viper"
 
-	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/domain"
 )
 
+// Pods are selected by the job id and pod number labels, pod names depend on the pod name template of the executor
 func GetKubectlCommand(cluster string, namespace string, jobId string, podNumber int, cmd string) string {
 	t := viper.GetString("kubectlCommandTemplate")
 	if t == "" {
 		t = "kubectl --context {{cluster}} -n {{namespace}} {{cmd}} {{pod}}"
 	}
-	r := strings.NewReplacer("{{cluster}}", cluster, "{{namespace}}", namespace, "{{cmd}}", cmd, "{{pod}}", fmt.Sprintf("%s%s-%d", common.PodNamePrefix, jobId, podNumber))
+	r := strings.NewReplacer("{{cluster}}", cluster, "{{namespace}}", namespace, "{{cmd}}", cmd, "{{pod}}", fmt.Sprintf("-l %s=%s,%s=%d", domain.JobId, jobId, domain.PodNumber, podNumber))
 	command := r.Replace(t)
 
 	return command
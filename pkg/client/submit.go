@@ -16,6 +16,13 @@ func CreateQueue(submitClient api.SubmitClient, queue *api.Queue) error {
 	return e
 }
 
+func UpdateQueue(submitClient api.SubmitClient, queue *api.Queue) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.UpdateQueue(ctx, queue)
+	return e
+}
+
 func DeleteQueue(submitClient api.SubmitClient, name string) error {
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
@@ -23,6 +30,20 @@ func DeleteQueue(submitClient api.SubmitClient, name string) error {
 	return e
 }
 
+func PauseQueue(submitClient api.SubmitClient, name string) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.PauseQueue(ctx, &api.QueuePauseRequest{Name: name})
+	return e
+}
+
+func ResumeQueue(submitClient api.SubmitClient, name string) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.ResumeQueue(ctx, &api.QueueResumeRequest{Name: name})
+	return e
+}
+
 func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
 	AddClientIds(request.JobRequestItems)
 	ctx, cancel := common.ContextWithDefaultTimeout()
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Default maximum size of messages received by grpc servers
+const DefaultMaxSubmitMessageSize = 4 * 1024 * 1024
+
+type BatchSubmitOptions struct {
+	MaxMessageSize    int // in bytes, 0 uses DefaultMaxSubmitMessageSize
+	MaxJobsPerRequest int // 0 uses MaxJobsPerRequest
+	Concurrency       int // number of requests submitted at the same time, values below 2 submit sequentially
+}
+
+type ChunkSubmitError struct {
+	Chunk     int
+	FirstItem int // index of the first job of the chunk in the original request
+	ItemCount int
+	Err       error
+}
+
+func (e *ChunkSubmitError) Error() string {
+	return fmt.Sprintf("chunk %d (jobs %d to %d) failed: %s", e.Chunk, e.FirstItem, e.FirstItem+e.ItemCount-1, e.Err)
+}
+
+func (e *ChunkSubmitError) Unwrap() error {
+	return e.Err
+}
+
+type BatchSubmitError struct {
+	Failures []*ChunkSubmitError
+}
+
+func (e *BatchSubmitError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, failure.Error())
+	}
+	return fmt.Sprintf("failed to submit %d chunks: %s", len(e.Failures), strings.Join(messages, "; "))
+}
+
+// SubmitJobsInBatches splits the request into requests fitting the maximum message size and submits them.
+// Response items are in the same order as the request items, items of chunks which failed have Error set
+// and the returned error is a *BatchSubmitError listing the failed chunks.
+func SubmitJobsInBatches(submitClient api.SubmitClient, request *api.JobSubmitRequest, options BatchSubmitOptions) (*api.JobSubmitResponse, error) {
+	AddClientIds(request.JobRequestItems)
+	requests, e := SplitSubmitRequest(request, options.MaxMessageSize, options.MaxJobsPerRequest)
+	if e != nil {
+		return nil, e
+	}
+
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	responses := make([]*api.JobSubmitResponse, len(requests))
+	errs := make([]error, len(requests))
+	limit := make(chan bool, concurrency)
+	wg := sync.WaitGroup{}
+	for i, chunk := range requests {
+		wg.Add(1)
+		limit <- true
+		go func(i int, chunk *api.JobSubmitRequest) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			responses[i], errs[i] = SubmitJobs(submitClient, chunk)
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	result := &api.JobSubmitResponse{JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(request.JobRequestItems)), DryRun: request.DryRun}
+	batchError := &BatchSubmitError{}
+	firstItem := 0
+	for i, chunk := range requests {
+		itemCount := len(chunk.JobRequestItems)
+		if errs[i] == nil && len(responses[i].JobResponseItems) != itemCount {
+			errs[i] = fmt.Errorf("expected %d response items, got %d", itemCount, len(responses[i].JobResponseItems))
+		}
+		if errs[i] != nil {
+			failure := &ChunkSubmitError{Chunk: i, FirstItem: firstItem, ItemCount: itemCount, Err: errs[i]}
+			batchError.Failures = append(batchError.Failures, failure)
+			for j := 0; j < itemCount; j++ {
+				result.JobResponseItems = append(result.JobResponseItems, &api.JobSubmitResponseItem{Error: failure.Error()})
+			}
+		} else {
+			result.JobResponseItems = append(result.JobResponseItems, responses[i].JobResponseItems...)
+		}
+		firstItem += itemCount
+	}
+
+	if len(batchError.Failures) > 0 {
+		return result, batchError
+	}
+	return result, nil
+}
+
+type StreamSubmitError struct {
+	FailedItem int // index of the first job which was not submitted
+	Err        error
+}
+
+func (e *StreamSubmitError) Error() string {
+	return fmt.Sprintf("jobs from index %d were not submitted: %s", e.FailedItem, e.Err)
+}
+
+func (e *StreamSubmitError) Unwrap() error {
+	return e.Err
+}
+
+// SubmitJobsStream splits the request in the same way as SubmitJobsInBatches and sends all requests over one stream.
+// Response items are in the same order as the request items. When the server stops the stream, jobs before the failed one
+// stay submitted, items from the failed one on have Error set and the returned error is a *StreamSubmitError.
+func SubmitJobsStream(submitClient api.SubmitClient, request *api.JobSubmitRequest, options BatchSubmitOptions) (*api.JobSubmitResponse, error) {
+	AddClientIds(request.JobRequestItems)
+	requests, e := SplitSubmitRequest(request, options.MaxMessageSize, options.MaxJobsPerRequest)
+	if e != nil {
+		return nil, e
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, e := submitClient.SubmitJobsStream(ctx)
+	if e != nil {
+		return nil, e
+	}
+	go func() {
+		for _, chunk := range requests {
+			// the reason of a failed send is returned by Recv
+			if e := stream.Send(chunk); e != nil {
+				return
+			}
+		}
+		_ = stream.CloseSend()
+	}()
+
+	result := &api.JobSubmitResponse{JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(request.JobRequestItems)), DryRun: request.DryRun}
+	var streamError error
+	for {
+		response, e := stream.Recv()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			streamError = e
+			break
+		}
+		result.JobResponseItems = append(result.JobResponseItems, response.JobResponseItems...)
+	}
+
+	if streamError == nil && len(result.JobResponseItems) != len(request.JobRequestItems) {
+		streamError = fmt.Errorf("expected %d response items, got %d", len(request.JobRequestItems), len(result.JobResponseItems))
+	}
+	if streamError != nil {
+		failure := &StreamSubmitError{FailedItem: len(result.JobResponseItems), Err: streamError}
+		for i := failure.FailedItem; i < len(request.JobRequestItems); i++ {
+			result.JobResponseItems = append(result.JobResponseItems, &api.JobSubmitResponseItem{Error: failure.Error()})
+		}
+		return result, failure
+	}
+	return result, nil
+}
+
+// SplitSubmitRequest splits the request into requests of at most maxJobsPerRequest jobs with encoded size under maxMessageSize.
+// Consecutive members of a gang are kept in the same request even when they exceed the limits, as the server accepts only whole gangs.
+func SplitSubmitRequest(request *api.JobSubmitRequest, maxMessageSize int, maxJobsPerRequest int) ([]*api.JobSubmitRequest, error) {
+	if maxMessageSize <= 0 {
+		maxMessageSize = DefaultMaxSubmitMessageSize
+	}
+	if maxJobsPerRequest <= 0 {
+		maxJobsPerRequest = MaxJobsPerRequest
+	}
+	emptyRequest := &api.JobSubmitRequest{Queue: request.Queue, JobSetId: request.JobSetId, DryRun: request.DryRun, RejectIfQueuePaused: request.RejectIfQueuePaused}
+	baseSize := emptyRequest.Size()
+
+	requests := []*api.JobSubmitRequest{}
+	current := []*api.JobSubmitRequestItem{}
+	currentSize := baseSize
+	items := request.JobRequestItems
+	for i := 0; i < len(items); {
+		end := i + 1
+		for items[i].GangId != "" && end < len(items) && items[end].GangId == items[i].GangId {
+			end++
+		}
+		unit := items[i:end]
+		unitSize := 0
+		for j, item := range unit {
+			// size of the item including its field tag and length prefix
+			itemSize := (&api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{item}}).Size()
+			if baseSize+itemSize > maxMessageSize {
+				return nil, fmt.Errorf("job %d is %d bytes, which is over the maximum message size of %d bytes", i+j, itemSize, maxMessageSize)
+			}
+			unitSize += itemSize
+		}
+		if len(current) > 0 && (len(current)+len(unit) > maxJobsPerRequest || currentSize+unitSize > maxMessageSize) {
+			requests = append(requests, &api.JobSubmitRequest{Queue: request.Queue, JobSetId: request.JobSetId, JobRequestItems: current, DryRun: request.DryRun, RejectIfQueuePaused: request.RejectIfQueuePaused})
+			current = []*api.JobSubmitRequestItem{}
+			currentSize = baseSize
+		}
+		current = append(current, unit...)
+		currentSize += unitSize
+		i = end
+	}
+	if len(current) > 0 {
+		requests = append(requests, &api.JobSubmitRequest{Queue: request.Queue, JobSetId: request.JobSetId, JobRequestItems: current, DryRun: request.DryRun, RejectIfQueuePaused: request.RejectIfQueuePaused})
+	}
+	return requests, nil
+}
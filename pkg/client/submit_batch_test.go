@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestSplitSubmitRequest_RespectsMaxMessageSize(t *testing.T) {
+	request := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobset", JobRequestItems: createJobRequestItems(50)}
+	maxMessageSize := request.Size() / 4
+
+	result, e := SplitSubmitRequest(request, maxMessageSize, 0)
+	assert.NoError(t, e)
+
+	assert.True(t, len(result) >= 4)
+	position := 0
+	for _, chunk := range result {
+		assert.True(t, chunk.Size() <= maxMessageSize)
+		assert.Equal(t, "queue", chunk.Queue)
+		assert.Equal(t, "jobset", chunk.JobSetId)
+		for _, item := range chunk.JobRequestItems {
+			assert.Equal(t, request.JobRequestItems[position], item)
+			position++
+		}
+	}
+	assert.Equal(t, len(request.JobRequestItems), position)
+}
+
+func TestSplitSubmitRequest_RespectsMaxJobsPerRequest(t *testing.T) {
+	request := &api.JobSubmitRequest{JobRequestItems: createJobRequestItems(5)}
+
+	result, e := SplitSubmitRequest(request, 0, 2)
+	assert.NoError(t, e)
+
+	assert.Equal(t, 3, len(result))
+	assert.Equal(t, 1, len(result[2].JobRequestItems))
+}
+
+func TestSplitSubmitRequest_KeepsGangTogether(t *testing.T) {
+	request := &api.JobSubmitRequest{JobRequestItems: createJobRequestItems(5)}
+	for _, item := range request.JobRequestItems[1:4] {
+		item.GangId = "gang"
+		item.GangSize = 3
+	}
+
+	result, e := SplitSubmitRequest(request, 0, 2)
+	assert.NoError(t, e)
+
+	assert.Equal(t, 3, len(result))
+	assert.Equal(t, request.JobRequestItems[1:4], result[1].JobRequestItems)
+}
+
+func TestSplitSubmitRequest_KeepsDryRun(t *testing.T) {
+	request := &api.JobSubmitRequest{JobRequestItems: createJobRequestItems(3), DryRun: true}
+
+	result, e := SplitSubmitRequest(request, 0, 2)
+	assert.NoError(t, e)
+
+	for _, chunk := range result {
+		assert.True(t, chunk.DryRun)
+	}
+}
+
+func TestSplitSubmitRequest_FailsForJobOverMaxMessageSize(t *testing.T) {
+	request := &api.JobSubmitRequest{JobRequestItems: createJobRequestItems(1)}
+
+	_, e := SplitSubmitRequest(request, 10, 0)
+	assert.Error(t, e)
+}
+
+func TestSubmitJobsInBatches_PreservesOrderAndReportsFailedChunk(t *testing.T) {
+	submitClient := &fakeBatchSubmitClient{failedChunks: map[string]bool{"Container2": true}}
+	request := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobset", JobRequestItems: createJobRequestItems(7)}
+
+	response, e := SubmitJobsInBatches(submitClient, request, BatchSubmitOptions{MaxJobsPerRequest: 2, Concurrency: 3})
+
+	assert.Error(t, e)
+	batchError, ok := e.(*BatchSubmitError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(batchError.Failures))
+	assert.Equal(t, 1, batchError.Failures[0].Chunk)
+	assert.Equal(t, 2, batchError.Failures[0].FirstItem)
+	assert.Equal(t, 2, batchError.Failures[0].ItemCount)
+
+	assert.Equal(t, 7, len(response.JobResponseItems))
+	for i, item := range response.JobResponseItems {
+		if i == 2 || i == 3 {
+			assert.NotEmpty(t, item.Error)
+			assert.Empty(t, item.JobId)
+		} else {
+			assert.Empty(t, item.Error)
+			assert.Equal(t, request.JobRequestItems[i].ClientId, item.JobId)
+		}
+	}
+	assert.Equal(t, 4, submitClient.calls)
+}
+
+func TestSubmitJobsStream_PreservesOrderAndReportsFailedItem(t *testing.T) {
+	submitClient := &fakeBatchSubmitClient{failedChunks: map[string]bool{"Container2": true}}
+	request := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobset", JobRequestItems: createJobRequestItems(7)}
+
+	response, e := SubmitJobsStream(submitClient, request, BatchSubmitOptions{MaxJobsPerRequest: 2})
+
+	assert.Error(t, e)
+	streamError, ok := e.(*StreamSubmitError)
+	assert.True(t, ok)
+	assert.Equal(t, 2, streamError.FailedItem)
+
+	assert.Equal(t, 7, len(response.JobResponseItems))
+	for i, item := range response.JobResponseItems {
+		if i < 2 {
+			assert.Empty(t, item.Error)
+			assert.Equal(t, request.JobRequestItems[i].ClientId, item.JobId)
+		} else {
+			assert.NotEmpty(t, item.Error)
+			assert.Empty(t, item.JobId)
+		}
+	}
+}
+
+func TestSubmitJobsStream_SubmitsAllChunks(t *testing.T) {
+	submitClient := &fakeBatchSubmitClient{}
+	request := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobset", JobRequestItems: createJobRequestItems(5)}
+
+	response, e := SubmitJobsStream(submitClient, request, BatchSubmitOptions{MaxJobsPerRequest: 2})
+
+	assert.NoError(t, e)
+	assert.Equal(t, 5, len(response.JobResponseItems))
+	for i, item := range response.JobResponseItems {
+		assert.Equal(t, request.JobRequestItems[i].ClientId, item.JobId)
+	}
+	assert.Equal(t, 3, submitClient.calls)
+}
+
+// Returns client ids as job ids, fails requests containing a job with container named in failedChunks
+type fakeBatchSubmitClient struct {
+	api.SubmitClient
+	failedChunks map[string]bool
+
+	mutex sync.Mutex
+	calls int
+}
+
+func (c *fakeBatchSubmitClient) SubmitJobs(ctx context.Context, in *api.JobSubmitRequest, opts ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	c.mutex.Lock()
+	c.calls++
+	c.mutex.Unlock()
+
+	response := &api.JobSubmitResponse{}
+	for _, item := range in.JobRequestItems {
+		if c.failedChunks[item.PodSpec.Containers[0].Name] {
+			return nil, fmt.Errorf("failed")
+		}
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{JobId: item.ClientId})
+	}
+	return response, nil
+}
+
+func (c *fakeBatchSubmitClient) SubmitJobsStream(ctx context.Context, opts ...grpc.CallOption) (api.Submit_SubmitJobsStreamClient, error) {
+	return &fakeSubmitStreamClient{client: c, responses: make(chan fakeStreamResponse, 100)}, nil
+}
+
+type fakeStreamResponse struct {
+	response *api.JobSubmitStreamResponse
+	err      error
+}
+
+// Submits every sent request with the SubmitJobs of the fake client, the stream stops at the first failed request
+type fakeSubmitStreamClient struct {
+	grpc.ClientStream
+	client    *fakeBatchSubmitClient
+	responses chan fakeStreamResponse
+	failed    bool
+}
+
+func (s *fakeSubmitStreamClient) Send(request *api.JobSubmitRequest) error {
+	if s.failed {
+		return io.EOF
+	}
+	response, e := s.client.SubmitJobs(context.Background(), request)
+	if e != nil {
+		s.failed = true
+		s.responses <- fakeStreamResponse{err: e}
+		close(s.responses)
+		return io.EOF
+	}
+	s.responses <- fakeStreamResponse{response: &api.JobSubmitStreamResponse{JobResponseItems: response.JobResponseItems}}
+	return nil
+}
+
+func (s *fakeSubmitStreamClient) CloseSend() error {
+	close(s.responses)
+	return nil
+}
+
+func (s *fakeSubmitStreamClient) Recv() (*api.JobSubmitStreamResponse, error) {
+	response, ok := <-s.responses
+	if !ok {
+		return nil, io.EOF
+	}
+	return response.response, response.err
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+)
+
+// Client side TLS of the connection to the Armada server, when empty the server is verified with the system roots
+// and no client certificate is presented
+type TlsConfig struct {
+	CertFile string // Client certificate presented to the server for mutual TLS, together with KeyFile
+	KeyFile  string
+	CaFile   string // PEM bundle of CAs the server certificate is verified with instead of the system roots
+	// SPIFFE id the server certificate has to carry as URI SAN, e.g. spiffe://example.org/armada/server. An id without
+	// path accepts any id of the trust domain. Host name of the server is not verified then, SPIFFE certificates usually have none.
+	ServerSpiffeId string
+}
+
+func (c TlsConfig) IsConfigured() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CaFile != "" || c.ServerSpiffeId != ""
+}
+
+func (c TlsConfig) ClientTlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, fmt.Errorf("client certificate and key have to be configured together")
+	}
+	if c.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if c.CaFile != "" {
+		caBundle, err := ioutil.ReadFile(c.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %v", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", c.CaFile)
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	if c.ServerSpiffeId != "" {
+		expectedId, err := parseSpiffeId(c.ServerSpiffeId)
+		if err != nil {
+			return nil, err
+		}
+		// the certificate chain is still verified by verifySpiffeServer, only verification of the host name is skipped
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = verifySpiffeServer(tlsConfig.RootCAs, expectedId)
+	}
+	return tlsConfig, nil
+}
+
+func parseSpiffeId(id string) (*url.URL, error) {
+	parsed, err := url.Parse(id)
+	if err != nil || parsed.Scheme != "spiffe" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid SPIFFE id %s, expected spiffe://<trust domain>/<path>", id)
+	}
+	return parsed, nil
+}
+
+func verifySpiffeServer(roots *x509.CertPool, expectedId *url.URL) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("server presented no certificate")
+		}
+		certificates := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, raw := range rawCerts {
+			certificate, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("failed to parse server certificate: %v", err)
+			}
+			certificates = append(certificates, certificate)
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, certificate := range certificates[1:] {
+			intermediates.AddCert(certificate)
+		}
+		leaf := certificates[0]
+		_, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, uri := range leaf.URIs {
+			if matchesSpiffeId(uri, expectedId) {
+				return nil
+			}
+		}
+		return fmt.Errorf("server certificate does not have SPIFFE id %s", expectedId)
+	}
+}
+
+func matchesSpiffeId(id *url.URL, expectedId *url.URL) bool {
+	if id.Scheme != "spiffe" || id.Host != expectedId.Host {
+		return false
+	}
+	return expectedId.Path == "" || expectedId.Path == "/" || id.Path == expectedId.Path
+}
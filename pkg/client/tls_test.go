@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testServerSpiffeId = "spiffe://armada.test/server"
+
+func TestCreateApiConnection_MutualTlsWithServerSpiffeId(t *testing.T) {
+	withTlsServer(t, func(listener *bufconn.Listener, files *testTlsFiles) {
+		err := checkHealth(listener, TlsConfig{
+			CertFile: files.clientCert, KeyFile: files.clientKey, CaFile: files.ca, ServerSpiffeId: testServerSpiffeId,
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateApiConnection_AcceptsAnyServerIdOfTrustDomain(t *testing.T) {
+	withTlsServer(t, func(listener *bufconn.Listener, files *testTlsFiles) {
+		err := checkHealth(listener, TlsConfig{
+			CertFile: files.clientCert, KeyFile: files.clientKey, CaFile: files.ca, ServerSpiffeId: "spiffe://armada.test",
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateApiConnection_RejectsServerWithDifferentSpiffeId(t *testing.T) {
+	withTlsServer(t, func(listener *bufconn.Listener, files *testTlsFiles) {
+		err := checkHealth(listener, TlsConfig{
+			CertFile: files.clientCert, KeyFile: files.clientKey, CaFile: files.ca, ServerSpiffeId: "spiffe://armada.test/other",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateApiConnection_RejectedByServerWithoutClientCertificate(t *testing.T) {
+	withTlsServer(t, func(listener *bufconn.Listener, files *testTlsFiles) {
+		err := checkHealth(listener, TlsConfig{CaFile: files.ca, ServerSpiffeId: testServerSpiffeId})
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateApiConnection_RejectsServerNotSignedByCa(t *testing.T) {
+	withTlsServer(t, func(listener *bufconn.Listener, files *testTlsFiles) {
+		otherCa, _ := createTestCa(t)
+		otherCaFile := filepath.Join(filepath.Dir(files.ca), "other-ca.pem")
+		writePem(t, otherCaFile, "CERTIFICATE", otherCa.Raw)
+
+		err := checkHealth(listener, TlsConfig{
+			CertFile: files.clientCert, KeyFile: files.clientKey, CaFile: otherCaFile, ServerSpiffeId: testServerSpiffeId,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestTlsConfig_ClientTlsConfig_ValidatesConfig(t *testing.T) {
+	_, err := TlsConfig{KeyFile: "client.key"}.ClientTlsConfig()
+	assert.Error(t, err)
+
+	_, err = TlsConfig{ServerSpiffeId: "https://armada.test/server"}.ClientTlsConfig()
+	assert.Error(t, err)
+
+	_, err = TlsConfig{CaFile: "/does/not/exist.pem"}.ClientTlsConfig()
+	assert.Error(t, err)
+
+	assert.False(t, TlsConfig{}.IsConfigured())
+}
+
+type testTlsFiles struct {
+	ca         string
+	clientCert string
+	clientKey  string
+}
+
+func checkHealth(listener *bufconn.Listener, tlsConfig TlsConfig) error {
+	conn, err := CreateApiConnection(&ApiConnectionDetails{ArmadaUrl: "bufnet", Tls: tlsConfig},
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{},
+		grpc.WaitForReady(false), grpc_retry.Disable())
+	return err
+}
+
+func withTlsServer(t *testing.T, action func(listener *bufconn.Listener, files *testTlsFiles)) {
+	dir, err := ioutil.TempDir("", "armada-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, caKey := createTestCa(t)
+	files := &testTlsFiles{
+		ca:         filepath.Join(dir, "ca.pem"),
+		clientCert: filepath.Join(dir, "client.pem"),
+		clientKey:  filepath.Join(dir, "client-key.pem"),
+	}
+	writePem(t, files.ca, "CERTIFICATE", ca.Raw)
+
+	clientCert, clientKey := createTestCertificate(t, ca, caKey, "spiffe://armada.test/executor", x509.ExtKeyUsageClientAuth)
+	writePem(t, files.clientCert, "CERTIFICATE", clientCert.Certificate[0])
+	keyBytes, err := x509.MarshalECPrivateKey(clientKey)
+	assert.NoError(t, err)
+	writePem(t, files.clientKey, "EC PRIVATE KEY", keyBytes)
+
+	serverCert, _ := createTestCertificate(t, ca, caKey, testServerSpiffeId, x509.ExtKeyUsageServerAuth)
+	clientCas := x509.NewCertPool()
+	clientCas.AddCert(ca)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCas,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	action(listener, files)
+}
+
+func createTestCa(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "armada test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	ca, err := x509.ParseCertificate(raw)
+	assert.NoError(t, err)
+	return ca, key
+}
+
+// Certificates have only a SPIFFE id as URI SAN and no host name, like SPIFFE X509-SVIDs
+func createTestCertificate(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey, spiffeId string, usage x509.ExtKeyUsage) (tls.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	id, err := url.Parse(spiffeId)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		URIs:         []*url.URL{id},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	assert.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{raw}, PrivateKey: key}, key
+}
+
+func writePem(t *testing.T, path string, blockType string, bytes []byte) {
+	err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	assert.NoError(t, err)
+}